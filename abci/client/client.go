@@ -44,6 +44,10 @@ type Client interface {
 	OfferSnapshotAsync(types.RequestOfferSnapshot, ResponseCallback) *ReqRes
 	LoadSnapshotChunkAsync(types.RequestLoadSnapshotChunk, ResponseCallback) *ReqRes
 	ApplySnapshotChunkAsync(types.RequestApplySnapshotChunk, ResponseCallback) *ReqRes
+	PrepareProposalAsync(types.RequestPrepareProposal, ResponseCallback) *ReqRes
+	ProcessProposalAsync(types.RequestProcessProposal, ResponseCallback) *ReqRes
+	ExtendVoteAsync(types.RequestExtendVote, ResponseCallback) *ReqRes
+	VerifyVoteExtensionAsync(types.RequestVerifyVoteExtension, ResponseCallback) *ReqRes
 
 	FlushSync() (*types.ResponseFlush, error)
 	EchoSync(string) (*types.ResponseEcho, error)
@@ -62,6 +66,10 @@ type Client interface {
 	OfferSnapshotSync(types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error)
 	LoadSnapshotChunkSync(types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error)
 	ApplySnapshotChunkSync(types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error)
+	PrepareProposalSync(types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error)
+	ProcessProposalSync(types.RequestProcessProposal) (*types.ResponseProcessProposal, error)
+	ExtendVoteSync(types.RequestExtendVote) (*types.ResponseExtendVote, error)
+	VerifyVoteExtensionSync(types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error)
 }
 
 //----------------------------------------
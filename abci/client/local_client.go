@@ -218,6 +218,42 @@ func (app *localClient) ApplySnapshotChunkAsync(req types.RequestApplySnapshotCh
 	return app.done(reqRes, types.ToResponseApplySnapshotChunk(res))
 }
 
+func (app *localClient) PrepareProposalAsync(req types.RequestPrepareProposal, cb ResponseCallback) *ReqRes {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	reqRes := NewReqRes(types.ToRequestPrepareProposal(req), cb)
+	res := app.Application.PrepareProposal(req)
+	return app.done(reqRes, types.ToResponsePrepareProposal(res))
+}
+
+func (app *localClient) ProcessProposalAsync(req types.RequestProcessProposal, cb ResponseCallback) *ReqRes {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	reqRes := NewReqRes(types.ToRequestProcessProposal(req), cb)
+	res := app.Application.ProcessProposal(req)
+	return app.done(reqRes, types.ToResponseProcessProposal(res))
+}
+
+func (app *localClient) ExtendVoteAsync(req types.RequestExtendVote, cb ResponseCallback) *ReqRes {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	reqRes := NewReqRes(types.ToRequestExtendVote(req), cb)
+	res := app.Application.ExtendVote(req)
+	return app.done(reqRes, types.ToResponseExtendVote(res))
+}
+
+func (app *localClient) VerifyVoteExtensionAsync(req types.RequestVerifyVoteExtension, cb ResponseCallback) *ReqRes {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	reqRes := NewReqRes(types.ToRequestVerifyVoteExtension(req), cb)
+	res := app.Application.VerifyVoteExtension(req)
+	return app.done(reqRes, types.ToResponseVerifyVoteExtension(res))
+}
+
 //-------------------------------------------------------
 func (app *localClient) FlushSync() (*types.ResponseFlush, error) {
 	return &types.ResponseFlush{}, nil
@@ -356,6 +392,38 @@ func (app *localClient) ApplySnapshotChunkSync(
 	return &res, nil
 }
 
+func (app *localClient) PrepareProposalSync(req types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.PrepareProposal(req)
+	return &res, nil
+}
+
+func (app *localClient) ProcessProposalSync(req types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.ProcessProposal(req)
+	return &res, nil
+}
+
+func (app *localClient) ExtendVoteSync(req types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.ExtendVote(req)
+	return &res, nil
+}
+
+func (app *localClient) VerifyVoteExtensionSync(req types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := app.Application.VerifyVoteExtension(req)
+	return &res, nil
+}
+
 //-------------------------------------------------------
 
 func (app *localClient) done(reqRes *ReqRes, res *types.Response) *ReqRes {
@@ -57,6 +57,10 @@ func TestLocalClientCalls(t *testing.T) {
 	c.OfferSnapshotAsync(types.RequestOfferSnapshot{}, getResponseCallback(t))
 	c.LoadSnapshotChunkAsync(types.RequestLoadSnapshotChunk{}, getResponseCallback(t))
 	c.ApplySnapshotChunkAsync(types.RequestApplySnapshotChunk{}, getResponseCallback(t))
+	c.PrepareProposalAsync(types.RequestPrepareProposal{}, getResponseCallback(t))
+	c.ProcessProposalAsync(types.RequestProcessProposal{}, getResponseCallback(t))
+	c.ExtendVoteAsync(types.RequestExtendVote{}, getResponseCallback(t))
+	c.VerifyVoteExtensionAsync(types.RequestVerifyVoteExtension{}, getResponseCallback(t))
 
 	_, err := c.EchoSync("msg")
 	require.NoError(t, err)
@@ -108,4 +112,16 @@ func TestLocalClientCalls(t *testing.T) {
 
 	_, err = c.ApplySnapshotChunkSync(types.RequestApplySnapshotChunk{})
 	require.NoError(t, err)
+
+	_, err = c.PrepareProposalSync(types.RequestPrepareProposal{})
+	require.NoError(t, err)
+
+	_, err = c.ProcessProposalSync(types.RequestProcessProposal{})
+	require.NoError(t, err)
+
+	_, err = c.ExtendVoteSync(types.RequestExtendVote{})
+	require.NoError(t, err)
+
+	_, err = c.VerifyVoteExtensionSync(types.RequestVerifyVoteExtension{})
+	require.NoError(t, err)
 }
@@ -292,6 +292,22 @@ func (cli *socketClient) ApplySnapshotChunkAsync(req types.RequestApplySnapshotC
 	return cli.queueRequest(types.ToRequestApplySnapshotChunk(req), cb)
 }
 
+func (cli *socketClient) PrepareProposalAsync(req types.RequestPrepareProposal, cb ResponseCallback) *ReqRes {
+	return cli.queueRequest(types.ToRequestPrepareProposal(req), cb)
+}
+
+func (cli *socketClient) ProcessProposalAsync(req types.RequestProcessProposal, cb ResponseCallback) *ReqRes {
+	return cli.queueRequest(types.ToRequestProcessProposal(req), cb)
+}
+
+func (cli *socketClient) ExtendVoteAsync(req types.RequestExtendVote, cb ResponseCallback) *ReqRes {
+	return cli.queueRequest(types.ToRequestExtendVote(req), cb)
+}
+
+func (cli *socketClient) VerifyVoteExtensionAsync(req types.RequestVerifyVoteExtension, cb ResponseCallback) *ReqRes {
+	return cli.queueRequest(types.ToRequestVerifyVoteExtension(req), cb)
+}
+
 //----------------------------------------
 
 func (cli *socketClient) FlushSync() (*types.ResponseFlush, error) {
@@ -448,6 +464,38 @@ func (cli *socketClient) ApplySnapshotChunkSync(
 	return reqres.Response.GetApplySnapshotChunk(), cli.Error()
 }
 
+func (cli *socketClient) PrepareProposalSync(req types.RequestPrepareProposal) (*types.ResponsePrepareProposal, error) {
+	reqres := cli.queueRequest(types.ToRequestPrepareProposal(req), nil)
+	if _, err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+	return reqres.Response.GetPrepareProposal(), cli.Error()
+}
+
+func (cli *socketClient) ProcessProposalSync(req types.RequestProcessProposal) (*types.ResponseProcessProposal, error) {
+	reqres := cli.queueRequest(types.ToRequestProcessProposal(req), nil)
+	if _, err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+	return reqres.Response.GetProcessProposal(), cli.Error()
+}
+
+func (cli *socketClient) ExtendVoteSync(req types.RequestExtendVote) (*types.ResponseExtendVote, error) {
+	reqres := cli.queueRequest(types.ToRequestExtendVote(req), nil)
+	if _, err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+	return reqres.Response.GetExtendVote(), cli.Error()
+}
+
+func (cli *socketClient) VerifyVoteExtensionSync(req types.RequestVerifyVoteExtension) (*types.ResponseVerifyVoteExtension, error) {
+	reqres := cli.queueRequest(types.ToRequestVerifyVoteExtension(req), nil)
+	if _, err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+	return reqres.Response.GetVerifyVoteExtension(), cli.Error()
+}
+
 //----------------------------------------
 
 func (cli *socketClient) queueRequest(req *types.Request, cb ResponseCallback) *ReqRes {
@@ -521,6 +569,14 @@ func resMatchesReq(req *types.Request, res *types.Response) (ok bool) {
 		_, ok = res.Value.(*types.Response_EndRecheckTx)
 	case *types.Request_ApplySnapshotChunk:
 		_, ok = res.Value.(*types.Response_ApplySnapshotChunk)
+	case *types.Request_PrepareProposal:
+		_, ok = res.Value.(*types.Response_PrepareProposal)
+	case *types.Request_ProcessProposal:
+		_, ok = res.Value.(*types.Response_ProcessProposal)
+	case *types.Request_ExtendVote:
+		_, ok = res.Value.(*types.Response_ExtendVote)
+	case *types.Request_VerifyVoteExtension:
+		_, ok = res.Value.(*types.Response_VerifyVoteExtension)
 	case *types.Request_LoadSnapshotChunk:
 		_, ok = res.Value.(*types.Response_LoadSnapshotChunk)
 	case *types.Request_ListSnapshots:
@@ -103,6 +103,9 @@ type response struct {
 	Log  string
 
 	Query *queryResponse
+
+	// process_proposal response
+	Status int32
 }
 
 type queryResponse struct {
@@ -167,6 +170,7 @@ func addCommands() {
 	RootCmd.AddCommand(deliverTxCmd)
 	RootCmd.AddCommand(checkTxCmd)
 	RootCmd.AddCommand(commitCmd)
+	RootCmd.AddCommand(processProposalCmd)
 	RootCmd.AddCommand(versionCmd)
 	RootCmd.AddCommand(testCmd)
 	addQueryFlags()
@@ -217,7 +221,7 @@ This command opens an interactive console for running any of the other commands
 without opening a new connection each time
 `,
 	Args:      cobra.ExactArgs(0),
-	ValidArgs: []string{"echo", "info", "set_option", "deliver_tx", "check_tx", "commit", "query"},
+	ValidArgs: []string{"echo", "info", "set_option", "deliver_tx", "check_tx", "commit", "query", "process_proposal"},
 	RunE:      cmdConsole,
 }
 
@@ -267,6 +271,14 @@ var commitCmd = &cobra.Command{
 	RunE:  cmdCommit,
 }
 
+var processProposalCmd = &cobra.Command{
+	Use:   "process_proposal",
+	Short: "ask the application to accept or reject a proposal of the given txs",
+	Long:  "ask the application to accept or reject a proposal of the given txs",
+	Args:  cobra.MinimumNArgs(0),
+	RunE:  cmdProcessProposal,
+}
+
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "print ABCI console version",
@@ -464,6 +476,8 @@ func muxOnCommands(cmd *cobra.Command, pArgs []string) error {
 		return cmdEcho(cmd, actualArgs)
 	case "info":
 		return cmdInfo(cmd, actualArgs)
+	case "process_proposal":
+		return cmdProcessProposal(cmd, actualArgs)
 	case "query":
 		return cmdQuery(cmd, actualArgs)
 	case "set_option":
@@ -492,6 +506,7 @@ func cmdUnimplemented(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s: %s\n", queryCmd.Use, queryCmd.Short)
 	fmt.Printf("%s: %s\n", commitCmd.Use, commitCmd.Short)
 	fmt.Printf("%s: %s\n", setOptionCmd.Use, setOptionCmd.Short)
+	fmt.Printf("%s: %s\n", processProposalCmd.Use, processProposalCmd.Short)
 	fmt.Println("Use \"[command] --help\" for more information about a command.")
 
 	return nil
@@ -614,6 +629,26 @@ func cmdCommit(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// Ask the application to accept or reject a proposal
+func cmdProcessProposal(cmd *cobra.Command, args []string) error {
+	txs := make([][]byte, len(args))
+	for i, arg := range args {
+		txBytes, err := stringOrHexToBytes(arg)
+		if err != nil {
+			return err
+		}
+		txs[i] = txBytes
+	}
+	res, err := client.ProcessProposalSync(types.RequestProcessProposal{Txs: txs})
+	if err != nil {
+		return err
+	}
+	printResponse(cmd, args, response{
+		Status: int32(res.Status),
+	})
+	return nil
+}
+
 // Query application state
 func cmdQuery(cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
@@ -794,6 +829,9 @@ func printResponse(cmd *cobra.Command, args []string, rsp response) {
 	if rsp.Log != "" {
 		fmt.Printf("-> log: %s\n", rsp.Log)
 	}
+	if cmd.Use == "process_proposal" {
+		fmt.Printf("-> status: %s\n", types.ResponseProcessProposal_ProposalStatus_name[rsp.Status])
+	}
 
 	if rsp.Query != nil {
 		fmt.Printf("-> height: %d\n", rsp.Query.Height)
@@ -129,6 +129,20 @@ func (_m *Application) EndRecheckTx(_a0 types.RequestEndRecheckTx) types.Respons
 	return r0
 }
 
+// ExtendVote provides a mock function with given fields: _a0
+func (_m *Application) ExtendVote(_a0 types.RequestExtendVote) types.ResponseExtendVote {
+	ret := _m.Called(_a0)
+
+	var r0 types.ResponseExtendVote
+	if rf, ok := ret.Get(0).(func(types.RequestExtendVote) types.ResponseExtendVote); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(types.ResponseExtendVote)
+	}
+
+	return r0
+}
+
 // Info provides a mock function with given fields: _a0
 func (_m *Application) Info(_a0 types.RequestInfo) types.ResponseInfo {
 	ret := _m.Called(_a0)
@@ -199,6 +213,34 @@ func (_m *Application) OfferSnapshot(_a0 types.RequestOfferSnapshot) types.Respo
 	return r0
 }
 
+// PrepareProposal provides a mock function with given fields: _a0
+func (_m *Application) PrepareProposal(_a0 types.RequestPrepareProposal) types.ResponsePrepareProposal {
+	ret := _m.Called(_a0)
+
+	var r0 types.ResponsePrepareProposal
+	if rf, ok := ret.Get(0).(func(types.RequestPrepareProposal) types.ResponsePrepareProposal); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(types.ResponsePrepareProposal)
+	}
+
+	return r0
+}
+
+// ProcessProposal provides a mock function with given fields: _a0
+func (_m *Application) ProcessProposal(_a0 types.RequestProcessProposal) types.ResponseProcessProposal {
+	ret := _m.Called(_a0)
+
+	var r0 types.ResponseProcessProposal
+	if rf, ok := ret.Get(0).(func(types.RequestProcessProposal) types.ResponseProcessProposal); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(types.ResponseProcessProposal)
+	}
+
+	return r0
+}
+
 // Query provides a mock function with given fields: _a0
 func (_m *Application) Query(_a0 types.RequestQuery) types.ResponseQuery {
 	ret := _m.Called(_a0)
@@ -226,3 +268,17 @@ func (_m *Application) SetOption(_a0 types.RequestSetOption) types.ResponseSetOp
 
 	return r0
 }
+
+// VerifyVoteExtension provides a mock function with given fields: _a0
+func (_m *Application) VerifyVoteExtension(_a0 types.RequestVerifyVoteExtension) types.ResponseVerifyVoteExtension {
+	ret := _m.Called(_a0)
+
+	var r0 types.ResponseVerifyVoteExtension
+	if rf, ok := ret.Get(0).(func(types.RequestVerifyVoteExtension) types.ResponseVerifyVoteExtension); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(types.ResponseVerifyVoteExtension)
+	}
+
+	return r0
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	bcproto "github.com/line/ostracon/proto/ostracon/blockchain"
+	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/types"
 )
 
@@ -83,10 +84,23 @@ func ValidateMsg(pb proto.Message) error {
 			return errors.New("negative Height")
 		}
 	case *bcproto.BlockResponse:
-		_, err := types.BlockFromProto(msg.Block)
+		block, err := types.BlockFromProto(msg.Block)
 		if err != nil {
 			return err
 		}
+		if msg.ExtCommit != nil {
+			extCommit, err := types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				return err
+			}
+			if err := extCommit.ValidateBasic(); err != nil {
+				return err
+			}
+			if extCommit.Height != block.Height {
+				return fmt.Errorf("extended commit height %v doesn't match block height %v",
+					extCommit.Height, block.Height)
+			}
+		}
 	case *bcproto.NoBlockResponse:
 		if msg.Height < 0 {
 			return errors.New("negative Height")
@@ -108,3 +122,18 @@ func ValidateMsg(pb proto.Message) error {
 	}
 	return nil
 }
+
+// VerifyExtendedCommit verifies the extended commit received along with the
+// block of the given ID and height, once the vote extensions are enabled at
+// that height, against the voters of the state right before it. The extended
+// commit is ignored before that.
+func VerifyExtendedCommit(state sm.State, blockID types.BlockID, height int64,
+	extCommit *types.ExtendedCommit) error {
+	if !types.VoteExtensionsEnabled(state.ConsensusParams.ABCI, height) {
+		return nil
+	}
+	if extCommit == nil {
+		return fmt.Errorf("no extended commit for the block at height %d", height)
+	}
+	return state.Voters.VerifyExtendedCommit(state.ChainID, blockID, height, extCommit)
+}
@@ -80,6 +80,49 @@ func TestBcStatusResponseMessageValidateBasic(t *testing.T) {
 }
 
 // nolint:lll // ignore line length in tests
+func TestBcBlockResponseMessageValidateBasic(t *testing.T) {
+	block := types.MakeBlock(int64(1), []types.Tx{types.Tx("Hello World")}, &types.Commit{}, nil, sm.InitStateVersion.Consensus)
+	block.ProposerAddress = make([]byte, 20)
+	bpb, err := block.ToProto()
+	require.NoError(t, err)
+
+	partSet := block.MakePartSet(types.BlockPartSizeBytes)
+	extCommit := func(height int64) *types.ExtendedCommit {
+		return &types.ExtendedCommit{
+			Height:             height,
+			BlockID:            types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()},
+			ExtendedSignatures: []types.ExtendedCommitSig{types.NewExtendedCommitSigAbsent()},
+		}
+	}
+
+	testCases := []struct {
+		testName  string
+		extCommit *types.ExtendedCommit
+		expectErr bool
+	}{
+		{"Without Extended Commit", nil, false},
+		{"With Extended Commit", extCommit(block.Height), false},
+		{"With Extended Commit Of Another Height", extCommit(block.Height + 1), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			response := bcproto.BlockResponse{Block: bpb, ExtCommit: tc.extCommit.ToProto()}
+			assert.Equal(t, tc.expectErr, ValidateMsg(&response) != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestVerifyExtendedCommit(t *testing.T) {
+	state := sm.State{ConsensusParams: *types.DefaultConsensusParams()}
+	state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = 10
+
+	// not needed before the vote extensions are enabled
+	assert.NoError(t, VerifyExtendedCommit(state, types.BlockID{}, 9, nil))
+	assert.Error(t, VerifyExtendedCommit(state, types.BlockID{}, 10, nil))
+}
+
 func TestBlockchainMessageVectors(t *testing.T) {
 	block := types.MakeBlock(int64(3), []types.Tx{types.Tx("Hello World")}, nil, nil, sm.InitStateVersion.Consensus)
 	block.Version.Block = 11 // overwrite updated protocol version
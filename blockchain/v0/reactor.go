@@ -5,10 +5,12 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/line/ostracon/behaviour"
 	bc "github.com/line/ostracon/blockchain"
 	"github.com/line/ostracon/libs/log"
 	"github.com/line/ostracon/p2p"
 	bcproto "github.com/line/ostracon/proto/ostracon/blockchain"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/store"
 	"github.com/line/ostracon/types"
@@ -59,6 +61,8 @@ type BlockchainReactor struct {
 
 	requestsCh <-chan BlockRequest
 	errorsCh   <-chan peerError
+
+	swReporter *behaviour.SwitchReporter
 }
 
 // NewBlockchainReactor returns new reactor instance.
@@ -100,6 +104,17 @@ func (bcR *BlockchainReactor) SetLogger(l log.Logger) {
 	bcR.pool.Logger = l
 }
 
+// SetSwitch implements Reactor by setting the switch and the reporter of peer
+// behaviour.
+func (bcR *BlockchainReactor) SetSwitch(sw *p2p.Switch) {
+	bcR.Switch = sw
+	if sw != nil {
+		bcR.swReporter = behaviour.NewSwitchReporter(sw)
+	} else {
+		bcR.swReporter = nil
+	}
+}
+
 // OnStart implements service.Service.
 func (bcR *BlockchainReactor) OnStart() error {
 	// call BaseReactor's OnStart()
@@ -189,7 +204,12 @@ func (bcR *BlockchainReactor) respondToPeer(msg *bcproto.BlockRequest,
 			return false
 		}
 
-		msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: bl})
+		var extCommit *tmproto.ExtendedCommit
+		if ec := bcR.store.LoadBlockExtendedCommit(msg.Height); ec != nil {
+			extCommit = ec.ToProto()
+		}
+
+		msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: bl, ExtCommit: extCommit})
 		if err != nil {
 			bcR.Logger.Error("could not marshal msg", "err", err)
 			return false
@@ -214,13 +234,13 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 	msg, err := bc.DecodeMsg(msgBytes)
 	if err != nil {
 		bcR.Logger.Error("Error decoding message", "src", src, "chId", chID, "err", err)
-		bcR.Switch.StopPeerForError(src, err)
+		_ = bcR.swReporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
 	if err = bc.ValidateMsg(msg); err != nil {
 		bcR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		bcR.Switch.StopPeerForError(src, err)
+		_ = bcR.swReporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
@@ -235,7 +255,15 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 			bcR.Logger.Error("Block content is invalid", "err", err)
 			return
 		}
-		bcR.pool.AddBlock(src.ID(), bi, len(msgBytes))
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				bcR.Logger.Error("Extended commit content is invalid", "err", err)
+				return
+			}
+		}
+		bcR.pool.AddBlock(src.ID(), bi, extCommit, len(msgBytes))
 	case *bcproto.StatusRequest:
 		// Send peer our state.
 		msgBytes, err := bc.EncodeMsg(&bcproto.StatusResponse{
@@ -356,7 +384,7 @@ FOR_LOOP:
 			// routine.
 
 			// See if there are any blocks to sync.
-			first, second := bcR.pool.PeekTwoBlocks()
+			first, second, extCommit := bcR.pool.PeekTwoBlocks()
 			// bcR.Logger.Info("TrySync peeked", "first", first, "second", second)
 			if first == nil || second == nil {
 				// We need both to sync the first block.
@@ -366,7 +394,7 @@ FOR_LOOP:
 				didProcessCh <- struct{}{}
 			}
 
-			firstParts := first.MakePartSet(types.BlockPartSizeBytes)
+			firstParts := first.MakePartSetWithParity(types.BlockPartSizeBytes, state.ConsensusParams.Block.ErasureParityPercent)
 			firstPartSetHeader := firstParts.Header()
 			firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
 			// Finally, verify the first block using the second's commit
@@ -378,6 +406,10 @@ FOR_LOOP:
 				// validate the block before we persist it
 				err = bcR.blockExec.ValidateBlock(state, first.Round, first)
 			}
+			if err == nil {
+				// and its extended commit, which we keep for the next proposer
+				err = bc.VerifyExtendedCommit(state, firstID, first.Height, extCommit)
+			}
 
 			// If either of the checks failed we log the error and request for a new block
 			// at that height
@@ -402,7 +434,11 @@ FOR_LOOP:
 				bcR.pool.PopRequest()
 
 				// TODO: batch saves so we dont persist to disk every block
-				bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+				if types.VoteExtensionsEnabled(state.ConsensusParams.ABCI, first.Height) {
+					bcR.store.SaveBlockWithExtendedCommit(first, firstParts, extCommit)
+				} else {
+					bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+				}
 
 				// TODO: same thing for app - but we would need a way to
 				// get the hash without persisting the state
@@ -17,6 +17,7 @@ import (
 	"github.com/line/ostracon/libs/log"
 	"github.com/line/ostracon/mempool/mock"
 	"github.com/line/ostracon/p2p"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/ostracon/proxy"
 	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/store"
@@ -119,12 +120,18 @@ func newBlockchainReactor(
 		thisParts := thisBlock.MakePartSet(types.BlockPartSizeBytes)
 		blockID := types.BlockID{Hash: thisBlock.Hash(), PartSetHeader: thisParts.Header()}
 
+		extensionsEnabled := types.VoteExtensionsEnabled(state.ConsensusParams.ABCI, blockHeight)
 		state, _, err = blockExec.ApplyBlock(state, blockID, thisBlock, nil)
 		if err != nil {
 			panic(fmt.Errorf("error apply block: %w", err))
 		}
 
-		blockStore.SaveBlock(thisBlock, thisParts, lastCommit)
+		if extensionsEnabled {
+			blockStore.SaveBlockWithExtendedCommit(thisBlock, thisParts,
+				makeExtendedCommit(blockHeight, blockID, state.Validators, privVals[0], genDoc.ChainID))
+		} else {
+			blockStore.SaveBlock(thisBlock, thisParts, lastCommit)
+		}
 	}
 
 	bcReactor := NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync, async, recvBufSize)
@@ -192,6 +199,53 @@ func TestNoBlockResponse(t *testing.T) {
 	}
 }
 
+func TestBlockResponseWithExtendedCommit(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+	genDoc.ConsensusParams = types.DefaultConsensusParams()
+	genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight = 10
+
+	maxBlockHeight := int64(20)
+
+	reactorPairs := make([]BlockchainReactorPair, 2)
+
+	reactorPairs[0] = newBlockchainReactor(log.TestingLogger(), genDoc, privVals, maxBlockHeight,
+		config.P2P.RecvAsync, config.P2P.BlockchainRecvBufSize)
+	reactorPairs[1] = newBlockchainReactor(log.TestingLogger(), genDoc, privVals, 0,
+		config.P2P.RecvAsync, config.P2P.BlockchainRecvBufSize)
+
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch, config *cfg.P2PConfig) *p2p.Switch {
+		s.AddReactor("BLOCKCHAIN", reactorPairs[i].reactor)
+		return s
+
+	}, p2p.Connect2Switches)
+
+	defer func() {
+		for _, r := range reactorPairs {
+			err := r.reactor.Stop()
+			require.NoError(t, err)
+			err = r.app.Stop()
+			require.NoError(t, err)
+		}
+	}()
+
+	// the last block isn't synced, as there is no next one to verify it with
+	store := reactorPairs[1].reactor.store
+	require.Eventually(t, func() bool {
+		return store.Height() == maxBlockHeight-1
+	}, 10*time.Second, 10*time.Millisecond)
+	for height := int64(1); height < maxBlockHeight; height++ {
+		extCommit := store.LoadBlockExtendedCommit(height)
+		if height < genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight {
+			assert.Nil(t, extCommit, "height %d", height)
+		} else if assert.NotNil(t, extCommit, "height %d", height) {
+			assert.NoError(t, extCommit.EnsureExtensions())
+			assert.Equal(t, store.LoadBlockMeta(height).BlockID, extCommit.BlockID)
+		}
+	}
+}
+
 // NOTE: This is too hard to test without
 // an easy way to add test peer to switch
 // or without significant refactoring of the module.
@@ -311,6 +365,38 @@ func makeBlock(privVal types.PrivValidator, height int64, state sm.State, lastCo
 	return block
 }
 
+func makeExtendedCommit(height int64, blockID types.BlockID, valSet *types.ValidatorSet,
+	privVal types.PrivValidator, chainID string) *types.ExtendedCommit {
+	pubKey, err := privVal.GetPubKey()
+	if err != nil {
+		panic(err)
+	}
+	idx, _ := valSet.GetByAddress(pubKey.Address())
+	vote := &types.Vote{
+		ValidatorAddress: pubKey.Address(),
+		ValidatorIndex:   idx,
+		Height:           height,
+		Round:            0,
+		Timestamp:        tmtime.Now(),
+		Type:             tmproto.PrecommitType,
+		BlockID:          blockID,
+		Extension:        []byte("extension"),
+	}
+	v := vote.ToProto()
+	if err := privVal.SignVote(chainID, v, true); err != nil {
+		panic(err)
+	}
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+
+	return &types.ExtendedCommit{
+		Height:             height,
+		Round:              0,
+		BlockID:            blockID,
+		ExtendedSignatures: []types.ExtendedCommitSig{vote.ExtendedCommitSig()},
+	}
+}
+
 type testApp struct {
 	abci.BaseApplication
 }
@@ -243,8 +243,10 @@ func (pool *BlockPool) sendRequest(height int64) bool {
 	return false
 }
 
-// AddBlock validates that the block comes from the peer it was expected from and stores it in the 'blocks' map.
-func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, blockSize int) error {
+// AddBlock validates that the block comes from the peer it was expected from and stores it in the 'blocks' map,
+// along with its extended commit, if any.
+func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, extCommit *types.ExtendedCommit,
+	blockSize int) error {
 	peer, ok := pool.peers[peerID]
 	if !ok {
 		pool.logger.Error("block from unknown peer", "height", block.Height, "peer", peerID)
@@ -256,13 +258,21 @@ func (pool *BlockPool) AddBlock(peerID p2p.ID, block *types.Block, blockSize int
 		return errBadDataFromPeer
 	}
 
-	return peer.AddBlock(block, blockSize)
+	if extCommit != nil && extCommit.Height != block.Height {
+		pool.logger.Error("extended commit of another height than the block", "height", block.Height,
+			"extCommitHeight", extCommit.Height, "peer", peerID)
+		return errBadDataFromPeer
+	}
+
+	return peer.AddBlock(block, extCommit, blockSize)
 }
 
-// BlockData stores the peer responsible to deliver a block and the actual block if delivered.
+// BlockData stores the peer responsible to deliver a block and the actual block, with its extended commit, if
+// delivered.
 type BlockData struct {
-	block *types.Block
-	peer  *BpPeer
+	block     *types.Block
+	extCommit *types.ExtendedCommit
+	peer      *BpPeer
 }
 
 // BlockAndPeerAtHeight retrieves the block and delivery peer at specified height.
@@ -279,7 +289,7 @@ func (pool *BlockPool) BlockAndPeerAtHeight(height int64) (bData *BlockData, err
 		return nil, err
 	}
 
-	return &BlockData{peer: peer, block: block}, nil
+	return &BlockData{peer: peer, block: block, extCommit: peer.ExtendedCommitAtHeight(height)}, nil
 
 }
 
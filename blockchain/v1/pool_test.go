@@ -84,6 +84,7 @@ func makeBlockPool(bcr *testBcR, height int64, peers []BpPeer, blocks map[int64]
 			// simulate that a block at height h has been received
 			_ = bPool.peers[p.id].AddBlock(
 				types.MakeBlock(h, txs, nil, nil, sm.InitStateVersion.Consensus),
+				nil,
 				100)
 		}
 	}
@@ -462,7 +463,7 @@ func TestBlockPoolAddBlock(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.pool.AddBlock(tt.args.peerID, tt.args.block, tt.args.blockSize)
+			err := tt.pool.AddBlock(tt.args.peerID, tt.args.block, nil, tt.args.blockSize)
 			assert.Equal(t, tt.errWanted, err)
 			assertBlockPoolEquivalent(t, tt.poolWanted, tt.pool)
 		})
@@ -10,6 +10,7 @@ import (
 	"github.com/line/ostracon/libs/log"
 	"github.com/line/ostracon/p2p"
 	bcproto "github.com/line/ostracon/proto/ostracon/blockchain"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/store"
 	"github.com/line/ostracon/types"
@@ -213,7 +214,11 @@ func (bcR *BlockchainReactor) sendBlockToPeer(msg *bcproto.BlockRequest,
 			bcR.Logger.Error("Could not send block message to peer", "err", err)
 			return false
 		}
-		msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: pbbi})
+		var extCommit *tmproto.ExtendedCommit
+		if ec := bcR.store.LoadBlockExtendedCommit(msg.Height); ec != nil {
+			extCommit = ec.ToProto()
+		}
+		msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: pbbi, ExtCommit: extCommit})
 		if err != nil {
 			bcR.Logger.Error("unable to marshal msg", "err", err)
 			return false
@@ -293,13 +298,22 @@ func (bcR *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte)
 			bcR.Logger.Error("error transition block from protobuf", "err", err)
 			return
 		}
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				bcR.Logger.Error("error transition extended commit from protobuf", "err", err)
+				return
+			}
+		}
 		msgForFSM := bcReactorMessage{
 			event: blockResponseEv,
 			data: bReactorEventData{
-				peerID: src.ID(),
-				height: bi.Height,
-				block:  bi,
-				length: len(msgBytes),
+				peerID:    src.ID(),
+				height:    bi.Height,
+				block:     bi,
+				extCommit: extCommit,
+				length:    len(msgBytes),
 			},
 		}
 		bcR.Logger.Info("Received", "src", src, "height", bi.Height)
@@ -464,7 +478,7 @@ func (bcR *BlockchainReactor) reportPeerErrorToSwitch(err error, peerID p2p.ID)
 
 func (bcR *BlockchainReactor) processBlock() error {
 
-	first, second, err := bcR.fsm.FirstTwoBlocks()
+	first, second, extCommit, err := bcR.fsm.FirstTwoBlocks()
 	if err != nil {
 		// We need both to sync the first block.
 		return err
@@ -472,7 +486,8 @@ func (bcR *BlockchainReactor) processBlock() error {
 
 	chainID := bcR.initialState.ChainID
 
-	firstParts := first.MakePartSet(types.BlockPartSizeBytes)
+	firstParts := first.MakePartSetWithParity(types.BlockPartSizeBytes,
+		bcR.state.ConsensusParams.Block.ErasureParityPercent)
 	firstPartSetHeader := firstParts.Header()
 	firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
 	// Finally, verify the first block using the second's commit
@@ -485,8 +500,18 @@ func (bcR *BlockchainReactor) processBlock() error {
 			"first", first.Height, "second", second.Height)
 		return errBlockVerificationFailure
 	}
+	err = bc.VerifyExtendedCommit(bcR.state, firstID, first.Height, extCommit)
+	if err != nil {
+		bcR.Logger.Error("error during extended commit verification", "err", err,
+			"first", first.Height)
+		return errBlockVerificationFailure
+	}
 
-	bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+	if types.VoteExtensionsEnabled(bcR.state.ConsensusParams.ABCI, first.Height) {
+		bcR.store.SaveBlockWithExtendedCommit(first, firstParts, extCommit)
+	} else {
+		bcR.store.SaveBlock(first, firstParts, second.LastCommit)
+	}
 
 	bcR.state, _, err = bcR.blockExec.ApplyBlock(bcR.state, firstID, first, nil)
 	if err != nil {
@@ -57,13 +57,14 @@ func NewFSM(height int64, toBcR bcReactor) *BcReactorFSM {
 // bReactorEventData is part of the message sent by the reactor to the FSM and used by the state handlers.
 type bReactorEventData struct {
 	peerID         p2p.ID
-	err            error        // for peer error: timeout, slow; for processed block event if error occurred
-	base           int64        // for status response
-	height         int64        // for status response; for processed block event
-	block          *types.Block // for block response
-	stateName      string       // for state timeout events
-	length         int          // for block response event, length of received block, used to detect slow peers
-	maxNumRequests int          // for request needed event, maximum number of pending requests
+	err            error                 // for peer error: timeout, slow; for processed block event if error occurred
+	base           int64                 // for status response
+	height         int64                 // for status response; for processed block event
+	block          *types.Block          // for block response
+	extCommit      *types.ExtendedCommit // for block response, the extended commit of the block, if any
+	stateName      string                // for state timeout events
+	length         int                   // for block response event, length of received block, used to detect slow peers
+	maxNumRequests int                   // for request needed event, maximum number of pending requests
 }
 
 // Blockchain Reactor Events (the input to the state machine)
@@ -264,7 +265,7 @@ func init() {
 
 			case blockResponseEv:
 				fsm.logger.Debug("blockResponseEv", "H", data.block.Height)
-				err := fsm.pool.AddBlock(data.peerID, data.block, data.length)
+				err := fsm.pool.AddBlock(data.peerID, data.block, data.extCommit, data.length)
 				if err != nil {
 					// A block was received that was unsolicited, from unexpected peer, or that we already have it.
 					// Ignore block, remove peer and send error to switch.
@@ -442,14 +443,16 @@ func (fsm *BcReactorFSM) NeedsBlocks() bool {
 	return fsm.state.name == "waitForBlock" && fsm.pool.NeedsBlocks()
 }
 
-// FirstTwoBlocks returns the two blocks at pool height and height+1
-func (fsm *BcReactorFSM) FirstTwoBlocks() (first, second *types.Block, err error) {
+// FirstTwoBlocks returns the two blocks at pool height and height+1, and the extended commit of the first, if any
+func (fsm *BcReactorFSM) FirstTwoBlocks() (first, second *types.Block, firstExtCommit *types.ExtendedCommit,
+	err error) {
 	fsm.mtx.Lock()
 	defer fsm.mtx.Unlock()
 	firstBP, secondBP, err := fsm.pool.FirstTwoBlocksAndPeers()
 	if err == nil {
 		first = firstBP.block
 		second = secondBP.block
+		firstExtCommit = firstBP.extCommit
 	}
 	return
 }
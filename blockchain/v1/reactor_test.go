@@ -71,12 +71,49 @@ func makeVote(
 
 	vpb := vote.ToProto()
 
-	_ = privVal.SignVote(header.ChainID, vpb)
+	_ = privVal.SignVote(header.ChainID, vpb, false)
 	vote.Signature = vpb.Signature
 
 	return vote
 }
 
+func makeExtendedCommit(
+	t *testing.T,
+	height int64,
+	blockID types.BlockID,
+	valset *types.ValidatorSet,
+	privVal types.PrivValidator,
+	chainID string) *types.ExtendedCommit {
+
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	valIdx, _ := valset.GetByAddress(pubKey.Address())
+	vote := &types.Vote{
+		ValidatorAddress: pubKey.Address(),
+		ValidatorIndex:   valIdx,
+		Height:           height,
+		Round:            1,
+		Timestamp:        tmtime.Now(),
+		Type:             tmproto.PrecommitType,
+		BlockID:          blockID,
+		Extension:        []byte("extension"),
+	}
+
+	vpb := vote.ToProto()
+
+	require.NoError(t, privVal.SignVote(chainID, vpb, true))
+	vote.Signature = vpb.Signature
+	vote.ExtensionSignature = vpb.ExtensionSignature
+
+	return &types.ExtendedCommit{
+		Height:             height,
+		Round:              vote.Round,
+		BlockID:            blockID,
+		ExtendedSignatures: []types.ExtendedCommitSig{vote.ExtendedCommitSig()},
+	}
+}
+
 type BlockchainReactorPair struct {
 	bcR  *BlockchainReactor
 	conR *consensusReactorTest
@@ -138,12 +175,18 @@ func newBlockchainReactor(
 		thisParts := thisBlock.MakePartSet(types.BlockPartSizeBytes)
 		blockID := types.BlockID{Hash: thisBlock.Hash(), PartSetHeader: thisParts.Header()}
 
+		extensionsEnabled := types.VoteExtensionsEnabled(state.ConsensusParams.ABCI, blockHeight)
 		state, _, err = blockExec.ApplyBlock(state, blockID, thisBlock, nil)
 		if err != nil {
 			panic(fmt.Errorf("error apply block: %w", err))
 		}
 
-		blockStore.SaveBlock(thisBlock, thisParts, lastCommit)
+		if extensionsEnabled {
+			blockStore.SaveBlockWithExtendedCommit(thisBlock, thisParts,
+				makeExtendedCommit(t, blockHeight, blockID, state.Validators, privVals[0], genDoc.ChainID))
+		} else {
+			blockStore.SaveBlock(thisBlock, thisParts, lastCommit)
+		}
 	}
 
 	bcReactor := NewBlockchainReactor(state.Copy(), blockExec, blockStore, fastSync,
@@ -243,6 +286,58 @@ func TestFastSyncNoBlockResponse(t *testing.T) {
 	}
 }
 
+func TestFastSyncExtendedCommit(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_new_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+	genDoc.ConsensusParams = types.DefaultConsensusParams()
+	genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight = 10
+
+	maxBlockHeight := int64(20)
+
+	reactorPairs := make([]BlockchainReactorPair, 2)
+
+	logger := log.TestingLogger()
+	reactorPairs[0] = newBlockchainReactorPair(t, logger, genDoc, privVals, maxBlockHeight)
+	reactorPairs[1] = newBlockchainReactorPair(t, logger, genDoc, privVals, 0)
+
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch, config *cfg.P2PConfig) *p2p.Switch {
+		s.AddReactor("BLOCKCHAIN", reactorPairs[i].bcR)
+		s.AddReactor("CONSENSUS", reactorPairs[i].conR)
+		moduleName := fmt.Sprintf("blockchain-%v", i)
+		reactorPairs[i].bcR.SetLogger(logger.With("module", moduleName))
+
+		return s
+
+	}, p2p.Connect2Switches)
+
+	defer func() {
+		for _, r := range reactorPairs {
+			_ = r.bcR.Stop()
+			_ = r.conR.Stop()
+		}
+	}()
+
+	require.Eventually(t, func() bool {
+		reactorPairs[1].conR.mtx.Lock()
+		defer reactorPairs[1].conR.mtx.Unlock()
+		return reactorPairs[1].conR.switchedToConsensus
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the last block isn't synced, as there is no next one to verify it with
+	store := reactorPairs[1].bcR.store
+	require.Equal(t, maxBlockHeight-1, store.Height())
+	for height := int64(1); height < maxBlockHeight; height++ {
+		extCommit := store.LoadBlockExtendedCommit(height)
+		if height < genDoc.ConsensusParams.ABCI.VoteExtensionsEnableHeight {
+			assert.Nil(t, extCommit, "height %d", height)
+		} else if assert.NotNil(t, extCommit, "height %d", height) {
+			assert.NoError(t, extCommit.EnsureExtensions())
+			assert.Equal(t, store.LoadBlockMeta(height).BlockID, extCommit.BlockID)
+		}
+	}
+}
+
 // NOTE: This is too hard to test without
 // an easy way to add test peer to switch
 // or without significant refactoring of the module.
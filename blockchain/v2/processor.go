@@ -45,8 +45,9 @@ func (p pcFinished) Error() string {
 }
 
 type queueItem struct {
-	block  *types.Block
-	peerID p2p.ID
+	block     *types.Block
+	extCommit *types.ExtendedCommit
+	peerID    p2p.ID
 }
 
 type blockQueue map[int64]queueItem
@@ -95,14 +96,14 @@ func (state *pcState) synced() bool {
 	return len(state.queue) <= 1
 }
 
-func (state *pcState) enqueue(peerID p2p.ID, block *types.Block, height int64) {
+func (state *pcState) enqueue(peerID p2p.ID, block *types.Block, extCommit *types.ExtendedCommit, height int64) {
 	if item, ok := state.queue[height]; ok {
 		panic(fmt.Sprintf(
 			"duplicate block %d (%X) enqueued by processor (sent by %v; existing block %X from %v)",
 			height, block.Hash(), peerID, item.block.Hash(), item.peerID))
 	}
 
-	state.queue[height] = queueItem{block: block, peerID: peerID}
+	state.queue[height] = queueItem{block: block, extCommit: extCommit, peerID: peerID}
 }
 
 func (state *pcState) height() int64 {
@@ -144,7 +145,7 @@ func (state *pcState) handle(event Event) (Event, error) {
 
 		// enqueue block if height is higher than state height, else ignore it
 		if event.block.Height > state.height() {
-			state.enqueue(event.peerID, event.block, event.block.Height)
+			state.enqueue(event.peerID, event.block, event.extCommit, event.block.Height)
 		}
 		return noOp, nil
 
@@ -160,12 +161,17 @@ func (state *pcState) handle(event Event) (Event, error) {
 
 		var (
 			first, second = firstItem.block, secondItem.block
-			firstParts    = first.MakePartSet(types.BlockPartSizeBytes)
+			parityPercent = tmState.ConsensusParams.Block.ErasureParityPercent
+			firstParts    = first.MakePartSetWithParity(types.BlockPartSizeBytes, parityPercent)
 			firstID       = types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
 		)
 
 		// verify if +second+ last commit "confirms" +first+ block
 		err = state.context.verifyCommit(tmState.ChainID, firstID, first.Height, second.LastCommit)
+		if err == nil {
+			// and the extended commit of +first+, which we keep for the next proposer
+			err = state.context.verifyExtendedCommit(firstID, first.Height, firstItem.extCommit)
+		}
 		if err != nil {
 			state.purgePeer(firstItem.peerID)
 			if firstItem.peerID != secondItem.peerID {
@@ -176,7 +182,7 @@ func (state *pcState) handle(event Event) (Event, error) {
 				nil
 		}
 
-		state.context.saveBlock(first, firstParts, second.LastCommit)
+		state.context.saveBlock(first, firstParts, second.LastCommit, firstItem.extCommit)
 
 		if err := state.context.applyBlock(firstID, first); err != nil {
 			panic(fmt.Sprintf("failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
@@ -3,6 +3,7 @@ package v2
 import (
 	"fmt"
 
+	bc "github.com/line/ostracon/blockchain"
 	"github.com/line/ostracon/state"
 	"github.com/line/ostracon/types"
 )
@@ -10,7 +11,9 @@ import (
 type processorContext interface {
 	applyBlock(blockID types.BlockID, block *types.Block) error
 	verifyCommit(chainID string, blockID types.BlockID, height int64, commit *types.Commit) error
-	saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
+	verifyExtendedCommit(blockID types.BlockID, height int64, extCommit *types.ExtendedCommit) error
+	saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit,
+		seenExtCommit *types.ExtendedCommit)
 	tmState() state.State
 	setState(state.State)
 }
@@ -47,7 +50,18 @@ func (pc pContext) verifyCommit(chainID string, blockID types.BlockID, height in
 	return pc.state.Voters.VerifyCommitLight(chainID, blockID, height, commit)
 }
 
-func (pc *pContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
+func (pc pContext) verifyExtendedCommit(blockID types.BlockID, height int64, extCommit *types.ExtendedCommit) error {
+	return bc.VerifyExtendedCommit(pc.state, blockID, height, extCommit)
+}
+
+// saveBlock saves the extended commit along with the block once the vote
+// extensions are enabled at its height, and only the seen commit before.
+func (pc *pContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit,
+	seenExtCommit *types.ExtendedCommit) {
+	if types.VoteExtensionsEnabled(pc.state.ConsensusParams.ABCI, block.Height) {
+		pc.store.SaveBlockWithExtendedCommit(block, blockParts, seenExtCommit)
+		return
+	}
 	pc.store.SaveBlock(block, blockParts, seenCommit)
 }
 
@@ -87,7 +101,13 @@ func (mpc *mockPContext) verifyCommit(chainID string, blockID types.BlockID, hei
 	return nil
 }
 
-func (mpc *mockPContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
+func (mpc *mockPContext) verifyExtendedCommit(blockID types.BlockID, height int64,
+	extCommit *types.ExtendedCommit) error {
+	return nil
+}
+
+func (mpc *mockPContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit,
+	seenExtCommit *types.ExtendedCommit) {
 
 }
 
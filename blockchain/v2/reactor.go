@@ -22,7 +22,9 @@ const (
 
 type blockStore interface {
 	LoadBlock(height int64) *types.Block
+	LoadBlockExtendedCommit(height int64) *types.ExtendedCommit
 	SaveBlock(*types.Block, *types.PartSet, *types.Commit)
+	SaveBlockWithExtendedCommit(*types.Block, *types.PartSet, *types.ExtendedCommit)
 	Base() int64
 	Height() int64
 }
@@ -214,10 +216,11 @@ func (e rProcessBlock) String() string {
 // blockResponse message received from a peer
 type bcBlockResponse struct {
 	priorityNormal
-	time   time.Time
-	peerID p2p.ID
-	size   int64
-	block  *types.Block
+	time      time.Time
+	peerID    p2p.ID
+	size      int64
+	block     *types.Block
+	extCommit *types.ExtendedCommit
 }
 
 func (resp bcBlockResponse) String() string {
@@ -482,7 +485,8 @@ func (r *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	case *bcproto.BlockRequest:
 		block := r.store.LoadBlock(msg.Height)
 		if block != nil {
-			if err = r.io.sendBlockToPeer(block, src.ID()); err != nil {
+			extCommit := r.store.LoadBlockExtendedCommit(msg.Height)
+			if err = r.io.sendBlockToPeer(block, extCommit, src.ID()); err != nil {
 				r.logger.Error("Could not send block message to peer: ", err)
 			}
 		} else {
@@ -506,13 +510,22 @@ func (r *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			r.logger.Error("error transitioning block from protobuf", "err", err)
 			return
 		}
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				r.logger.Error("error transitioning extended commit from protobuf", "err", err)
+				return
+			}
+		}
 		r.mtx.RLock()
 		if r.events != nil {
 			r.events <- bcBlockResponse{
-				peerID: src.ID(),
-				block:  bi,
-				size:   int64(len(msgBytes)),
-				time:   time.Now(),
+				peerID:    src.ID(),
+				block:     bi,
+				extCommit: extCommit,
+				size:      int64(len(msgBytes)),
+				time:      time.Now(),
 			}
 		}
 		r.mtx.RUnlock()
@@ -75,11 +75,22 @@ func (ml *mockBlockStore) LoadBlock(height int64) *types.Block {
 	return ml.blocks[height]
 }
 
+// nolint:unused // ignore
+func (ml *mockBlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	return nil
+}
+
 // nolint:unused // ignore
 func (ml *mockBlockStore) SaveBlock(block *types.Block, part *types.PartSet, commit *types.Commit) {
 	ml.blocks[block.Height] = block
 }
 
+// nolint:unused // ignore
+func (ml *mockBlockStore) SaveBlockWithExtendedCommit(block *types.Block, part *types.PartSet,
+	extCommit *types.ExtendedCommit) {
+	ml.blocks[block.Height] = block
+}
+
 type mockBlockApplier struct {
 }
 
@@ -110,7 +121,7 @@ func (sio *mockSwitchIo) sendStatusResponse(base, height int64, peerID p2p.ID) e
 	return nil
 }
 
-func (sio *mockSwitchIo) sendBlockToPeer(block *types.Block, peerID p2p.ID) error {
+func (sio *mockSwitchIo) sendBlockToPeer(block *types.Block, extCommit *types.ExtendedCommit, peerID p2p.ID) error {
 	sio.mtx.Lock()
 	defer sio.mtx.Unlock()
 	sio.numBlockResponse++
@@ -37,8 +37,9 @@ func (e scBlockRequest) String() string {
 // a block has been received and validated by the scheduler
 type scBlockReceived struct {
 	priorityNormal
-	peerID p2p.ID
-	block  *types.Block
+	peerID    p2p.ID
+	block     *types.Block
+	extCommit *types.ExtendedCommit
 }
 
 func (e scBlockReceived) String() string {
@@ -538,7 +539,7 @@ func (sc *scheduler) handleBlockResponse(event bcBlockResponse) (Event, error) {
 		return scPeerError{peerID: event.peerID, reason: err}, nil
 	}
 
-	return scBlockReceived{peerID: event.peerID, block: event.block}, nil
+	return scBlockReceived{peerID: event.peerID, block: event.block, extCommit: event.extCommit}, nil
 }
 
 func (sc *scheduler) handleNoBlockResponse(event bcNoBlockResponse) (Event, error) {
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/ostracon/libs/log"
+)
+
+// CompactGoLevelDBCmd is the command to compact the goleveldb databases of a
+// stopped node.
+var CompactGoLevelDBCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Compact the goleveldb databases of a stopped node",
+	Long: `
+The compact command compacts the block store, state, evidence and tx_index
+databases of the node, which must not be running, so that the disk space of
+the keys deleted by pruning is reclaimed.
+
+Only the goleveldb backend is supported.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if dbm.BackendType(config.DBBackend) != dbm.GoLevelDBBackend {
+			return errors.New("compaction is only supported for the goleveldb backend")
+		}
+		return compactGoLevelDBs(config.DBDir(), logger)
+	},
+}
+
+// compactGoLevelDBs compacts the goleveldb databases of the node in dbDir
+// concurrently.
+func compactGoLevelDBs(dbDir string, logger log.Logger) error {
+	dbNames := []string{"blockstore", "state", "evidence", "tx_index"}
+	errs := make([]error, len(dbNames))
+
+	var wg sync.WaitGroup
+	for i, name := range dbNames {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			errs[i] = compactGoLevelDB(filepath.Join(dbDir, name+".db"), logger.With("db", name))
+		}(i, name)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("failed to compact %s database: %w", dbNames[i], err)
+		}
+	}
+	return nil
+}
+
+func compactGoLevelDB(path string, logger log.Logger) error {
+	db, err := leveldb.OpenFile(path, &opt.Options{ErrorIfMissing: true})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	logger.Info("starting compaction")
+	if err := db.CompactRange(util.Range{}); err != nil {
+		return err
+	}
+	logger.Info("finished compaction")
+	return nil
+}
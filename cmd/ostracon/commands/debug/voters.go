@@ -0,0 +1,174 @@
+package debug
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+
+	cfg "github.com/line/ostracon/config"
+	sm "github.com/line/ostracon/state"
+	"github.com/line/ostracon/types"
+)
+
+var (
+	fromHeight        int64
+	toHeight          int64
+	simulations       int
+	simulationSeed    int64
+	electionThreshold int32
+	byzantinePercent  int32
+
+	flagFromHeight        = "from"
+	flagToHeight          = "to"
+	flagSimulations       = "simulate"
+	flagSimulationSeed    = "seed"
+	flagElectionThreshold = "voter-election-threshold"
+	flagByzantinePercent  = "max-tolerable-byzantine-percentage"
+)
+
+var votersCmd = &cobra.Command{
+	Use:   "voters",
+	Short: "Replay or simulate voter elections and report how often each validator is elected",
+	Long: `Replay the voter elections of a range of heights from the validator sets and
+proof hashes in the state database, or, with --simulate, run the given number of
+elections over the latest validator set with random proof hashes.
+
+For each validator, the number of elections it took part in and was elected in,
+its election frequency and its expected share of the rewards are reported,
+along with the share of the voting weight a byzantine attacker holding the
+tolerable byzantine voting power could control.
+
+The node must not be running, as the state database is opened directly. The
+voter params may be overridden to see how elections would turn out with them.`,
+	Args: cobra.NoArgs,
+	RunE: votersCmdHandler,
+}
+
+func init() {
+	votersCmd.Flags().Int64Var(
+		&fromHeight,
+		flagFromHeight,
+		0,
+		"the first height to replay (defaults to 100 heights before --to)",
+	)
+	votersCmd.Flags().Int64Var(
+		&toHeight,
+		flagToHeight,
+		0,
+		"the last height to replay (defaults to the latest height)",
+	)
+	votersCmd.Flags().IntVar(
+		&simulations,
+		flagSimulations,
+		0,
+		"the number of elections to simulate with random proof hashes instead of replaying",
+	)
+	votersCmd.Flags().Int64Var(
+		&simulationSeed,
+		flagSimulationSeed,
+		0,
+		"the seed of the random proof hashes of the simulated elections",
+	)
+	votersCmd.Flags().Int32Var(
+		&electionThreshold,
+		flagElectionThreshold,
+		-1,
+		"override the voter election threshold of the voter params",
+	)
+	votersCmd.Flags().Int32Var(
+		&byzantinePercent,
+		flagByzantinePercent,
+		-1,
+		"override the max tolerable byzantine percentage of the voter params",
+	)
+}
+
+func votersCmdHandler(_ *cobra.Command, _ []string) error {
+	conf := cfg.DefaultConfig()
+	if err := viper.Unmarshal(conf); err != nil {
+		return err
+	}
+	conf.SetRoot(conf.RootDir)
+
+	stateDB, err := dbm.NewDB("state", dbm.BackendType(conf.DBBackend), conf.DBDir())
+	if err != nil {
+		return fmt.Errorf("failed to open state database: %w", err)
+	}
+	stateStore := sm.NewStore(stateDB)
+	defer func() {
+		_ = stateStore.Close()
+	}()
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	if state.IsEmpty() {
+		return errors.New("no state found")
+	}
+
+	var stats *types.VoterElectionStats
+	if simulations > 0 {
+		stats = types.SimulateVoterElections(state.Validators, overrideVoterParams(state.VoterParams),
+			simulations, simulationSeed)
+	} else {
+		to := toHeight
+		if to == 0 {
+			to = state.LastBlockHeight
+		}
+		from := fromHeight
+		if from == 0 {
+			from = to - 99
+			if from < state.InitialHeight {
+				from = state.InitialHeight
+			}
+		}
+
+		var voterParams *types.VoterParams
+		if electionThreshold >= 0 || byzantinePercent >= 0 {
+			voterParams = overrideVoterParams(state.VoterParams)
+		}
+		stats, err = sm.ReplayVoterElections(stateStore, from, to, voterParams)
+		if err != nil {
+			return err
+		}
+	}
+
+	return printVoterElectionStats(os.Stdout, stats)
+}
+
+// overrideVoterParams returns a copy of params with the values given by flags
+// overridden.
+func overrideVoterParams(params *types.VoterParams) *types.VoterParams {
+	p := *params
+	if electionThreshold >= 0 {
+		p.VoterElectionThreshold = electionThreshold
+	}
+	if byzantinePercent >= 0 {
+		p.MaxTolerableByzantinePercentage = byzantinePercent
+	}
+	return &p
+}
+
+// printVoterElectionStats writes stats to w as a table.
+func printVoterElectionStats(w io.Writer, stats *types.VoterElectionStats) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ADDRESS\tVOTING POWER\tCANDIDACIES\tELECTED\tFREQUENCY\tREWARD SHARE")
+	for _, val := range stats.Validators {
+		fmt.Fprintf(tw, "%v\t%d\t%d\t%d\t%.4f\t%.4f\n", val.Address, val.VotingPower,
+			val.Candidacies, val.Elected, val.ElectionFrequency(), val.RewardShare())
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\nelections: %d\nbyzantine voting weight share: min %.4f, mean %.4f, max %.4f\n",
+		stats.Elections, stats.MinByzantineWeight, stats.MeanByzantineWeight, stats.MaxByzantineWeight)
+	return err
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/ostracon/inspect"
+)
+
+// InspectCmd is the command to serve the RPC over the databases of a stopped
+// node.
+var InspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "Run an RPC server over the databases of a stopped node for post-mortem analysis",
+	Long: `
+The inspect command opens the block store, the state store and the indexers of
+the node read-only, and serves the RPC endpoints that only read from them:
+health, blockchain, block, block_by_hash, block_results, block_search, commit,
+consensus_params, tx, tx_search, validators, voters, validators_voters and
+voter_election_proof.
+
+No consensus, p2p or mempool is started, and no ABCI application is needed, so
+it works against the data of a node that crashed or halted. The node must not
+be running. Only the goleveldb backend can be opened read-only, so the command
+fails for the other backends.
+`,
+	RunE: runInspect,
+}
+
+func init() {
+	InspectCmd.Flags().String("rpc.laddr", config.RPC.ListenAddress, "RPC listen address. Port required")
+	InspectCmd.Flags().String("db_backend", config.DBBackend,
+		"database backend: goleveldb | cleveldb | boltdb | rocksdb | badgerdb")
+	InspectCmd.Flags().String("db_dir", config.DBPath, "database directory")
+}
+
+func runInspect(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		<-c
+		cancel()
+	}()
+
+	ins, err := inspect.NewFromConfig(config, logger.With("module", "inspect"))
+	if err != nil {
+		return err
+	}
+	logger.Info("starting inspect server")
+	return ins.Run(ctx)
+}
@@ -23,6 +23,7 @@ import (
 	lrpc "github.com/line/ostracon/light/rpc"
 	dbs "github.com/line/ostracon/light/store/db"
 	rpcserver "github.com/line/ostracon/rpc/jsonrpc/server"
+	"github.com/line/ostracon/types"
 )
 
 // LightCmd represents the base command when called without any subcommands
@@ -67,6 +68,9 @@ var (
 	trustedHash    []byte
 	trustLevelStr  string
 
+	voterElectionThreshold          int32
+	maxTolerableByzantinePercentage int32
+
 	verbose bool
 
 	primaryKey   = []byte("primary")
@@ -98,6 +102,14 @@ func init() {
 	LightCmd.Flags().BoolVar(&sequential, "sequential", false,
 		"sequential verification. Verify all headers sequentially as opposed to using skipping verification",
 	)
+	LightCmd.Flags().Int32Var(&voterElectionThreshold, "voter-election-threshold",
+		types.DefaultVoterElectionThreshold,
+		"voter_election_threshold of the voter params in the genesis of the chain, to verify the voter elections",
+	)
+	LightCmd.Flags().Int32Var(&maxTolerableByzantinePercentage, "max-tolerable-byzantine-percentage",
+		types.DefaultMaxTolerableByzantinePercentage,
+		"max_tolerable_byzantine_percentage of the voter params in the genesis of the chain, to verify the voter elections",
+	)
 }
 
 func runProxy(cmd *cobra.Command, args []string) error {
@@ -212,7 +224,16 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		cfg.WriteTimeout = config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
-	p, err := lproxy.NewProxy(c, listenAddr, primaryAddr, cfg, logger, lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()))
+	voterParams := &types.VoterParams{
+		VoterElectionThreshold:          voterElectionThreshold,
+		MaxTolerableByzantinePercentage: maxTolerableByzantinePercentage,
+	}
+	if err := voterParams.Validate(); err != nil {
+		return fmt.Errorf("invalid voter params: %w", err)
+	}
+
+	p, err := lproxy.NewProxy(c, listenAddr, primaryAddr, cfg, logger,
+		lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()), lrpc.VoterParams(voterParams))
 	if err != nil {
 		return err
 	}
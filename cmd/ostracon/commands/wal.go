@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	cs "github.com/line/ostracon/consensus"
+	tmjson "github.com/line/ostracon/libs/json"
+	tmstrings "github.com/line/ostracon/libs/strings"
+)
+
+var (
+	walFile      string
+	walMinHeight int64
+	walMaxHeight int64
+	walRound     int32
+	walTypes     string
+)
+
+// WALCmd is the command to inspect and repair the consensus WAL of a stopped
+// node.
+var WALCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Inspect and repair the consensus WAL of a stopped node",
+}
+
+var walDumpCmd = &cobra.Command{
+	Use:   "dump",
+	Short: "Dump the messages of the WAL as JSON lines",
+	Long: `
+The dump command prints the messages of the WAL, from the oldest rotated file to
+the head, as JSON lines with the file and offset of each message, and its
+height, round and type. The round of the messages that have none is -1.
+
+The messages can be filtered by height, round and type. The types are:
+end_height, round_state, timeout, proposal, block_part, vote and msg_info.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		files, err := cs.WALFiles(walPath())
+		if err != nil {
+			return err
+		}
+		types := make(map[string]bool)
+		for _, typ := range tmstrings.SplitAndTrim(walTypes, ",", " ") {
+			if typ != "" {
+				types[typ] = true
+			}
+		}
+		return dumpWAL(cmd.OutOrStdout(), files, func(entry *cs.WALEntry) bool {
+			return (walMinHeight <= 0 || entry.Height >= walMinHeight) &&
+				(walMaxHeight <= 0 || entry.Height <= walMaxHeight) &&
+				(walRound < 0 || entry.Round == walRound) &&
+				(len(types) == 0 || types[entry.Type])
+		})
+	},
+}
+
+var walVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the checksums of the WAL messages",
+	Long: `
+The verify command decodes all the messages of the WAL, checking their CRC, and
+reports the file and offset of the first corrupted message, if any. It exits
+with an error in that case.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		files, err := cs.WALFiles(walPath())
+		if err != nil {
+			return err
+		}
+		var count, height int64
+		err = cs.ReadWAL(files, func(entry *cs.WALEntry) error {
+			count++
+			if m, ok := entry.Msg.Msg.(cs.EndHeightMessage); ok {
+				height = m.Height
+			}
+			return nil
+		})
+		if err != nil {
+			var corruption cs.WALCorruptionError
+			if errors.As(err, &corruption) {
+				fmt.Fprintf(cmd.OutOrStdout(), "corrupted message after height %d: %v\n", height, corruption)
+			}
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "verified %d messages in %d files, last height %d\n",
+			count, len(files), height)
+		return nil
+	},
+}
+
+var walTruncateCmd = &cobra.Command{
+	Use:   "truncate <height>",
+	Short: "Truncate the WAL after the end of a height",
+	Long: `
+The truncate command discards the messages of the WAL after the end of the given
+height, including the rotated files that follow it. The node must not be
+running.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || height < 0 {
+			return fmt.Errorf("invalid height %q", args[0])
+		}
+		if err := cs.TruncateWALAfterHeight(walPath(), height); err != nil {
+			return err
+		}
+		logger.Info("Truncated WAL", "file", walPath(), "height", height)
+		return nil
+	},
+}
+
+func init() {
+	WALCmd.PersistentFlags().StringVar(&walFile, "wal_file", "",
+		"path of the WAL head file (default the node's consensus WAL)")
+
+	walDumpCmd.Flags().Int64Var(&walMinHeight, "min_height", 0, "lowest height of the messages to dump")
+	walDumpCmd.Flags().Int64Var(&walMaxHeight, "max_height", 0, "highest height of the messages to dump")
+	walDumpCmd.Flags().Int32Var(&walRound, "round", -1, "round of the messages to dump (-1 for any)")
+	walDumpCmd.Flags().StringVar(&walTypes, "types", "", "comma-separated types of the messages to dump")
+
+	WALCmd.AddCommand(walDumpCmd)
+	WALCmd.AddCommand(walVerifyCmd)
+	WALCmd.AddCommand(walTruncateCmd)
+}
+
+func walPath() string {
+	if walFile != "" {
+		return walFile
+	}
+	return config.Consensus.WalFile()
+}
+
+// dumpWAL writes the WAL entries for which filter returns true to w as JSON
+// lines.
+func dumpWAL(w io.Writer, files []string, filter func(*cs.WALEntry) bool) error {
+	return cs.ReadWAL(files, func(entry *cs.WALEntry) error {
+		if !filter(entry) {
+			return nil
+		}
+		bz, err := tmjson.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to marshal WAL message: %w", err)
+		}
+		_, err = fmt.Fprintf(w, "%s\n", bz)
+		return err
+	})
+}
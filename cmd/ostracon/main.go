@@ -28,6 +28,9 @@ func main() {
 		cmd.GenNodeKeyCmd,
 		cmd.VersionCmd,
 		cmd.RollbackStateCmd,
+		cmd.InspectCmd,
+		cmd.CompactGoLevelDBCmd,
+		cmd.WALCmd,
 		debug.DebugCmd,
 		cli.NewCompletionCmd(rootCmd, true),
 	)
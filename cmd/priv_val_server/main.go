@@ -2,23 +2,33 @@ package main
 
 import (
 	"flag"
+	"net"
 	"os"
 	"time"
 
+	"google.golang.org/grpc"
+
 	"github.com/line/ostracon/crypto/ed25519"
 	"github.com/line/ostracon/libs/log"
 	tmnet "github.com/line/ostracon/libs/net"
 	tmos "github.com/line/ostracon/libs/os"
+	privvalproto "github.com/line/ostracon/proto/ostracon/privval"
+	"github.com/line/ostracon/types"
 
 	"github.com/line/ostracon/privval"
+	tmgrpc "github.com/line/ostracon/privval/grpc"
 )
 
 func main() {
 	var (
-		addr             = flag.String("addr", ":26659", "Address of client to connect to")
+		addr = flag.String("addr", ":26659",
+			"Address of client to connect to, or grpc://host:port address to serve the gRPC API on")
 		chainID          = flag.String("chain-id", "mychain", "chain id")
 		privValKeyPath   = flag.String("priv-key", "", "priv val key file path")
 		privValStatePath = flag.String("priv-state", "", "priv val state file path")
+		certFile         = flag.String("cert", "", "gRPC server certificate file path")
+		keyFile          = flag.String("key", "", "gRPC server certificate key file path")
+		rootCAFile       = flag.String("root-ca", "", "CA certificate file path to verify gRPC clients against")
 
 		logger = log.NewOCLogger(
 			log.NewSyncWriter(os.Stdout),
@@ -39,6 +49,9 @@ func main() {
 	var dialer privval.SocketDialer
 	protocol, address := tmnet.ProtocolAndAddress(*addr)
 	switch protocol {
+	case "grpc":
+		serveGRPC(address, *chainID, pv, *certFile, *keyFile, *rootCAFile, logger)
+		return
 	case "unix":
 		dialer = privval.DialUnixFn(address)
 	case "tcp":
@@ -68,3 +81,35 @@ func main() {
 	// Run forever.
 	select {}
 }
+
+// serveGRPC serves the PrivValidatorAPI on address until SIGTERM or CTRL-C,
+// requiring clients to authenticate with mutual TLS. It exits if any of the
+// certificates isn't given.
+func serveGRPC(address, chainID string, pv types.PrivValidator, certFile, keyFile, rootCAFile string,
+	logger log.Logger) {
+	if certFile == "" || keyFile == "" || rootCAFile == "" {
+		logger.Error("Serving the gRPC API requires mutual TLS: set -cert, -key and -root-ca")
+		os.Exit(1)
+	}
+	creds, err := tmgrpc.ServerTLS(certFile, keyFile, rootCAFile)
+	if err != nil {
+		logger.Error("Failed to load TLS credentials", "err", err)
+		os.Exit(1)
+	}
+
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		logger.Error("Failed to listen", "addr", address, "err", err)
+		os.Exit(1)
+	}
+
+	s := grpc.NewServer(grpc.Creds(creds))
+	privvalproto.RegisterPrivValidatorAPIServer(s, tmgrpc.NewSignerServer(chainID, pv, logger))
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	tmos.TrapSignal(logger, s.GracefulStop)
+
+	if err := s.Serve(lis); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/line/ostracon/privval"
@@ -27,6 +29,18 @@ const (
 	DefaultLogLevel = "info"
 
 	DefaultDBBackend = "goleveldb"
+
+	// P2PModeFull is the p2p mode of a node with no particular place in a
+	// sentry topology
+	P2PModeFull = "full"
+	// P2PModeValidator is the p2p mode of a validator behind sentries, which
+	// connects to them only
+	P2PModeValidator = "validator"
+	// P2PModeSentry is the p2p mode of a sentry, which connects a validator
+	// to the network without ever gossiping its address
+	P2PModeSentry = "sentry"
+	// P2PModeSeed is the p2p mode of a seed node (see seed_mode)
+	P2PModeSeed = "seed"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -74,6 +88,7 @@ type Config struct {
 	FastSync        *FastSyncConfig        `mapstructure:"fastsync"`
 	Consensus       *ConsensusConfig       `mapstructure:"consensus"`
 	TxIndex         *TxIndexConfig         `mapstructure:"tx_index"`
+	Pruning         *PruningConfig         `mapstructure:"pruning"`
 	Instrumentation *InstrumentationConfig `mapstructure:"instrumentation"`
 }
 
@@ -88,6 +103,7 @@ func DefaultConfig() *Config {
 		FastSync:        DefaultFastSyncConfig(),
 		Consensus:       DefaultConsensusConfig(),
 		TxIndex:         DefaultTxIndexConfig(),
+		Pruning:         DefaultPruningConfig(),
 		Instrumentation: DefaultInstrumentationConfig(),
 	}
 }
@@ -103,6 +119,7 @@ func TestConfig() *Config {
 		FastSync:        TestFastSyncConfig(),
 		Consensus:       TestConsensusConfig(),
 		TxIndex:         TestTxIndexConfig(),
+		Pruning:         TestPruningConfig(),
 		Instrumentation: TestInstrumentationConfig(),
 	}
 }
@@ -141,6 +158,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [consensus] section: %w", err)
 	}
+	if err := cfg.Pruning.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [pruning] section: %w", err)
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [instrumentation] section: %w", err)
 	}
@@ -232,9 +252,30 @@ type BaseConfig struct { //nolint: maligned
 	PrivValidatorState string `mapstructure:"priv_validator_state_file"`
 
 	// TCP or UNIX socket address for Ostracon to listen on for
-	// connections from an external PrivValidator process
+	// connections from an external PrivValidator process, or gRPC address
+	// (grpc://host:port) of an external PrivValidator process to connect to
 	PrivValidatorListenAddr string `mapstructure:"priv_validator_laddr"`
 
+	// Comma separated addresses of the signer nodes of a multi-signer cluster,
+	// each holding the validator key, in the format of priv_validator_laddr.
+	// Can't be set along with priv_validator_laddr
+	PrivValidatorSignerAddrs string `mapstructure:"priv_validator_signer_laddrs"`
+
+	// Number of the signer nodes of priv_validator_signer_laddrs that must
+	// agree on a signature for it to be used; more than half of them
+	PrivValidatorSignerThreshold int `mapstructure:"priv_validator_signer_threshold"`
+
+	// Path to the PEM encoded client certificate presented to a gRPC
+	// PrivValidator process
+	PrivValidatorClientCertificate string `mapstructure:"priv_validator_client_certificate_file"`
+
+	// Path to the PEM encoded private key of the client certificate
+	PrivValidatorClientKey string `mapstructure:"priv_validator_client_key_file"`
+
+	// Path to the PEM encoded certificate of the CA the certificate of a gRPC
+	// PrivValidator process is verified against
+	PrivValidatorRootCA string `mapstructure:"priv_validator_root_ca_file"`
+
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
@@ -302,6 +343,45 @@ func (cfg BaseConfig) PrivValidatorStateFile() string {
 	return rootify(cfg.PrivValidatorState, cfg.RootDir)
 }
 
+// PrivValidatorClientCertificateFile returns the full path to the client
+// certificate for a gRPC PrivValidator process
+func (cfg BaseConfig) PrivValidatorClientCertificateFile() string {
+	return rootify(cfg.PrivValidatorClientCertificate, cfg.RootDir)
+}
+
+// PrivValidatorClientKeyFile returns the full path to the key of the client
+// certificate for a gRPC PrivValidator process
+func (cfg BaseConfig) PrivValidatorClientKeyFile() string {
+	return rootify(cfg.PrivValidatorClientKey, cfg.RootDir)
+}
+
+// PrivValidatorRootCAFile returns the full path to the CA certificate for a
+// gRPC PrivValidator process
+func (cfg BaseConfig) PrivValidatorRootCAFile() string {
+	return rootify(cfg.PrivValidatorRootCA, cfg.RootDir)
+}
+
+// ArePrivValidatorSecurityOptionsPresent returns true if the client
+// certificate, its key and the CA certificate are all configured, so that the
+// connection to a gRPC PrivValidator process can use mutual TLS.
+func (cfg BaseConfig) ArePrivValidatorSecurityOptionsPresent() bool {
+	return cfg.PrivValidatorClientCertificate != "" &&
+		cfg.PrivValidatorClientKey != "" &&
+		cfg.PrivValidatorRootCA != ""
+}
+
+// PrivValidatorSignerAddresses returns the addresses of the signer nodes of
+// priv_validator_signer_laddrs.
+func (cfg BaseConfig) PrivValidatorSignerAddresses() []string {
+	var addrs []string
+	for _, addr := range strings.Split(cfg.PrivValidatorSignerAddrs, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
 // NodeKeyFile returns the full path to the node_key.json file
 func (cfg BaseConfig) NodeKeyFile() string {
 	return rootify(cfg.NodeKey, cfg.RootDir)
@@ -324,6 +404,20 @@ func (cfg BaseConfig) ValidateBasic() error {
 	default:
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
+	if !cfg.ArePrivValidatorSecurityOptionsPresent() &&
+		(cfg.PrivValidatorClientCertificate != "" || cfg.PrivValidatorClientKey != "" || cfg.PrivValidatorRootCA != "") {
+		return errors.New("priv_validator_client_certificate_file, priv_validator_client_key_file and " +
+			"priv_validator_root_ca_file must be set together")
+	}
+	if signers := len(cfg.PrivValidatorSignerAddresses()); signers > 0 {
+		if cfg.PrivValidatorListenAddr != "" {
+			return errors.New("priv_validator_laddr and priv_validator_signer_laddrs can't be set together")
+		}
+		if cfg.PrivValidatorSignerThreshold <= signers/2 || cfg.PrivValidatorSignerThreshold > signers {
+			return fmt.Errorf("priv_validator_signer_threshold must be more than half of and at most the %d signer nodes",
+				signers)
+		}
+	}
 	return nil
 }
 
@@ -591,6 +685,11 @@ type P2PConfig struct { //nolint: maligned
 	// Address to listen for incoming connections
 	ListenAddress string `mapstructure:"laddr"`
 
+	// Address to listen for incoming QUIC connections, along with the TCP
+	// ones. Empty to disable QUIC. Requires a node built with the
+	// github.com/line/ostracon/p2p/quic module
+	QUICListenAddress string `mapstructure:"quic_laddr"`
+
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
@@ -611,6 +710,12 @@ type P2PConfig struct { //nolint: maligned
 	// Set false for private or local networks
 	AddrBookStrict bool `mapstructure:"addr_book_strict"`
 
+	// Keep the address book in a database, along with the dial history,
+	// latency, node version and ban reason of every address, instead of
+	// saving it to addr_book_file periodically. addr_book_file is imported
+	// on the first start.
+	AddrBookDB bool `mapstructure:"addr_book_db"`
+
 	// Maximum number of inbound peers
 	MaxNumInboundPeers int `mapstructure:"max_num_inbound_peers"`
 
@@ -635,6 +740,24 @@ type P2PConfig struct { //nolint: maligned
 	// Rate at which packets can be received, in bytes/second
 	RecvRate int64 `mapstructure:"recv_rate"`
 
+	// Rate at which packets can be sent to all the peers together, in bytes/second
+	// (0 for no limit)
+	TotalSendRate int64 `mapstructure:"total_send_rate"`
+
+	// Rate at which packets can be received from all the peers together, in bytes/second
+	// (0 for no limit)
+	TotalRecvRate int64 `mapstructure:"total_recv_rate"`
+
+	// Comma separated list of channel rates at which packets can be sent to a peer,
+	// in bytes/second, each as <hex channel ID>:<rate> (e.g. "0x30:102400")
+	ChannelSendRates string `mapstructure:"channel_send_rates"`
+
+	// Comma separated list of channel rates at which packets can be received from a peer,
+	// in bytes/second, each as <hex channel ID>:<rate> (e.g. "0x30:102400").
+	// Once a channel is over its rate, the connection is not read until it is
+	// back under it.
+	ChannelRecvRates string `mapstructure:"channel_recv_rates"`
+
 	// Set true to enable the peer-exchange reactor
 	PexReactor bool `mapstructure:"pex"`
 
@@ -648,6 +771,16 @@ type P2PConfig struct { //nolint: maligned
 	// other peers)
 	PrivatePeerIDs string `mapstructure:"private_peer_ids"`
 
+	// Place of the node in a sentry topology, one of:
+	// - "full": no particular place, the default
+	// - "validator": a validator behind sentries. It only accepts and dials
+	//   its persistent and unconditional peers (its sentries), and runs no PEX
+	// - "sentry": a sentry in front of validators, which must be in both
+	//   private_peer_ids and unconditional_peer_ids. It never gossips their
+	//   addresses nor asks them for addresses
+	// - "seed": a seed node, as with seed_mode
+	Mode string `mapstructure:"mode"`
+
 	// Toggle to disable guard against peers connecting from the same ip.
 	AllowDuplicateIP bool `mapstructure:"allow_duplicate_ip"`
 
@@ -678,6 +811,7 @@ type P2PConfig struct { //nolint: maligned
 func DefaultP2PConfig() *P2PConfig {
 	return &P2PConfig{
 		ListenAddress:                "tcp://0.0.0.0:26656",
+		QUICListenAddress:            "",
 		ExternalAddress:              "",
 		UPNP:                         false,
 		AddrBook:                     defaultAddrBookPath,
@@ -689,8 +823,13 @@ func DefaultP2PConfig() *P2PConfig {
 		MaxPacketMsgPayloadSize:      1024,    // 1 kB
 		SendRate:                     5120000, // 5 mB/s
 		RecvRate:                     5120000, // 5 mB/s
+		TotalSendRate:                0,
+		TotalRecvRate:                0,
+		ChannelSendRates:             "",
+		ChannelRecvRates:             "",
 		PexReactor:                   true,
 		SeedMode:                     false,
+		Mode:                         P2PModeFull,
 		AllowDuplicateIP:             false,
 		HandshakeTimeout:             20 * time.Second,
 		DialTimeout:                  3 * time.Second,
@@ -721,6 +860,83 @@ func (cfg *P2PConfig) AddrBookFile() string {
 	return rootify(cfg.AddrBook, cfg.RootDir)
 }
 
+// SentryPeerIDs returns the IDs of the persistent and unconditional peers, the
+// only ones a validator connects with in validator mode.
+func (cfg *P2PConfig) SentryPeerIDs() []string {
+	return peerIDs(cfg.PersistentPeers + "," + cfg.UnconditionalPeerIDs)
+}
+
+// peerIDs returns the IDs of a comma separated list of IDs and id@host:port
+// addresses, without duplicates.
+func peerIDs(s string) []string {
+	var (
+		ids  = []string{}
+		seen = make(map[string]bool)
+	)
+	for _, peer := range strings.Split(s, ",") {
+		id := strings.TrimSpace(strings.SplitN(peer, "@", 2)[0])
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// ChannelSendRateMap returns the channel send rates by channel ID.
+// The malformed entries are reported by ValidateBasic, and skipped here.
+func (cfg *P2PConfig) ChannelSendRateMap() map[byte]int64 {
+	rates, _ := parseChannelRates(cfg.ChannelSendRates)
+	return rates
+}
+
+// ChannelRecvRateMap returns the channel receive rates by channel ID.
+// The malformed entries are reported by ValidateBasic, and skipped here.
+func (cfg *P2PConfig) ChannelRecvRateMap() map[byte]int64 {
+	rates, _ := parseChannelRates(cfg.ChannelRecvRates)
+	return rates
+}
+
+// parseChannelRates parses a comma separated list of <hex channel ID>:<rate>.
+// It returns the well-formed entries along with the error of the first
+// malformed one.
+func parseChannelRates(s string) (map[byte]int64, error) {
+	var (
+		rates    = make(map[byte]int64)
+		firstErr error
+	)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		err := func() error {
+			parts := strings.Split(entry, ":")
+			if len(parts) != 2 {
+				return fmt.Errorf("%q is not <hex channel ID>:<rate>", entry)
+			}
+			id, err := strconv.ParseUint(strings.TrimSpace(parts[0]), 0, 8)
+			if err != nil {
+				return fmt.Errorf("invalid channel ID in %q: %w", entry, err)
+			}
+			rate, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid rate in %q: %w", entry, err)
+			}
+			if rate < 0 {
+				return fmt.Errorf("rate in %q can't be negative", entry)
+			}
+			rates[byte(id)] = rate
+			return nil
+		}()
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return rates, firstErr
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
@@ -745,6 +961,69 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return errors.New("recv_rate can't be negative")
 	}
+	if cfg.TotalSendRate < 0 {
+		return errors.New("total_send_rate can't be negative")
+	}
+	if cfg.TotalRecvRate < 0 {
+		return errors.New("total_recv_rate can't be negative")
+	}
+	if _, err := parseChannelRates(cfg.ChannelSendRates); err != nil {
+		return fmt.Errorf("wrong channel_send_rates: %w", err)
+	}
+	if _, err := parseChannelRates(cfg.ChannelRecvRates); err != nil {
+		return fmt.Errorf("wrong channel_recv_rates: %w", err)
+	}
+	if cfg.QUICListenAddress != "" && !strings.HasPrefix(cfg.QUICListenAddress, "quic://") {
+		return errors.New("quic_laddr must start with quic://")
+	}
+	return cfg.validateMode()
+}
+
+// validateMode checks the settings required by the mode, for the node to fit
+// in the topology.
+func (cfg *P2PConfig) validateMode() error {
+	switch cfg.Mode {
+	case P2PModeFull:
+	case P2PModeValidator:
+		if cfg.SeedMode {
+			return errors.New("mode = validator is incompatible with seed_mode")
+		}
+		if cfg.PexReactor {
+			return errors.New("mode = validator requires pex = false, for the validator not to gossip " +
+				"with other peers than its sentries")
+		}
+		if cfg.Seeds != "" {
+			return errors.New("mode = validator requires no seeds, for the validator to connect to its sentries only")
+		}
+		if len(cfg.SentryPeerIDs()) == 0 {
+			return errors.New("mode = validator requires its sentries in persistent_peers or unconditional_peer_ids")
+		}
+	case P2PModeSentry:
+		if cfg.SeedMode {
+			return errors.New("mode = sentry is incompatible with seed_mode")
+		}
+		privateIDs := peerIDs(cfg.PrivatePeerIDs)
+		if len(privateIDs) == 0 {
+			return errors.New("mode = sentry requires the validators behind the sentry in private_peer_ids")
+		}
+		unconditional := make(map[string]bool)
+		for _, id := range peerIDs(cfg.UnconditionalPeerIDs) {
+			unconditional[id] = true
+		}
+		for _, id := range privateIDs {
+			if !unconditional[id] {
+				return fmt.Errorf("mode = sentry requires the private peer %s in unconditional_peer_ids too, "+
+					"for the sentry to always accept its validator", id)
+			}
+		}
+	case P2PModeSeed:
+		if !cfg.PexReactor {
+			return errors.New("mode = seed requires pex = true")
+		}
+	default:
+		return fmt.Errorf("unknown mode %q, must be one of %q, %q, %q or %q",
+			cfg.Mode, P2PModeFull, P2PModeValidator, P2PModeSentry, P2PModeSeed)
+	}
 	return nil
 }
 
@@ -773,6 +1052,11 @@ func DefaultFuzzConnConfig() *FuzzConnConfig {
 
 // MempoolConfig defines the configuration options for the Ostracon mempool
 type MempoolConfig struct {
+	// Mempool version to use:
+	//  1) "v0" - FIFO mempool (default)
+	//  2) "v1" - prioritized mempool, ordering and evicting txs by the
+	//     priority assigned in ResponseCheckTx
+	Version   string `mapstructure:"version"`
 	RootDir   string `mapstructure:"home"`
 	Recheck   bool   `mapstructure:"recheck"`
 	Broadcast bool   `mapstructure:"broadcast"`
@@ -796,11 +1080,26 @@ type MempoolConfig struct {
 	// Including space needed by encoding (one varint per transaction).
 	// XXX: Unused due to https://github.com/line/ostracon/issues/5796
 	MaxBatchBytes int `mapstructure:"max_batch_bytes"`
+	// TTLDuration, if non-zero, defines the maximum amount of time a transaction
+	// can exist for in the mempool.
+	//
+	// Note, if TTLNumBlocks is also defined, a transaction will be removed if it
+	// has existed in the mempool at least TTLNumBlocks number of blocks or if its
+	// insertion time into the mempool is beyond TTLDuration.
+	TTLDuration time.Duration `mapstructure:"ttl_duration"`
+	// TTLNumBlocks, if non-zero, defines the maximum number of blocks a transaction
+	// can exist for in the mempool.
+	//
+	// Note, if TTLDuration is also defined, a transaction will be removed if it
+	// has existed in the mempool at least TTLNumBlocks number of blocks or if
+	// its insertion time into the mempool is beyond TTLDuration.
+	TTLNumBlocks int64 `mapstructure:"ttl_num_blocks"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Ostracon mempool
 func DefaultMempoolConfig() *MempoolConfig {
 	return &MempoolConfig{
+		Version:   "v0",
 		Recheck:   true,
 		Broadcast: true,
 		WalPath:   "",
@@ -833,6 +1132,11 @@ func (cfg *MempoolConfig) WalEnabled() bool {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
+	switch cfg.Version {
+	case "v0", "v1":
+	default:
+		return fmt.Errorf("unknown mempool version %s", cfg.Version)
+	}
 	if cfg.Size < 0 {
 		return errors.New("size can't be negative")
 	}
@@ -845,6 +1149,12 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxBytes < 0 {
 		return errors.New("max_tx_bytes can't be negative")
 	}
+	if cfg.TTLDuration < 0 {
+		return errors.New("ttl_duration can't be negative")
+	}
+	if cfg.TTLNumBlocks < 0 {
+		return errors.New("ttl_num_blocks can't be negative")
+	}
 	return nil
 }
 
@@ -1179,6 +1489,69 @@ func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
 }
 
+//-----------------------------------------------------------------------------
+// PruningConfig
+
+// PruningConfig defines the configuration for the pruning of the block store,
+// the state store and the indexers by the node, in addition to the pruning
+// requested by the application with the retain height of Commit.
+type PruningConfig struct {
+	// Number of recent blocks, with their states and ABCI responses, to keep.
+	// 0 disables the pruning of blocks and states.
+	KeepRecent int64 `mapstructure:"keep_recent"`
+
+	// When non-zero, the blocks at the heights that are multiples of KeepEvery,
+	// e.g. the heights of the state sync snapshots, are kept when the older
+	// blocks are pruned. Their states and ABCI responses are pruned.
+	KeepEvery int64 `mapstructure:"keep_every"`
+
+	// Number of recent heights whose transactions and block events are kept
+	// in the indexer. 0 disables the pruning of the indexer.
+	IndexerKeepRecent int64 `mapstructure:"indexer_keep_recent"`
+
+	// How often to prune.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// DefaultPruningConfig returns a default configuration for pruning, which
+// keeps everything.
+func DefaultPruningConfig() *PruningConfig {
+	return &PruningConfig{
+		KeepRecent:        0,
+		KeepEvery:         0,
+		IndexerKeepRecent: 0,
+		Interval:          time.Minute,
+	}
+}
+
+// TestPruningConfig returns a default configuration for pruning.
+func TestPruningConfig() *PruningConfig {
+	return DefaultPruningConfig()
+}
+
+// Enabled returns true if anything is to be pruned.
+func (cfg *PruningConfig) Enabled() bool {
+	return cfg.KeepRecent > 0 || cfg.IndexerKeepRecent > 0
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *PruningConfig) ValidateBasic() error {
+	if cfg.KeepRecent < 0 {
+		return errors.New("keep_recent can't be negative")
+	}
+	if cfg.KeepEvery < 0 {
+		return errors.New("keep_every can't be negative")
+	}
+	if cfg.IndexerKeepRecent < 0 {
+		return errors.New("indexer_keep_recent can't be negative")
+	}
+	if cfg.Enabled() && cfg.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // InstrumentationConfig
 
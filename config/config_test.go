@@ -64,6 +64,23 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	assert.Error(t, cfg.ValidateBasic())
 }
 
+func TestBaseConfigPrivValidatorSigners(t *testing.T) {
+	cfg := TestBaseConfig()
+	cfg.PrivValidatorSignerAddrs = "tcp://127.0.0.1:26659, grpc://127.0.0.1:26660,unix://signer.sock"
+	assert.Equal(t, []string{"tcp://127.0.0.1:26659", "grpc://127.0.0.1:26660", "unix://signer.sock"},
+		cfg.PrivValidatorSignerAddresses())
+
+	cfg.PrivValidatorSignerThreshold = 1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorSignerThreshold = 4
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PrivValidatorSignerThreshold = 2
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.PrivValidatorListenAddr = "tcp://127.0.0.1:26661"
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestRPCConfigValidateBasic(t *testing.T) {
 	cfg := TestRPCConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -96,6 +113,8 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		"MaxPacketMsgPayloadSize",
 		"SendRate",
 		"RecvRate",
+		"TotalSendRate",
+		"TotalRecvRate",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -103,6 +122,92 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.QUICListenAddress = "quic://0.0.0.0:26656"
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.QUICListenAddress = "tcp://0.0.0.0:26656"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.QUICListenAddress = ""
+
+	cfg.ChannelSendRates = "0x30:102400, 0x22:0"
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.Equal(t, map[byte]int64{0x30: 102400, 0x22: 0}, cfg.ChannelSendRateMap())
+	for _, rates := range []string{"0x30", "0x30:", "0x100:1", "foo:1", "0x30:-1", "0x30:1:2"} {
+		cfg.ChannelRecvRates = rates
+		assert.Error(t, cfg.ValidateBasic(), rates)
+	}
+	cfg.ChannelRecvRates = "48:1024,0x30:bar"
+	assert.Equal(t, map[byte]int64{0x30: 1024}, cfg.ChannelRecvRateMap())
+}
+
+func TestP2PConfigValidateMode(t *testing.T) {
+	const (
+		id1 = "d51fb70907db1c6c2d5237e78379b25cf1a37ab4"
+		id2 = "f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"
+	)
+	testCases := []struct {
+		name  string
+		set   func(*P2PConfig)
+		isErr bool
+	}{
+		{"full", func(cfg *P2PConfig) {}, false},
+		{"unknown", func(cfg *P2PConfig) { cfg.Mode = "observer" }, true},
+		{"validator", func(cfg *P2PConfig) {
+			cfg.Mode = P2PModeValidator
+			cfg.PexReactor = false
+			cfg.PersistentPeers = id1 + "@1.2.3.4:26656"
+		}, false},
+		{"validator with pex", func(cfg *P2PConfig) {
+			cfg.Mode = P2PModeValidator
+			cfg.PersistentPeers = id1 + "@1.2.3.4:26656"
+		}, true},
+		{"validator with seeds", func(cfg *P2PConfig) {
+			cfg.Mode = P2PModeValidator
+			cfg.PexReactor = false
+			cfg.PersistentPeers = id1 + "@1.2.3.4:26656"
+			cfg.Seeds = id2 + "@5.6.7.8:26656"
+		}, true},
+		{"validator without sentries", func(cfg *P2PConfig) {
+			cfg.Mode = P2PModeValidator
+			cfg.PexReactor = false
+		}, true},
+		{"validator in seed mode", func(cfg *P2PConfig) {
+			cfg.Mode = P2PModeValidator
+			cfg.PexReactor = false
+			cfg.UnconditionalPeerIDs = id1
+			cfg.SeedMode = true
+		}, true},
+		{"sentry", func(cfg *P2PConfig) {
+			cfg.Mode = P2PModeSentry
+			cfg.PrivatePeerIDs = id1
+			cfg.UnconditionalPeerIDs = id2 + ", " + id1
+		}, false},
+		{"sentry without validators", func(cfg *P2PConfig) { cfg.Mode = P2PModeSentry }, true},
+		{"sentry with conditional validator", func(cfg *P2PConfig) {
+			cfg.Mode = P2PModeSentry
+			cfg.PrivatePeerIDs = id1 + "," + id2
+			cfg.UnconditionalPeerIDs = id1
+		}, true},
+		{"seed", func(cfg *P2PConfig) { cfg.Mode = P2PModeSeed }, false},
+		{"seed without pex", func(cfg *P2PConfig) {
+			cfg.Mode = P2PModeSeed
+			cfg.PexReactor = false
+		}, true},
+	}
+	for _, tc := range testCases {
+		cfg := TestP2PConfig()
+		tc.set(cfg)
+		if tc.isErr {
+			assert.Error(t, cfg.ValidateBasic(), tc.name)
+		} else {
+			assert.NoError(t, cfg.ValidateBasic(), tc.name)
+		}
+	}
+
+	cfg := TestP2PConfig()
+	cfg.PersistentPeers = id1 + "@1.2.3.4:26656, " + id2 + "@5.6.7.8:26656"
+	cfg.UnconditionalPeerIDs = id2
+	assert.Equal(t, []string{id1, id2}, cfg.SentryPeerIDs())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -114,6 +219,8 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"TTLDuration",
+		"TTLNumBlocks",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -121,6 +228,13 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	// tamper with version
+	cfg.Version = "v1"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Version = "invalid"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestStateSyncConfigValidateBasic(t *testing.T) {
@@ -211,6 +325,33 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestPruningConfigValidateBasic(t *testing.T) {
+	cfg := TestPruningConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.False(t, cfg.Enabled())
+
+	cfg.KeepRecent = 100
+	cfg.KeepEvery = 1000
+	cfg.IndexerKeepRecent = 10
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.True(t, cfg.Enabled())
+
+	// tamper with the interval
+	cfg.Interval = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Interval = time.Second
+
+	// tamper with the heights to keep
+	cfg.KeepRecent = -1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.KeepRecent = 100
+	cfg.KeepEvery = -1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.KeepEvery = 0
+	cfg.IndexerKeepRecent = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg := TestInstrumentationConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -155,9 +155,30 @@ priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 
 # TCP or UNIX socket address for Ostracon to listen on for
-# connections from an external PrivValidator process
+# connections from an external PrivValidator process, or gRPC address
+# (e.g. "grpc://127.0.0.1:26659") of an external PrivValidator process to
+# connect to
 priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 
+# Comma separated addresses of the signer nodes of a multi-signer cluster, each
+# holding the validator key, in the format of priv_validator_laddr. Votes and
+# proposals are signed by all of them, and a signature is only used once
+# priv_validator_signer_threshold of them agree on it.
+# Can't be set along with priv_validator_laddr.
+priv_validator_signer_laddrs = "{{ .BaseConfig.PrivValidatorSignerAddrs }}"
+
+# Number of the signer nodes of priv_validator_signer_laddrs that must agree on
+# a signature, more than half of them
+priv_validator_signer_threshold = {{ .BaseConfig.PrivValidatorSignerThreshold }}
+
+# Paths to the PEM encoded client certificate and key presented to a gRPC
+# PrivValidator process, and to the certificate of the CA its certificate is
+# verified against. The connection uses mutual TLS, so all three must be set
+# to connect to a gRPC PrivValidator process.
+priv_validator_client_certificate_file = "{{ js .BaseConfig.PrivValidatorClientCertificate }}"
+priv_validator_client_key_file = "{{ js .BaseConfig.PrivValidatorClientKey }}"
+priv_validator_root_ca_file = "{{ js .BaseConfig.PrivValidatorRootCA }}"
+
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
@@ -315,6 +336,13 @@ pprof_laddr = "{{ .RPC.PprofListenAddress }}"
 # Address to listen for incoming connections
 laddr = "{{ .P2P.ListenAddress }}"
 
+# Address to listen for incoming QUIC connections, along with the TCP ones
+# of laddr. Peers may then be given as quic://<ID>@<IP>:<PORT> addresses
+# example: quic://0.0.0.0:26656
+# If empty, QUIC is disabled
+# QUIC requires a node built with the github.com/line/ostracon/p2p/quic module
+quic_laddr = "{{ .P2P.QUICListenAddress }}"
+
 # Address to advertise to peers for them to dial
 # If empty, will use the same port as the laddr,
 # and will introspect on the listener or use UPnP
@@ -338,6 +366,12 @@ addr_book_file = "{{ js .P2P.AddrBook }}"
 # Set false for private or local networks
 addr_book_strict = {{ .P2P.AddrBookStrict }}
 
+# Keep the address book in a database (see db_backend), along with the dial
+# history, latency, node version and ban reason of every address, instead of
+# saving it to addr_book_file periodically. addr_book_file is imported on the
+# first start.
+addr_book_db = {{ .P2P.AddrBookDB }}
+
 # Maximum number of inbound peers
 max_num_inbound_peers = {{ .P2P.MaxNumInboundPeers }}
 
@@ -362,6 +396,26 @@ send_rate = {{ .P2P.SendRate }}
 # Rate at which packets can be received, in bytes/second
 recv_rate = {{ .P2P.RecvRate }}
 
+# Rate at which packets can be sent to all the peers together, in bytes/second
+# (0 for no limit)
+total_send_rate = {{ .P2P.TotalSendRate }}
+
+# Rate at which packets can be received from all the peers together, in bytes/second
+# (0 for no limit)
+total_recv_rate = {{ .P2P.TotalRecvRate }}
+
+# Comma separated list of channel rates at which packets can be sent to a peer,
+# in bytes/second, each as <hex channel ID>:<rate>, e.g. "0x30:102400" to keep
+# the mempool from starving the consensus gossip. The channels not listed are
+# only limited by send_rate.
+channel_send_rates = "{{ .P2P.ChannelSendRates }}"
+
+# Comma separated list of channel rates at which packets can be received from a
+# peer, in bytes/second, each as <hex channel ID>:<rate>. Once a channel is over
+# its rate, the connection is not read until it is back under it. The channels
+# not listed are only limited by recv_rate.
+channel_recv_rates = "{{ .P2P.ChannelRecvRates }}"
+
 # Set true to enable the peer-exchange reactor
 pex = {{ .P2P.PexReactor }}
 
@@ -374,6 +428,17 @@ seed_mode = {{ .P2P.SeedMode }}
 # Comma separated list of peer IDs to keep private (will not be gossiped to other peers)
 private_peer_ids = "{{ .P2P.PrivatePeerIDs }}"
 
+# Place of the node in a sentry topology, one of:
+# - "full": no particular place
+# - "validator": a validator behind sentries. It only accepts and dials its
+#   persistent and unconditional peers (its sentries), any other peer being
+#   rejected as soon as it's authenticated. Requires pex = false and no seeds
+# - "sentry": a sentry in front of validators, which must be in both
+#   private_peer_ids and unconditional_peer_ids. It never gossips their
+#   addresses nor asks them for addresses
+# - "seed": a seed node, as with seed_mode
+mode = "{{ .P2P.Mode }}"
+
 # Toggle to disable guard against peers connecting from the same ip.
 allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 
@@ -397,6 +462,14 @@ statesync_recv_buf_size = {{ .P2P.StatesyncRecvBufSize }}
 #######################################################
 [mempool]
 
+# Mempool version to use:
+#   1) "v0" (default) - FIFO mempool.
+#   2) "v1" - prioritized mempool. Transactions are reaped by the priority the
+#      application assigns in ResponseCheckTx, keeping the transactions of each
+#      sender in order, and lower priority transactions are evicted to make
+#      room when the mempool is full.
+version = "{{ .Mempool.Version }}"
+
 recheck = {{ .Mempool.Recheck }}
 broadcast = {{ .Mempool.Broadcast }}
 wal_dir = "{{ js .Mempool.WalPath }}"
@@ -426,6 +499,22 @@ max_tx_bytes = {{ .Mempool.MaxTxBytes }}
 # XXX: Unused due to https://github.com/line/ostracon/issues/5796
 max_batch_bytes = {{ .Mempool.MaxBatchBytes }}
 
+# ttl_duration, if non-zero, defines the maximum amount of time a transaction
+# can exist for in the mempool.
+#
+# Note, if ttl_num_blocks is also defined, a transaction will be removed if it
+# has existed in the mempool at least ttl_num_blocks number of blocks or if its
+# insertion time into the mempool is beyond ttl_duration.
+ttl_duration = "{{ .Mempool.TTLDuration }}"
+
+# ttl_num_blocks, if non-zero, defines the maximum number of blocks a transaction
+# can exist for in the mempool.
+#
+# Note, if ttl_duration is also defined, a transaction will be removed if it
+# has existed in the mempool at least ttl_num_blocks number of blocks or if
+# its insertion time into the mempool is beyond ttl_duration.
+ttl_num_blocks = {{ .Mempool.TTLNumBlocks }}
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -533,6 +622,32 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # 		- When "kv" is chosen "tx.height" and "tx.hash" will always be indexed.
 indexer = "{{ .TxIndex.Indexer }}"
 
+#######################################################
+###         Pruning Configuration Options           ###
+#######################################################
+[pruning]
+
+# Besides the blocks the application asks to prune with the retain height of
+# Commit, the node can prune blocks, states and ABCI responses itself, along
+# with the indexed transactions and block events. Nothing within the evidence
+# max age (both in blocks and in time) is ever pruned.
+
+# Number of recent blocks, with their states and ABCI responses, to keep.
+# 0 disables the pruning of blocks and states.
+keep_recent = {{ .Pruning.KeepRecent }}
+
+# When non-zero, the blocks at the heights that are multiples of keep_every,
+# e.g. the heights of the state sync snapshots, are kept when the older blocks
+# are pruned. Their states and ABCI responses are pruned.
+keep_every = {{ .Pruning.KeepEvery }}
+
+# Number of recent heights whose transactions and block events are kept in the
+# indexer. 0 disables the pruning of the indexer.
+indexer_keep_recent = {{ .Pruning.IndexerKeepRecent }}
+
+# How often to prune.
+interval = "{{ .Pruning.Interval }}"
+
 #######################################################
 ###       Instrumentation Configuration Options     ###
 #######################################################
@@ -186,15 +186,15 @@ func TestByzantinePrevoteEquivocation(t *testing.T) {
 				panic("entered createProposalBlock with privValidator being nil")
 			}
 
-			var commit *types.Commit
+			var extCommit *types.ExtendedCommit
 			switch {
 			case lazyProposer.Height == lazyProposer.state.InitialHeight:
 				// We're creating a proposal for the first block.
 				// The commit is empty, but not nil.
-				commit = types.NewCommit(0, 0, types.BlockID{}, nil)
+				extCommit = &types.ExtendedCommit{}
 			case lazyProposer.LastCommit.HasTwoThirdsMajority():
 				// Make the commit from LastCommit
-				commit = lazyProposer.LastCommit.MakeCommit()
+				extCommit = lazyProposer.LastCommit.MakeExtendedCommit()
 			default: // This shouldn't happen.
 				lazyProposer.Logger.Error("enterPropose: Cannot propose anything: No commit for the previous block")
 				return
@@ -202,8 +202,8 @@ func TestByzantinePrevoteEquivocation(t *testing.T) {
 
 			// omit the last signature in the commit
 			// except a proposal for the first block
-			if commit.Signatures != nil {
-				commit.Signatures[len(commit.Signatures)-1] = types.NewCommitSigAbsent()
+			if extCommit.ExtendedSignatures != nil {
+				extCommit.ExtendedSignatures[len(extCommit.ExtendedSignatures)-1] = types.NewExtendedCommitSigAbsent()
 			}
 
 			if lazyProposer.privValidatorPubKey == nil {
@@ -217,7 +217,7 @@ func TestByzantinePrevoteEquivocation(t *testing.T) {
 			message := lazyProposer.state.MakeHashMessage(lazyProposer.Round)
 			proof, _ := lazyProposer.privValidator.GenerateVRFProof(message)
 			block, blockParts := lazyProposer.blockExec.CreateProposalBlock(
-				lazyProposer.Height, lazyProposer.state, commit, proposerAddr, lazyProposer.Round, proof, 0,
+				lazyProposer.Height, lazyProposer.state, extCommit, proposerAddr, lazyProposer.Round, proof, 0,
 			)
 
 			// Flush the WAL. Otherwise, we may not recompute the same proposal to sign,
@@ -105,7 +105,7 @@ func (vs *validatorStub) signVote(
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
 	}
 	v := vote.ToProto()
-	if err := vs.PrivValidator.SignVote(config.ChainID(), v); err != nil {
+	if err := vs.PrivValidator.SignVote(config.ChainID(), v, false); err != nil {
 		return nil, fmt.Errorf("sign vote failed: %w", err)
 	}
 
@@ -232,11 +232,11 @@ func decideProposal(
 
 // createProposalBlockSlim is copy from consensus/state.go:createProposalBlock and slimmed down
 func createProposalBlockSlim(cs *State, vs *validatorStub, round int32) (*types.Block, *types.PartSet) {
-	var commit *types.Commit
+	var extCommit *types.ExtendedCommit
 	if cs.Height == 1 {
-		commit = types.NewCommit(0, 0, types.BlockID{}, nil)
+		extCommit = &types.ExtendedCommit{}
 	} else {
-		commit = cs.LastCommit.MakeCommit()
+		extCommit = cs.LastCommit.MakeExtendedCommit()
 	}
 	pubKey, _ := vs.GetPubKey()
 	proposerAddr := pubKey.Address()
@@ -246,7 +246,7 @@ func createProposalBlockSlim(cs *State, vs *validatorStub, round int32) (*types.
 		cs.Logger.Error("enterPropose: Cannot generate vrf proof: %s", err.Error())
 		return nil, nil
 	}
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr, round, proof, 0)
+	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, extCommit, proposerAddr, round, proof, 0)
 }
 
 func addVotes(to *State, votes ...*types.Vote) {
@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"reflect"
@@ -9,7 +10,9 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/line/ostracon/behaviour"
 	cstypes "github.com/line/ostracon/consensus/types"
+	"github.com/line/ostracon/crypto/tmhash"
 	"github.com/line/ostracon/libs/bits"
 	tmevents "github.com/line/ostracon/libs/events"
 	tmjson "github.com/line/ostracon/libs/json"
@@ -47,6 +50,7 @@ type Reactor struct {
 	waitSync bool
 	eventBus *types.EventBus
 	rs       *cstypes.RoundState
+	reporter behaviour.Reporter
 
 	Metrics *Metrics
 }
@@ -71,6 +75,17 @@ func NewReactor(consensusState *State, waitSync bool, async bool, recvBufSize in
 	return conR
 }
 
+// SetSwitch implements Reactor by setting the switch and the reporter of peer
+// behaviour.
+func (conR *Reactor) SetSwitch(sw *p2p.Switch) {
+	conR.Switch = sw
+	if sw != nil {
+		conR.reporter = behaviour.NewSwitchReporter(sw)
+	} else {
+		conR.reporter = nil
+	}
+}
+
 // OnStart implements BaseService by subscribing to events, which later will be
 // broadcasted to other peers and starting state if we're not in fast sync.
 func (conR *Reactor) OnStart() error {
@@ -238,13 +253,13 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		conR.Logger.Error("Error decoding message", "src", src, "chId", chID, "err", err)
-		conR.Switch.StopPeerForError(src, err)
+		_ = conR.reporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		conR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
-		conR.Switch.StopPeerForError(src, err)
+		_ = conR.reporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
 		return
 	}
 
@@ -420,6 +435,15 @@ func (conR *Reactor) subscribeToBroadcastEvents() {
 		conR.Logger.Error("Error adding listener for events", "err", err)
 	}
 
+	// Only fired for the erasure coded blocks, whose parts we may have rebuilt
+	// without receiving them.
+	if err := conR.conS.evsw.AddListenerForEvent(subscriber, types.EventCompleteProposal,
+		func(data tmevents.EventData) {
+			conR.broadcastNewValidBlockMessage(data.(*cstypes.RoundState))
+		}); err != nil {
+		conR.Logger.Error("Error adding listener for events", "err", err)
+	}
+
 	if err := conR.conS.evsw.AddListenerForEvent(subscriber, types.EventVote,
 		func(data tmevents.EventData) {
 			conR.broadcastHasVoteMessage(data.(*types.Vote))
@@ -530,7 +554,7 @@ OUTER_LOOP:
 
 		// Send proposal Block parts?
 		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
-			if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(); ok {
+			if index, ok := pickBlockPart(rs.ProposalBlockParts, prs.ProposalBlockParts.Copy(), peer.ID()); ok {
 				part := rs.ProposalBlockParts.GetPart(index)
 				msg := &BlockPartMessage{
 					Height: rs.Height, // This tells peer that this part applies to us.
@@ -613,6 +637,28 @@ OUTER_LOOP:
 	}
 }
 
+// pickBlockPart picks a part of the proposal block that we have and the peer
+// doesn't. The parts of an erasure coded block are picked in order from an
+// offset that depends on the peer, so that the peers receive different parts
+// first, and rebuild the block from the parts they send to each other.
+func pickBlockPart(parts *types.PartSet, peerParts *bits.BitArray, peerID p2p.ID) (int, bool) {
+	missing := parts.BitArray().Sub(peerParts)
+	if !parts.IsErasureCoded() {
+		return missing.PickRandom()
+	}
+	if missing == nil {
+		return 0, false
+	}
+	total := missing.Size()
+	offset := int(binary.BigEndian.Uint32(tmhash.Sum([]byte(peerID))) % uint32(total))
+	for i := 0; i < total; i++ {
+		if index := (offset + i) % total; missing.GetIndex(index) {
+			return index, true
+		}
+	}
+	return 0, false
+}
+
 func (conR *Reactor) gossipDataForCatchup(logger log.Logger, rs *cstypes.RoundState,
 	prs *cstypes.PeerRoundState, ps *PeerState, peer p2p.Peer) {
 
@@ -706,7 +752,13 @@ OUTER_LOOP:
 			// which contains precommit signatures for prs.Height.
 			// Originally the block commit was used, but with the addition of the BLS signature-aggregation,
 			// we use seen commit instead of the block commit because block commit has no individual signature.
-			if commit := conR.conS.blockStore.LoadSeenCommit(prs.Height); commit != nil {
+			// When the vote extensions are enabled, the peer needs them along with the precommits.
+			if extCommit := conR.conS.blockStore.LoadBlockExtendedCommit(prs.Height); extCommit != nil {
+				if ps.PickSendVote(extCommit) {
+					logger.Debug("Picked Catchup extended commit to send", "height", prs.Height)
+					continue OUTER_LOOP
+				}
+			} else if commit := conR.conS.blockStore.LoadSeenCommit(prs.Height); commit != nil {
 				if ps.PickSendVote(commit) {
 					logger.Debug("Picked Catchup commit to send", "height", prs.Height)
 					continue OUTER_LOOP
@@ -901,11 +953,11 @@ func (conR *Reactor) peerStatsRoutine() {
 			switch msg.Msg.(type) {
 			case *VoteMessage:
 				if numVotes := ps.RecordVote(); numVotes%votesToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					_ = conR.reporter.Report(behaviour.ConsensusVote(peer.ID(), "contributed votes"))
 				}
 			case *BlockPartMessage:
 				if numParts := ps.RecordBlockPart(); numParts%blocksToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					_ = conR.reporter.Report(behaviour.BlockPart(peer.ID(), "contributed block parts"))
 				}
 			}
 		case <-conR.conS.Quit():
@@ -1551,8 +1603,9 @@ func (m *NewValidBlockMessage) ValidateBasic() error {
 			m.BlockParts.Size(),
 			m.BlockPartSetHeader.Total)
 	}
-	if m.BlockParts.Size() > int(types.MaxBlockPartsCount) {
-		return fmt.Errorf("blockParts bit array is too big: %d, max: %d", m.BlockParts.Size(), types.MaxBlockPartsCount)
+	if m.BlockParts.Size() > int(types.MaxErasureBlockPartsCount) {
+		return fmt.Errorf("blockParts bit array is too big: %d, max: %d",
+			m.BlockParts.Size(), types.MaxErasureBlockPartsCount)
 	}
 	return nil
 }
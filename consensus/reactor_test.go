@@ -757,6 +757,30 @@ func TestNewRoundStepMessageValidateHeight(t *testing.T) {
 	}
 }
 
+func TestPickBlockPart(t *testing.T) {
+	parts := types.NewPartSetFromDataWithParity(make([]byte, 1000), 64, 50)
+	total := int(parts.Total())
+
+	// the peers get different parts first
+	picked := make(map[int]bool)
+	for i := 0; i < 10; i++ {
+		index, ok := pickBlockPart(parts, bits.NewBitArray(total), p2p.ID(fmt.Sprintf("peer%d", i)))
+		require.True(t, ok)
+		picked[index] = true
+	}
+	assert.Greater(t, len(picked), 1)
+
+	// the only missing part is picked
+	peerParts := parts.BitArray()
+	peerParts.SetIndex(3, false)
+	index, ok := pickBlockPart(parts, peerParts, "peer")
+	require.True(t, ok)
+	assert.Equal(t, 3, index)
+
+	_, ok = pickBlockPart(parts, parts.BitArray(), "peer")
+	assert.False(t, ok)
+}
+
 func TestNewValidBlockMessageValidateBasic(t *testing.T) {
 	testCases := []struct {
 		malleateFn func(*NewValidBlockMessage)
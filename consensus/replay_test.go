@@ -1228,16 +1228,28 @@ func (bs *mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 func (bs *mockBlockStore) LoadBlockPart(height int64, index int) *types.Part { return nil }
 func (bs *mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 }
+func (bs *mockBlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet,
+	seenExtCommit *types.ExtendedCommit) {
+}
 func (bs *mockBlockStore) LoadBlockCommit(height int64) *types.Commit {
 	return bs.commits[height-1]
 }
 func (bs *mockBlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return bs.commits[height-1]
 }
+func (bs *mockBlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	return nil
+}
 
 func (bs *mockBlockStore) PruneBlocks(height int64) (uint64, error) {
+	return bs.PruneBlocksKeepingEvery(height, 0)
+}
+func (bs *mockBlockStore) PruneBlocksKeepingEvery(height int64, keepEvery int64) (uint64, error) {
 	pruned := uint64(0)
 	for i := int64(0); i < height-1; i++ {
+		if keepEvery > 0 && (i+1)%keepEvery == 0 {
+			continue
+		}
 		bs.chain[i] = nil
 		bs.commits[i] = nil
 		pruned++
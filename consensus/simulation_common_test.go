@@ -0,0 +1,1007 @@
+package consensus
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+
+	abcicli "github.com/line/ostracon/abci/client"
+	"github.com/line/ostracon/abci/example/kvstore"
+	cfg "github.com/line/ostracon/config"
+	cstypes "github.com/line/ostracon/consensus/types"
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/libs/log"
+	tmquery "github.com/line/ostracon/libs/pubsub/query"
+	"github.com/line/ostracon/libs/service"
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/p2p"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	sm "github.com/line/ostracon/state"
+	"github.com/line/ostracon/store"
+	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
+)
+
+/*
+The simulation runs several consensus states in one process, over an
+in-memory p2p network and with a virtual clock, to reproduce consensus
+scenarios deterministically.
+
+Each node is a full State, with its own block store, state store and kvstore
+application, and a virtualTimeoutTicker in place of the timeout ticker. The
+nodes are connected by p2p switches over a p2p.MemoryNetwork, with a
+simReactor in place of the consensus reactor. The simulation runs a single
+event loop over the virtual clock: it delivers the messages in flight and
+fires the timeouts in order of virtual time, one at a time. A message is
+sent by the switch of its sender, and the simulation waits for the switch of
+the receiver to receive it, and for the consensus state to process it. The
+messages a node sends in return are read from its event bus, and routed
+through the network policies:
+
+	simDelay      delays every message by a random duration
+	simDrop       drops messages at random
+	simPartition  drops the messages between groups of nodes for a while
+	simReorder    delays some messages further, so they overtake each other
+
+Byzantine nodes alter the messages they send with a simBehaviour:
+
+	simEquivocate       sends a conflicting vote along with each vote
+	simWithholdProposal does not send its proposals
+	simTamperVRFProof   proposes a block whose VRF proof is invalid
+
+The consensus reactor is not used: it gossips from its own goroutines and
+timers, which would make the order of the messages depend on the Go
+scheduler. Like the reactor, the simulation periodically gossips to each node
+the votes and proposals of its peers it is missing, and the commits of the
+heights it is behind on, so that dropped messages are eventually
+retransmitted.
+
+After each step, the simulation checks that no two nodes committed different
+blocks at the same height, and runUntilHeight checks that the honest nodes
+reach the given height in time.
+
+With the same seed and configuration, the simulation delivers the same
+messages in the same order, and the nodes go through the same heights,
+rounds and steps, which can be checked with the trace. Only the timestamps,
+and so the hashes of the blocks, still come from the wall clock.
+*/
+
+const (
+	simChainID = "simulation"
+
+	// the capacity of the event subscriptions, which are drained after each
+	// step
+	simEventCapacity = 1000
+
+	// the wall time waited for a message sent over the p2p network
+	simReceiveTimeout = 10 * time.Second
+
+	simBarrierEvent = "SimulationBarrier"
+	simBarrierData  = types.EventDataString("barrier")
+)
+
+// simGenesisTime is fixed, as the proof hash of the genesis state is derived
+// from the genesis doc, and the proposers of the first height from it.
+var simGenesisTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var (
+	// errSafetyViolation is returned when two nodes commit different blocks
+	// at the same height.
+	errSafetyViolation = errors.New("safety violation")
+	// errLivenessViolation is returned when the honest nodes don't reach a
+	// height in time.
+	errLivenessViolation = errors.New("liveness violation")
+)
+
+// simConfig is the configuration of a simulation.
+type simConfig struct {
+	// Seed seeds the keys of the nodes and the choices of the network.
+	Seed int64
+	// Powers are the voting powers of the validators, one per node.
+	Powers []int64
+	// VoterParams are the voter params of the genesis.
+	VoterParams *types.VoterParams
+	// ConsensusParams are the consensus params of the genesis, or nil for
+	// the defaults.
+	ConsensusParams *tmproto.ConsensusParams
+	// Consensus is the consensus config of the nodes. Its timeout_commit
+	// should be zero, as round 0 is scheduled against the wall clock.
+	Consensus *cfg.ConsensusConfig
+	// GossipInterval is the virtual time between two gossips of the missing
+	// votes, proposals and commits.
+	GossipInterval time.Duration
+	// Policies decide how the network delivers each message.
+	Policies []simPolicy
+	// Byzantine maps the indexes of the byzantine nodes to their behaviour.
+	Byzantine map[int]simBehaviour
+	// Logger is the logger of the nodes.
+	Logger log.Logger
+}
+
+// defaultSimConfig returns the config of a simulation of n validators of
+// equal power, with neither network faults nor byzantine nodes.
+func defaultSimConfig(n int) simConfig {
+	powers := make([]int64, n)
+	for i := range powers {
+		powers[i] = 10
+	}
+	consensusConfig := cfg.TestConsensusConfig()
+	consensusConfig.TimeoutCommit = 0
+	return simConfig{
+		Seed:           1,
+		Powers:         powers,
+		VoterParams:    types.DefaultVoterParams(),
+		Consensus:      consensusConfig,
+		GossipInterval: 50 * time.Millisecond,
+		Logger:         log.NewNopLogger(),
+	}
+}
+
+// simNode is a validator node of a simulation.
+type simNode struct {
+	Index         int
+	ID            p2p.ID
+	PrivValidator types.PrivValidator
+	State         *State
+	BlockStore    *store.BlockStore
+	StateStore    sm.Store
+
+	address   crypto.Address
+	logger    log.Logger
+	ticker    *virtualTimeoutTicker
+	eventBus  *types.EventBus
+	events    types.Subscription
+	evpool    *simEvidencePool
+	behaviour simBehaviour
+
+	netAddr   *p2p.NetAddress
+	transport *p2p.MemoryTransport
+	sw        *p2p.Switch
+	reactor   *simReactor
+
+	// the height up to which the committed blocks were checked
+	checkedHeight int64
+}
+
+// Address returns the address of the node's validator.
+func (n *simNode) Address() crypto.Address {
+	return n.address
+}
+
+// Byzantine returns true if the node has a byzantine behaviour.
+func (n *simNode) Byzantine() bool {
+	return n.behaviour != nil
+}
+
+// ConflictingVotes returns the pairs of conflicting votes the node reported
+// to its evidence pool.
+func (n *simNode) ConflictingVotes() [][2]*types.Vote {
+	return n.evpool.conflictingVotes()
+}
+
+// simulation runs the nodes over an in-memory network with a virtual clock.
+// It is not safe for concurrent use.
+type simulation struct {
+	conf  simConfig
+	rng   *rand.Rand
+	nodes []*simNode
+
+	now        time.Duration
+	seq        uint64
+	inFlight   []*simEnvelope // sorted by delivery time, then sequence
+	nextGossip time.Duration
+	trace      []simEvent
+
+	// the hashes of the committed blocks by height
+	blockHashes map[int64][]byte
+}
+
+// simEnvelope is a message in flight, encoded when it was sent.
+type simEnvelope struct {
+	from, to *simNode
+	msg      Message
+	chID     byte
+	msgBytes []byte
+	at       time.Duration
+	seq      uint64
+}
+
+// newSimulation creates the nodes of a simulation from the config. The nodes
+// must be started with Start.
+func newSimulation(conf simConfig) (*simulation, error) {
+	if len(conf.Powers) == 0 {
+		return nil, errors.New("no validators")
+	}
+	for i := range conf.Byzantine {
+		if i < 0 || i >= len(conf.Powers) {
+			return nil, fmt.Errorf("no node of index %d to be byzantine", i)
+		}
+	}
+
+	s := &simulation{
+		conf:        conf,
+		rng:         rand.New(rand.NewSource(conf.Seed)), // nolint: gosec
+		blockHashes: make(map[int64][]byte),
+	}
+
+	privVals := make([]types.PrivValidator, len(conf.Powers))
+	genDoc := &types.GenesisDoc{
+		GenesisTime:     simGenesisTime,
+		ChainID:         simChainID,
+		InitialHeight:   1,
+		ConsensusParams: conf.ConsensusParams,
+		VoterParams:     conf.VoterParams,
+	}
+	for i, power := range conf.Powers {
+		secret := []byte(fmt.Sprintf("simulation/%d/%d", conf.Seed, i))
+		privVals[i] = types.NewMockPVWithParams(ed25519.GenPrivKeyFromSecret(secret), false, false)
+		pubKey, err := privVals[i].GetPubKey()
+		if err != nil {
+			return nil, err
+		}
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{PubKey: pubKey, Power: power})
+	}
+	state, err := sm.MakeGenesisState(genDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	network := p2p.NewMemoryNetwork()
+	for i, privVal := range privVals {
+		node, err := s.newNode(i, state.Copy(), privVal, network)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create node %d: %w", i, err)
+		}
+		s.nodes = append(s.nodes, node)
+	}
+	return s, nil
+}
+
+func (s *simulation) newNode(
+	index int,
+	state sm.State,
+	privVal types.PrivValidator,
+	network *p2p.MemoryNetwork,
+) (*simNode, error) {
+	stateStore := sm.NewStore(dbm.NewMemDB())
+	if err := stateStore.Save(state); err != nil {
+		return nil, err
+	}
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	pubKey, err := privVal.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	nodeKey := p2p.NodeKey{
+		PrivKey: ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("simulation/%d/%d/node", s.conf.Seed, index))),
+	}
+
+	node := &simNode{
+		Index:         index,
+		ID:            nodeKey.ID(),
+		PrivValidator: privVal,
+		BlockStore:    blockStore,
+		StateStore:    stateStore,
+		address:       pubKey.Address(),
+		logger:        s.conf.Logger.With("validator", index),
+		eventBus:      types.NewEventBus(),
+		evpool:        &simEvidencePool{},
+		behaviour:     s.conf.Byzantine[index],
+	}
+	node.ticker = newVirtualTimeoutTicker(s.Now)
+
+	proxyApp := abcicli.NewLocalClient(new(tmsync.Mutex), kvstore.NewApplication())
+	blockExec := sm.NewBlockExecutor(stateStore, node.logger.With("module", "state"), proxyApp,
+		emptyMempool{}, sm.EmptyEvidencePool{})
+	consensusConfig := *s.conf.Consensus
+	node.State = NewState(&consensusConfig, state, blockExec, blockStore, emptyMempool{}, node.evpool)
+	node.State.SetTimeoutTicker(node.ticker)
+	node.State.SetLogger(node.logger.With("module", "consensus"))
+	node.State.SetPrivValidator(privVal)
+	node.eventBus.SetLogger(node.logger.With("module", "events"))
+	node.State.SetEventBus(node.eventBus)
+	// The messages are not written to a file, and there is nothing to
+	// replay.
+	node.State.wal = &simFlushWAL{cs: node.State}
+	node.State.doWALCatchup = false
+
+	if err := node.newSwitch(nodeKey, network); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// newSwitch creates the switch of the node, listening on the network with a
+// simReactor.
+func (n *simNode) newSwitch(nodeKey p2p.NodeKey, network *p2p.MemoryNetwork) error {
+	nodeInfo := p2p.DefaultNodeInfo{
+		ProtocolVersion: p2p.NewProtocolVersion(version.P2PProtocol, version.BlockProtocol, 0),
+		DefaultNodeID:   nodeKey.ID(),
+		ListenAddr:      fmt.Sprintf("127.0.0.%d:26656", n.Index+1),
+		Network:         simChainID,
+		Channels:        []byte{DataChannel, VoteChannel},
+		Moniker:         fmt.Sprintf("node%d", n.Index),
+	}
+	netAddr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), nodeInfo.ListenAddr))
+	if err != nil {
+		return err
+	}
+
+	p2pConfig := cfg.TestP2PConfig()
+	// the simulation waits for each message to be received
+	p2pConfig.FlushThrottleTimeout = 0
+	n.transport = p2p.NewMemoryTransport(network, nodeInfo, nodeKey, p2p.MConnConfig(p2pConfig))
+	if err := n.transport.Listen(*netAddr); err != nil {
+		return err
+	}
+
+	n.reactor = newSimReactor(p2pConfig.RecvAsync, p2pConfig.ConsensusRecvBufSize)
+	n.sw = p2p.NewSwitch(p2pConfig, n.transport)
+	n.sw.SetLogger(n.logger.With("module", "p2p"))
+	n.reactor.SetLogger(n.logger.With("module", "p2p"))
+	n.sw.AddReactor("SIMULATION", n.reactor)
+	n.sw.SetNodeKey(&nodeKey)
+	n.sw.SetNodeInfo(nodeInfo)
+	n.netAddr = netAddr
+	return nil
+}
+
+// Start connects the nodes to each other, and starts them.
+func (s *simulation) Start() error {
+	for _, node := range s.nodes {
+		if err := node.sw.Start(); err != nil {
+			return err
+		}
+	}
+	for i, from := range s.nodes {
+		for _, to := range s.nodes[i+1:] {
+			if err := from.sw.DialPeerWithAddress(to.netAddr); err != nil {
+				return fmt.Errorf("failed to connect node %d to node %d: %w", from.Index, to.Index, err)
+			}
+		}
+	}
+	// the inbound peers are added by the switches asynchronously
+	deadline := time.Now().Add(simReceiveTimeout)
+	for _, node := range s.nodes {
+		for node.sw.Peers().Size() < len(s.nodes)-1 {
+			if time.Now().After(deadline) {
+				return fmt.Errorf("node %d is connected to %d nodes only", node.Index, node.sw.Peers().Size())
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	for _, node := range s.nodes {
+		if err := node.eventBus.Start(); err != nil {
+			return err
+		}
+		events, err := node.eventBus.Subscribe(context.Background(), "simulation", tmquery.Empty{},
+			simEventCapacity)
+		if err != nil {
+			return err
+		}
+		node.events = events
+		if err := node.State.Start(); err != nil {
+			return fmt.Errorf("failed to start node %d: %w", node.Index, err)
+		}
+	}
+	s.nextGossip = s.now + s.conf.GossipInterval
+	return nil
+}
+
+// Stop stops the nodes.
+func (s *simulation) Stop() error {
+	var errs []error
+	for _, node := range s.nodes {
+		if node.State.IsRunning() {
+			if err := node.State.Stop(); err != nil {
+				errs = append(errs, err)
+			}
+			node.State.Wait()
+		}
+		if node.sw.IsRunning() {
+			if err := node.sw.Stop(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if err := node.transport.Close(); err != nil {
+			errs = append(errs, err)
+		}
+		if node.eventBus.IsRunning() {
+			if err := node.eventBus.Stop(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// Now returns the virtual time elapsed since the start of the simulation.
+func (s *simulation) Now() time.Duration {
+	return s.now
+}
+
+// Nodes returns the nodes of the simulation.
+func (s *simulation) Nodes() []*simNode {
+	return s.nodes
+}
+
+// Trace returns the events of the simulation so far.
+func (s *simulation) Trace() []simEvent {
+	return s.trace
+}
+
+// Run runs the simulation for the given virtual duration. It returns an error
+// if the nodes commit conflicting blocks.
+func (s *simulation) Run(duration time.Duration) error {
+	end := s.now + duration
+	for {
+		done, err := s.step(end)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// RunUntilHeight runs the simulation until all the honest nodes committed
+// the given height. It returns an error if the nodes commit conflicting
+// blocks, or if they don't reach the height within the given virtual time.
+func (s *simulation) RunUntilHeight(height int64, timeout time.Duration) error {
+	end := s.now + timeout
+	for !s.reached(height) {
+		done, err := s.step(end)
+		if err != nil {
+			return err
+		}
+		if done {
+			heights := make([]int64, len(s.nodes))
+			for i, node := range s.nodes {
+				heights[i] = node.BlockStore.Height()
+			}
+			return fmt.Errorf("%w: height %d not reached after %v, the nodes are at heights %v",
+				errLivenessViolation, height, timeout, heights)
+		}
+	}
+	return nil
+}
+
+func (s *simulation) reached(height int64) bool {
+	for _, node := range s.nodes {
+		if !node.Byzantine() && node.BlockStore.Height() < height {
+			return false
+		}
+	}
+	return true
+}
+
+// step processes the next event if it is due before end, and returns true
+// otherwise. The messages are delivered first, then the timeouts, and then
+// the gossip, when due at the same time.
+func (s *simulation) step(end time.Duration) (bool, error) {
+	next := s.nextGossip
+	var timeoutNode *simNode
+	for _, node := range s.nodes {
+		deadline, ok := node.ticker.Deadline()
+		if ok && (deadline < next || (deadline == next && timeoutNode == nil)) {
+			next = deadline
+			timeoutNode = node
+		}
+	}
+	if len(s.inFlight) > 0 && s.inFlight[0].at <= next {
+		next = s.inFlight[0].at
+		timeoutNode = nil
+	}
+	if next > end {
+		s.now = end
+		return true, nil
+	}
+	s.now = next
+
+	switch {
+	case len(s.inFlight) > 0 && s.inFlight[0].at == next:
+		env := s.inFlight[0]
+		s.inFlight = s.inFlight[1:]
+		return false, s.deliver(env)
+	case timeoutNode != nil:
+		return false, s.fireTimeout(timeoutNode)
+	default:
+		s.nextGossip += s.conf.GossipInterval
+		return false, s.gossip()
+	}
+}
+
+// deliver sends the message over the p2p network, and hands it to the
+// consensus state of the receiver once received.
+func (s *simulation) deliver(env *simEnvelope) error {
+	peer := env.from.sw.Peers().Get(env.to.ID)
+	if peer == nil {
+		return fmt.Errorf("node %d is not connected to node %d", env.from.Index, env.to.Index)
+	}
+	if !peer.Send(env.chID, env.msgBytes) {
+		return fmt.Errorf("node %d failed to send %s to node %d", env.from.Index, simMsgString(env.msg),
+			env.to.Index)
+	}
+
+	var received simReceived
+	select {
+	case received = <-env.to.reactor.received:
+	case <-time.After(simReceiveTimeout):
+		return fmt.Errorf("node %d didn't receive %s from node %d", env.to.Index, simMsgString(env.msg),
+			env.from.Index)
+	}
+	if received.from != env.from.ID {
+		return fmt.Errorf("node %d received %s from %v rather than node %d", env.to.Index,
+			simMsgString(received.msg), received.from, env.from.Index)
+	}
+
+	var err error
+	switch msg := received.msg.(type) {
+	case *ProposalMessage:
+		err = env.to.State.SetProposal(msg.Proposal, received.from)
+	case *BlockPartMessage:
+		err = env.to.State.AddProposalBlockPart(msg.Height, msg.Round, msg.Part, received.from)
+	case *VoteMessage:
+		_, err = env.to.State.AddVote(msg.Vote, received.from)
+	default:
+		err = fmt.Errorf("unexpected message %T", msg)
+	}
+	if err != nil {
+		return err
+	}
+	env.to.flush()
+	s.record(simEventDeliver, env.from, env.to, simMsgString(received.msg))
+	return s.collect(env.to)
+}
+
+func (s *simulation) fireTimeout(node *simNode) error {
+	rs := node.State.GetRoundState()
+	if !node.ticker.Fire() {
+		return nil
+	}
+	node.flush()
+	s.record(simEventTimeout, node, node, fmt.Sprintf("Timeout{%d/%d %v}", rs.Height, rs.Round, rs.Step))
+	return s.collect(node)
+}
+
+// collect sends the votes and the proposal the node made in the last step,
+// and checks the blocks it committed.
+func (s *simulation) collect(node *simNode) error {
+	events, err := node.drainEvents()
+	if err != nil {
+		return err
+	}
+
+	var msgs []Message
+	for _, data := range events {
+		switch data := data.(type) {
+		case types.EventDataVote:
+			if bytes.Equal(data.Vote.ValidatorAddress, node.address) {
+				msgs = append(msgs, &VoteMessage{Vote: data.Vote})
+			}
+		case types.EventDataCompleteProposal:
+			rs := node.State.GetRoundState()
+			if rs.Height == data.Height && rs.Round == data.Round {
+				msgs = append(msgs, node.proposalMessages(rs)...)
+			}
+		}
+	}
+	for _, to := range s.nodes {
+		if to != node {
+			s.send(node, to, msgs)
+		}
+	}
+
+	return s.checkSafety(node)
+}
+
+// gossip sends to each node the votes and proposal of its peers it is
+// missing at its height, or the commits of the heights it is behind on.
+func (s *simulation) gossip() error {
+	for _, from := range s.nodes {
+		fromRS := from.State.GetRoundState()
+		for _, to := range s.nodes {
+			if to == from {
+				continue
+			}
+			toRS := to.State.GetRoundState()
+			var msgs []Message
+			switch {
+			case toRS.Height < fromRS.Height:
+				msgs = from.commitMessages(toRS.Height)
+			case toRS.Height == fromRS.Height:
+				msgs = from.missingMessages(fromRS, toRS)
+			}
+			s.send(from, to, msgs)
+		}
+	}
+	return nil
+}
+
+// send routes the messages from a node to another through the network
+// policies, after the behaviour of the sender altered them if byzantine.
+// The messages are encoded right away, so that the nodes share no memory.
+func (s *simulation) send(from, to *simNode, msgs []Message) {
+	if from.behaviour != nil && len(msgs) > 0 {
+		msgs = from.behaviour.Outbound(from, to.Index, msgs)
+	}
+	for _, msg := range msgs {
+		delay, ok := s.route(from.Index, to.Index, msg)
+		if !ok {
+			s.record(simEventDrop, from, to, simMsgString(msg))
+			continue
+		}
+		chID := DataChannel
+		if _, ok := msg.(*VoteMessage); ok {
+			chID = VoteChannel
+		}
+		s.seq++
+		env := &simEnvelope{
+			from:     from,
+			to:       to,
+			msg:      msg,
+			chID:     chID,
+			msgBytes: MustEncode(msg),
+			at:       s.now + delay,
+			seq:      s.seq,
+		}
+		i := sort.Search(len(s.inFlight), func(i int) bool { return s.inFlight[i].at > env.at })
+		s.inFlight = append(s.inFlight, nil)
+		copy(s.inFlight[i+1:], s.inFlight[i:])
+		s.inFlight[i] = env
+	}
+}
+
+func (s *simulation) route(from, to int, msg Message) (time.Duration, bool) {
+	var delay time.Duration
+	for _, policy := range s.conf.Policies {
+		d, ok := policy.Route(s.rng, s.now, from, to, msg)
+		if !ok {
+			return 0, false
+		}
+		delay += d
+	}
+	return delay, true
+}
+
+// checkSafety checks that the blocks the node committed since the last
+// check are the same as the ones of the other nodes.
+func (s *simulation) checkSafety(node *simNode) error {
+	for height := node.checkedHeight + 1; height <= node.BlockStore.Height(); height++ {
+		meta := node.BlockStore.LoadBlockMeta(height)
+		if meta == nil {
+			return fmt.Errorf("node %d has no block meta at height %d", node.Index, height)
+		}
+		hash, ok := s.blockHashes[height]
+		if !ok {
+			s.blockHashes[height] = meta.BlockID.Hash
+		} else if !bytes.Equal(hash, meta.BlockID.Hash) {
+			return fmt.Errorf("%w: node %d committed block %X at height %d, another node committed %X",
+				errSafetyViolation, node.Index, meta.BlockID.Hash, height, hash)
+		}
+		node.checkedHeight = height
+	}
+	return nil
+}
+
+func (s *simulation) record(typ simEventType, from, to *simNode, msg string) {
+	s.trace = append(s.trace, simEvent{Time: s.now, Type: typ, From: from.Index, To: to.Index, Msg: msg})
+}
+
+// flush blocks until the messages queued to the consensus state so far, and
+// the internal messages they cause, are processed, or the state is stopped.
+func (n *simNode) flush() {
+	done := make(chan struct{})
+	select {
+	case n.State.peerMsgQueue <- msgInfo{&simFlushMessage{done}, ""}:
+	case <-n.State.Quit():
+		return
+	}
+
+	select {
+	case <-done:
+	case <-n.State.Quit():
+	}
+}
+
+// drainEvents returns the events the node published so far. It publishes a
+// barrier event and reads the events until it.
+func (n *simNode) drainEvents() ([]types.OCEventData, error) {
+	if err := n.eventBus.Publish(simBarrierEvent, simBarrierData); err != nil {
+		return nil, err
+	}
+	var events []types.OCEventData
+	for {
+		select {
+		case msg := <-n.events.Out():
+			if msg.Data() == simBarrierData {
+				return events, nil
+			}
+			events = append(events, msg.Data())
+		case <-n.events.Cancelled():
+			return nil, fmt.Errorf("events of node %d cancelled: %w", n.Index, n.events.Err())
+		}
+	}
+}
+
+// proposalMessages returns the proposal of the round state and its block
+// parts, if the node is the proposer and the block is complete.
+func (n *simNode) proposalMessages(rs *cstypes.RoundState) []Message {
+	if rs.Proposal == nil || rs.Proposer == nil || !bytes.Equal(rs.Proposer.Address, n.address) ||
+		rs.ProposalBlockParts == nil || !rs.ProposalBlockParts.IsComplete() {
+		return nil
+	}
+	msgs := []Message{&ProposalMessage{Proposal: rs.Proposal}}
+	for i := 0; i < int(rs.ProposalBlockParts.Total()); i++ {
+		msgs = append(msgs, &BlockPartMessage{
+			Height: rs.Height,
+			Round:  rs.Round,
+			Part:   rs.ProposalBlockParts.GetPart(i),
+		})
+	}
+	return msgs
+}
+
+// missingMessages returns the votes of the node, and its proposal, that the
+// peer at the same height is missing.
+func (n *simNode) missingMessages(rs, peerRS *cstypes.RoundState) []Message {
+	var msgs []Message
+	if rs.Voters.HasAddress(n.address) {
+		for round := int32(0); round <= rs.Round; round++ {
+			if vote := rs.Votes.Prevotes(round).GetByAddress(n.address); vote != nil &&
+				peerRS.Votes.Prevotes(round).GetByAddress(n.address) == nil {
+				msgs = append(msgs, &VoteMessage{Vote: vote})
+			}
+			if vote := rs.Votes.Precommits(round).GetByAddress(n.address); vote != nil &&
+				peerRS.Votes.Precommits(round).GetByAddress(n.address) == nil {
+				msgs = append(msgs, &VoteMessage{Vote: vote})
+			}
+		}
+	}
+	if peerRS.Round == rs.Round &&
+		(peerRS.Proposal == nil || peerRS.ProposalBlockParts == nil || !peerRS.ProposalBlockParts.IsComplete()) {
+		msgs = append(msgs, n.proposalMessages(rs)...)
+	}
+	return msgs
+}
+
+// commitMessages returns the precommits of the commit of the given height,
+// and the parts of the committed block.
+func (n *simNode) commitMessages(height int64) []Message {
+	commit := n.BlockStore.LoadBlockCommit(height)
+	if commit == nil {
+		commit = n.BlockStore.LoadSeenCommit(height)
+	}
+	meta := n.BlockStore.LoadBlockMeta(height)
+	if commit == nil || meta == nil {
+		return nil
+	}
+
+	var msgs []Message
+	for i, sig := range commit.Signatures {
+		if !sig.Absent() {
+			msgs = append(msgs, &VoteMessage{Vote: commit.GetVote(int32(i))})
+		}
+	}
+	for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+		part := n.BlockStore.LoadBlockPart(height, i)
+		if part == nil {
+			break
+		}
+		msgs = append(msgs, &BlockPartMessage{Height: height, Round: commit.Round, Part: part})
+	}
+	return msgs
+}
+
+//-------------------------------------------------------------
+
+// simReceived is a message received by the reactor of a node.
+type simReceived struct {
+	from p2p.ID
+	msg  Message
+}
+
+// simReactor receives the consensus messages sent to a node of a
+// simulation, for the simulation to hand them to the consensus state.
+type simReactor struct {
+	p2p.BaseReactor
+
+	received chan simReceived
+}
+
+func newSimReactor(async bool, recvBufSize int) *simReactor {
+	r := &simReactor{received: make(chan simReceived)}
+	r.BaseReactor = *p2p.NewBaseReactor("SimulationReactor", r, async, recvBufSize)
+	return r
+}
+
+// GetChannels implements Reactor.
+func (r *simReactor) GetChannels() []*p2p.ChannelDescriptor {
+	return []*p2p.ChannelDescriptor{
+		{
+			ID:                  DataChannel,
+			Priority:            10,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: maxMsgSize,
+		},
+		{
+			ID:                  VoteChannel,
+			Priority:            7,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  100 * 100,
+			RecvMessageCapacity: maxMsgSize,
+		},
+	}
+}
+
+// Receive implements Reactor.
+func (r *simReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
+	msg, err := decodeMsg(msgBytes)
+	if err != nil {
+		r.Logger.Error("error decoding message", "src", src, "chId", chID, "err", err)
+		r.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	select {
+	case r.received <- simReceived{src.ID(), msg}:
+	case <-r.Quit():
+	}
+}
+
+// simFlushMessage is queued by simNode.flush behind the peer messages of a
+// consensus state.
+type simFlushMessage struct {
+	done chan struct{}
+}
+
+func (m *simFlushMessage) ValidateBasic() error {
+	return nil
+}
+
+// simFlushWAL is the WAL of the consensus states of a simulation. The receive
+// routine writes each message to the WAL right before processing it, so once
+// a simFlushMessage is written, the messages queued before it were processed.
+// The state then drops it as a message of an unknown type.
+type simFlushWAL struct {
+	nilWAL
+
+	cs *State
+}
+
+func (w *simFlushWAL) Write(m WALMessage) error {
+	mi, ok := m.(msgInfo)
+	if !ok {
+		return nil
+	}
+	flush, ok := mi.Msg.(*simFlushMessage)
+	if !ok {
+		return nil
+	}
+
+	if len(w.cs.internalMsgQueue) > 0 {
+		// requeue it behind the internal messages
+		go func() {
+			select {
+			case w.cs.peerMsgQueue <- mi:
+			case <-w.cs.Quit():
+			}
+		}()
+		return nil
+	}
+	close(flush.done)
+	return nil
+}
+
+//-------------------------------------------------------------
+
+// virtualTimeoutTicker is a TimeoutTicker driven by a virtual clock instead of
+// the wall clock, for simulations to be deterministic. A scheduled timeout
+// is not fired on its own, but by Fire once the clock reached its deadline.
+type virtualTimeoutTicker struct {
+	service.BaseService
+
+	now func() time.Duration // the virtual clock
+
+	mtx       tmsync.Mutex
+	ti        timeoutInfo
+	deadline  time.Duration
+	scheduled bool
+	tockChan  chan timeoutInfo
+}
+
+var _ TimeoutTicker = (*virtualTimeoutTicker)(nil)
+
+// newVirtualTimeoutTicker returns a new virtualTimeoutTicker reading the
+// time from the given virtual clock.
+func newVirtualTimeoutTicker(now func() time.Duration) *virtualTimeoutTicker {
+	t := &virtualTimeoutTicker{
+		now:      now,
+		tockChan: make(chan timeoutInfo),
+	}
+	t.BaseService = *service.NewBaseService(nil, "VirtualTimeoutTicker", t)
+	return t
+}
+
+// Chan returns a channel on which timeouts are sent. It is unbuffered, so
+// Fire returns once the timeout is received.
+func (t *virtualTimeoutTicker) Chan() <-chan timeoutInfo {
+	return t.tockChan
+}
+
+// ScheduleTimeout replaces the scheduled timeout if ti is for a later
+// height/round/step. The deadline is the current time of the clock plus
+// the duration of ti, if positive.
+func (t *virtualTimeoutTicker) ScheduleTimeout(ti timeoutInfo) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if !isLaterTimeout(ti, t.ti) {
+		return
+	}
+	t.ti = ti
+	t.deadline = t.now()
+	if ti.Duration > 0 {
+		t.deadline += ti.Duration
+	}
+	t.scheduled = true
+}
+
+// Deadline returns the deadline of the scheduled timeout, if any.
+func (t *virtualTimeoutTicker) Deadline() (time.Duration, bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	return t.deadline, t.scheduled
+}
+
+// Fire fires the scheduled timeout if the clock reached its deadline, and
+// returns true if it did. It blocks until the timeout is received, or the
+// ticker is stopped.
+func (t *virtualTimeoutTicker) Fire() bool {
+	t.mtx.Lock()
+	if !t.scheduled || t.deadline > t.now() {
+		t.mtx.Unlock()
+		return false
+	}
+	t.scheduled = false
+	ti := t.ti
+	t.mtx.Unlock()
+
+	select {
+	case t.tockChan <- ti:
+		return true
+	case <-t.Quit():
+		return false
+	}
+}
+
+//-------------------------------------------------------------
+
+// simEvidencePool records the conflicting votes reported by the consensus
+// state.
+type simEvidencePool struct {
+	mtx   tmsync.Mutex
+	votes [][2]*types.Vote
+}
+
+func (p *simEvidencePool) ReportConflictingVotes(voteA, voteB *types.Vote) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.votes = append(p.votes, [2]*types.Vote{voteA, voteB})
+}
+
+func (p *simEvidencePool) conflictingVotes() [][2]*types.Vote {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return append([][2]*types.Vote(nil), p.votes...)
+}
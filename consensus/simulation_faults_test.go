@@ -0,0 +1,301 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/line/ostracon/crypto/tmhash"
+	"github.com/line/ostracon/types"
+)
+
+// simPolicy decides how the network delivers a message. The policies of a
+// simulation are applied in order: the delays add up, and a message dropped
+// by any of them is dropped.
+type simPolicy interface {
+	// Route returns the delay of the message sent at now from the node of
+	// index from to the node of index to, or false if it is dropped. The
+	// random choices must be made with rng for the simulation to be
+	// deterministic.
+	Route(rng *rand.Rand, now time.Duration, from, to int, msg Message) (time.Duration, bool)
+}
+
+// simDelay delays each message by a random duration between Min and Max.
+type simDelay struct {
+	Min, Max time.Duration
+}
+
+// Route implements simPolicy.
+func (p simDelay) Route(rng *rand.Rand, _ time.Duration, _, _ int, _ Message) (time.Duration, bool) {
+	return p.Min + randDuration(rng, p.Max-p.Min), true
+}
+
+// simDrop drops each message with the probability Rate.
+type simDrop struct {
+	Rate float64
+}
+
+// Route implements simPolicy.
+func (p simDrop) Route(rng *rand.Rand, _ time.Duration, _, _ int, _ Message) (time.Duration, bool) {
+	return 0, rng.Float64() >= p.Rate
+}
+
+// simPartition drops the messages between nodes of different groups from the
+// time From until the time Until. The nodes in no group are cut from all the
+// others.
+type simPartition struct {
+	Groups      [][]int
+	From, Until time.Duration
+}
+
+// Route implements simPolicy.
+func (p simPartition) Route(_ *rand.Rand, now time.Duration, from, to int, _ Message) (time.Duration, bool) {
+	if now < p.From || now >= p.Until {
+		return 0, true
+	}
+	for _, group := range p.Groups {
+		if contains(group, from) {
+			return 0, contains(group, to)
+		}
+	}
+	return 0, false
+}
+
+// simReorder delays each message with the probability Rate by a random
+// duration up to MaxDelay, so that it is overtaken by the messages sent
+// after it.
+type simReorder struct {
+	Rate     float64
+	MaxDelay time.Duration
+}
+
+// Route implements simPolicy.
+func (p simReorder) Route(rng *rand.Rand, _ time.Duration, _, _ int, _ Message) (time.Duration, bool) {
+	if rng.Float64() >= p.Rate {
+		return 0, true
+	}
+	return randDuration(rng, p.MaxDelay), true
+}
+
+func randDuration(rng *rand.Rand, max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rng.Int63n(int64(max) + 1))
+}
+
+func contains(indexes []int, index int) bool {
+	for _, i := range indexes {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
+
+//-----------------------------------------------------------------------------
+
+// simEventType is the type of an event of the simulation.
+type simEventType string
+
+const (
+	// simEventDeliver is the delivery of a message to a node.
+	simEventDeliver simEventType = "deliver"
+	// simEventDrop is a message dropped by the network.
+	simEventDrop simEventType = "drop"
+	// simEventTimeout is a timeout fired on a node.
+	simEventTimeout simEventType = "timeout"
+)
+
+// simEvent is an event of the simulation. The message is described without
+// the timestamps and hashes, so that the events of two runs with the same
+// seed are equal.
+type simEvent struct {
+	Time     time.Duration
+	Type     simEventType
+	From, To int
+	Msg      string
+}
+
+func (e simEvent) String() string {
+	return fmt.Sprintf("%v %s %d->%d %s", e.Time, e.Type, e.From, e.To, e.Msg)
+}
+
+func simMsgString(msg Message) string {
+	switch msg := msg.(type) {
+	case *ProposalMessage:
+		return fmt.Sprintf("Proposal{%d/%d POL:%d}", msg.Proposal.Height, msg.Proposal.Round, msg.Proposal.POLRound)
+	case *BlockPartMessage:
+		return fmt.Sprintf("BlockPart{%d/%d #%d}", msg.Height, msg.Round, msg.Part.Index)
+	case *VoteMessage:
+		target := "block"
+		if msg.Vote.BlockID.IsZero() {
+			target = "nil"
+		}
+		return fmt.Sprintf("Vote{%d/%d %v %s by %d}",
+			msg.Vote.Height, msg.Vote.Round, msg.Vote.Type, target, msg.Vote.ValidatorIndex)
+	default:
+		return fmt.Sprintf("%T", msg)
+	}
+}
+
+//-----------------------------------------------------------------------------
+
+var errNoProposalBlock = errors.New("no proposal block with a VRF proof")
+
+// simBehaviour alters the messages a byzantine node sends. The node itself runs
+// an honest consensus state.
+type simBehaviour interface {
+	// Outbound returns the messages the node sends to the node of index to,
+	// in place of msgs.
+	Outbound(node *simNode, to int, msgs []Message) []Message
+}
+
+// simEquivocate sends a conflicting vote along with each vote of the node: a
+// vote for nil if the vote is for a block, and for a made-up block
+// otherwise. The peers of even index receive the conflicting vote first, so
+// that they count it rather than the original one.
+type simEquivocate struct{}
+
+// Outbound implements simBehaviour.
+func (simEquivocate) Outbound(node *simNode, to int, msgs []Message) []Message {
+	out := make([]Message, 0, 2*len(msgs))
+	for _, msg := range msgs {
+		vm, ok := msg.(*VoteMessage)
+		if !ok || !bytes.Equal(vm.Vote.ValidatorAddress, node.address) {
+			out = append(out, msg)
+			continue
+		}
+
+		conflicting := vm.Vote.Copy()
+		if vm.Vote.BlockID.IsZero() {
+			hash := tmhash.Sum([]byte("equivocation"))
+			conflicting.BlockID = types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Total: 1, Hash: hash}}
+		} else {
+			conflicting.BlockID = types.BlockID{}
+		}
+		pb := conflicting.ToProto()
+		if err := node.PrivValidator.SignVote(simChainID, pb, false); err != nil {
+			node.logger.Error("failed to sign conflicting vote", "err", err)
+			out = append(out, msg)
+			continue
+		}
+		conflicting.Signature = pb.Signature
+
+		if to%2 == 0 {
+			out = append(out, &VoteMessage{Vote: conflicting}, msg)
+		} else {
+			out = append(out, msg, &VoteMessage{Vote: conflicting})
+		}
+	}
+	return out
+}
+
+// simWithholdProposal does not send the proposals of the node, nor their block
+// parts, to the nodes of the given indexes, or to all of them if To is empty.
+type simWithholdProposal struct {
+	To []int
+}
+
+// Outbound implements simBehaviour.
+func (b simWithholdProposal) Outbound(node *simNode, to int, msgs []Message) []Message {
+	if len(b.To) > 0 && !contains(b.To, to) {
+		return msgs
+	}
+	height := node.State.GetRoundState().Height
+	out := make([]Message, 0, len(msgs))
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *ProposalMessage:
+			continue
+		case *BlockPartMessage:
+			// the parts of the committed blocks are still sent to the nodes
+			// catching up
+			if msg.Height == height {
+				continue
+			}
+		}
+		out = append(out, msg)
+	}
+	return out
+}
+
+// simTamperVRFProof replaces the proposals of the node with the proposal of a
+// copy of the block whose VRF proof is altered, which the honest nodes must
+// reject. It must be used by pointer, as it keeps the tampered proposal.
+type simTamperVRFProof struct {
+	original *types.Proposal
+	msgs     []Message
+}
+
+// Outbound implements simBehaviour.
+func (b *simTamperVRFProof) Outbound(node *simNode, to int, msgs []Message) []Message {
+	var proposal *types.Proposal
+	for _, msg := range msgs {
+		if pm, ok := msg.(*ProposalMessage); ok {
+			proposal = pm.Proposal
+		}
+	}
+	if proposal == nil {
+		return msgs
+	}
+	if b.original == nil || !bytes.Equal(b.original.Signature, proposal.Signature) {
+		tampered, err := b.tamper(node, proposal)
+		if err != nil {
+			node.logger.Error("failed to tamper proposal", "err", err)
+			return msgs
+		}
+		b.original = proposal
+		b.msgs = tampered
+	}
+
+	out := make([]Message, 0, len(msgs))
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *ProposalMessage:
+			continue
+		case *BlockPartMessage:
+			if msg.Height == proposal.Height && msg.Round == proposal.Round {
+				continue
+			}
+		}
+		out = append(out, msg)
+	}
+	return append(out, b.msgs...)
+}
+
+func (b *simTamperVRFProof) tamper(node *simNode, proposal *types.Proposal) ([]Message, error) {
+	rs := node.State.GetRoundState()
+	if rs.ProposalBlock == nil || len(rs.ProposalBlock.Proof) == 0 ||
+		!bytes.Equal(rs.ProposalBlock.Hash(), proposal.BlockID.Hash) {
+		return nil, errNoProposalBlock
+	}
+	pb, err := rs.ProposalBlock.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	block, err := types.BlockFromProto(pb)
+	if err != nil {
+		return nil, err
+	}
+	block.Proof = append([]byte(nil), block.Proof...)
+	block.Proof[len(block.Proof)-1] ^= 0xff
+
+	parts := block.MakePartSetWithParity(types.BlockPartSizeBytes,
+		node.State.GetState().ConsensusParams.Block.ErasureParityPercent)
+	tampered := types.NewProposal(proposal.Height, proposal.Round, proposal.POLRound,
+		types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()})
+	ppb := tampered.ToProto()
+	if err := node.PrivValidator.SignProposal(simChainID, ppb); err != nil {
+		return nil, err
+	}
+	tampered.Signature = ppb.Signature
+
+	msgs := []Message{&ProposalMessage{Proposal: tampered}}
+	for i := 0; i < int(parts.Total()); i++ {
+		msgs = append(msgs, &BlockPartMessage{Height: block.Height, Round: proposal.Round, Part: parts.GetPart(i)})
+	}
+	return msgs, nil
+}
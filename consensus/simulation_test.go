@@ -0,0 +1,143 @@
+package consensus
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/types"
+)
+
+func runSimulation(t *testing.T, conf simConfig, height int64) *simulation {
+	s, err := newSimulation(conf)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	t.Cleanup(func() { assert.NoError(t, s.Stop()) })
+
+	require.NoError(t, s.RunUntilHeight(height, time.Minute))
+	return s
+}
+
+func TestSimulation(t *testing.T) {
+	s := runSimulation(t, defaultSimConfig(4), 5)
+	for _, node := range s.Nodes() {
+		assert.GreaterOrEqual(t, node.BlockStore.Height(), int64(5))
+	}
+}
+
+func TestSimulationDeterministic(t *testing.T) {
+	conf := defaultSimConfig(4)
+	conf.Seed = 7
+	conf.Policies = []simPolicy{
+		simDelay{Min: time.Millisecond, Max: 10 * time.Millisecond},
+		simDrop{Rate: 0.1},
+		simReorder{Rate: 0.2, MaxDelay: 20 * time.Millisecond},
+	}
+
+	traces := make([][]simEvent, 2)
+	for i := range traces {
+		traces[i] = runSimulation(t, conf, 3).Trace()
+	}
+	require.NotEmpty(t, traces[0])
+	assert.Equal(t, traces[0], traces[1])
+}
+
+func TestSimulationPartition(t *testing.T) {
+	conf := defaultSimConfig(4)
+	conf.Policies = []simPolicy{
+		simDelay{Max: 5 * time.Millisecond},
+		simPartition{Groups: [][]int{{0, 1}, {2, 3}}, Until: time.Second},
+	}
+	s, err := newSimulation(conf)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer func() { assert.NoError(t, s.Stop()) }()
+
+	// no group has more than 2/3 of the voting power
+	require.NoError(t, s.Run(time.Second))
+	for _, node := range s.Nodes() {
+		assert.Zero(t, node.BlockStore.Height())
+	}
+
+	require.NoError(t, s.RunUntilHeight(3, time.Minute))
+}
+
+func TestSimulationErasureCoding(t *testing.T) {
+	conf := defaultSimConfig(4)
+	conf.ConsensusParams = types.DefaultConsensusParams()
+	conf.ConsensusParams.Block.ErasureParityPercent = 100
+	conf.Policies = []simPolicy{
+		simDelay{Max: 5 * time.Millisecond},
+		simDrop{Rate: 0.2},
+	}
+	s := runSimulation(t, conf, 5)
+	block := s.Nodes()[0].BlockStore.LoadBlock(1)
+	require.NotNil(t, block)
+	assert.EqualValues(t, 2, s.Nodes()[0].BlockStore.LoadBlockMeta(1).BlockID.PartSetHeader.Total)
+}
+
+func TestSimulationByzantine(t *testing.T) {
+	const byzantine = 0
+	testCases := map[string]struct {
+		behaviour simBehaviour
+		check     func(t *testing.T, s *simulation)
+	}{
+		"equivocation": {
+			simEquivocate{},
+			func(t *testing.T, s *simulation) {
+				reported := false
+				for _, node := range s.Nodes()[1:] {
+					for _, votes := range node.ConflictingVotes() {
+						assert.Equal(t, s.Nodes()[byzantine].Address(), votes[0].ValidatorAddress)
+						reported = true
+					}
+				}
+				assert.True(t, reported, "no conflicting votes reported")
+			},
+		},
+		"withheld proposals":  {simWithholdProposal{}, checkNotProposer},
+		"VRF proof tampering": {&simTamperVRFProof{}, checkNotProposer},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			conf := defaultSimConfig(4)
+			conf.Byzantine = map[int]simBehaviour{byzantine: tc.behaviour}
+			s := runSimulation(t, conf, 10)
+			tc.check(t, s)
+		})
+	}
+}
+
+// checkNotProposer checks that none of the blocks committed by the honest
+// nodes were proposed by the byzantine node 0, and that some heights took
+// more than one round, when node 0 was the proposer.
+func checkNotProposer(t *testing.T, s *simulation) {
+	node := s.Nodes()[1]
+	rounds := 0
+	for height := int64(1); height <= node.BlockStore.Height(); height++ {
+		block := node.BlockStore.LoadBlock(height)
+		require.NotNil(t, block)
+		assert.False(t, bytes.Equal(s.Nodes()[0].Address(), block.ProposerAddress), "height %d", height)
+		if block.Round > 0 {
+			rounds++
+		}
+	}
+	assert.NotZero(t, rounds)
+}
+
+func TestSimulationLivenessViolation(t *testing.T) {
+	conf := defaultSimConfig(4)
+	conf.Policies = []simPolicy{simDrop{Rate: 1}}
+	s, err := newSimulation(conf)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer func() { assert.NoError(t, s.Stop()) }()
+
+	err = s.RunUntilHeight(1, time.Second)
+	assert.ErrorIs(t, err, errLivenessViolation)
+	assert.Equal(t, time.Second, s.Now())
+}
@@ -349,6 +349,12 @@ func (cs *State) LoadCommit(height int64) *types.Commit {
 // OnStart loads the latest state via the WAL, and starts the timeout and
 // receive routines.
 func (cs *State) OnStart() error {
+	if cs.state.LastBlockHeight > 0 && !cs.LastCommit.ExtensionsEnabled() &&
+		types.VoteExtensionsEnabled(cs.state.ConsensusParams.ABCI, cs.state.LastBlockHeight) {
+		cs.Logger.Error("no extended commit for the last height; if we propose the next block, "+
+			"the app gets no vote extensions in PrepareProposal", "height", cs.state.LastBlockHeight)
+	}
+
 	// We may set the WAL in testing before calling Start, so only OpenWAL if its
 	// still the nilWAL.
 	if _, ok := cs.wal.(nilWAL); ok {
@@ -601,13 +607,35 @@ func (cs *State) sendInternalMessage(mi msgInfo) {
 		// TODO: use CList here for strict determinism and
 		// attempt push to internalMsgQueue in receiveRoutine
 		cs.Logger.Debug("internal msg queue is full; using a go-routine")
-		go func() { cs.internalMsgQueue <- mi }()
+		go func() {
+			select {
+			case cs.internalMsgQueue <- mi:
+			case <-cs.Quit():
+			}
+		}()
 	}
 }
 
 // Reconstruct LastCommit from SeenCommit, which we saved along with the block,
 // (which happens even before saving the state)
+//
+// Once the vote extensions are enabled, the extended commit saved along with
+// the block is used instead. The block sync saves it too, but the state sync
+// does not, so right after the latter LastCommit has no extensions, and if we
+// propose the next block, the app gets none in PrepareProposal. OnStart logs an
+// error in this case.
 func (cs *State) reconstructLastCommit(state sm.State) {
+	if types.VoteExtensionsEnabled(state.ConsensusParams.ABCI, state.LastBlockHeight) {
+		if extCommit := cs.blockStore.LoadBlockExtendedCommit(state.LastBlockHeight); extCommit != nil {
+			lastPrecommits := types.ExtendedCommitToVoteSet(state.ChainID, extCommit, state.LastVoters)
+			if !lastPrecommits.HasTwoThirdsMajority() {
+				panic("failed to reconstruct last extended commit; does not have +2/3 maj")
+			}
+			cs.LastCommit = lastPrecommits
+			return
+		}
+	}
+
 	seenCommit := cs.blockStore.LoadSeenCommit(state.LastBlockHeight)
 	if seenCommit == nil {
 		panic(fmt.Sprintf(
@@ -722,7 +750,11 @@ func (cs *State) updateToState(state sm.State) {
 	cs.ValidRound = -1
 	cs.ValidBlock = nil
 	cs.ValidBlockParts = nil
-	cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, voters)
+	if types.VoteExtensionsEnabled(state.ConsensusParams.ABCI, height) {
+		cs.Votes = cstypes.NewExtendedHeightVoteSet(state.ChainID, height, voters)
+	} else {
+		cs.Votes = cstypes.NewHeightVoteSet(state.ChainID, height, voters)
+	}
 	cs.CommitRound = -1
 	cs.LastVoters = state.LastVoters
 	cs.TriggeredTimeoutPrecommit = false
@@ -1240,16 +1272,17 @@ func (cs *State) createProposalBlock(round int32) (block *types.Block, blockPart
 		panic("entered createProposalBlock with privValidator being nil")
 	}
 
-	var commit *types.Commit
+	var lastExtCommit *types.ExtendedCommit
 	switch {
 	case cs.Height == cs.state.InitialHeight:
 		// We're creating a proposal for the first block.
 		// The commit is empty, but not nil.
-		commit = types.NewCommit(0, 0, types.BlockID{}, nil)
+		lastExtCommit = &types.ExtendedCommit{}
 
 	case cs.LastCommit.HasTwoThirdsMajority():
-		// Make the commit from LastCommit
-		commit = cs.LastCommit.MakeCommit()
+		// Make the commit from LastCommit, with the vote extensions, if any,
+		// for the app.
+		lastExtCommit = cs.LastCommit.MakeExtendedCommit()
 	default: // This shouldn't happen.
 		cs.Logger.Error("propose step; cannot propose anything without commit for the previous block")
 		return
@@ -1272,7 +1305,8 @@ func (cs *State) createProposalBlock(round int32) (block *types.Block, blockPart
 		return
 	}
 
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr, round, proof, cs.config.MaxTxs)
+	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, lastExtCommit, proposerAddr, round, proof,
+		cs.config.MaxTxs)
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -1335,6 +1369,18 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		return
 	}
 
+	// Let the app validate the proposal block
+	accepted, err := cs.blockExec.ProcessProposal(cs.state, cs.ProposalBlock)
+	if err != nil {
+		panic(fmt.Sprintf("ProcessProposal: %v", err))
+	}
+	if !accepted {
+		// ProposalBlock is rejected by the app, prevote nil.
+		logger.Error("prevote step: ProposalBlock is rejected by the app")
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1493,7 +1539,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 
 	if !cs.ProposalBlockParts.HasHeader(blockID.PartSetHeader) {
 		cs.ProposalBlock = nil
-		cs.ProposalBlockParts = types.NewPartSetFromHeader(blockID.PartSetHeader)
+		cs.ProposalBlockParts = cs.newProposalBlockParts(logger, blockID.PartSetHeader)
 	}
 
 	if err := cs.eventBus.PublishEventUnlock(cs.RoundStateEvent()); err != nil {
@@ -1587,7 +1633,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 			// We're getting the wrong block.
 			// Set up ProposalBlockParts and keep waiting.
 			cs.ProposalBlock = nil
-			cs.ProposalBlockParts = types.NewPartSetFromHeader(blockID.PartSetHeader)
+			cs.ProposalBlockParts = cs.newProposalBlockParts(logger, blockID.PartSetHeader)
 
 			if err := cs.eventBus.PublishEventValidBlock(cs.RoundStateEvent()); err != nil {
 				logger.Error("failed publishing valid block", "err", err)
@@ -1671,8 +1717,13 @@ func (cs *State) finalizeCommit(height int64) {
 		// NOTE: the seenCommit is local justification to commit this block,
 		// but may differ from the LastCommit included in the next block
 		precommits := cs.Votes.Precommits(cs.CommitRound)
-		seenCommit := precommits.MakeCommit()
-		cs.blockStore.SaveBlock(block, blockParts, seenCommit)
+		if precommits.ExtensionsEnabled() {
+			// Keep the vote extensions for the proposer of the next height.
+			cs.blockStore.SaveBlockWithExtendedCommit(block, blockParts, precommits.MakeExtendedCommit())
+		} else {
+			seenCommit := precommits.MakeCommit()
+			cs.blockStore.SaveBlock(block, blockParts, seenCommit)
+		}
 	} else {
 		// Happens during replay if we already saved the block but didn't commit
 		logger.Debug("calling finalizeCommit on already stored block", "height", block.Height)
@@ -1954,13 +2005,31 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
 	if cs.ProposalBlockParts == nil {
-		cs.ProposalBlockParts = types.NewPartSetFromHeader(proposal.BlockID.PartSetHeader)
+		parts, err := types.NewPartSetFromHeaderWithParity(proposal.BlockID.PartSetHeader,
+			cs.state.ConsensusParams.Block.ErasureParityPercent)
+		if err != nil {
+			return fmt.Errorf("invalid proposal block parts: %w", err)
+		}
+		cs.ProposalBlockParts = parts
 	}
 
 	cs.Logger.Info("received proposal", "proposal", proposal)
 	return nil
 }
 
+// newProposalBlockParts returns an empty part set of the given header, erasure
+// coded if the consensus params say so. It returns nil if the header is not
+// that of an erasure coded part set, which +2/3 of the voters can't have
+// voted for.
+func (cs *State) newProposalBlockParts(logger log.Logger, header types.PartSetHeader) *types.PartSet {
+	parts, err := types.NewPartSetFromHeaderWithParity(header, cs.state.ConsensusParams.Block.ErasureParityPercent)
+	if err != nil {
+		logger.Error("invalid block part set header", "header", header, "err", err)
+		return nil
+	}
+	return parts
+}
+
 // NOTE: block is not necessarily valid.
 // Asynchronously triggers either enterPrevote (before we timeout of propose) or tryFinalizeCommit,
 // once we have the full block.
@@ -1991,9 +2060,11 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 	if err != nil {
 		return added, err
 	}
-	if cs.ProposalBlockParts.ByteSize() > cs.state.ConsensusParams.Block.MaxBytes {
+	maxByteSize := types.MaxPartSetByteSize(cs.state.ConsensusParams.Block.MaxBytes,
+		types.BlockPartSizeBytes, cs.state.ConsensusParams.Block.ErasureParityPercent)
+	if cs.ProposalBlockParts.ByteSize() > maxByteSize {
 		return added, fmt.Errorf("total size of proposal block parts exceeds maximum block bytes (%d > %d)",
-			cs.ProposalBlockParts.ByteSize(), cs.state.ConsensusParams.Block.MaxBytes,
+			cs.ProposalBlockParts.ByteSize(), maxByteSize,
 		)
 	}
 	if added && cs.ProposalBlockParts.IsComplete() {
@@ -2001,6 +2072,10 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		if err != nil {
 			return added, err
 		}
+		if int64(len(bz)) > cs.state.ConsensusParams.Block.MaxBytes {
+			return added, fmt.Errorf("proposal block exceeds maximum block bytes (%d > %d)",
+				len(bz), cs.state.ConsensusParams.Block.MaxBytes)
+		}
 
 		var pbb = new(tmproto.Block)
 		err = proto.Unmarshal(bz, pbb)
@@ -2021,6 +2096,12 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		if err := cs.eventBus.PublishEventCompleteProposal(cs.CompleteProposalEvent()); err != nil {
 			cs.Logger.Error("failed publishing event complete proposal", "err", err)
 		}
+
+		// The parts of an erasure coded block may have been rebuilt before
+		// all of them were received: let the reactor tell the peers.
+		if cs.ProposalBlockParts.IsErasureCoded() {
+			cs.evsw.FireEvent(types.EventCompleteProposal, &cs.RoundState)
+		}
 	}
 	return added, nil
 }
@@ -2127,6 +2208,9 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			return
 		}
 
+		if !cs.LastCommit.ExtensionsEnabled() {
+			vote.StripExtension()
+		}
 		added, err = cs.LastCommit.AddVote(vote)
 		if !added {
 			return
@@ -2156,6 +2240,16 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		return
 	}
 
+	if vote.Type == tmproto.PrecommitType {
+		if !types.VoteExtensionsEnabled(cs.state.ConsensusParams.ABCI, cs.Height) {
+			// The signers sign no extension until the vote extensions are
+			// enabled; drop one a peer attached anyway.
+			vote.StripExtension()
+		} else if err := cs.verifyVoteExtension(vote); err != nil {
+			return false, err
+		}
+	}
+
 	height := cs.Height
 	added, err = cs.Votes.AddVote(vote, peerID)
 	if !added {
@@ -2218,7 +2312,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 				}
 
 				if !cs.ProposalBlockParts.HasHeader(blockID.PartSetHeader) {
-					cs.ProposalBlockParts = types.NewPartSetFromHeader(blockID.PartSetHeader)
+					cs.ProposalBlockParts = cs.newProposalBlockParts(cs.Logger, blockID.PartSetHeader)
 				}
 
 				cs.evsw.FireEvent(types.EventValidBlock, &cs.RoundState)
@@ -2285,6 +2379,28 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 }
 
 // CONTRACT: cs.privValidator is not nil.
+// verifyVoteExtension checks the signature of the extension of a precommit for
+// a block from another validator, and then has the app verify the extension.
+func (cs *State) verifyVoteExtension(vote *types.Vote) error {
+	if vote.BlockID.IsZero() ||
+		(cs.privValidatorPubKey != nil && bytes.Equal(vote.ValidatorAddress, cs.privValidatorPubKey.Address())) {
+		return nil
+	}
+
+	_, voter := cs.Voters.GetByIndex(vote.ValidatorIndex)
+	if voter == nil {
+		return fmt.Errorf("cannot find voter %d in voterSet of size %d: %w",
+			vote.ValidatorIndex, cs.Voters.Size(), types.ErrVoteInvalidValidatorIndex)
+	}
+	if err := vote.EnsureExtension(); err != nil {
+		return err
+	}
+	if err := vote.VerifyExtension(cs.state.ChainID, voter.PubKey); err != nil {
+		return err
+	}
+	return cs.blockExec.VerifyVoteExtension(vote)
+}
+
 func (cs *State) signVote(
 	msgType tmproto.SignedMsgType,
 	hash []byte,
@@ -2313,10 +2429,24 @@ func (cs *State) signVote(
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
 	}
 
+	// The precommits for a block carry an extension from the app, once the
+	// vote extensions are enabled.
+	extEnabled := types.VoteExtensionsEnabled(cs.state.ConsensusParams.ABCI, cs.Height)
+	if extEnabled && msgType == tmproto.PrecommitType && !vote.BlockID.IsZero() {
+		ext, err := cs.blockExec.ExtendVote(vote)
+		if err != nil {
+			return nil, err
+		}
+		vote.Extension = ext
+	}
+
 	v := vote.ToProto()
-	err := cs.privValidator.SignVote(cs.state.ChainID, v)
+	err := cs.privValidator.SignVote(cs.state.ChainID, v, extEnabled)
 	vote.Signature = v.Signature
 	vote.Timestamp = v.Timestamp
+	if extEnabled {
+		vote.ExtensionSignature = v.ExtensionSignature
+	}
 
 	return vote, err
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -246,6 +247,78 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, tmproto.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+type rejectProposalApp struct {
+	abci.BaseApplication
+}
+
+func (rejectProposalApp) ProcessProposal(abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+}
+
+func TestStateProcessProposalReject(t *testing.T) {
+	cs1, vss := randStateWithVoterParamsWithApp(1, types.DefaultVoterParams(), rejectProposalApp{})
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+
+	// the app rejects the proposal, so prevote nil
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], nil)
+}
+
+type voteExtensionApp struct {
+	abci.BaseApplication
+
+	mtx         sync.Mutex
+	lastCommits map[int64]abci.ExtendedCommitInfo
+}
+
+func (*voteExtensionApp) ExtendVote(req abci.RequestExtendVote) abci.ResponseExtendVote {
+	return abci.ResponseExtendVote{VoteExtension: []byte(fmt.Sprintf("price@%d", req.Height))}
+}
+
+func (app *voteExtensionApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.lastCommits[req.Height] = req.LocalLastCommit
+	return abci.ResponsePrepareProposal{Txs: req.Txs}
+}
+
+func TestStateVoteExtensions(t *testing.T) {
+	state, privVals := randGenesisState(1, false, 10, types.DefaultVoterParams())
+	state.LastProofHash = []byte{2}
+	state.ConsensusParams.ABCI.VoteExtensionsEnableHeight = state.InitialHeight
+	app := &voteExtensionApp{lastCommits: make(map[int64]abci.ExtendedCommitInfo)}
+	cs1 := newState(state, privVals[0], app)
+	height, round := cs1.Height, cs1.Round
+
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	// the extension is stored with the commit of the block
+	extCommit := cs1.blockStore.LoadBlockExtendedCommit(height)
+	require.NotNil(t, extCommit)
+	require.Len(t, extCommit.ExtendedSignatures, 1)
+	assert.Equal(t, []byte(fmt.Sprintf("price@%d", height)), extCommit.ExtendedSignatures[0].Extension)
+
+	// and delivered to the app when proposing the next block
+	ensureNewBlock(newBlockCh, height+1)
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	lastCommit := app.lastCommits[height+1]
+	require.Len(t, lastCommit.Votes, 1)
+	assert.Equal(t, []byte(fmt.Sprintf("price@%d", height)), lastCommit.Votes[0].VoteExtension)
+}
+
 func TestStateOversizedBlock(t *testing.T) {
 	cs1, vss := randState(2)
 	cs1.state.ConsensusParams.Block.MaxBytes = 2000
@@ -2478,6 +2551,9 @@ func TestPruneBlocks(t *testing.T) {
 	mockApp := &mocks.Application{}
 	mockApp.On("BeginBlock", mock.Anything).Return(abci.ResponseBeginBlock{})
 	mockApp.On("EndBlock", mock.Anything).Return(abci.ResponseEndBlock{})
+	mockApp.On("PrepareProposal", mock.Anything).Return(abci.ResponsePrepareProposal{})
+	mockApp.On("ProcessProposal", mock.Anything).Return(
+		abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT})
 	mockApp.On("BeginRecheckTx", mock.Anything).Return(abci.ResponseBeginRecheckTx{Code: abci.CodeTypeOK})
 	mockApp.On("EndRecheckTx", mock.Anything).Return(abci.ResponseEndRecheckTx{Code: abci.CodeTypeOK})
 	// Mocking behaviour to response `RetainHeight` for pruneBlocks
@@ -100,16 +100,8 @@ func (t *timeoutTicker) timeoutRoutine() {
 			t.Logger.Debug("Received tick", "old_ti", ti, "new_ti", newti)
 
 			// ignore tickers for old height/round/step
-			if newti.Height < ti.Height {
+			if !isLaterTimeout(newti, ti) {
 				continue
-			} else if newti.Height == ti.Height {
-				if newti.Round < ti.Round {
-					continue
-				} else if newti.Round == ti.Round {
-					if ti.Step > 0 && newti.Step <= ti.Step {
-						continue
-					}
-				}
 			}
 
 			// stop the last timer
@@ -132,3 +124,15 @@ func (t *timeoutTicker) timeoutRoutine() {
 		}
 	}
 }
+
+// isLaterTimeout returns true if newti is for a later height/round/step than
+// ti, that is if it should replace ti.
+func isLaterTimeout(newti, ti timeoutInfo) bool {
+	if newti.Height != ti.Height {
+		return newti.Height > ti.Height
+	}
+	if newti.Round != ti.Round {
+		return newti.Round > ti.Round
+	}
+	return ti.Step == 0 || newti.Step > ti.Step
+}
@@ -39,9 +39,10 @@ We let each peer provide us with up to 2 unexpected "catchup" rounds.
 One for their LastCommit round, and another for the official commit round.
 */
 type HeightVoteSet struct {
-	chainID  string
-	height   int64
-	voterSet *types.VoterSet
+	chainID           string
+	height            int64
+	voterSet          *types.VoterSet
+	extensionsEnabled bool // precommits carry vote extensions
 
 	mtx               sync.Mutex
 	round             int32                  // max tracked round
@@ -57,6 +58,17 @@ func NewHeightVoteSet(chainID string, height int64, voterSet *types.VoterSet) *H
 	return hvs
 }
 
+// NewExtendedHeightVoteSet returns a HeightVoteSet whose precommits carry
+// vote extensions.
+func NewExtendedHeightVoteSet(chainID string, height int64, voterSet *types.VoterSet) *HeightVoteSet {
+	hvs := &HeightVoteSet{
+		chainID:           chainID,
+		extensionsEnabled: true,
+	}
+	hvs.Reset(height, voterSet)
+	return hvs
+}
+
 func (hvs *HeightVoteSet) Reset(height int64, voterSet *types.VoterSet) {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
@@ -105,7 +117,12 @@ func (hvs *HeightVoteSet) addRound(round int32) {
 	}
 	// log.Debug("addRound(round)", "round", round)
 	prevotes := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrevoteType, hvs.voterSet)
-	precommits := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrecommitType, hvs.voterSet)
+	var precommits *types.VoteSet
+	if hvs.extensionsEnabled {
+		precommits = types.NewExtendedVoteSet(hvs.chainID, hvs.height, round, tmproto.PrecommitType, hvs.voterSet)
+	} else {
+		precommits = types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrecommitType, hvs.voterSet)
+	}
 	hvs.roundVoteSets[round] = RoundVoteSet{
 		Prevotes:   prevotes,
 		Precommits: precommits,
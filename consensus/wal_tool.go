@@ -0,0 +1,226 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/line/ostracon/types"
+)
+
+// The types of the WAL messages, as reported in WALEntry.
+const (
+	WALMsgTypeEndHeight  = "end_height"
+	WALMsgTypeRoundState = "round_state"
+	WALMsgTypeTimeout    = "timeout"
+	WALMsgTypeProposal   = "proposal"
+	WALMsgTypeBlockPart  = "block_part"
+	WALMsgTypeVote       = "vote"
+	WALMsgTypeMsgInfo    = "msg_info"
+)
+
+var walIndexedFilePattern = regexp.MustCompile(`^.+\.([0-9]{3,})$`)
+
+// WALFiles returns the paths of the files of the WAL whose head is walFile,
+// from the oldest to the head, as rotated by the autofile group.
+func WALFiles(walFile string) ([]string, error) {
+	if _, err := os.Stat(walFile); err != nil {
+		return nil, err
+	}
+
+	paths, err := filepath.Glob(walFile + ".*")
+	if err != nil {
+		return nil, err
+	}
+	indexes := make(map[string]int, len(paths))
+	files := make([]string, 0, len(paths)+1)
+	for _, path := range paths {
+		submatch := walIndexedFilePattern.FindStringSubmatch(path)
+		if submatch == nil {
+			continue
+		}
+		index, err := strconv.Atoi(submatch[1])
+		if err != nil {
+			return nil, err
+		}
+		indexes[path] = index
+		files = append(files, path)
+	}
+	sort.Slice(files, func(i, j int) bool { return indexes[files[i]] < indexes[files[j]] })
+
+	return append(files, walFile), nil
+}
+
+// WALEntry is a message read from a WAL file, with its position and the
+// height, round and type it relates to.
+type WALEntry struct {
+	File   string `json:"file"`
+	Offset int64  `json:"offset"`
+	Size   int64  `json:"size"`
+	// Height is the height of the message, or the one following the last
+	// EndHeightMessage if the message has none.
+	Height int64 `json:"height"`
+	// Round is the round of the message, or -1 if it has none.
+	Round int32            `json:"round"`
+	Type  string           `json:"type"`
+	Msg   *TimedWALMessage `json:"msg"`
+}
+
+// WALCorruptionError is returned when a WAL message can't be decoded. It
+// gives the position of the corrupted message.
+type WALCorruptionError struct {
+	File   string
+	Offset int64
+	Err    error
+}
+
+func (e WALCorruptionError) Error() string {
+	return fmt.Sprintf("corrupted WAL message in %s at offset %d: %v", e.File, e.Offset, e.Err)
+}
+
+func (e WALCorruptionError) Unwrap() error {
+	return e.Err
+}
+
+// ReadWAL decodes the messages of the WAL files in order, and calls fn with
+// each of them. It stops at the first message that can't be decoded, and
+// returns a WALCorruptionError, or at the first error returned by fn.
+func ReadWAL(files []string, fn func(*WALEntry) error) error {
+	height := int64(0)
+	for _, file := range files {
+		err := readWALFile(file, func(entry *WALEntry) error {
+			if entry.Height == 0 {
+				entry.Height = height + 1
+			}
+			if m, ok := entry.Msg.Msg.(EndHeightMessage); ok {
+				height = m.Height
+			}
+			return fn(entry)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readWALFile(file string, fn func(*WALEntry) error) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rd := &countingReader{rd: f}
+	dec := NewWALDecoder(rd)
+	for {
+		offset := rd.n
+		msg, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return WALCorruptionError{File: file, Offset: offset, Err: err}
+		}
+
+		entry := &WALEntry{File: file, Offset: offset, Size: rd.n - offset, Msg: msg}
+		entry.Type, entry.Height, entry.Round = walMessageInfo(msg.Msg)
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+}
+
+// walMessageInfo returns the type, height and round of a WAL message. The
+// height is 0 and the round -1 if the message has none.
+func walMessageInfo(msg WALMessage) (typ string, height int64, round int32) {
+	switch m := msg.(type) {
+	case EndHeightMessage:
+		return WALMsgTypeEndHeight, m.Height, -1
+	case types.EventDataRoundState:
+		return WALMsgTypeRoundState, m.Height, m.Round
+	case timeoutInfo:
+		return WALMsgTypeTimeout, m.Height, m.Round
+	case msgInfo:
+		switch cm := m.Msg.(type) {
+		case *ProposalMessage:
+			return WALMsgTypeProposal, cm.Proposal.Height, cm.Proposal.Round
+		case *BlockPartMessage:
+			return WALMsgTypeBlockPart, cm.Height, cm.Round
+		case *VoteMessage:
+			return WALMsgTypeVote, cm.Vote.Height, cm.Vote.Round
+		}
+		return WALMsgTypeMsgInfo, 0, -1
+	}
+	return "", 0, -1
+}
+
+// TruncateWALAfterHeight truncates the WAL whose head is walFile right after
+// the EndHeightMessage of the given height, so that the messages of the
+// following heights are discarded. The rotated files after the one holding
+// the EndHeightMessage are removed, and the head is emptied. It returns an
+// error if the message is not found or a message before it is corrupted.
+//
+// The WAL must not be in use.
+func TruncateWALAfterHeight(walFile string, height int64) error {
+	files, err := WALFiles(walFile)
+	if err != nil {
+		return err
+	}
+
+	var (
+		endFile  string
+		errFound = errors.New("found")
+	)
+	err = ReadWAL(files, func(entry *WALEntry) error {
+		if m, ok := entry.Msg.Msg.(EndHeightMessage); ok && m.Height == height {
+			endFile = entry.File
+			if err := os.Truncate(entry.File, entry.Offset+entry.Size); err != nil {
+				return err
+			}
+			return errFound
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errFound) {
+		return err
+	}
+	if endFile == "" {
+		return fmt.Errorf("end of height %d not found in the WAL", height)
+	}
+
+	after := false
+	for _, file := range files {
+		switch {
+		case file == endFile:
+			after = true
+		case !after:
+		case file == walFile:
+			if err := os.Truncate(file, 0); err != nil {
+				return err
+			}
+		default:
+			if err := os.Remove(file); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// countingReader counts the bytes read from rd.
+type countingReader struct {
+	rd io.Reader
+	n  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.rd.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,145 @@
+package consensus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/consensus/types"
+	"github.com/line/ostracon/crypto"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	tmtypes "github.com/line/ostracon/types"
+	tmtime "github.com/line/ostracon/types/time"
+)
+
+// writeTestWAL writes a WAL of heights 1 to 3, with the heights 1 and 2 in a
+// rotated file, and the height 3 in the head. It returns the path of the head.
+func writeTestWAL(t *testing.T) string {
+	walFile := filepath.Join(t.TempDir(), "wal")
+	now := tmtime.Now()
+	heightMsgs := func(height int64) []WALMessage {
+		return []WALMessage{
+			tmtypes.EventDataRoundState{Height: height, Round: 0, Step: "RoundStepNewHeight"},
+			timeoutInfo{Duration: time.Second, Height: height, Round: 0, Step: types.RoundStepPropose},
+			msgInfo{Msg: &VoteMessage{Vote: &tmtypes.Vote{
+				Type: tmproto.PrevoteType, Height: height, Round: 1, Timestamp: now,
+				ValidatorAddress: make([]byte, crypto.AddressSize), Signature: []byte{1},
+			}}},
+			EndHeightMessage{Height: height},
+		}
+	}
+	write := func(path string, heights ...int64) {
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		defer f.Close()
+		enc := NewWALEncoder(f)
+		for _, height := range heights {
+			for _, msg := range heightMsgs(height) {
+				require.NoError(t, enc.Encode(&TimedWALMessage{Time: now, Msg: msg}))
+			}
+		}
+	}
+	write(walFile+".000", 1, 2)
+	write(walFile, 3)
+	return walFile
+}
+
+func readTestWAL(t *testing.T, walFile string) []*WALEntry {
+	files, err := WALFiles(walFile)
+	require.NoError(t, err)
+	var entries []*WALEntry
+	require.NoError(t, ReadWAL(files, func(entry *WALEntry) error {
+		entries = append(entries, entry)
+		return nil
+	}))
+	return entries
+}
+
+func TestReadWAL(t *testing.T) {
+	walFile := writeTestWAL(t)
+
+	files, err := WALFiles(walFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{walFile + ".000", walFile}, files)
+
+	entries := readTestWAL(t, walFile)
+	require.Len(t, entries, 12)
+	for i, entry := range entries {
+		assert.EqualValues(t, i/4+1, entry.Height)
+		if i < 8 {
+			assert.Equal(t, walFile+".000", entry.File)
+		} else {
+			assert.Equal(t, walFile, entry.File)
+		}
+		if i%4 > 0 {
+			assert.Equal(t, entries[i-1].Offset+entries[i-1].Size, entry.Offset)
+		}
+	}
+	assert.Equal(t, WALMsgTypeRoundState, entries[0].Type)
+	assert.Equal(t, WALMsgTypeTimeout, entries[1].Type)
+	assert.Equal(t, WALMsgTypeVote, entries[2].Type)
+	assert.EqualValues(t, 1, entries[2].Round)
+	assert.Equal(t, WALMsgTypeEndHeight, entries[3].Type)
+	assert.EqualValues(t, -1, entries[3].Round)
+}
+
+func TestReadWALCorruption(t *testing.T) {
+	walFile := writeTestWAL(t)
+	entries := readTestWAL(t, walFile)
+
+	// corrupt the data of the vote of height 2
+	corrupted := entries[6]
+	f, err := os.OpenFile(corrupted.File, os.O_RDWR, 0)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte{0xff}, corrupted.Offset+corrupted.Size-1)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	files, err := WALFiles(walFile)
+	require.NoError(t, err)
+	read := 0
+	err = ReadWAL(files, func(*WALEntry) error {
+		read++
+		return nil
+	})
+	var corruption WALCorruptionError
+	require.ErrorAs(t, err, &corruption)
+	assert.Equal(t, corrupted.File, corruption.File)
+	assert.Equal(t, corrupted.Offset, corruption.Offset)
+	assert.True(t, IsDataCorruptionError(corruption.Err))
+	assert.Equal(t, 6, read)
+}
+
+func TestTruncateWALAfterHeight(t *testing.T) {
+	walFile := writeTestWAL(t)
+
+	require.NoError(t, TruncateWALAfterHeight(walFile, 1))
+	entries := readTestWAL(t, walFile)
+	require.Len(t, entries, 4)
+	assert.Equal(t, EndHeightMessage{Height: 1}, entries[3].Msg.Msg)
+
+	// the head is emptied, and kept for the WAL to be reopened
+	info, err := os.Stat(walFile)
+	require.NoError(t, err)
+	assert.Zero(t, info.Size())
+
+	require.NoError(t, TruncateWALAfterHeight(walFile, 1))
+	assert.Len(t, readTestWAL(t, walFile), 4)
+	assert.Error(t, TruncateWALAfterHeight(walFile, 2))
+}
+
+func TestTruncateWALAfterHeightRemovesRotatedFiles(t *testing.T) {
+	walFile := writeTestWAL(t)
+	require.NoError(t, os.Rename(walFile, walFile+".001"))
+	require.NoError(t, os.WriteFile(walFile, nil, 0600))
+
+	require.NoError(t, TruncateWALAfterHeight(walFile, 2))
+	files, err := WALFiles(walFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{walFile + ".000", walFile}, files)
+	assert.Len(t, readTestWAL(t, walFile), 8)
+}
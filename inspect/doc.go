@@ -0,0 +1,14 @@
+/*
+Package inspect provides a tool to investigate the state of a node that is
+not running, e.g. after it crashed or halted.
+
+The Inspector opens the block store, the state store and the indexers of the
+node read-only, and serves the subset of the RPC endpoints that only read from
+them: block, block_results, commit, validators, voters, tx, tx_search and so
+on. No consensus, p2p or mempool is started, and no ABCI application is
+needed. Only the goleveldb backend can be opened read-only.
+
+It is run with the `ostracon inspect` command, with the same configuration as
+the node.
+*/
+package inspect
@@ -0,0 +1,143 @@
+package inspect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/inspect/rpc"
+	"github.com/line/ostracon/libs/log"
+	tmstrings "github.com/line/ostracon/libs/strings"
+	"github.com/line/ostracon/node"
+	rpcserver "github.com/line/ostracon/rpc/jsonrpc/server"
+	sm "github.com/line/ostracon/state"
+	"github.com/line/ostracon/state/indexer"
+	"github.com/line/ostracon/state/txindex"
+	"github.com/line/ostracon/store"
+)
+
+// Inspector manages an RPC server that serves the data of the block store,
+// the state store and the indexers of a node that is not running. No
+// consensus, p2p or mempool is started, and no ABCI application is needed.
+type Inspector struct {
+	config *cfg.RPCConfig
+	routes map[string]*rpcserver.RPCFunc
+
+	// the databases opened by NewFromConfig
+	dbs []dbm.DB
+
+	logger log.Logger
+}
+
+// New returns an Inspector serving the given stores and indexers with the
+// RPC config.
+func New(
+	config *cfg.RPCConfig,
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
+	txIndexer txindex.TxIndexer,
+	blockIndexer indexer.BlockIndexer,
+	logger log.Logger,
+) *Inspector {
+	return &Inspector{
+		config: config,
+		routes: rpc.Routes(*config, stateStore, blockStore, txIndexer, blockIndexer, logger),
+		logger: logger,
+	}
+}
+
+// NewFromConfig returns an Inspector serving the databases of the node
+// configured by config, which are opened read-only. It fails for the
+// backends other than goleveldb, which can't be. The databases are closed
+// when Run returns.
+func NewFromConfig(config *cfg.Config, logger log.Logger) (*Inspector, error) {
+	var dbs []dbm.DB
+	dbProvider := func(ctx *node.DBContext) (dbm.DB, error) {
+		db, err := node.ReadOnlyDBProvider(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s database: %w", ctx.ID, err)
+		}
+		dbs = append(dbs, db)
+		return db, nil
+	}
+	closeDBs := func() {
+		for _, db := range dbs {
+			_ = db.Close()
+		}
+	}
+
+	blockStoreDB, err := dbProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	stateDB, err := dbProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		closeDBs()
+		return nil, err
+	}
+	stateStore := sm.NewStore(stateDB)
+
+	state, err := stateStore.Load()
+	if err != nil {
+		closeDBs()
+		return nil, fmt.Errorf("failed to load state: %w", err)
+	}
+	txIndexer, blockIndexer, err := node.CreateIndexers(config, state.ChainID, dbProvider)
+	if err != nil {
+		closeDBs()
+		return nil, err
+	}
+
+	ins := New(config.RPC, stateStore, store.NewBlockStore(blockStoreDB), txIndexer, blockIndexer, logger)
+	ins.dbs = dbs
+	return ins, nil
+}
+
+// Run serves the RPC on the listen addresses of the RPC config until ctx is
+// done or serving fails.
+func (ins *Inspector) Run(ctx context.Context) error {
+	defer ins.closeDBs()
+
+	var addrs []string
+	for _, addr := range tmstrings.SplitAndTrim(ins.config.ListenAddress, ",", " ") {
+		if addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	if len(addrs) == 0 {
+		return errors.New("no RPC listen address configured")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	handler := rpc.Handler(ins.config, ins.routes, ins.logger)
+	errCh := make(chan error, len(addrs))
+	for _, addr := range addrs {
+		go func(addr string) {
+			ins.logger.Info("serving inspect RPC", "addr", addr)
+			errCh <- rpc.ListenAndServe(ctx, addr, handler, ins.config, ins.logger)
+		}(addr)
+	}
+
+	// stop serving on all the addresses as soon as one fails
+	var err error
+	for range addrs {
+		if e := <-errCh; e != nil && err == nil {
+			err = e
+			cancel()
+		}
+	}
+	return err
+}
+
+func (ins *Inspector) closeDBs() {
+	for _, db := range ins.dbs {
+		if err := db.Close(); err != nil {
+			ins.logger.Error("failed to close database", "err", err)
+		}
+	}
+}
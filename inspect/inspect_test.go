@@ -0,0 +1,93 @@
+package inspect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/line/ostracon/abci/types"
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/inspect"
+	"github.com/line/ostracon/libs/log"
+	tmnet "github.com/line/ostracon/libs/net"
+	httpclient "github.com/line/ostracon/rpc/client/http"
+	sm "github.com/line/ostracon/state"
+	blockidxkv "github.com/line/ostracon/state/indexer/block/kv"
+	"github.com/line/ostracon/state/mocks"
+	"github.com/line/ostracon/state/txindex/kv"
+	"github.com/line/ostracon/types"
+)
+
+func TestInspectorRun(t *testing.T) {
+	// the state store holds the genesis validators
+	val, _ := types.RandValidator(false, 10)
+	state, err := sm.MakeGenesisState(&types.GenesisDoc{
+		ChainID:    "inspect-test",
+		Validators: []types.GenesisValidator{{PubKey: val.PubKey, Power: val.VotingPower}},
+	})
+	require.NoError(t, err)
+	stateStore := sm.NewStore(dbm.NewMemDB())
+	require.NoError(t, stateStore.Save(state))
+
+	// the block store holds a block at height 1
+	block := types.MakeBlock(1, []types.Tx{types.Tx("tx")}, nil, nil, sm.InitStateVersion.Consensus)
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("Height").Return(int64(1))
+	blockStore.On("LoadBlock", int64(1)).Return(block)
+	blockStore.On("LoadBlockMeta", int64(1)).Return(&types.BlockMeta{Header: block.Header})
+
+	// the indexer holds the tx of the block
+	txIndexer := kv.NewTxIndex(dbm.NewMemDB())
+	require.NoError(t, txIndexer.Index(&abci.TxResult{Height: 1, Tx: types.Tx("tx")}))
+
+	port, err := tmnet.GetFreePort()
+	require.NoError(t, err)
+	config := cfg.TestRPCConfig()
+	config.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", port)
+
+	ins := inspect.New(config, stateStore, blockStore, txIndexer, blockidxkv.New(dbm.NewMemDB()),
+		log.TestingLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() { errCh <- ins.Run(ctx) }()
+
+	client, err := httpclient.New(config.ListenAddress, "/websocket")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, err := client.Health(context.Background())
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	resBlock, err := client.Block(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, block.Hash(), resBlock.Block.Hash())
+
+	height := int64(1)
+	resVals, err := client.Validators(context.Background(), &height, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, resVals.Validators, 1)
+	assert.Equal(t, val.PubKey, resVals.Validators[0].PubKey)
+
+	resTxs, err := client.TxSearch(context.Background(), "tx.height = 1", false, nil, nil, "")
+	require.NoError(t, err)
+	require.Len(t, resTxs.Txs, 1)
+	assert.Equal(t, types.Tx("tx"), resTxs.Txs[0].Tx)
+
+	// the endpoints that need a running node are not served
+	_, err = client.Status(context.Background())
+	assert.Error(t, err)
+
+	cancel()
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the inspector did not stop")
+	}
+}
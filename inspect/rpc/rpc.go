@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rs/cors"
+
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/libs/log"
+	rpccore "github.com/line/ostracon/rpc/core"
+	rpcserver "github.com/line/ostracon/rpc/jsonrpc/server"
+	sm "github.com/line/ostracon/state"
+	"github.com/line/ostracon/state/indexer"
+	"github.com/line/ostracon/state/txindex"
+)
+
+// routes are the names of the routes of rpccore.Routes that are served by
+// the inspect server, as they only need the stores and the indexers.
+var routes = []string{
+	"health",
+	"blockchain",
+	"block",
+	"block_by_hash",
+	"block_results",
+	"block_search",
+	"commit",
+	"consensus_params",
+	"tx",
+	"tx_search",
+	"validators",
+	"voters",
+	"validators_voters",
+	"voter_election_proof",
+}
+
+// Routes sets up the environment of the RPC with the given stores and
+// indexers, and returns the routes that can be served from them alone.
+func Routes(
+	config cfg.RPCConfig,
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
+	txIndexer txindex.TxIndexer,
+	blockIndexer indexer.BlockIndexer,
+	logger log.Logger,
+) map[string]*rpcserver.RPCFunc {
+	rpccore.SetEnvironment(&rpccore.Environment{
+		StateStore:       stateStore,
+		BlockStore:       blockStore,
+		TxIndexer:        txIndexer,
+		BlockIndexer:     blockIndexer,
+		ConsensusReactor: waitSyncChecker{},
+		Logger:           logger,
+		Config:           config,
+	})
+
+	m := make(map[string]*rpcserver.RPCFunc, len(routes))
+	for _, name := range routes {
+		m[name] = rpccore.Routes[name]
+	}
+	return m
+}
+
+// waitSyncChecker stands in for the consensus reactor of the RPC environment,
+// as the node is not syncing.
+type waitSyncChecker struct{}
+
+func (waitSyncChecker) WaitSync() bool { return false }
+
+// Handler returns the http.Handler serving routes over JSON-RPC, with CORS
+// if enabled by config.
+func Handler(config *cfg.RPCConfig, routes map[string]*rpcserver.RPCFunc, logger log.Logger) http.Handler {
+	mux := http.NewServeMux()
+	rpcserver.RegisterRPCFuncs(mux, routes, logger)
+
+	if !config.IsCorsEnabled() {
+		return mux
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins: config.CORSAllowedOrigins,
+		AllowedMethods: config.CORSAllowedMethods,
+		AllowedHeaders: config.CORSAllowedHeaders,
+	}).Handler(mux)
+}
+
+// ListenAndServe serves handler on addr, over TLS if enabled by config,
+// until ctx is done.
+func ListenAndServe(ctx context.Context, addr string, handler http.Handler, config *cfg.RPCConfig,
+	logger log.Logger) error {
+	serverConfig := rpcserver.DefaultConfig()
+	serverConfig.MaxBodyBytes = config.MaxBodyBytes
+	serverConfig.MaxHeaderBytes = config.MaxHeaderBytes
+	serverConfig.MaxOpenConnections = config.MaxOpenConnections
+	serverConfig.ReadTimeout = config.ReadTimeout
+	serverConfig.WriteTimeout = config.WriteTimeout
+	serverConfig.IdleTimeout = config.IdleTimeout
+
+	listener, err := rpcserver.Listen(addr, serverConfig)
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		if config.IsTLSEnabled() {
+			errCh <- rpcserver.ServeTLS(listener, handler, config.CertFile(), config.KeyFile(), logger, serverConfig)
+		} else {
+			errCh <- rpcserver.Serve(listener, handler, logger, serverConfig)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		// Serve returns an error once the listener is closed.
+		if err := listener.Close(); err != nil {
+			return err
+		}
+		select {
+		case <-errCh:
+		case <-time.After(time.Second):
+		}
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
@@ -84,6 +84,24 @@ func TestParser(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"transfer.sender='Igor' OR transfer.recipient='Igor'", true},
+		{"transfer.sender='Igor' or transfer.recipient='Igor'", true},
+		{"transfer.sender='Igor' OR", false},
+		{"OR transfer.sender='Igor'", false},
+		{"transfer.sender='Igor' OR AND transfer.recipient='Igor'", false},
+		{"NOT message.action='vote'", true},
+		{"NOT NOT message.action='vote'", true},
+		{"NOT(message.action='vote')", false},
+		{"NOT (message.action='vote')", true},
+		{"message.action='vote' NOT", false},
+		{"(transfer.sender='Igor' OR transfer.recipient='Igor') AND NOT message.action='vote'", true},
+		{"( transfer.sender='Igor' OR (transfer.recipient='Igor') )", true},
+		{"((tx.gas > 7))", true},
+		{"(tx.gas > 7", false},
+		{"tx.gas > 7)", false},
+		{"()", false},
+		{"tx.gas > 7 AND (tx.gas < 9 OR tx.fee EXISTS)", true},
 	}
 
 	for _, c := range cases {
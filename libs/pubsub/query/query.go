@@ -1,6 +1,7 @@
 // Package query provides a parser for a custom query format:
 //
 //		abci.invoice.number=22 AND abci.invoice.owner=Ivan
+//		(transfer.sender='Ivan' OR transfer.recipient='Ivan') AND NOT message.action='vote'
 //
 // See query.peg for the grammar, which is a https://en.wikipedia.org/wiki/Parsing_expression_grammar.
 // More: https://github.com/PhilippeSigaud/Pegged/wiki/PEG-Basics
@@ -89,18 +90,98 @@ const (
 	TimeLayout = time.RFC3339
 )
 
-// Conditions returns a list of conditions. It returns an error if there is any
-// error with the provided grammar in the Query.
-func (q *Query) Conditions() ([]Condition, error) {
+// ExprKind identifies the type of a node in the syntax tree of a query.
+type ExprKind uint8
+
+const (
+	// ExprCondition is a leaf node holding a single condition.
+	ExprCondition ExprKind = iota
+	// ExprAnd matches if all of its sub-expressions match.
+	ExprAnd
+	// ExprOr matches if any of its sub-expressions matches.
+	ExprOr
+	// ExprNot matches if its only sub-expression does not match.
+	ExprNot
+)
+
+// Expr is a node in the syntax tree of a query. Conditions are the leaves of
+// the tree and are combined by AND, OR and NOT nodes.
+type Expr struct {
+	Kind      ExprKind
+	Condition Condition // set iff Kind is ExprCondition
+	Children  []*Expr   // set iff Kind is ExprAnd, ExprOr or ExprNot
+}
+
+// Conjunction returns the conditions of e if e consists only of conditions
+// joined by AND, i.e. the query language as it was before OR and NOT were
+// introduced. It returns false if e uses OR or NOT anywhere.
+func (e *Expr) Conjunction() ([]Condition, bool) {
+	switch e.Kind {
+	case ExprCondition:
+		return []Condition{e.Condition}, true
+
+	case ExprAnd:
+		conditions := make([]Condition, 0, len(e.Children))
+		for _, child := range e.Children {
+			cs, ok := child.Conjunction()
+			if !ok {
+				return nil, false
+			}
+			conditions = append(conditions, cs...)
+		}
+		return conditions, true
+
+	default:
+		return nil, false
+	}
+}
+
+// conditions appends the leaves of e to conditions, from left to right.
+func (e *Expr) conditions(conditions []Condition) []Condition {
+	if e.Kind == ExprCondition {
+		return append(conditions, e.Condition)
+	}
+	for _, child := range e.Children {
+		conditions = child.conditions(conditions)
+	}
+	return conditions
+}
+
+// Expression returns the syntax tree of the query. NOT binds tighter than AND,
+// which binds tighter than OR; parentheses may be used to group expressions.
+// It returns an error if there is any error with the provided grammar in the
+// Query.
+func (q *Query) Expression() (*Expr, error) {
+	type node struct {
+		begin, end uint32
+		expr       *Expr // nil for the NOT keyword
+	}
+
 	var (
 		eventAttr string
 		op        Operator
+		condition Condition
+		stack     []node
+		root      *Expr
 	)
 
-	conditions := make([]Condition, 0)
+	// pop removes the nodes enclosed by token from the stack and returns them
+	// in the order in which they appear in the query.
+	pop := func(token token32) []node {
+		i := len(stack)
+		for i > 0 && stack[i-1].begin >= token.begin && stack[i-1].end <= token.end {
+			i--
+		}
+		children := append([]node(nil), stack[i:]...)
+		stack = stack[:i]
+		return children
+	}
+
 	buffer, begin, end := q.parser.Buffer, 0, 0
 
-	// tokens must be in the following order: tag ("tx.gas") -> operator ("=") -> operand ("7")
+	// Tokens are emitted in post-order, i.e. each rule after the rules it is
+	// made of. Within a condition they must be in the following order:
+	// tag ("tx.gas") -> operator ("=") -> operand ("7")
 	for token := range q.parser.Tokens() {
 		switch token.pegRule {
 		case rulePegText:
@@ -129,12 +210,12 @@ func (q *Query) Conditions() ([]Condition, error) {
 
 		case ruleexists:
 			op = OpExists
-			conditions = append(conditions, Condition{eventAttr, op, nil})
+			condition = Condition{eventAttr, op, nil}
 
 		case rulevalue:
 			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
 			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
-			conditions = append(conditions, Condition{eventAttr, op, valueWithoutSingleQuotes})
+			condition = Condition{eventAttr, op, valueWithoutSingleQuotes}
 
 		case rulenumber:
 			number := buffer[begin:end]
@@ -148,7 +229,7 @@ func (q *Query) Conditions() ([]Condition, error) {
 					return nil, err
 				}
 
-				conditions = append(conditions, Condition{eventAttr, op, value})
+				condition = Condition{eventAttr, op, value}
 			} else {
 				value, err := strconv.ParseInt(number, 10, 64)
 				if err != nil {
@@ -159,7 +240,7 @@ func (q *Query) Conditions() ([]Condition, error) {
 					return nil, err
 				}
 
-				conditions = append(conditions, Condition{eventAttr, op, value})
+				condition = Condition{eventAttr, op, value}
 			}
 
 		case ruletime:
@@ -172,7 +253,7 @@ func (q *Query) Conditions() ([]Condition, error) {
 				return nil, err
 			}
 
-			conditions = append(conditions, Condition{eventAttr, op, value})
+			condition = Condition{eventAttr, op, value}
 
 		case ruledate:
 			value, err := time.Parse("2006-01-02", buffer[begin:end])
@@ -184,11 +265,60 @@ func (q *Query) Conditions() ([]Condition, error) {
 				return nil, err
 			}
 
-			conditions = append(conditions, Condition{eventAttr, op, value})
+			condition = Condition{eventAttr, op, value}
+
+		case rulecondition:
+			stack = append(stack, node{token.begin, token.end, &Expr{Kind: ExprCondition, Condition: condition}})
+
+		case rulenot:
+			stack = append(stack, node{token.begin, token.end, nil})
+
+		case rulefactor:
+			children := pop(token)
+			expr := children[len(children)-1].expr
+			if len(children) == 2 { // NOT factor
+				expr = &Expr{Kind: ExprNot, Children: []*Expr{expr}}
+			}
+			stack = append(stack, node{token.begin, token.end, expr})
+
+		case ruleterm, ruleexpr:
+			children := pop(token)
+			expr := children[0].expr
+			if len(children) > 1 {
+				kind := ExprAnd
+				if token.pegRule == ruleexpr {
+					kind = ExprOr
+				}
+				expr = &Expr{Kind: kind, Children: make([]*Expr, len(children))}
+				for i, child := range children {
+					expr.Children[i] = child.expr
+				}
+			}
+			stack = append(stack, node{token.begin, token.end, expr})
+
+		case rulee:
+			root = pop(token)[0].expr
 		}
 	}
 
-	return conditions, nil
+	if root == nil {
+		return nil, fmt.Errorf("query %q has no conditions", q.str)
+	}
+	return root, nil
+}
+
+// Conditions returns a list of conditions. It returns an error if there is any
+// error with the provided grammar in the Query.
+//
+// The conditions are listed in the order in which they appear in the query.
+// For queries that use OR or NOT, the list does not tell how the conditions
+// are combined; use Expression instead.
+func (q *Query) Conditions() ([]Condition, error) {
+	expr, err := q.Expression()
+	if err != nil {
+		return nil, err
+	}
+	return expr.conditions(make([]Condition, 0)), nil
 }
 
 // Matches returns true if the query matches against any event in the given set
@@ -203,160 +333,75 @@ func (q *Query) Matches(events map[string][]string) (bool, error) {
 		return false, nil
 	}
 
-	var (
-		eventAttr string
-		op        Operator
-	)
-
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order:
-
-	// tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
-		case ruletag:
-			eventAttr = buffer[begin:end]
-
-		case rulele:
-			op = OpLessEqual
-
-		case rulege:
-			op = OpGreaterEqual
-
-		case rulel:
-			op = OpLess
-
-		case ruleg:
-			op = OpGreater
-
-		case ruleequal:
-			op = OpEqual
-
-		case rulecontains:
-			op = OpContains
-		case ruleexists:
-			op = OpExists
-			if strings.Contains(eventAttr, ".") {
-				// Searching for a full "type.attribute" event.
-				_, ok := events[eventAttr]
-				if !ok {
-					return false, nil
-				}
-			} else {
-				foundEvent := false
-
-			loop:
-				for compositeKey := range events {
-					if strings.Index(compositeKey, eventAttr) == 0 {
-						foundEvent = true
-						break loop
-					}
-				}
-				if !foundEvent {
-					return false, nil
-				}
-			}
-
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
+	expr, err := q.Expression()
+	if err != nil {
+		return false, err
+	}
+	return expr.matches(events)
+}
 
-			// see if the triplet (event attribute, operator, operand) matches any event
-			// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
-			match, err := match(eventAttr, op, reflect.ValueOf(valueWithoutSingleQuotes), events)
-			if err != nil {
+// matches evaluates e against the given set of events. Sub-expressions are
+// evaluated from left to right and evaluation stops as soon as the result is
+// known.
+func (e *Expr) matches(events map[string][]string) (bool, error) {
+	switch e.Kind {
+	case ExprCondition:
+		return e.Condition.matches(events)
+
+	case ExprAnd:
+		for _, child := range e.Children {
+			ok, err := child.matches(events)
+			if err != nil || !ok {
 				return false, err
 			}
+		}
+		return true, nil
 
-			if !match {
-				return false, nil
-			}
-
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			}
-
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
+	case ExprOr:
+		for _, child := range e.Children {
+			ok, err := child.matches(events)
 			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
 				return false, err
 			}
-
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
+			if ok {
+				return true, nil
 			}
+		}
+		return false, nil
 
-			if !match {
-				return false, nil
-			}
+	case ExprNot:
+		ok, err := e.Children[0].matches(events)
+		if err != nil {
+			return false, err
+		}
+		return !ok, nil
 
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
-			}
+	default:
+		return false, fmt.Errorf("unknown expression kind %d", e.Kind)
+	}
+}
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
+// matches returns true if the condition matches any event in the given set of
+// events.
+func (c Condition) matches(events map[string][]string) (bool, error) {
+	if c.Op != OpExists {
+		// see if the triplet (event attribute, operator, operand) matches any event
+		// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
+		return match(c.CompositeKey, c.Op, reflect.ValueOf(c.Operand), events)
+	}
 
-			if !match {
-				return false, nil
-			}
-		}
+	if strings.Contains(c.CompositeKey, ".") {
+		// Searching for a full "type.attribute" event.
+		_, ok := events[c.CompositeKey]
+		return ok, nil
 	}
 
-	return true, nil
+	for compositeKey := range events {
+		if strings.Index(compositeKey, c.CompositeKey) == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 // match returns true if the given triplet (attribute, operator, operand) matches
@@ -16,6 +16,9 @@ type pegRule uint8
 const (
 	ruleUnknown pegRule = iota
 	rulee
+	ruleexpr
+	ruleterm
+	rulefactor
 	rulecondition
 	ruletag
 	rulevalue
@@ -27,6 +30,8 @@ const (
 	rulemonth
 	ruleday
 	ruleand
+	ruleor
+	rulenot
 	ruleequal
 	rulecontains
 	ruleexists
@@ -44,6 +49,9 @@ const (
 var rul3s = [...]string{
 	"Unknown",
 	"e",
+	"expr",
+	"term",
+	"factor",
 	"condition",
 	"tag",
 	"value",
@@ -55,6 +63,8 @@ var rul3s = [...]string{
 	"month",
 	"day",
 	"and",
+	"or",
+	"not",
 	"equal",
 	"contains",
 	"exists",
@@ -356,7 +366,7 @@ func (t *tokens32) Expand(index int) {
 type QueryParser struct {
 	Buffer string
 	buffer []rune
-	rules  [21]func() bool
+	rules  [26]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
 	Pretty bool
@@ -494,7 +504,7 @@ func (p *QueryParser) Init() {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 e <- <('"' condition (' '+ and ' '+ condition)* '"' !.)> */
+		/* 0 e <- <('"' expr '"' !.)> */
 		func() bool {
 			position0, tokenIndex0, depth0 := position, tokenIndex, depth
 			{
@@ -504,569 +514,808 @@ func (p *QueryParser) Init() {
 					goto l0
 				}
 				position++
-				if !_rules[rulecondition]() {
+				if !_rules[ruleexpr]() {
 					goto l0
 				}
-			l2:
+				if buffer[position] != rune('"') {
+					goto l0
+				}
+				position++
+				{
+					position2, tokenIndex2, depth2 := position, tokenIndex, depth
+					if !matchDot() {
+						goto l2
+					}
+					goto l0
+				l2:
+					position, tokenIndex, depth = position2, tokenIndex2, depth2
+				}
+				depth--
+				add(rulee, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex, depth = position0, tokenIndex0, depth0
+			return false
+		},
+		/* 1 expr <- <(term (' '+ or ' '+ term)*)> */
+		func() bool {
+			position3, tokenIndex3, depth3 := position, tokenIndex, depth
+			{
+				position4 := position
+				depth++
+				if !_rules[ruleterm]() {
+					goto l3
+				}
+			l5:
+				{
+					position6, tokenIndex6, depth6 := position, tokenIndex, depth
+					if buffer[position] != rune(' ') {
+						goto l6
+					}
+					position++
+				l7:
+					{
+						position8, tokenIndex8, depth8 := position, tokenIndex, depth
+						if buffer[position] != rune(' ') {
+							goto l8
+						}
+						position++
+						goto l7
+					l8:
+						position, tokenIndex, depth = position8, tokenIndex8, depth8
+					}
+					{
+						position9 := position
+						depth++
+						{
+							position10, tokenIndex10, depth10 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l11
+							}
+							position++
+							goto l10
+						l11:
+							position, tokenIndex, depth = position10, tokenIndex10, depth10
+							if buffer[position] != rune('O') {
+								goto l6
+							}
+							position++
+						}
+					l10:
+						{
+							position12, tokenIndex12, depth12 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l13
+							}
+							position++
+							goto l12
+						l13:
+							position, tokenIndex, depth = position12, tokenIndex12, depth12
+							if buffer[position] != rune('R') {
+								goto l6
+							}
+							position++
+						}
+					l12:
+						depth--
+						add(ruleor, position9)
+					}
+					if buffer[position] != rune(' ') {
+						goto l6
+					}
+					position++
+				l14:
+					{
+						position15, tokenIndex15, depth15 := position, tokenIndex, depth
+						if buffer[position] != rune(' ') {
+							goto l15
+						}
+						position++
+						goto l14
+					l15:
+						position, tokenIndex, depth = position15, tokenIndex15, depth15
+					}
+					if !_rules[ruleterm]() {
+						goto l6
+					}
+					goto l5
+				l6:
+					position, tokenIndex, depth = position6, tokenIndex6, depth6
+				}
+				depth--
+				add(ruleexpr, position4)
+			}
+			return true
+		l3:
+			position, tokenIndex, depth = position3, tokenIndex3, depth3
+			return false
+		},
+		/* 2 term <- <(factor (' '+ and ' '+ factor)*)> */
+		func() bool {
+			position16, tokenIndex16, depth16 := position, tokenIndex, depth
+			{
+				position17 := position
+				depth++
+				if !_rules[rulefactor]() {
+					goto l16
+				}
+			l18:
 				{
-					position3, tokenIndex3, depth3 := position, tokenIndex, depth
+					position19, tokenIndex19, depth19 := position, tokenIndex, depth
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l19
 					}
 					position++
-				l4:
+				l20:
 					{
-						position5, tokenIndex5, depth5 := position, tokenIndex, depth
+						position21, tokenIndex21, depth21 := position, tokenIndex, depth
 						if buffer[position] != rune(' ') {
-							goto l5
+							goto l21
 						}
 						position++
-						goto l4
-					l5:
-						position, tokenIndex, depth = position5, tokenIndex5, depth5
+						goto l20
+					l21:
+						position, tokenIndex, depth = position21, tokenIndex21, depth21
 					}
 					{
-						position6 := position
+						position22 := position
 						depth++
 						{
-							position7, tokenIndex7, depth7 := position, tokenIndex, depth
+							position23, tokenIndex23, depth23 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l8
+								goto l24
 							}
 							position++
-							goto l7
-						l8:
-							position, tokenIndex, depth = position7, tokenIndex7, depth7
+							goto l23
+						l24:
+							position, tokenIndex, depth = position23, tokenIndex23, depth23
 							if buffer[position] != rune('A') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l7:
+					l23:
 						{
-							position9, tokenIndex9, depth9 := position, tokenIndex, depth
+							position25, tokenIndex25, depth25 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l10
+								goto l26
 							}
 							position++
-							goto l9
-						l10:
-							position, tokenIndex, depth = position9, tokenIndex9, depth9
+							goto l25
+						l26:
+							position, tokenIndex, depth = position25, tokenIndex25, depth25
 							if buffer[position] != rune('N') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l9:
+					l25:
 						{
-							position11, tokenIndex11, depth11 := position, tokenIndex, depth
+							position27, tokenIndex27, depth27 := position, tokenIndex, depth
 							if buffer[position] != rune('d') {
-								goto l12
+								goto l28
 							}
 							position++
-							goto l11
-						l12:
-							position, tokenIndex, depth = position11, tokenIndex11, depth11
+							goto l27
+						l28:
+							position, tokenIndex, depth = position27, tokenIndex27, depth27
 							if buffer[position] != rune('D') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l11:
+					l27:
 						depth--
-						add(ruleand, position6)
+						add(ruleand, position22)
 					}
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l19
 					}
 					position++
-				l13:
+				l29:
 					{
-						position14, tokenIndex14, depth14 := position, tokenIndex, depth
+						position30, tokenIndex30, depth30 := position, tokenIndex, depth
 						if buffer[position] != rune(' ') {
-							goto l14
+							goto l30
 						}
 						position++
-						goto l13
-					l14:
-						position, tokenIndex, depth = position14, tokenIndex14, depth14
+						goto l29
+					l30:
+						position, tokenIndex, depth = position30, tokenIndex30, depth30
 					}
-					if !_rules[rulecondition]() {
-						goto l3
+					if !_rules[rulefactor]() {
+						goto l19
 					}
-					goto l2
-				l3:
-					position, tokenIndex, depth = position3, tokenIndex3, depth3
-				}
-				if buffer[position] != rune('"') {
-					goto l0
+					goto l18
+				l19:
+					position, tokenIndex, depth = position19, tokenIndex19, depth19
 				}
-				position++
+				depth--
+				add(ruleterm, position17)
+			}
+			return true
+		l16:
+			position, tokenIndex, depth = position16, tokenIndex16, depth16
+			return false
+		},
+		/* 3 factor <- <((not ' '+ factor) / ('(' ' '* expr ' '* ')') / condition)> */
+		func() bool {
+			position31, tokenIndex31, depth31 := position, tokenIndex, depth
+			{
+				position32 := position
+				depth++
 				{
-					position15, tokenIndex15, depth15 := position, tokenIndex, depth
-					if !matchDot() {
-						goto l15
+					position33, tokenIndex33, depth33 := position, tokenIndex, depth
+					{
+						position34 := position
+						depth++
+						{
+							position35, tokenIndex35, depth35 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l36
+							}
+							position++
+							goto l35
+						l36:
+							position, tokenIndex, depth = position35, tokenIndex35, depth35
+							if buffer[position] != rune('N') {
+								goto l37
+							}
+							position++
+						}
+					l35:
+						{
+							position38, tokenIndex38, depth38 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l39
+							}
+							position++
+							goto l38
+						l39:
+							position, tokenIndex, depth = position38, tokenIndex38, depth38
+							if buffer[position] != rune('O') {
+								goto l37
+							}
+							position++
+						}
+					l38:
+						{
+							position40, tokenIndex40, depth40 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l41
+							}
+							position++
+							goto l40
+						l41:
+							position, tokenIndex, depth = position40, tokenIndex40, depth40
+							if buffer[position] != rune('T') {
+								goto l37
+							}
+							position++
+						}
+					l40:
+						depth--
+						add(rulenot, position34)
+					}
+					if buffer[position] != rune(' ') {
+						goto l37
+					}
+					position++
+				l42:
+					{
+						position43, tokenIndex43, depth43 := position, tokenIndex, depth
+						if buffer[position] != rune(' ') {
+							goto l43
+						}
+						position++
+						goto l42
+					l43:
+						position, tokenIndex, depth = position43, tokenIndex43, depth43
+					}
+					if !_rules[rulefactor]() {
+						goto l37
+					}
+					goto l44
+				l37:
+					position, tokenIndex, depth = position33, tokenIndex33, depth33
+					if buffer[position] != rune('(') {
+						goto l45
+					}
+					position++
+				l46:
+					{
+						position47, tokenIndex47, depth47 := position, tokenIndex, depth
+						if buffer[position] != rune(' ') {
+							goto l47
+						}
+						position++
+						goto l46
+					l47:
+						position, tokenIndex, depth = position47, tokenIndex47, depth47
+					}
+					if !_rules[ruleexpr]() {
+						goto l45
+					}
+				l48:
+					{
+						position49, tokenIndex49, depth49 := position, tokenIndex, depth
+						if buffer[position] != rune(' ') {
+							goto l49
+						}
+						position++
+						goto l48
+					l49:
+						position, tokenIndex, depth = position49, tokenIndex49, depth49
+					}
+					if buffer[position] != rune(')') {
+						goto l45
+					}
+					position++
+					goto l44
+				l45:
+					position, tokenIndex, depth = position33, tokenIndex33, depth33
+					if !_rules[rulecondition]() {
+						goto l31
 					}
-					goto l0
-				l15:
-					position, tokenIndex, depth = position15, tokenIndex15, depth15
 				}
+			l44:
 				depth--
-				add(rulee, position1)
+				add(rulefactor, position32)
 			}
 			return true
-		l0:
-			position, tokenIndex, depth = position0, tokenIndex0, depth0
+		l31:
+			position, tokenIndex, depth = position31, tokenIndex31, depth31
 			return false
 		},
-		/* 1 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
+		/* 4 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
 		func() bool {
-			position16, tokenIndex16, depth16 := position, tokenIndex, depth
+			position50, tokenIndex50, depth50 := position, tokenIndex, depth
 			{
-				position17 := position
+				position51 := position
 				depth++
 				{
-					position18 := position
+					position52 := position
 					depth++
 					{
-						position19 := position
+						position53 := position
 						depth++
 						{
-							position22, tokenIndex22, depth22 := position, tokenIndex, depth
+							position54, tokenIndex54, depth54 := position, tokenIndex, depth
 							{
 								switch buffer[position] {
 								case '<':
 									if buffer[position] != rune('<') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case '>':
 									if buffer[position] != rune('>') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case '=':
 									if buffer[position] != rune('=') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case '\'':
 									if buffer[position] != rune('\'') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case '"':
 									if buffer[position] != rune('"') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case ')':
 									if buffer[position] != rune(')') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case '(':
 									if buffer[position] != rune('(') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case '\\':
 									if buffer[position] != rune('\\') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case '\r':
 									if buffer[position] != rune('\r') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case '\n':
 									if buffer[position] != rune('\n') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								case '\t':
 									if buffer[position] != rune('\t') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								default:
 									if buffer[position] != rune(' ') {
-										goto l22
+										goto l54
 									}
 									position++
 									break
 								}
 							}
 
-							goto l16
-						l22:
-							position, tokenIndex, depth = position22, tokenIndex22, depth22
+							goto l50
+						l54:
+							position, tokenIndex, depth = position54, tokenIndex54, depth54
 						}
 						if !matchDot() {
-							goto l16
+							goto l50
 						}
-					l20:
+					l55:
 						{
-							position21, tokenIndex21, depth21 := position, tokenIndex, depth
+							position56, tokenIndex56, depth56 := position, tokenIndex, depth
 							{
-								position24, tokenIndex24, depth24 := position, tokenIndex, depth
+								position57, tokenIndex57, depth57 := position, tokenIndex, depth
 								{
 									switch buffer[position] {
 									case '<':
 										if buffer[position] != rune('<') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case '>':
 										if buffer[position] != rune('>') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case '=':
 										if buffer[position] != rune('=') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case '\'':
 										if buffer[position] != rune('\'') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case '"':
 										if buffer[position] != rune('"') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case ')':
 										if buffer[position] != rune(')') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case '(':
 										if buffer[position] != rune('(') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case '\\':
 										if buffer[position] != rune('\\') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case '\r':
 										if buffer[position] != rune('\r') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case '\n':
 										if buffer[position] != rune('\n') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									case '\t':
 										if buffer[position] != rune('\t') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									default:
 										if buffer[position] != rune(' ') {
-											goto l24
+											goto l57
 										}
 										position++
 										break
 									}
 								}
 
-								goto l21
-							l24:
-								position, tokenIndex, depth = position24, tokenIndex24, depth24
+								goto l56
+							l57:
+								position, tokenIndex, depth = position57, tokenIndex57, depth57
 							}
 							if !matchDot() {
-								goto l21
+								goto l56
 							}
-							goto l20
-						l21:
-							position, tokenIndex, depth = position21, tokenIndex21, depth21
+							goto l55
+						l56:
+							position, tokenIndex, depth = position56, tokenIndex56, depth56
 						}
 						depth--
-						add(rulePegText, position19)
+						add(rulePegText, position53)
 					}
 					depth--
-					add(ruletag, position18)
+					add(ruletag, position52)
 				}
-			l26:
+			l58:
 				{
-					position27, tokenIndex27, depth27 := position, tokenIndex, depth
+					position59, tokenIndex59, depth59 := position, tokenIndex, depth
 					if buffer[position] != rune(' ') {
-						goto l27
+						goto l59
 					}
 					position++
-					goto l26
-				l27:
-					position, tokenIndex, depth = position27, tokenIndex27, depth27
+					goto l58
+				l59:
+					position, tokenIndex, depth = position59, tokenIndex59, depth59
 				}
 				{
-					position28, tokenIndex28, depth28 := position, tokenIndex, depth
+					position60, tokenIndex60, depth60 := position, tokenIndex, depth
 					{
-						position30 := position
+						position61 := position
 						depth++
 						if buffer[position] != rune('<') {
-							goto l29
+							goto l62
 						}
 						position++
 						if buffer[position] != rune('=') {
-							goto l29
+							goto l62
 						}
 						position++
 						depth--
-						add(rulele, position30)
+						add(rulele, position61)
 					}
-				l31:
+				l63:
 					{
-						position32, tokenIndex32, depth32 := position, tokenIndex, depth
+						position64, tokenIndex64, depth64 := position, tokenIndex, depth
 						if buffer[position] != rune(' ') {
-							goto l32
+							goto l64
 						}
 						position++
-						goto l31
-					l32:
-						position, tokenIndex, depth = position32, tokenIndex32, depth32
+						goto l63
+					l64:
+						position, tokenIndex, depth = position64, tokenIndex64, depth64
 					}
 					{
 						switch buffer[position] {
 						case 'D', 'd':
 							if !_rules[ruledate]() {
-								goto l29
+								goto l62
 							}
 							break
 						case 'T', 't':
 							if !_rules[ruletime]() {
-								goto l29
+								goto l62
 							}
 							break
 						default:
 							if !_rules[rulenumber]() {
-								goto l29
+								goto l62
 							}
 							break
 						}
 					}
 
-					goto l28
-				l29:
-					position, tokenIndex, depth = position28, tokenIndex28, depth28
+					goto l60
+				l62:
+					position, tokenIndex, depth = position60, tokenIndex60, depth60
 					{
-						position35 := position
+						position65 := position
 						depth++
 						if buffer[position] != rune('>') {
-							goto l34
+							goto l66
 						}
 						position++
 						if buffer[position] != rune('=') {
-							goto l34
+							goto l66
 						}
 						position++
 						depth--
-						add(rulege, position35)
+						add(rulege, position65)
 					}
-				l36:
+				l67:
 					{
-						position37, tokenIndex37, depth37 := position, tokenIndex, depth
+						position68, tokenIndex68, depth68 := position, tokenIndex, depth
 						if buffer[position] != rune(' ') {
-							goto l37
+							goto l68
 						}
 						position++
-						goto l36
-					l37:
-						position, tokenIndex, depth = position37, tokenIndex37, depth37
+						goto l67
+					l68:
+						position, tokenIndex, depth = position68, tokenIndex68, depth68
 					}
 					{
 						switch buffer[position] {
 						case 'D', 'd':
 							if !_rules[ruledate]() {
-								goto l34
+								goto l66
 							}
 							break
 						case 'T', 't':
 							if !_rules[ruletime]() {
-								goto l34
+								goto l66
 							}
 							break
 						default:
 							if !_rules[rulenumber]() {
-								goto l34
+								goto l66
 							}
 							break
 						}
 					}
 
-					goto l28
-				l34:
-					position, tokenIndex, depth = position28, tokenIndex28, depth28
+					goto l60
+				l66:
+					position, tokenIndex, depth = position60, tokenIndex60, depth60
 					{
 						switch buffer[position] {
 						case 'E', 'e':
 							{
-								position40 := position
+								position69 := position
 								depth++
 								{
-									position41, tokenIndex41, depth41 := position, tokenIndex, depth
+									position70, tokenIndex70, depth70 := position, tokenIndex, depth
 									if buffer[position] != rune('e') {
-										goto l42
+										goto l71
 									}
 									position++
-									goto l41
-								l42:
-									position, tokenIndex, depth = position41, tokenIndex41, depth41
+									goto l70
+								l71:
+									position, tokenIndex, depth = position70, tokenIndex70, depth70
 									if buffer[position] != rune('E') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l41:
+							l70:
 								{
-									position43, tokenIndex43, depth43 := position, tokenIndex, depth
+									position72, tokenIndex72, depth72 := position, tokenIndex, depth
 									if buffer[position] != rune('x') {
-										goto l44
+										goto l73
 									}
 									position++
-									goto l43
-								l44:
-									position, tokenIndex, depth = position43, tokenIndex43, depth43
+									goto l72
+								l73:
+									position, tokenIndex, depth = position72, tokenIndex72, depth72
 									if buffer[position] != rune('X') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l43:
+							l72:
 								{
-									position45, tokenIndex45, depth45 := position, tokenIndex, depth
+									position74, tokenIndex74, depth74 := position, tokenIndex, depth
 									if buffer[position] != rune('i') {
-										goto l46
+										goto l75
 									}
 									position++
-									goto l45
-								l46:
-									position, tokenIndex, depth = position45, tokenIndex45, depth45
+									goto l74
+								l75:
+									position, tokenIndex, depth = position74, tokenIndex74, depth74
 									if buffer[position] != rune('I') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l45:
+							l74:
 								{
-									position47, tokenIndex47, depth47 := position, tokenIndex, depth
+									position76, tokenIndex76, depth76 := position, tokenIndex, depth
 									if buffer[position] != rune('s') {
-										goto l48
+										goto l77
 									}
 									position++
-									goto l47
-								l48:
-									position, tokenIndex, depth = position47, tokenIndex47, depth47
+									goto l76
+								l77:
+									position, tokenIndex, depth = position76, tokenIndex76, depth76
 									if buffer[position] != rune('S') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l47:
+							l76:
 								{
-									position49, tokenIndex49, depth49 := position, tokenIndex, depth
+									position78, tokenIndex78, depth78 := position, tokenIndex, depth
 									if buffer[position] != rune('t') {
-										goto l50
+										goto l79
 									}
 									position++
-									goto l49
-								l50:
-									position, tokenIndex, depth = position49, tokenIndex49, depth49
+									goto l78
+								l79:
+									position, tokenIndex, depth = position78, tokenIndex78, depth78
 									if buffer[position] != rune('T') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l49:
+							l78:
 								{
-									position51, tokenIndex51, depth51 := position, tokenIndex, depth
+									position80, tokenIndex80, depth80 := position, tokenIndex, depth
 									if buffer[position] != rune('s') {
-										goto l52
+										goto l81
 									}
 									position++
-									goto l51
-								l52:
-									position, tokenIndex, depth = position51, tokenIndex51, depth51
+									goto l80
+								l81:
+									position, tokenIndex, depth = position80, tokenIndex80, depth80
 									if buffer[position] != rune('S') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l51:
+							l80:
 								depth--
-								add(ruleexists, position40)
+								add(ruleexists, position69)
 							}
 							break
 						case '=':
 							{
-								position53 := position
+								position82 := position
 								depth++
 								if buffer[position] != rune('=') {
-									goto l16
+									goto l50
 								}
 								position++
 								depth--
-								add(ruleequal, position53)
+								add(ruleequal, position82)
 							}
-						l54:
+						l83:
 							{
-								position55, tokenIndex55, depth55 := position, tokenIndex, depth
+								position84, tokenIndex84, depth84 := position, tokenIndex, depth
 								if buffer[position] != rune(' ') {
-									goto l55
+									goto l84
 								}
 								position++
-								goto l54
-							l55:
-								position, tokenIndex, depth = position55, tokenIndex55, depth55
+								goto l83
+							l84:
+								position, tokenIndex, depth = position84, tokenIndex84, depth84
 							}
 							{
 								switch buffer[position] {
 								case '\'':
 									if !_rules[rulevalue]() {
-										goto l16
+										goto l50
 									}
 									break
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l50
 									}
 									break
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l50
 									}
 									break
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l50
 									}
 									break
 								}
@@ -1075,41 +1324,41 @@ func (p *QueryParser) Init() {
 							break
 						case '>':
 							{
-								position57 := position
+								position85 := position
 								depth++
 								if buffer[position] != rune('>') {
-									goto l16
+									goto l50
 								}
 								position++
 								depth--
-								add(ruleg, position57)
+								add(ruleg, position85)
 							}
-						l58:
+						l86:
 							{
-								position59, tokenIndex59, depth59 := position, tokenIndex, depth
+								position87, tokenIndex87, depth87 := position, tokenIndex, depth
 								if buffer[position] != rune(' ') {
-									goto l59
+									goto l87
 								}
 								position++
-								goto l58
-							l59:
-								position, tokenIndex, depth = position59, tokenIndex59, depth59
+								goto l86
+							l87:
+								position, tokenIndex, depth = position87, tokenIndex87, depth87
 							}
 							{
 								switch buffer[position] {
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l50
 									}
 									break
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l50
 									}
 									break
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l50
 									}
 									break
 								}
@@ -1118,41 +1367,41 @@ func (p *QueryParser) Init() {
 							break
 						case '<':
 							{
-								position61 := position
+								position88 := position
 								depth++
 								if buffer[position] != rune('<') {
-									goto l16
+									goto l50
 								}
 								position++
 								depth--
-								add(rulel, position61)
+								add(rulel, position88)
 							}
-						l62:
+						l89:
 							{
-								position63, tokenIndex63, depth63 := position, tokenIndex, depth
+								position90, tokenIndex90, depth90 := position, tokenIndex, depth
 								if buffer[position] != rune(' ') {
-									goto l63
+									goto l90
 								}
 								position++
-								goto l62
-							l63:
-								position, tokenIndex, depth = position63, tokenIndex63, depth63
+								goto l89
+							l90:
+								position, tokenIndex, depth = position90, tokenIndex90, depth90
 							}
 							{
 								switch buffer[position] {
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l50
 									}
 									break
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l50
 									}
 									break
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l50
 									}
 									break
 								}
@@ -1161,677 +1410,677 @@ func (p *QueryParser) Init() {
 							break
 						default:
 							{
-								position65 := position
+								position91 := position
 								depth++
 								{
-									position66, tokenIndex66, depth66 := position, tokenIndex, depth
+									position92, tokenIndex92, depth92 := position, tokenIndex, depth
 									if buffer[position] != rune('c') {
-										goto l67
+										goto l93
 									}
 									position++
-									goto l66
-								l67:
-									position, tokenIndex, depth = position66, tokenIndex66, depth66
+									goto l92
+								l93:
+									position, tokenIndex, depth = position92, tokenIndex92, depth92
 									if buffer[position] != rune('C') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l66:
+							l92:
 								{
-									position68, tokenIndex68, depth68 := position, tokenIndex, depth
+									position94, tokenIndex94, depth94 := position, tokenIndex, depth
 									if buffer[position] != rune('o') {
-										goto l69
+										goto l95
 									}
 									position++
-									goto l68
-								l69:
-									position, tokenIndex, depth = position68, tokenIndex68, depth68
+									goto l94
+								l95:
+									position, tokenIndex, depth = position94, tokenIndex94, depth94
 									if buffer[position] != rune('O') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l68:
+							l94:
 								{
-									position70, tokenIndex70, depth70 := position, tokenIndex, depth
+									position96, tokenIndex96, depth96 := position, tokenIndex, depth
 									if buffer[position] != rune('n') {
-										goto l71
+										goto l97
 									}
 									position++
-									goto l70
-								l71:
-									position, tokenIndex, depth = position70, tokenIndex70, depth70
+									goto l96
+								l97:
+									position, tokenIndex, depth = position96, tokenIndex96, depth96
 									if buffer[position] != rune('N') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l70:
+							l96:
 								{
-									position72, tokenIndex72, depth72 := position, tokenIndex, depth
+									position98, tokenIndex98, depth98 := position, tokenIndex, depth
 									if buffer[position] != rune('t') {
-										goto l73
+										goto l99
 									}
 									position++
-									goto l72
-								l73:
-									position, tokenIndex, depth = position72, tokenIndex72, depth72
+									goto l98
+								l99:
+									position, tokenIndex, depth = position98, tokenIndex98, depth98
 									if buffer[position] != rune('T') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l72:
+							l98:
 								{
-									position74, tokenIndex74, depth74 := position, tokenIndex, depth
+									position100, tokenIndex100, depth100 := position, tokenIndex, depth
 									if buffer[position] != rune('a') {
-										goto l75
+										goto l101
 									}
 									position++
-									goto l74
-								l75:
-									position, tokenIndex, depth = position74, tokenIndex74, depth74
+									goto l100
+								l101:
+									position, tokenIndex, depth = position100, tokenIndex100, depth100
 									if buffer[position] != rune('A') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l74:
+							l100:
 								{
-									position76, tokenIndex76, depth76 := position, tokenIndex, depth
+									position102, tokenIndex102, depth102 := position, tokenIndex, depth
 									if buffer[position] != rune('i') {
-										goto l77
+										goto l103
 									}
 									position++
-									goto l76
-								l77:
-									position, tokenIndex, depth = position76, tokenIndex76, depth76
+									goto l102
+								l103:
+									position, tokenIndex, depth = position102, tokenIndex102, depth102
 									if buffer[position] != rune('I') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l76:
+							l102:
 								{
-									position78, tokenIndex78, depth78 := position, tokenIndex, depth
+									position104, tokenIndex104, depth104 := position, tokenIndex, depth
 									if buffer[position] != rune('n') {
-										goto l79
+										goto l105
 									}
 									position++
-									goto l78
-								l79:
-									position, tokenIndex, depth = position78, tokenIndex78, depth78
+									goto l104
+								l105:
+									position, tokenIndex, depth = position104, tokenIndex104, depth104
 									if buffer[position] != rune('N') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l78:
+							l104:
 								{
-									position80, tokenIndex80, depth80 := position, tokenIndex, depth
+									position106, tokenIndex106, depth106 := position, tokenIndex, depth
 									if buffer[position] != rune('s') {
-										goto l81
+										goto l107
 									}
 									position++
-									goto l80
-								l81:
-									position, tokenIndex, depth = position80, tokenIndex80, depth80
+									goto l106
+								l107:
+									position, tokenIndex, depth = position106, tokenIndex106, depth106
 									if buffer[position] != rune('S') {
-										goto l16
+										goto l50
 									}
 									position++
 								}
-							l80:
+							l106:
 								depth--
-								add(rulecontains, position65)
+								add(rulecontains, position91)
 							}
-						l82:
+						l108:
 							{
-								position83, tokenIndex83, depth83 := position, tokenIndex, depth
+								position109, tokenIndex109, depth109 := position, tokenIndex, depth
 								if buffer[position] != rune(' ') {
-									goto l83
+									goto l109
 								}
 								position++
-								goto l82
-							l83:
-								position, tokenIndex, depth = position83, tokenIndex83, depth83
+								goto l108
+							l109:
+								position, tokenIndex, depth = position109, tokenIndex109, depth109
 							}
 							if !_rules[rulevalue]() {
-								goto l16
+								goto l50
 							}
 							break
 						}
 					}
 
 				}
-			l28:
+			l60:
 				depth--
-				add(rulecondition, position17)
+				add(rulecondition, position51)
 			}
 			return true
-		l16:
-			position, tokenIndex, depth = position16, tokenIndex16, depth16
+		l50:
+			position, tokenIndex, depth = position50, tokenIndex50, depth50
 			return false
 		},
-		/* 2 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
+		/* 5 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
 		nil,
-		/* 3 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
+		/* 6 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
 		func() bool {
-			position85, tokenIndex85, depth85 := position, tokenIndex, depth
+			position110, tokenIndex110, depth110 := position, tokenIndex, depth
 			{
-				position86 := position
+				position111 := position
 				depth++
 				{
-					position87 := position
+					position112 := position
 					depth++
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l110
 					}
 					position++
-				l88:
+				l113:
 					{
-						position89, tokenIndex89, depth89 := position, tokenIndex, depth
+						position114, tokenIndex114, depth114 := position, tokenIndex, depth
 						{
-							position90, tokenIndex90, depth90 := position, tokenIndex, depth
+							position115, tokenIndex115, depth115 := position, tokenIndex, depth
 							{
-								position91, tokenIndex91, depth91 := position, tokenIndex, depth
+								position116, tokenIndex116, depth116 := position, tokenIndex, depth
 								if buffer[position] != rune('"') {
-									goto l92
+									goto l117
 								}
 								position++
-								goto l91
-							l92:
-								position, tokenIndex, depth = position91, tokenIndex91, depth91
+								goto l116
+							l117:
+								position, tokenIndex, depth = position116, tokenIndex116, depth116
 								if buffer[position] != rune('\'') {
-									goto l90
+									goto l115
 								}
 								position++
 							}
-						l91:
-							goto l89
-						l90:
-							position, tokenIndex, depth = position90, tokenIndex90, depth90
+						l116:
+							goto l114
+						l115:
+							position, tokenIndex, depth = position115, tokenIndex115, depth115
 						}
 						if !matchDot() {
-							goto l89
+							goto l114
 						}
-						goto l88
-					l89:
-						position, tokenIndex, depth = position89, tokenIndex89, depth89
+						goto l113
+					l114:
+						position, tokenIndex, depth = position114, tokenIndex114, depth114
 					}
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l110
 					}
 					position++
 					depth--
-					add(rulePegText, position87)
+					add(rulePegText, position112)
 				}
 				depth--
-				add(rulevalue, position86)
+				add(rulevalue, position111)
 			}
 			return true
-		l85:
-			position, tokenIndex, depth = position85, tokenIndex85, depth85
+		l110:
+			position, tokenIndex, depth = position110, tokenIndex110, depth110
 			return false
 		},
-		/* 4 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
+		/* 7 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
 		func() bool {
-			position93, tokenIndex93, depth93 := position, tokenIndex, depth
+			position118, tokenIndex118, depth118 := position, tokenIndex, depth
 			{
-				position94 := position
+				position119 := position
 				depth++
 				{
-					position95 := position
+					position120 := position
 					depth++
 					{
-						position96, tokenIndex96, depth96 := position, tokenIndex, depth
+						position121, tokenIndex121, depth121 := position, tokenIndex, depth
 						if buffer[position] != rune('0') {
-							goto l97
+							goto l122
 						}
 						position++
-						goto l96
-					l97:
-						position, tokenIndex, depth = position96, tokenIndex96, depth96
+						goto l121
+					l122:
+						position, tokenIndex, depth = position121, tokenIndex121, depth121
 						if c := buffer[position]; c < rune('1') || c > rune('9') {
-							goto l93
+							goto l118
 						}
 						position++
-					l98:
+					l123:
 						{
-							position99, tokenIndex99, depth99 := position, tokenIndex, depth
+							position124, tokenIndex124, depth124 := position, tokenIndex, depth
 							if !_rules[ruledigit]() {
-								goto l99
+								goto l124
 							}
-							goto l98
-						l99:
-							position, tokenIndex, depth = position99, tokenIndex99, depth99
+							goto l123
+						l124:
+							position, tokenIndex, depth = position124, tokenIndex124, depth124
 						}
 						{
-							position100, tokenIndex100, depth100 := position, tokenIndex, depth
+							position125, tokenIndex125, depth125 := position, tokenIndex, depth
 							if buffer[position] != rune('.') {
-								goto l100
+								goto l125
 							}
 							position++
-						l102:
+						l126:
 							{
-								position103, tokenIndex103, depth103 := position, tokenIndex, depth
+								position127, tokenIndex127, depth127 := position, tokenIndex, depth
 								if !_rules[ruledigit]() {
-									goto l103
+									goto l127
 								}
-								goto l102
-							l103:
-								position, tokenIndex, depth = position103, tokenIndex103, depth103
+								goto l126
+							l127:
+								position, tokenIndex, depth = position127, tokenIndex127, depth127
 							}
-							goto l101
-						l100:
-							position, tokenIndex, depth = position100, tokenIndex100, depth100
+							goto l128
+						l125:
+							position, tokenIndex, depth = position125, tokenIndex125, depth125
 						}
-					l101:
+					l128:
 					}
-				l96:
+				l121:
 					depth--
-					add(rulePegText, position95)
+					add(rulePegText, position120)
 				}
 				depth--
-				add(rulenumber, position94)
+				add(rulenumber, position119)
 			}
 			return true
-		l93:
-			position, tokenIndex, depth = position93, tokenIndex93, depth93
+		l118:
+			position, tokenIndex, depth = position118, tokenIndex118, depth118
 			return false
 		},
-		/* 5 digit <- <[0-9]> */
+		/* 8 digit <- <[0-9]> */
 		func() bool {
-			position104, tokenIndex104, depth104 := position, tokenIndex, depth
+			position129, tokenIndex129, depth129 := position, tokenIndex, depth
 			{
-				position105 := position
+				position130 := position
 				depth++
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l104
+					goto l129
 				}
 				position++
 				depth--
-				add(ruledigit, position105)
+				add(ruledigit, position130)
 			}
 			return true
-		l104:
-			position, tokenIndex, depth = position104, tokenIndex104, depth104
+		l129:
+			position, tokenIndex, depth = position129, tokenIndex129, depth129
 			return false
 		},
-		/* 6 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
+		/* 9 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
 		func() bool {
-			position106, tokenIndex106, depth106 := position, tokenIndex, depth
+			position131, tokenIndex131, depth131 := position, tokenIndex, depth
 			{
-				position107 := position
+				position132 := position
 				depth++
 				{
-					position108, tokenIndex108, depth108 := position, tokenIndex, depth
+					position133, tokenIndex133, depth133 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l109
+						goto l134
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex, depth = position108, tokenIndex108, depth108
+					goto l133
+				l134:
+					position, tokenIndex, depth = position133, tokenIndex133, depth133
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l131
 					}
 					position++
 				}
-			l108:
+			l133:
 				{
-					position110, tokenIndex110, depth110 := position, tokenIndex, depth
+					position135, tokenIndex135, depth135 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l111
+						goto l136
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex, depth = position110, tokenIndex110, depth110
+					goto l135
+				l136:
+					position, tokenIndex, depth = position135, tokenIndex135, depth135
 					if buffer[position] != rune('I') {
-						goto l106
+						goto l131
 					}
 					position++
 				}
-			l110:
+			l135:
 				{
-					position112, tokenIndex112, depth112 := position, tokenIndex, depth
+					position137, tokenIndex137, depth137 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l113
+						goto l138
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex, depth = position112, tokenIndex112, depth112
+					goto l137
+				l138:
+					position, tokenIndex, depth = position137, tokenIndex137, depth137
 					if buffer[position] != rune('M') {
-						goto l106
+						goto l131
 					}
 					position++
 				}
-			l112:
+			l137:
 				{
-					position114, tokenIndex114, depth114 := position, tokenIndex, depth
+					position139, tokenIndex139, depth139 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l115
+						goto l140
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex, depth = position114, tokenIndex114, depth114
+					goto l139
+				l140:
+					position, tokenIndex, depth = position139, tokenIndex139, depth139
 					if buffer[position] != rune('E') {
-						goto l106
+						goto l131
 					}
 					position++
 				}
-			l114:
+			l139:
 				if buffer[position] != rune(' ') {
-					goto l106
+					goto l131
 				}
 				position++
 				{
-					position116 := position
+					position141 := position
 					depth++
 					if !_rules[ruleyear]() {
-						goto l106
+						goto l131
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l131
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l106
+						goto l131
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l131
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l106
+						goto l131
 					}
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l131
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l131
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l131
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l131
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l131
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l131
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l131
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l131
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l131
 					}
 					{
-						position117, tokenIndex117, depth117 := position, tokenIndex, depth
+						position142, tokenIndex142, depth142 := position, tokenIndex, depth
 						{
-							position119, tokenIndex119, depth119 := position, tokenIndex, depth
+							position143, tokenIndex143, depth143 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l120
+								goto l144
 							}
 							position++
-							goto l119
-						l120:
-							position, tokenIndex, depth = position119, tokenIndex119, depth119
+							goto l143
+						l144:
+							position, tokenIndex, depth = position143, tokenIndex143, depth143
 							if buffer[position] != rune('+') {
-								goto l118
+								goto l145
 							}
 							position++
 						}
-					l119:
+					l143:
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l145
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l145
 						}
 						if buffer[position] != rune(':') {
-							goto l118
+							goto l145
 						}
 						position++
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l145
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l145
 						}
-						goto l117
-					l118:
-						position, tokenIndex, depth = position117, tokenIndex117, depth117
+						goto l142
+					l145:
+						position, tokenIndex, depth = position142, tokenIndex142, depth142
 						if buffer[position] != rune('Z') {
-							goto l106
+							goto l131
 						}
 						position++
 					}
-				l117:
+				l142:
 					depth--
-					add(rulePegText, position116)
+					add(rulePegText, position141)
 				}
 				depth--
-				add(ruletime, position107)
+				add(ruletime, position132)
 			}
 			return true
-		l106:
-			position, tokenIndex, depth = position106, tokenIndex106, depth106
+		l131:
+			position, tokenIndex, depth = position131, tokenIndex131, depth131
 			return false
 		},
-		/* 7 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
+		/* 10 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
 		func() bool {
-			position121, tokenIndex121, depth121 := position, tokenIndex, depth
+			position146, tokenIndex146, depth146 := position, tokenIndex, depth
 			{
-				position122 := position
+				position147 := position
 				depth++
 				{
-					position123, tokenIndex123, depth123 := position, tokenIndex, depth
+					position148, tokenIndex148, depth148 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l124
+						goto l149
 					}
 					position++
-					goto l123
-				l124:
-					position, tokenIndex, depth = position123, tokenIndex123, depth123
+					goto l148
+				l149:
+					position, tokenIndex, depth = position148, tokenIndex148, depth148
 					if buffer[position] != rune('D') {
-						goto l121
+						goto l146
 					}
 					position++
 				}
-			l123:
+			l148:
 				{
-					position125, tokenIndex125, depth125 := position, tokenIndex, depth
+					position150, tokenIndex150, depth150 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l126
+						goto l151
 					}
 					position++
-					goto l125
-				l126:
-					position, tokenIndex, depth = position125, tokenIndex125, depth125
+					goto l150
+				l151:
+					position, tokenIndex, depth = position150, tokenIndex150, depth150
 					if buffer[position] != rune('A') {
-						goto l121
+						goto l146
 					}
 					position++
 				}
-			l125:
+			l150:
 				{
-					position127, tokenIndex127, depth127 := position, tokenIndex, depth
+					position152, tokenIndex152, depth152 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l128
+						goto l153
 					}
 					position++
-					goto l127
-				l128:
-					position, tokenIndex, depth = position127, tokenIndex127, depth127
+					goto l152
+				l153:
+					position, tokenIndex, depth = position152, tokenIndex152, depth152
 					if buffer[position] != rune('T') {
-						goto l121
+						goto l146
 					}
 					position++
 				}
-			l127:
+			l152:
 				{
-					position129, tokenIndex129, depth129 := position, tokenIndex, depth
+					position154, tokenIndex154, depth154 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l130
+						goto l155
 					}
 					position++
-					goto l129
-				l130:
-					position, tokenIndex, depth = position129, tokenIndex129, depth129
+					goto l154
+				l155:
+					position, tokenIndex, depth = position154, tokenIndex154, depth154
 					if buffer[position] != rune('E') {
-						goto l121
+						goto l146
 					}
 					position++
 				}
-			l129:
+			l154:
 				if buffer[position] != rune(' ') {
-					goto l121
+					goto l146
 				}
 				position++
 				{
-					position131 := position
+					position156 := position
 					depth++
 					if !_rules[ruleyear]() {
-						goto l121
+						goto l146
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l146
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l121
+						goto l146
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l146
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l121
+						goto l146
 					}
 					depth--
-					add(rulePegText, position131)
+					add(rulePegText, position156)
 				}
 				depth--
-				add(ruledate, position122)
+				add(ruledate, position147)
 			}
 			return true
-		l121:
-			position, tokenIndex, depth = position121, tokenIndex121, depth121
+		l146:
+			position, tokenIndex, depth = position146, tokenIndex146, depth146
 			return false
 		},
-		/* 8 year <- <(('1' / '2') digit digit digit)> */
+		/* 11 year <- <(('1' / '2') digit digit digit)> */
 		func() bool {
-			position132, tokenIndex132, depth132 := position, tokenIndex, depth
+			position157, tokenIndex157, depth157 := position, tokenIndex, depth
 			{
-				position133 := position
+				position158 := position
 				depth++
 				{
-					position134, tokenIndex134, depth134 := position, tokenIndex, depth
+					position159, tokenIndex159, depth159 := position, tokenIndex, depth
 					if buffer[position] != rune('1') {
-						goto l135
+						goto l160
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex, depth = position134, tokenIndex134, depth134
+					goto l159
+				l160:
+					position, tokenIndex, depth = position159, tokenIndex159, depth159
 					if buffer[position] != rune('2') {
-						goto l132
+						goto l157
 					}
 					position++
 				}
-			l134:
+			l159:
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l157
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l157
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l157
 				}
 				depth--
-				add(ruleyear, position133)
+				add(ruleyear, position158)
 			}
 			return true
-		l132:
-			position, tokenIndex, depth = position132, tokenIndex132, depth132
+		l157:
+			position, tokenIndex, depth = position157, tokenIndex157, depth157
 			return false
 		},
-		/* 9 month <- <(('0' / '1') digit)> */
+		/* 12 month <- <(('0' / '1') digit)> */
 		func() bool {
-			position136, tokenIndex136, depth136 := position, tokenIndex, depth
+			position161, tokenIndex161, depth161 := position, tokenIndex, depth
 			{
-				position137 := position
+				position162 := position
 				depth++
 				{
-					position138, tokenIndex138, depth138 := position, tokenIndex, depth
+					position163, tokenIndex163, depth163 := position, tokenIndex, depth
 					if buffer[position] != rune('0') {
-						goto l139
+						goto l164
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex, depth = position138, tokenIndex138, depth138
+					goto l163
+				l164:
+					position, tokenIndex, depth = position163, tokenIndex163, depth163
 					if buffer[position] != rune('1') {
-						goto l136
+						goto l161
 					}
 					position++
 				}
-			l138:
+			l163:
 				if !_rules[ruledigit]() {
-					goto l136
+					goto l161
 				}
 				depth--
-				add(rulemonth, position137)
+				add(rulemonth, position162)
 			}
 			return true
-		l136:
-			position, tokenIndex, depth = position136, tokenIndex136, depth136
+		l161:
+			position, tokenIndex, depth = position161, tokenIndex161, depth161
 			return false
 		},
-		/* 10 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
+		/* 13 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
 		func() bool {
-			position140, tokenIndex140, depth140 := position, tokenIndex, depth
+			position165, tokenIndex165, depth165 := position, tokenIndex, depth
 			{
-				position141 := position
+				position166 := position
 				depth++
 				{
 					switch buffer[position] {
 					case '3':
 						if buffer[position] != rune('3') {
-							goto l140
+							goto l165
 						}
 						position++
 						break
 					case '2':
 						if buffer[position] != rune('2') {
-							goto l140
+							goto l165
 						}
 						position++
 						break
 					case '1':
 						if buffer[position] != rune('1') {
-							goto l140
+							goto l165
 						}
 						position++
 						break
 					default:
 						if buffer[position] != rune('0') {
-							goto l140
+							goto l165
 						}
 						position++
 						break
@@ -1839,31 +2088,35 @@ func (p *QueryParser) Init() {
 				}
 
 				if !_rules[ruledigit]() {
-					goto l140
+					goto l165
 				}
 				depth--
-				add(ruleday, position141)
+				add(ruleday, position166)
 			}
 			return true
-		l140:
-			position, tokenIndex, depth = position140, tokenIndex140, depth140
+		l165:
+			position, tokenIndex, depth = position165, tokenIndex165, depth165
 			return false
 		},
-		/* 11 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		/* 14 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		nil,
+		/* 15 or <- <(('o' / 'O') ('r' / 'R'))> */
+		nil,
+		/* 16 not <- <(('n' / 'N') ('o' / 'O') ('t' / 'T'))> */
 		nil,
-		/* 12 equal <- <'='> */
+		/* 17 equal <- <'='> */
 		nil,
-		/* 13 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
+		/* 18 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
 		nil,
-		/* 14 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
+		/* 19 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
 		nil,
-		/* 15 le <- <('<' '=')> */
+		/* 20 le <- <('<' '=')> */
 		nil,
-		/* 16 ge <- <('>' '=')> */
+		/* 21 ge <- <('>' '=')> */
 		nil,
-		/* 17 l <- <'<'> */
+		/* 22 l <- <'<'> */
 		nil,
-		/* 18 g <- <'>'> */
+		/* 23 g <- <'>'> */
 		nil,
 		nil,
 	}
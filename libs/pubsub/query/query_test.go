@@ -150,6 +150,66 @@ func TestMatches(t *testing.T) {
 			false,
 			false,
 		},
+		{"transfer.sender = 'Igor' OR transfer.recipient = 'Igor'",
+			map[string][]string{"transfer.sender": {"Ivan"}, "transfer.recipient": {"Igor"}},
+			false,
+			true,
+			false,
+		},
+		{"transfer.sender = 'Igor' OR transfer.recipient = 'Igor'",
+			map[string][]string{"transfer.sender": {"Ivan"}, "transfer.recipient": {"Pavel"}},
+			false,
+			false,
+			false,
+		},
+		{"NOT message.action = 'vote'", map[string][]string{"message.action": {"send"}}, false, true, false},
+		{"NOT message.action = 'vote'", map[string][]string{"message.action": {"vote"}}, false, false, false},
+		{"NOT NOT message.action = 'vote'", map[string][]string{"message.action": {"vote"}}, false, true, false},
+		{"NOT slash EXISTS", map[string][]string{"message.action": {"vote"}}, false, true, false},
+		{
+			// AND binds tighter than OR
+			"tx.gas > 7 AND tx.gas < 9 OR tx.fee EXISTS",
+			map[string][]string{"tx.gas": {"20"}, "tx.fee": {"1"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"tx.gas > 7 AND (tx.gas < 9 OR tx.fee EXISTS)",
+			map[string][]string{"tx.gas": {"5"}, "tx.fee": {"1"}},
+			false,
+			false,
+			false,
+		},
+		{
+			"(transfer.sender = 'Igor' OR transfer.recipient = 'Igor') AND NOT message.action = 'vote'",
+			map[string][]string{"transfer.recipient": {"Igor"}, "message.action": {"send"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"(transfer.sender = 'Igor' OR transfer.recipient = 'Igor') AND NOT message.action = 'vote'",
+			map[string][]string{"transfer.recipient": {"Igor"}, "message.action": {"vote"}},
+			false,
+			false,
+			false,
+		},
+		{
+			// evaluation stops at the first matching alternative
+			"tx.gas > 7 OR tx.time > TIME 2013-05-03T14:45:00Z",
+			map[string][]string{"tx.gas": {"8"}, "tx.time": {"invalid"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"tx.gas > 9 OR tx.time > TIME 2013-05-03T14:45:00Z",
+			map[string][]string{"tx.gas": {"8"}, "tx.time": {"invalid"}},
+			false,
+			false,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -209,6 +269,14 @@ func TestConditions(t *testing.T) {
 				{CompositeKey: "slashing", Op: query.OpExists},
 			},
 		},
+		{
+			s: "(tx.gas > 7 OR NOT tx.fee EXISTS) AND tx.gas < 9",
+			conditions: []query.Condition{
+				{CompositeKey: "tx.gas", Op: query.OpGreater, Operand: int64(7)},
+				{CompositeKey: "tx.fee", Op: query.OpExists},
+				{CompositeKey: "tx.gas", Op: query.OpLess, Operand: int64(9)},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -220,3 +288,65 @@ func TestConditions(t *testing.T) {
 		assert.Equal(t, tc.conditions, c)
 	}
 }
+
+func TestExpression(t *testing.T) {
+	cond := func(key string, op query.Operator, operand interface{}) *query.Expr {
+		return &query.Expr{
+			Kind:      query.ExprCondition,
+			Condition: query.Condition{CompositeKey: key, Op: op, Operand: operand},
+		}
+	}
+	a := cond("a.x", query.OpEqual, int64(1))
+	b := cond("b.x", query.OpEqual, int64(2))
+	c := cond("c.x", query.OpExists, nil)
+
+	testCases := []struct {
+		s           string
+		expr        *query.Expr
+		conjunction bool
+	}{
+		{"a.x = 1", a, true},
+		{"a.x = 1 AND b.x = 2", &query.Expr{Kind: query.ExprAnd, Children: []*query.Expr{a, b}}, true},
+		{
+			"a.x = 1 AND (b.x = 2 AND c.x EXISTS)",
+			&query.Expr{Kind: query.ExprAnd, Children: []*query.Expr{
+				a,
+				{Kind: query.ExprAnd, Children: []*query.Expr{b, c}},
+			}},
+			true,
+		},
+		{"a.x = 1 OR b.x = 2", &query.Expr{Kind: query.ExprOr, Children: []*query.Expr{a, b}}, false},
+		{"NOT a.x = 1", &query.Expr{Kind: query.ExprNot, Children: []*query.Expr{a}}, false},
+		{
+			"a.x = 1 OR b.x = 2 AND NOT c.x EXISTS",
+			&query.Expr{Kind: query.ExprOr, Children: []*query.Expr{
+				a,
+				{Kind: query.ExprAnd, Children: []*query.Expr{
+					b,
+					{Kind: query.ExprNot, Children: []*query.Expr{c}},
+				}},
+			}},
+			false,
+		},
+		{
+			"(a.x = 1 OR b.x = 2) AND c.x EXISTS",
+			&query.Expr{Kind: query.ExprAnd, Children: []*query.Expr{
+				{Kind: query.ExprOr, Children: []*query.Expr{a, b}},
+				c,
+			}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		q, err := query.New(tc.s)
+		require.NoError(t, err)
+
+		expr, err := q.Expression()
+		require.NoError(t, err)
+		assert.Equal(t, tc.expr, expr, tc.s)
+
+		_, ok := expr.Conjunction()
+		assert.Equal(t, tc.conjunction, ok, tc.s)
+	}
+}
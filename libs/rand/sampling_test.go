@@ -1,7 +1,6 @@
 package rand
 
 import (
-	"fmt"
 	"math"
 	"math/rand"
 	s "sort"
@@ -40,7 +39,7 @@ func TestRandomSamplingWithPriority(t *testing.T) {
 
 	elected := RandomSamplingWithPriority(0, candidates, 10, calculateTotalPriority(candidates))
 	if len(elected) != 10 {
-		t.Errorf(fmt.Sprintf("unexpected sample size: %d", len(elected)))
+		t.Errorf("unexpected sample size: %d", len(elected))
 	}
 
 	// ----
@@ -48,7 +47,7 @@ func TestRandomSamplingWithPriority(t *testing.T) {
 	others := newCandidates(100, func(i int) uint64 { return uint64(i) })
 	secondTimeElected := RandomSamplingWithPriority(0, others, 10, calculateTotalPriority(others))
 	if len(elected) != len(secondTimeElected) || !sameCandidates(elected, secondTimeElected) {
-		t.Errorf(fmt.Sprintf("undeterministic: %+v != %+v", elected, others))
+		t.Errorf("undeterministic: %+v != %+v", elected, others)
 	}
 
 	// ----
@@ -0,0 +1,54 @@
+package reedsolomon
+
+// The arithmetic of GF(2^8), with the generator polynomial
+// x^8 + x^4 + x^3 + x^2 + 1.
+const fieldSize = 256
+
+var (
+	expTable [2 * fieldSize]byte
+	logTable [fieldSize]byte
+	mulTable [fieldSize][fieldSize]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < fieldSize-1; i++ {
+		expTable[i] = byte(x)
+		logTable[x] = byte(i)
+		x <<= 1
+		if x >= fieldSize {
+			x ^= 0x11d
+		}
+	}
+	for i := fieldSize - 1; i < len(expTable); i++ {
+		expTable[i] = expTable[i-(fieldSize-1)]
+	}
+	for a := 0; a < fieldSize; a++ {
+		for b := 0; b < fieldSize; b++ {
+			mulTable[a][b] = galMul(byte(a), byte(b))
+		}
+	}
+}
+
+func galMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[int(logTable[a])+int(logTable[b])]
+}
+
+// galInv returns the inverse of a, which must not be 0.
+func galInv(a byte) byte {
+	return expTable[fieldSize-1-int(logTable[a])]
+}
+
+// galMulSliceXor adds c * in to out.
+func galMulSliceXor(c byte, in, out []byte) {
+	if c == 0 {
+		return
+	}
+	mt := &mulTable[c]
+	for i, b := range in {
+		out[i] ^= mt[b]
+	}
+}
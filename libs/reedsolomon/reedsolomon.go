@@ -0,0 +1,208 @@
+// Package reedsolomon implements a systematic Reed-Solomon erasure code over
+// GF(2^8).
+//
+// The k data shards are extended with m parity shards, so that the data can
+// be rebuilt from any k of the k+m shards. The parity shards are computed
+// with a Cauchy matrix, every square submatrix of which is invertible.
+package reedsolomon
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxShards is the maximum number of data and parity shards of a code.
+const MaxShards = fieldSize
+
+var (
+	// ErrTooFewShards is returned when fewer than the data shards count are
+	// available to reconstruct the missing shards.
+	ErrTooFewShards = errors.New("too few shards to reconstruct the data")
+	// ErrShardSize is returned when the shards have different sizes.
+	ErrShardSize = errors.New("shards have different sizes")
+)
+
+// Code is a Reed-Solomon code of a given number of data and parity shards.
+// It is safe for concurrent use.
+type Code struct {
+	dataShards   int
+	parityShards int
+	// the parityShards x dataShards matrix of the parity shards
+	parity [][]byte
+}
+
+// New returns the code of the given number of data and parity shards, whose
+// sum must not exceed MaxShards.
+func New(dataShards, parityShards int) (*Code, error) {
+	if dataShards <= 0 {
+		return nil, fmt.Errorf("data shards must be positive, got %d", dataShards)
+	}
+	if parityShards < 0 {
+		return nil, fmt.Errorf("parity shards must not be negative, got %d", parityShards)
+	}
+	if dataShards+parityShards > MaxShards {
+		return nil, fmt.Errorf("too many shards: %d > %d", dataShards+parityShards, MaxShards)
+	}
+
+	parity := make([][]byte, parityShards)
+	for i := range parity {
+		parity[i] = make([]byte, dataShards)
+		for j := range parity[i] {
+			parity[i][j] = galInv(byte(dataShards+i) ^ byte(j))
+		}
+	}
+	return &Code{dataShards: dataShards, parityShards: parityShards, parity: parity}, nil
+}
+
+// DataShards returns the number of data shards of the code.
+func (c *Code) DataShards() int {
+	return c.dataShards
+}
+
+// ParityShards returns the number of parity shards of the code.
+func (c *Code) ParityShards() int {
+	return c.parityShards
+}
+
+// Encode returns the parity shards of the given data shards, which must all
+// have the same size.
+func (c *Code) Encode(data [][]byte) ([][]byte, error) {
+	if len(data) != c.dataShards {
+		return nil, fmt.Errorf("expected %d data shards, got %d", c.dataShards, len(data))
+	}
+	size, err := shardSize(data)
+	if err != nil {
+		return nil, err
+	}
+	parity := make([][]byte, c.parityShards)
+	for i := range parity {
+		parity[i] = make([]byte, size)
+		for j, shard := range data {
+			galMulSliceXor(c.parity[i][j], shard, parity[i])
+		}
+	}
+	return parity, nil
+}
+
+// Reconstruct rebuilds in place the missing shards, which are nil, from the
+// others. shards holds the data shards followed by the parity shards.
+// It returns ErrTooFewShards if fewer than DataShards shards are present.
+func (c *Code) Reconstruct(shards [][]byte) error {
+	if len(shards) != c.dataShards+c.parityShards {
+		return fmt.Errorf("expected %d shards, got %d", c.dataShards+c.parityShards, len(shards))
+	}
+	size, err := shardSize(shards)
+	if err != nil {
+		return err
+	}
+
+	// Pick the first dataShards present shards, and invert the matrix that
+	// maps the data shards to them.
+	present := make([]int, 0, c.dataShards)
+	for i, shard := range shards {
+		if shard != nil {
+			present = append(present, i)
+			if len(present) == c.dataShards {
+				break
+			}
+		}
+	}
+	if len(present) < c.dataShards {
+		return ErrTooFewShards
+	}
+	matrix := make([][]byte, c.dataShards)
+	for r, i := range present {
+		if i < c.dataShards {
+			matrix[r] = make([]byte, c.dataShards)
+			matrix[r][i] = 1
+		} else {
+			matrix[r] = append([]byte(nil), c.parity[i-c.dataShards]...)
+		}
+	}
+	inverse, err := invert(matrix)
+	if err != nil {
+		return err
+	}
+
+	for j := 0; j < c.dataShards; j++ {
+		if shards[j] != nil {
+			continue
+		}
+		shard := make([]byte, size)
+		for r, i := range present {
+			galMulSliceXor(inverse[j][r], shards[i], shard)
+		}
+		shards[j] = shard
+	}
+	for i := 0; i < c.parityShards; i++ {
+		if shards[c.dataShards+i] != nil {
+			continue
+		}
+		shard := make([]byte, size)
+		for j := 0; j < c.dataShards; j++ {
+			galMulSliceXor(c.parity[i][j], shards[j], shard)
+		}
+		shards[c.dataShards+i] = shard
+	}
+	return nil
+}
+
+// shardSize returns the size of the non-nil shards, and an error if they have
+// different sizes.
+func shardSize(shards [][]byte) (int, error) {
+	size := -1
+	for _, shard := range shards {
+		if shard == nil {
+			continue
+		}
+		if size == -1 {
+			size = len(shard)
+		} else if len(shard) != size {
+			return 0, ErrShardSize
+		}
+	}
+	if size == -1 {
+		return 0, ErrTooFewShards
+	}
+	return size, nil
+}
+
+// invert returns the inverse of the square matrix, with a Gauss-Jordan
+// elimination. The matrix is modified.
+func invert(matrix [][]byte) ([][]byte, error) {
+	n := len(matrix)
+	inverse := make([][]byte, n)
+	for i := range inverse {
+		inverse[i] = make([]byte, n)
+		inverse[i][i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for pivot < n && matrix[pivot][col] == 0 {
+			pivot++
+		}
+		if pivot == n {
+			return nil, errors.New("singular matrix")
+		}
+		matrix[col], matrix[pivot] = matrix[pivot], matrix[col]
+		inverse[col], inverse[pivot] = inverse[pivot], inverse[col]
+
+		if c := matrix[col][col]; c != 1 {
+			scale := galInv(c)
+			for j := 0; j < n; j++ {
+				matrix[col][j] = galMul(matrix[col][j], scale)
+				inverse[col][j] = galMul(inverse[col][j], scale)
+			}
+		}
+		for row := 0; row < n; row++ {
+			if row == col || matrix[row][col] == 0 {
+				continue
+			}
+			c := matrix[row][col]
+			galMulSliceXor(c, matrix[col], matrix[row])
+			galMulSliceXor(c, inverse[col], inverse[row])
+		}
+	}
+	return inverse, nil
+}
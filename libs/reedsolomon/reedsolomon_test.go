@@ -0,0 +1,95 @@
+package reedsolomon
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func randShards(rng *rand.Rand, n, size int) [][]byte {
+	shards := make([][]byte, n)
+	for i := range shards {
+		shards[i] = make([]byte, size)
+		rng.Read(shards[i])
+	}
+	return shards
+}
+
+func TestGalois(t *testing.T) {
+	for a := 1; a < fieldSize; a++ {
+		assert.EqualValues(t, 1, galMul(byte(a), galInv(byte(a))), "a=%d", a)
+		for b := 0; b < fieldSize; b++ {
+			assert.Equal(t, galMul(byte(a), byte(b)), galMul(byte(b), byte(a)))
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		data, parity int
+		ok           bool
+	}{
+		{1, 0, true},
+		{10, 4, true},
+		{200, 56, true},
+		{0, 1, false},
+		{10, -1, false},
+		{200, 57, false},
+	}
+	for _, tc := range testCases {
+		_, err := New(tc.data, tc.parity)
+		assert.Equal(t, tc.ok, err == nil, "data=%d parity=%d", tc.data, tc.parity)
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	testCases := []struct {
+		data, parity int
+	}{
+		{1, 1},
+		{4, 2},
+		{10, 10},
+		{200, 50},
+	}
+	for _, tc := range testCases {
+		code, err := New(tc.data, tc.parity)
+		require.NoError(t, err)
+		data := randShards(rng, tc.data, 100)
+		parity, err := code.Encode(data)
+		require.NoError(t, err)
+		all := append(append([][]byte{}, data...), parity...)
+
+		for trial := 0; trial < 10; trial++ {
+			shards := append([][]byte{}, all...)
+			for _, i := range rng.Perm(len(shards))[:tc.parity] {
+				shards[i] = nil
+			}
+			require.NoError(t, code.Reconstruct(shards))
+			assert.Equal(t, all, shards)
+		}
+	}
+}
+
+func TestReconstructTooFewShards(t *testing.T) {
+	code, err := New(4, 2)
+	require.NoError(t, err)
+	data := randShards(rand.New(rand.NewSource(1)), 4, 10)
+	parity, err := code.Encode(data)
+	require.NoError(t, err)
+
+	shards := [][]byte{data[0], nil, data[2], data[3], nil, parity[1]}
+	require.NoError(t, code.Reconstruct(append([][]byte{}, shards...)))
+	shards[0] = nil
+	assert.Equal(t, ErrTooFewShards, code.Reconstruct(shards))
+}
+
+func TestShardSize(t *testing.T) {
+	code, err := New(2, 1)
+	require.NoError(t, err)
+	_, err = code.Encode([][]byte{make([]byte, 2), make([]byte, 3)})
+	assert.Equal(t, ErrShardSize, err)
+	assert.Equal(t, ErrShardSize, code.Reconstruct([][]byte{make([]byte, 2), nil, make([]byte, 3)}))
+}
@@ -127,6 +127,28 @@ func MaxBlockLag(d time.Duration) Option {
 	}
 }
 
+// WitnessPool option sets the providers the witnesses removed for misbehaving
+// (or promoted to primary) are replaced with, so that the client keeps as
+// many witnesses as it started with. The providers are picked from the best
+// scoring to the worst one, see ProviderScores; the providers removed for
+// misbehaving are never picked again.
+func WitnessPool(pool []provider.Provider) Option {
+	return func(c *Client) {
+		c.witnessPool = pool
+	}
+}
+
+// PrimaryRotation option makes the client replace the primary with a witness
+// whose score is higher than the one of the primary by at least margin, see
+// ProviderScores. Both need some requests before being compared. The primary
+// is only replaced after the witnesses have confirmed a new light block.
+// Default: 0, the primary is only replaced when it fails.
+func PrimaryRotation(margin float64) Option {
+	return func(c *Client) {
+		c.primaryRotationMargin = margin
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -147,6 +169,14 @@ type Client struct {
 	primary provider.Provider
 	// Providers used to "witness" new headers.
 	witnesses []provider.Provider
+	// Number of witnesses the client started with, see WitnessPool option
+	numWitnesses int
+	// See WitnessPool option
+	witnessPool []provider.Provider
+	// See PrimaryRotation option
+	primaryRotationMargin float64
+	// Scores of the providers, see ProviderScores
+	scores *providerScores
 
 	// Where trusted light blocks are stored.
 	trustedStore store.Store
@@ -229,6 +259,8 @@ func NewClientFromTrustedStore(
 		maxBlockLag:      defaultMaxBlockLag,
 		primary:          primary,
 		witnesses:        witnesses,
+		numWitnesses:     len(witnesses),
+		scores:           newProviderScores(),
 		trustedStore:     trustedStore,
 		pruningSize:      defaultPruningSize,
 		confirmationFn:   func(action string) bool { return true },
@@ -252,6 +284,12 @@ func NewClientFromTrustedStore(
 				i, w, w.ChainID(), chainID)
 		}
 	}
+	for i, p := range c.witnessPool {
+		if p.ChainID() != chainID {
+			return nil, fmt.Errorf("witness pool #%d: %v is on another chain %s, expected %s",
+				i, p, p.ChainID(), chainID)
+		}
+	}
 
 	// Validate trust level.
 	if err := ValidateTrustLevel(c.trustLevel); err != nil {
@@ -749,7 +787,7 @@ func (c *Client) verifySkipping(
 			if depth == len(blockCache)-1 {
 				pivotHeight := verifiedBlock.Height + (blockCache[depth].Height-verifiedBlock.
 					Height)*verifySkippingNumerator/verifySkippingDenominator
-				interimBlock, providerErr := source.LightBlock(ctx, pivotHeight)
+				interimBlock, providerErr := c.lightBlockFrom(ctx, source, pivotHeight)
 				switch providerErr {
 				case nil:
 					blockCache = append(blockCache, interimBlock)
@@ -990,7 +1028,7 @@ func (c *Client) backwards(
 //    any other error, the primary is permanently dropped and is replaced by a witness.
 func (c *Client) lightBlockFromPrimary(ctx context.Context, height int64) (*types.LightBlock, error) {
 	c.providerMutex.Lock()
-	l, err := c.primary.LightBlock(ctx, height)
+	l, err := c.lightBlockFrom(ctx, c.primary, height)
 	c.providerMutex.Unlock()
 
 	switch err {
@@ -1016,10 +1054,13 @@ func (c *Client) lightBlockFromPrimary(ctx context.Context, height int64) (*type
 	}
 }
 
+// removeWitnesses removes the witnesses of the given indexes and replaces them
+// with providers of the witness pool, if any.
+//
 // NOTE: requires a providerMutex lock
 func (c *Client) removeWitnesses(indexes []int) error {
 	// check that we will still have witnesses remaining
-	if len(c.witnesses) <= len(indexes) {
+	if len(c.witnesses) <= len(indexes) && len(c.witnessCandidates()) == 0 {
 		return ErrNoWitnesses
 	}
 
@@ -1031,6 +1072,8 @@ func (c *Client) removeWitnesses(indexes []int) error {
 		c.witnesses = c.witnesses[:len(c.witnesses)-1]
 	}
 
+	c.refillWitnesses()
+
 	return nil
 }
 
@@ -1067,7 +1110,7 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 		go func(witnessIndex int, witnessResponsesC chan witnessResponse) {
 			defer wg.Done()
 
-			lb, err := c.witnesses[witnessIndex].LightBlock(subctx, height)
+			lb, err := c.lightBlockFrom(subctx, c.witnesses[witnessIndex], height)
 			witnessResponsesC <- witnessResponse{lb, witnessIndex, err}
 		}(index, witnessResponsesC)
 	}
@@ -1085,6 +1128,8 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 			// if we are not intending on removing the primary then append the old primary to the end of the witness slice
 			if !remove {
 				c.witnesses = append(c.witnesses, c.primary)
+			} else {
+				c.scores.recordRemoval(c.primary)
 			}
 
 			// promote respondent as the new primary
@@ -1115,6 +1160,7 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 			lastError = response.err
 			c.logger.Error("error on light block request from witness, removing...",
 				"error", response.err, "primary", c.witnesses[response.witnessIndex])
+			c.scores.recordRemoval(c.witnesses[response.witnessIndex])
 			witnessesToRemove = append(witnessesToRemove, response.witnessIndex)
 		}
 	}
@@ -1157,12 +1203,14 @@ func (c *Client) compareFirstHeaderWithWitnesses(ctx context.Context, h *types.S
 		case errConflictingHeaders:
 			c.logger.Error(fmt.Sprintf(`Witness #%d has a different header. Please check primary is correct
 and remove witness. Otherwise, use the different primary`, e.WitnessIndex), "witness", c.witnesses[e.WitnessIndex])
+			c.scores.recordDivergence(c.witnesses[e.WitnessIndex])
 			return err
 		case errBadWitness:
 			// If witness sent us an invalid header, then remove it
 			c.logger.Info("witness sent an invalid light block, removing...",
 				"witness", c.witnesses[e.WitnessIndex],
 				"err", err)
+			c.scores.recordRemoval(c.witnesses[e.WitnessIndex])
 			witnessesToRemove = append(witnessesToRemove, e.WitnessIndex)
 		default: // benign errors can be ignored with the exception of context errors
 			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
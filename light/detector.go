@@ -65,12 +65,14 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 			//
 			// We combine these actions together, verifying the witnesses headers and outputting the trace
 			// which captures the bifurcation point and if successful provides the information to create valid evidence.
+			c.scores.recordDivergence(c.witnesses[e.WitnessIndex])
 			err := c.handleConflictingHeaders(ctx, primaryTrace, e.Block, e.WitnessIndex, now)
 			if err != nil {
 				// return information of the attack
 				return err
 			}
 			// if attempt to generate conflicting headers failed then remove witness
+			c.scores.recordRemoval(c.witnesses[e.WitnessIndex])
 			witnessesToRemove = append(witnessesToRemove, e.WitnessIndex)
 
 		case errBadWitness:
@@ -78,6 +80,7 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 			// witness
 			c.logger.Info("witness returned an error during header comparison, removing...",
 				"witness", c.witnesses[e.WitnessIndex], "err", err)
+			c.scores.recordRemoval(c.witnesses[e.WitnessIndex])
 			witnessesToRemove = append(witnessesToRemove, e.WitnessIndex)
 		default:
 			// Benign errors which can be ignored unless there was a context
@@ -97,6 +100,7 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 	// 1. If we had at least one witness that returned the same header then we
 	// conclude that we can trust the header
 	if headerMatched {
+		c.rotatePrimary()
 		return nil
 	}
 
@@ -114,7 +118,7 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 func (c *Client) compareNewHeaderWithWitness(ctx context.Context, errc chan error, h *types.SignedHeader,
 	witness provider.Provider, witnessIndex int) {
 
-	lightBlock, err := witness.LightBlock(ctx, h.Height)
+	lightBlock, err := c.lightBlockFrom(ctx, witness, h.Height)
 	switch err {
 	// no error means we move on to checking the hash of the two headers
 	case nil:
@@ -331,7 +335,7 @@ func (c *Client) examineConflictingHeaderAgainstTrace(
 		if traceBlock.Height == targetBlock.Height {
 			sourceBlock = targetBlock
 		} else {
-			sourceBlock, err = source.LightBlock(ctx, traceBlock.Height)
+			sourceBlock, err = c.lightBlockFrom(ctx, source, traceBlock.Height)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to examine trace: %w", err)
 			}
@@ -379,7 +383,7 @@ func (c *Client) getTargetBlockOrLatest(
 	height int64,
 	witness provider.Provider,
 ) (bool, *types.LightBlock, error) {
-	lightBlock, err := witness.LightBlock(ctx, 0)
+	lightBlock, err := c.lightBlockFrom(ctx, witness, 0)
 	if err != nil {
 		return false, nil, err
 	}
@@ -394,7 +398,7 @@ func (c *Client) getTargetBlockOrLatest(
 		// the witness has caught up. We recursively call the function again. However in order
 		// to avoud a wild goose chase where the witness sends us one header below and one header
 		// above the height we set a timeout to the context
-		lightBlock, err := witness.LightBlock(ctx, height)
+		lightBlock, err := c.lightBlockFrom(ctx, witness, height)
 		return true, lightBlock, err
 	}
 
@@ -117,6 +117,10 @@ signatures from non-existent validators to justify it. Secure RPC proxy serves
 as a wrapper, which verifies all the headers, using a light client connected to
 some other node.
 
+Not all the data can be verified against the headers: the genesis document
+(except for its chain ID) and the events of the tx results are passed through
+unverified, see light/rpc.Client for the details.
+
 See
 https://docs.tendermint.com/master/tendermint-core/light-client-protocol.html
 for usage example.
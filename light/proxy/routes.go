@@ -21,18 +21,22 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"health":               rpcserver.NewRPCFunc(makeHealthFunc(c), ""),
 		"status":               rpcserver.NewRPCFunc(makeStatusFunc(c), ""),
 		"net_info":             rpcserver.NewRPCFunc(makeNetInfoFunc(c), ""),
+		"net_addrbook":         rpcserver.NewRPCFunc(makeNetAddrBookFunc(c), ""),
 		"blockchain":           rpcserver.NewRPCFunc(makeBlockchainInfoFunc(c), "minHeight,maxHeight"),
 		"genesis":              rpcserver.NewRPCFunc(makeGenesisFunc(c), ""),
-		"genesis_chunked":      rpcserver.NewRPCFunc(makeGenesisChunkedFunc(c), ""),
+		"genesis_chunked":      rpcserver.NewRPCFunc(makeGenesisChunkedFunc(c), "chunk"),
 		"block":                rpcserver.NewRPCFunc(makeBlockFunc(c), "height"),
 		"block_by_hash":        rpcserver.NewRPCFunc(makeBlockByHashFunc(c), "hash"),
 		"block_results":        rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height"),
 		"commit":               rpcserver.NewRPCFunc(makeCommitFunc(c), "height"),
+		"check_tx":             rpcserver.NewRPCFunc(makeCheckTxFunc(c), "tx"),
 		"tx":                   rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove"),
 		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
 		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by"),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page"),
 		"voters":               rpcserver.NewRPCFunc(makeVotersFunc(c), "height,page,per_page"),
+		"validators_voters":    rpcserver.NewRPCFunc(makeValidatorsWithVotersFunc(c), "height,page,per_page"),
+		"voter_election_proof": rpcserver.NewRPCFunc(makeVoterElectionProofFunc(c), "height"),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height"),
@@ -70,14 +74,22 @@ func makeStatusFunc(c *lrpc.Client) rpcStatusFunc {
 	}
 }
 
-type rpcNetInfoFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultNetInfo, error)
+type rpcNetInfoFunc func(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error)
 
 func makeNetInfoFunc(c *lrpc.Client) rpcNetInfoFunc {
-	return func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultNetInfo, error) {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 		return c.NetInfo(ctx.Context())
 	}
 }
 
+type rpcNetAddrBookFunc func(ctx *rpctypes.Context) (*ctypes.ResultNetAddrBook, error)
+
+func makeNetAddrBookFunc(c *lrpc.Client) rpcNetAddrBookFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultNetAddrBook, error) {
+		return c.NetAddrBook(ctx.Context())
+	}
+}
+
 type rpcBlockchainInfoFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error)
 
 func makeBlockchainInfoFunc(c *lrpc.Client) rpcBlockchainInfoFunc {
@@ -134,6 +146,14 @@ func makeCommitFunc(c *lrpc.Client) rpcCommitFunc {
 	}
 }
 
+type rpcCheckTxFunc func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultCheckTx, error)
+
+func makeCheckTxFunc(c *lrpc.Client) rpcCheckTxFunc {
+	return func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
+		return c.CheckTx(ctx.Context(), tx)
+	}
+}
+
 type rpcTxFunc func(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
 func makeTxFunc(c *lrpc.Client) rpcTxFunc {
@@ -165,7 +185,6 @@ func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
 type rpcBlockSearchFunc func(
 	ctx *rpctypes.Context,
 	query string,
-	prove bool,
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultBlockSearch, error)
@@ -174,7 +193,6 @@ func makeBlockSearchFunc(c *lrpc.Client) rpcBlockSearchFunc {
 	return func(
 		ctx *rpctypes.Context,
 		query string,
-		prove bool,
 		page, perPage *int,
 		orderBy string,
 	) (*ctypes.ResultBlockSearch, error) {
@@ -200,6 +218,23 @@ func makeVotersFunc(c *lrpc.Client) rpcVotersFunc {
 	}
 }
 
+type rpcValidatorsWithVotersFunc func(ctx *rpctypes.Context, height *int64,
+	page, perPage *int) (*ctypes.ResultValidatorsWithVoters, error)
+
+func makeValidatorsWithVotersFunc(c *lrpc.Client) rpcValidatorsWithVotersFunc {
+	return func(ctx *rpctypes.Context, height *int64, page, perPage *int) (*ctypes.ResultValidatorsWithVoters, error) {
+		return c.ValidatorsWithVoters(ctx.Context(), height, page, perPage)
+	}
+}
+
+type rpcVoterElectionProofFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultVoterElectionProof, error)
+
+func makeVoterElectionProofFunc(c *lrpc.Client) rpcVoterElectionProofFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultVoterElectionProof, error) {
+		return c.VoterElectionProof(ctx.Context(), height)
+	}
+}
+
 type rpcDumpConsensusStateFunc func(ctx *rpctypes.Context) (*ctypes.ResultDumpConsensusState, error)
 
 func makeDumpConsensusStateFunc(c *lrpc.Client) rpcDumpConsensusStateFunc {
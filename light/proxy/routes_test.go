@@ -0,0 +1,27 @@
+package proxy
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	lrpc "github.com/line/ostracon/light/rpc"
+	rpcmock "github.com/line/ostracon/rpc/client/mocks"
+	"github.com/line/ostracon/rpc/core"
+)
+
+func TestRPCRoutes(t *testing.T) {
+	routes := RPCRoutes(lrpc.NewClient(&rpcmock.Client{}, nil))
+
+	// every route of a full node is proxied
+	for name := range core.Routes {
+		assert.Contains(t, routes, name)
+	}
+
+	// every argument but the context is named
+	for name, route := range routes {
+		fn := reflect.ValueOf(route).Elem()
+		assert.Equal(t, fn.FieldByName("args").Len()-1, fn.FieldByName("argNames").Len(), name)
+	}
+}
@@ -23,6 +23,10 @@ import (
 
 var errNegOrZeroHeight = errors.New("negative or zero height")
 
+// ErrUnverifiable is returned for the data which can't be verified against
+// the trusted headers.
+var ErrUnverifiable = errors.New("can't be verified by the light client")
+
 // KeyPathFunc builds a merkle path out of the given path and key.
 type KeyPathFunc func(path string, key []byte) (merkle.KeyPath, error)
 
@@ -40,6 +44,20 @@ var _ rpcclient.Client = (*Client)(nil)
 // Client is an RPC client, which uses light#Client to verify data (if it can
 // be proved). Note, merkle.DefaultProofRuntime is used to verify values
 // returned by ABCI#Query.
+//
+// The chain data is verified against the trusted headers, or an error
+// wrapping ErrUnverifiable is returned, with the following exceptions, which
+// aren't covered by the headers:
+//   - Genesis only verifies the chain ID, the rest of the genesis document is
+//     passed through as it is, and GenesisChunked always returns an error;
+//   - the events of the tx results (in BlockResults, Tx and TxSearch) aren't
+//     verified, only their code, data and gas are;
+//   - the number of txs or blocks found by TxSearch and BlockSearch isn't
+//     verified, only each of them is.
+//
+// The data about the node itself (Status, Health, NetInfo, the consensus
+// state, the mempool, ABCIInfo and CheckTx), the broadcasts and the
+// subscriptions are passed through to the next client as they are.
 type Client struct {
 	service.BaseService
 
@@ -49,6 +67,9 @@ type Client struct {
 	// proof runtime used to verify values returned by ABCIQuery
 	prt       *merkle.ProofRuntime
 	keyPathFn KeyPathFunc
+
+	// voter params of the chain, used to verify the voter elections
+	voterParams *types.VoterParams
 }
 
 var _ rpcclient.Client = (*Client)(nil)
@@ -86,12 +107,22 @@ func DefaultMerkleKeyPathFn() KeyPathFunc {
 	}
 }
 
+// VoterParams option sets the voter params of the chain, from its genesis doc,
+// that the voter elections are verified with. The default voter params are
+// used otherwise.
+func VoterParams(vp *types.VoterParams) Option {
+	return func(c *Client) {
+		c.voterParams = vp
+	}
+}
+
 // NewClient returns a new client.
 func NewClient(next rpcclient.Client, lc LightClient, opts ...Option) *Client {
 	c := &Client{
-		next: next,
-		lc:   lc,
-		prt:  merkle.DefaultProofRuntime(),
+		next:        next,
+		lc:          lc,
+		prt:         merkle.DefaultProofRuntime(),
+		voterParams: types.DefaultVoterParams(),
 	}
 	c.BaseService = *service.NewBaseService(nil, "Client", c)
 	for _, o := range opts {
@@ -218,6 +249,10 @@ func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
 
+func (c *Client) NetAddrBook(ctx context.Context) (*ctypes.ResultNetAddrBook, error) {
+	return c.next.NetAddrBook(ctx)
+}
+
 func (c *Client) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.next.DumpConsensusState(ctx)
 }
@@ -300,46 +335,40 @@ func (c *Client) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64)
 	return res, nil
 }
 
+// Genesis calls rpcclient#Genesis and then verifies the chain ID. The rest of
+// the genesis document isn't covered by the headers, so it is NOT verified:
+// don't trust it more than the next client.
 func (c *Client) Genesis(ctx context.Context) (*ctypes.ResultGenesis, error) {
-	return c.next.Genesis(ctx)
-}
-
-func (c *Client) GenesisChunked(ctx context.Context, id uint) (*ctypes.ResultGenesisChunk, error) {
-	return c.next.GenesisChunked(ctx, id)
-}
-
-// Block calls rpcclient#Block and then verifies the result.
-func (c *Client) Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error) {
-	res, err := c.next.Block(ctx, height)
+	res, err := c.next.Genesis(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Validate res.
-	if err := res.BlockID.ValidateBasic(); err != nil {
-		return nil, err
+	if res.Genesis == nil {
+		return nil, errors.New("nil genesis")
 	}
-	if err := res.Block.ValidateBasic(); err != nil {
-		return nil, err
-	}
-	if bmH, bH := res.BlockID.Hash, res.Block.Hash(); !bytes.Equal(bmH, bH) {
-		return nil, fmt.Errorf("blockID %X does not match with block %X",
-			bmH, bH)
+	if res.Genesis.ChainID != c.lc.ChainID() {
+		return nil, fmt.Errorf("genesis chain ID %s does not match with trusted chain ID %s",
+			res.Genesis.ChainID, c.lc.ChainID())
 	}
 
-	// Update the light client if we're behind.
-	l, err := c.updateLightClientIfNeededTo(ctx, &res.Block.Height)
+	return res, nil
+}
+
+// GenesisChunked always returns an error, since a chunk of the genesis
+// document can't be verified on its own. Use Genesis instead.
+func (c *Client) GenesisChunked(ctx context.Context, id uint) (*ctypes.ResultGenesisChunk, error) {
+	return nil, fmt.Errorf("genesis chunk %d %w", id, ErrUnverifiable)
+}
+
+// Block calls rpcclient#Block and then verifies the result.
+func (c *Client) Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	res, err := c.next.Block(ctx, height)
 	if err != nil {
 		return nil, err
 	}
-
-	// Verify block.
-	if bH, tH := res.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
-		return nil, fmt.Errorf("block header %X does not match with trusted header %X",
-			bH, tH)
-	}
-
-	return res, nil
+	return res, c.verifyBlock(ctx, res)
 }
 
 // BlockByHash calls rpcclient#BlockByHash and then verifies the result.
@@ -348,32 +377,46 @@ func (c *Client) BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBl
 	if err != nil {
 		return nil, err
 	}
+	if err := c.verifyBlock(ctx, res); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(res.BlockID.Hash, hash) {
+		return nil, fmt.Errorf("blockID %X does not match with requested hash %X",
+			res.BlockID.Hash, hash)
+	}
+	return res, nil
+}
 
+// verifyBlock verifies the block against the trusted header of its height.
+func (c *Client) verifyBlock(ctx context.Context, res *ctypes.ResultBlock) error {
 	// Validate res.
+	if res.Block == nil {
+		return errors.New("nil block")
+	}
 	if err := res.BlockID.ValidateBasic(); err != nil {
-		return nil, err
+		return err
 	}
 	if err := res.Block.ValidateBasic(); err != nil {
-		return nil, err
+		return err
 	}
 	if bmH, bH := res.BlockID.Hash, res.Block.Hash(); !bytes.Equal(bmH, bH) {
-		return nil, fmt.Errorf("blockID %X does not match with block %X",
+		return fmt.Errorf("blockID %X does not match with block %X",
 			bmH, bH)
 	}
 
 	// Update the light client if we're behind.
 	l, err := c.updateLightClientIfNeededTo(ctx, &res.Block.Height)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Verify block.
 	if bH, tH := res.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
-		return nil, fmt.Errorf("block header %X does not match with trusted header %X",
+		return fmt.Errorf("block header %X does not match with trusted header %X",
 			bH, tH)
 	}
 
-	return res, nil
+	return nil
 }
 
 // BlockResults returns the block results for the given height. If no height is
@@ -396,17 +439,26 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 	if err != nil {
 		return nil, err
 	}
+	if err := c.verifyBlockResults(ctx, h, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
 
+// verifyBlockResults verifies the block results of the given height against
+// the LastResultsHash of the trusted header of the next height. Note the events
+// of the txs aren't covered by the hash.
+func (c *Client) verifyBlockResults(ctx context.Context, height int64, res *ctypes.ResultBlockResults) error {
 	// Validate res.
 	if res.Height <= 0 {
-		return nil, errNegOrZeroHeight
+		return errNegOrZeroHeight
 	}
 
 	// Update the light client if we're behind.
-	nextHeight := h + 1
+	nextHeight := height + 1
 	trustedBlock, err := c.updateLightClientIfNeededTo(ctx, &nextHeight)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// proto-encode BeginBlock events
@@ -414,7 +466,7 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 		Events: res.BeginBlockEvents,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Build a Merkle tree of proto-encoded DeliverTx results and get a hash.
@@ -425,7 +477,7 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 		Events: res.EndBlockEvents,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Build a Merkle tree out of the above 3 binary slices.
@@ -433,11 +485,11 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 
 	// Verify block results.
 	if !bytes.Equal(rH, trustedBlock.LastResultsHash) {
-		return nil, fmt.Errorf("last results %X does not match with trusted last results %X",
+		return fmt.Errorf("last results %X does not match with trusted last results %X",
 			rH, trustedBlock.LastResultsHash)
 	}
 
-	return res, nil
+	return nil
 }
 
 func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
@@ -454,46 +506,131 @@ func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommi
 	}, nil
 }
 
-// Tx calls rpcclient#Tx method and then verifies the proof if such was
-// requested.
+// Tx calls rpcclient#Tx method, always requesting the proof, and then
+// verifies the proof of inclusion of the tx and its TxResult, see verifyTx.
 func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
-	res, err := c.next.Tx(ctx, hash, prove)
-	if err != nil || !prove {
-		return res, err
+	// always request the proof
+	res, err := c.next.Tx(ctx, hash, true)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(res.Hash, hash) {
+		return nil, fmt.Errorf("tx hash %X does not match with requested hash %X", res.Hash, hash)
+	}
+	return res, c.verifyTx(ctx, res, map[int64]*ctypes.ResultBlockResults{})
+}
+
+// TxSearch calls rpcclient#TxSearch, always requesting the proofs, and then
+// verifies the proof of inclusion of every tx found and its TxResult, see
+// verifyTx. Note the number of txs found isn't covered by the proofs.
+func (c *Client) TxSearch(
+	ctx context.Context,
+	query string,
+	prove bool,
+	page, perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	// always request the proofs
+	res, err := c.next.TxSearch(ctx, query, true, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
 	}
 
+	blockResults := make(map[int64]*ctypes.ResultBlockResults)
+	for i, tx := range res.Txs {
+		if tx == nil {
+			return nil, fmt.Errorf("nil tx %d", i)
+		}
+		if err := c.verifyTx(ctx, tx, blockResults); err != nil {
+			return nil, fmt.Errorf("tx %d: %w", i, err)
+		}
+	}
+
+	return res, nil
+}
+
+// verifyTx verifies the proof of inclusion of the tx against the DataHash of
+// the trusted header of its height, and its TxResult against the verified
+// results of the block, which are fetched unless found in blockResults. The
+// results are only covered by the LastResultsHash of the next header, so the
+// TxResult of a tx of the latest block can't be verified until the next one
+// is committed. Note the events of the TxResult aren't covered by the hash.
+func (c *Client) verifyTx(ctx context.Context, res *ctypes.ResultTx,
+	blockResults map[int64]*ctypes.ResultBlockResults) error {
 	// Validate res.
 	if res.Height <= 0 {
-		return nil, errNegOrZeroHeight
+		return errNegOrZeroHeight
+	}
+	if tH := res.Tx.Hash(); !bytes.Equal(tH, res.Hash) {
+		return fmt.Errorf("tx %X does not match with tx hash %X", tH, res.Hash)
+	}
+	if !bytes.Equal(res.Proof.Data, res.Tx) {
+		return errors.New("proof does not prove the tx")
+	}
+	if res.Proof.Proof.Index != int64(res.Index) {
+		return fmt.Errorf("proof index %d does not match with tx index %d",
+			res.Proof.Proof.Index, res.Index)
 	}
 
 	// Update the light client if we're behind.
 	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Validate the proof.
-	return res, res.Proof.Validate(l.DataHash)
-}
+	if err := res.Proof.Validate(l.DataHash); err != nil {
+		return err
+	}
 
-func (c *Client) TxSearch(
-	ctx context.Context,
-	query string,
-	prove bool,
-	page, perPage *int,
-	orderBy string,
-) (*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	// Verify the TxResult.
+	results, ok := blockResults[res.Height]
+	if !ok {
+		results, err = c.next.BlockResults(ctx, &res.Height)
+		if err != nil {
+			return fmt.Errorf("can't get the block results: %w", err)
+		}
+		if err := c.verifyBlockResults(ctx, res.Height, results); err != nil {
+			return err
+		}
+		blockResults[res.Height] = results
+	}
+	if int(res.Index) >= len(results.TxsResults) {
+		return fmt.Errorf("tx index %d out of the %d results of the block",
+			res.Index, len(results.TxsResults))
+	}
+	rH := types.NewResults([]*abci.ResponseDeliverTx{&res.TxResult}).Hash()
+	tH := types.NewResults(results.TxsResults[res.Index : res.Index+1]).Hash()
+	if !bytes.Equal(rH, tH) {
+		return fmt.Errorf("tx result %X does not match with trusted tx result %X", rH, tH)
+	}
+
+	return nil
 }
 
+// BlockSearch calls rpcclient#BlockSearch and then verifies every block found.
+// Note the number of blocks found isn't covered by the headers.
 func (c *Client) BlockSearch(
 	ctx context.Context,
 	query string,
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultBlockSearch, error) {
-	return c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	res, err := c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, block := range res.Blocks {
+		if block == nil {
+			return nil, fmt.Errorf("nil block %d", i)
+		}
+		if err := c.verifyBlock(ctx, block); err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+
+	return res, nil
 }
 
 // Validators fetches and verifies validators.
@@ -512,6 +649,9 @@ func (c *Client) Validators(
 	if err != nil {
 		return nil, err
 	}
+	if l.ValidatorSet == nil {
+		return nil, fmt.Errorf("light block %d has no validator set", l.Height)
+	}
 
 	totalCount := len(l.ValidatorSet.Validators)
 	perPage := validatePerPage(perPagePtr)
@@ -543,6 +683,9 @@ func (c *Client) Voters(
 	if err != nil {
 		return nil, err
 	}
+	if l.VoterSet == nil {
+		return nil, fmt.Errorf("light block %d has no voter set", l.Height)
+	}
 
 	totalCount := len(l.VoterSet.Voters)
 	perPage := validatePerPage(perPagePtr)
@@ -562,6 +705,8 @@ func (c *Client) Voters(
 		Total:       totalCount}, nil
 }
 
+// ValidatorsWithVoters fetches and verifies validators along with the voters
+// among them.
 func (c *Client) ValidatorsWithVoters(
 	ctx context.Context,
 	height *int64,
@@ -574,6 +719,9 @@ func (c *Client) ValidatorsWithVoters(
 	if err != nil {
 		return nil, err
 	}
+	if l.ValidatorSet == nil || l.VoterSet == nil {
+		return nil, fmt.Errorf("light block %d has no validator or voter set", l.Height)
+	}
 
 	totalCount := len(l.ValidatorSet.Validators)
 	perPage := validatePerPage(perPagePtr)
@@ -583,7 +731,9 @@ func (c *Client) ValidatorsWithVoters(
 	}
 
 	skipCount := validateSkipCount(page, perPage)
-	v := l.ValidatorSet.Validators[skipCount : skipCount+tmmath.MinInt(perPage, totalCount-skipCount)]
+	// copied, since the voters replace some of the validators below
+	v := append([]*types.Validator(nil),
+		l.ValidatorSet.Validators[skipCount:skipCount+tmmath.MinInt(perPage, totalCount-skipCount)]...)
 
 	// Retrieve to the indices where selected as Voters in Validators.
 	votersIndices := make([]int32, 0, len(v))
@@ -604,6 +754,47 @@ func (c *Client) ValidatorsWithVoters(
 	}, nil
 }
 
+// VoterElectionProof calls rpcclient#VoterElectionProof and then verifies the
+// election with the trusted headers of the height and the one before it, and
+// the voter params of the VoterParams option.
+func (c *Client) VoterElectionProof(ctx context.Context, height *int64) (*ctypes.ResultVoterElectionProof, error) {
+	res, err := c.next.VoterElectionProof(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if err := res.Proof.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if res.BlockHeight != res.Proof.Height() {
+		return nil, fmt.Errorf("proof of height %d does not elect the voters of height %d",
+			res.Proof.Height(), res.BlockHeight)
+	}
+
+	// Update the light client if we're behind and retrieve the light blocks
+	// of the height and the one before it.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	lp, err := c.updateLightClientIfNeededTo(ctx, &res.Proof.ProofHeader.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the election.
+	if pH, tH := res.Proof.ProofHeader.Hash(), lp.Hash(); !bytes.Equal(pH, tH) {
+		return nil, fmt.Errorf("proof header %X does not match with trusted header %X",
+			pH, tH)
+	}
+	if _, err := types.VerifyVoterElection(&res.Proof, c.voterParams, l.VotersHash); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.next.BroadcastEvidence(ctx, ev)
 }
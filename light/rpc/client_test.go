@@ -0,0 +1,163 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/merkle"
+	"github.com/line/ostracon/crypto/tmhash"
+	lcmock "github.com/line/ostracon/light/rpc/mocks"
+	tmversion "github.com/line/ostracon/proto/ostracon/version"
+	rpcmock "github.com/line/ostracon/rpc/client/mocks"
+	ctypes "github.com/line/ostracon/rpc/core/types"
+	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
+)
+
+func TestTxSearch(t *testing.T) {
+	const height = 2
+	txs := types.Txs{types.Tx("foo"), types.Tx("bar")}
+	txResults := []*abci.ResponseDeliverTx{{Data: []byte("foo")}, {Code: 1, GasUsed: 10}}
+	resultTx := func(i int) *ctypes.ResultTx {
+		return &ctypes.ResultTx{
+			Hash:     txs[i].Hash(),
+			Height:   height,
+			Index:    uint32(i),
+			Tx:       txs[i],
+			TxResult: *txResults[i],
+			Proof:    txs.Proof(i),
+		}
+	}
+	bbeBytes, err := proto.Marshal(&abci.ResponseBeginBlock{})
+	require.NoError(t, err)
+	ebeBytes, err := proto.Marshal(&abci.ResponseEndBlock{})
+	require.NoError(t, err)
+	resultsHash := merkle.HashFromByteSlices([][]byte{bbeBytes, types.NewResults(txResults).Hash(), ebeBytes})
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", context.Background(), int64(height), mock.AnythingOfType("time.Time")).Return(
+		&types.LightBlock{
+			SignedHeader: &types.SignedHeader{
+				Header: &types.Header{Height: height, DataHash: txs.Hash()},
+			},
+		},
+		nil,
+	)
+	lc.On("VerifyLightBlockAtHeight", context.Background(), int64(height+1), mock.AnythingOfType("time.Time")).Return(
+		&types.LightBlock{
+			SignedHeader: &types.SignedHeader{
+				Header: &types.Header{Height: height + 1, LastResultsHash: resultsHash},
+			},
+		},
+		nil,
+	)
+
+	next := &rpcmock.Client{}
+	c := NewClient(next, lc)
+	next.On("BlockResults", context.Background(), mock.MatchedBy(func(h *int64) bool { return *h == height })).Return(
+		&ctypes.ResultBlockResults{Height: height, TxsResults: txResults}, nil)
+
+	// the proofs are always requested
+	next.On("Tx", context.Background(), txs[0].Hash(), true).Return(resultTx(0), nil).Once()
+	res, err := c.Tx(context.Background(), txs[0].Hash(), false)
+	require.NoError(t, err)
+	assert.Equal(t, resultTx(0), res)
+
+	next.On("TxSearch", context.Background(), "tx.height=2", true, (*int)(nil), (*int)(nil), "").Return(
+		&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{resultTx(0), resultTx(1)}, TotalCount: 2}, nil).Once()
+	resSearch, err := c.TxSearch(context.Background(), "tx.height=2", false, nil, nil, "")
+	require.NoError(t, err)
+	assert.Len(t, resSearch.Txs, 2)
+
+	// a tx not proved by its proof
+	tampered := resultTx(1)
+	tampered.Tx = types.Tx("baz")
+	tampered.Hash = tampered.Tx.Hash()
+	next.On("Tx", context.Background(), []byte(tampered.Hash), true).Return(tampered, nil).Once()
+	_, err = c.Tx(context.Background(), tampered.Hash, true)
+	assert.Error(t, err)
+
+	// a tx of another block
+	otherTxs := types.Txs{types.Tx("foo"), types.Tx("baz")}
+	tampered = resultTx(0)
+	tampered.Proof = otherTxs.Proof(0)
+	next.On("TxSearch", context.Background(), "tx.height=2", true, (*int)(nil), (*int)(nil), "").Return(
+		&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{resultTx(1), tampered}, TotalCount: 2}, nil).Once()
+	_, err = c.TxSearch(context.Background(), "tx.height=2", true, nil, nil, "")
+	assert.Error(t, err)
+
+	// a tx other than the requested one
+	next.On("Tx", context.Background(), txs[0].Hash(), true).Return(resultTx(1), nil).Once()
+	_, err = c.Tx(context.Background(), txs[0].Hash(), true)
+	assert.Error(t, err)
+
+	// a tx result other than the one of the block
+	tampered = resultTx(1)
+	tampered.TxResult.Code = 0
+	next.On("Tx", context.Background(), []byte(tampered.Hash), true).Return(tampered, nil).Once()
+	_, err = c.Tx(context.Background(), tampered.Hash, true)
+	assert.Error(t, err)
+
+	next.AssertExpectations(t)
+}
+
+func TestBlockSearch(t *testing.T) {
+	block := types.MakeBlock(1, []types.Tx{types.Tx("foo")}, &types.Commit{}, nil,
+		tmversion.Consensus{Block: version.BlockProtocol, App: version.AppProtocol})
+	block.ChainID = "test"
+	block.ProposerAddress = crypto.AddressHash([]byte("proposer"))
+	block.ValidatorsHash = tmhash.Sum([]byte("validators"))
+	block.VotersHash = tmhash.Sum([]byte("voters"))
+	resultBlock := &ctypes.ResultBlock{
+		BlockID: types.BlockID{Hash: block.Hash(), PartSetHeader: block.MakePartSet(types.BlockPartSizeBytes).Header()},
+		Block:   block,
+	}
+	otherHeader := block.Header
+	otherHeader.ChainID = "other"
+
+	lc := &lcmock.LightClient{}
+	next := &rpcmock.Client{}
+	c := NewClient(next, lc)
+
+	next.On("BlockSearch", context.Background(), "block.height=1", (*int)(nil), (*int)(nil), "").Return(
+		&ctypes.ResultBlockSearch{Blocks: []*ctypes.ResultBlock{resultBlock}, TotalCount: 1}, nil)
+
+	lc.On("VerifyLightBlockAtHeight", context.Background(), int64(1), mock.AnythingOfType("time.Time")).Return(
+		&types.LightBlock{SignedHeader: &types.SignedHeader{Header: &block.Header}}, nil).Once()
+	res, err := c.BlockSearch(context.Background(), "block.height=1", nil, nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, resultBlock, res.Blocks[0])
+
+	// a block of another chain
+	lc.On("VerifyLightBlockAtHeight", context.Background(), int64(1), mock.AnythingOfType("time.Time")).Return(
+		&types.LightBlock{SignedHeader: &types.SignedHeader{Header: &otherHeader}}, nil).Once()
+	_, err = c.BlockSearch(context.Background(), "block.height=1", nil, nil, "")
+	assert.Error(t, err)
+}
+
+func TestGenesis(t *testing.T) {
+	lc := &lcmock.LightClient{}
+	lc.On("ChainID").Return("test")
+	next := &rpcmock.Client{}
+	c := NewClient(next, lc)
+
+	next.On("Genesis", context.Background()).Return(
+		&ctypes.ResultGenesis{Genesis: &types.GenesisDoc{ChainID: "test"}}, nil).Once()
+	_, err := c.Genesis(context.Background())
+	assert.NoError(t, err)
+
+	next.On("Genesis", context.Background()).Return(
+		&ctypes.ResultGenesis{Genesis: &types.GenesisDoc{ChainID: "other"}}, nil).Once()
+	_, err = c.Genesis(context.Background())
+	assert.Error(t, err)
+
+	_, err = c.GenesisChunked(context.Background(), 0)
+	assert.ErrorIs(t, err, ErrUnverifiable)
+}
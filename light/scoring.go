@@ -0,0 +1,237 @@
+package light
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/light/provider"
+	"github.com/line/ostracon/types"
+)
+
+const (
+	// weight of the latest latency in the moving average of the latencies
+	latencyAlpha = 0.2
+
+	// minimum number of requests to the primary and a witness before the
+	// witness may replace the primary, see PrimaryRotation
+	minRequestsToRotate = 10
+)
+
+// ProviderScore holds the statistics the light client keeps about a provider
+// to rank it among the others.
+type ProviderScore struct {
+	Provider provider.Provider
+
+	// Number of light block requests to the provider, and of the ones which
+	// failed (no response, invalid light block, ...). The requests for a light
+	// block the provider doesn't have yet aren't counted.
+	Requests uint64
+	Failures uint64
+	// Number of times the provider returned a light block conflicting with the
+	// one of the primary.
+	Divergences uint64
+	// Exponentially weighted moving average of the latency of the successful
+	// requests.
+	Latency time.Duration
+	// Whether the provider has been removed for misbehaving. A removed
+	// provider is never used as a witness again.
+	Removed bool
+}
+
+// Score returns the score of the provider, between 0 and 1, the higher the
+// better. It's the success rate of the requests, divided by one plus the
+// number of divergences and by one plus the latency in seconds.
+func (s ProviderScore) Score() float64 {
+	// an unknown provider succeeds half of the time
+	successRate := float64(s.Requests-s.Failures+1) / float64(s.Requests+2)
+	return successRate / float64(1+s.Divergences) / (1 + s.Latency.Seconds())
+}
+
+// providerScores keeps the scores of the providers.
+type providerScores struct {
+	mtx    tmsync.Mutex
+	scores map[provider.Provider]*ProviderScore
+}
+
+func newProviderScores() *providerScores {
+	return &providerScores{scores: make(map[provider.Provider]*ProviderScore)}
+}
+
+// get returns the score of the provider, which is empty if unknown.
+func (ps *providerScores) get(p provider.Provider) ProviderScore {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	return *ps.score(p)
+}
+
+// list returns the scores of the given providers and of every provider which
+// had a score, from the best to the worst one.
+func (ps *providerScores) list(providers ...provider.Provider) []ProviderScore {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	for _, p := range providers {
+		ps.score(p)
+	}
+	scores := make([]ProviderScore, 0, len(ps.scores))
+	for _, s := range ps.scores {
+		scores = append(scores, *s)
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Removed != scores[j].Removed {
+			return !scores[i].Removed
+		}
+		return scores[i].Score() > scores[j].Score()
+	})
+	return scores
+}
+
+// recordRequest records a light block request to the provider, which took the
+// given latency and returned the given error.
+func (ps *providerScores) recordRequest(p provider.Provider, latency time.Duration, err error) {
+	switch {
+	case err == nil:
+	case errors.Is(err, provider.ErrLightBlockNotFound), errors.Is(err, provider.ErrHeightTooHigh),
+		errors.Is(err, context.Canceled):
+		// not the fault of the provider
+		return
+	}
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	s := ps.score(p)
+	s.Requests++
+	if err != nil {
+		s.Failures++
+		return
+	}
+	if s.Latency == 0 {
+		s.Latency = latency
+	} else {
+		s.Latency = time.Duration(latencyAlpha*float64(latency) + (1-latencyAlpha)*float64(s.Latency))
+	}
+}
+
+// recordDivergence records the provider returned a conflicting light block.
+func (ps *providerScores) recordDivergence(p provider.Provider) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	ps.score(p).Divergences++
+}
+
+// recordRemoval records the provider was removed for misbehaving.
+func (ps *providerScores) recordRemoval(p provider.Provider) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	ps.score(p).Removed = true
+}
+
+// NOTE: requires a lock
+func (ps *providerScores) score(p provider.Provider) *ProviderScore {
+	s, ok := ps.scores[p]
+	if !ok {
+		s = &ProviderScore{Provider: p}
+		ps.scores[p] = s
+	}
+	return s
+}
+
+// ProviderScores returns the scores of the primary, the witnesses, the
+// providers of the witness pool and the providers removed for misbehaving,
+// from the best to the worst one (the removed ones last).
+func (c *Client) ProviderScores() []ProviderScore {
+	c.providerMutex.Lock()
+	defer c.providerMutex.Unlock()
+
+	providers := append([]provider.Provider{c.primary}, c.witnesses...)
+	providers = append(providers, c.witnessPool...)
+	return c.scores.list(providers...)
+}
+
+// lightBlockFrom requests the light block of the given height from the
+// provider, recording the request in the score of the provider.
+func (c *Client) lightBlockFrom(ctx context.Context, p provider.Provider, height int64) (*types.LightBlock, error) {
+	start := time.Now()
+	l, err := p.LightBlock(ctx, height)
+	c.scores.recordRequest(p, time.Since(start), err)
+	return l, err
+}
+
+// witnessCandidates returns the providers of the witness pool which may
+// become witnesses, from the best to the worst one.
+//
+// NOTE: requires a providerMutex lock
+func (c *Client) witnessCandidates() []provider.Provider {
+	inUse := make(map[provider.Provider]bool, len(c.witnesses)+1)
+	inUse[c.primary] = true
+	for _, w := range c.witnesses {
+		inUse[w] = true
+	}
+
+	candidates := make([]provider.Provider, 0, len(c.witnessPool))
+	scores := make(map[provider.Provider]float64, len(c.witnessPool))
+	for _, p := range c.witnessPool {
+		s := c.scores.get(p)
+		if inUse[p] || s.Removed {
+			continue
+		}
+		inUse[p] = true
+		candidates = append(candidates, p)
+		scores[p] = s.Score()
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i]] > scores[candidates[j]]
+	})
+	return candidates
+}
+
+// refillWitnesses adds the best providers of the witness pool to the
+// witnesses, until there are as many witnesses as the client started with.
+//
+// NOTE: requires a providerMutex lock
+func (c *Client) refillWitnesses() {
+	if len(c.witnesses) >= c.numWitnesses {
+		return
+	}
+	for _, p := range c.witnessCandidates() {
+		c.logger.Info("adding witness from the pool", "witness", p)
+		c.witnesses = append(c.witnesses, p)
+		if len(c.witnesses) >= c.numWitnesses {
+			return
+		}
+	}
+}
+
+// rotatePrimary replaces the primary with the witness of the best score, if
+// any witness scores higher than the primary by the margin set by the
+// PrimaryRotation option.
+//
+// NOTE: requires a providerMutex lock
+func (c *Client) rotatePrimary() {
+	if c.primaryRotationMargin <= 0 {
+		return
+	}
+	primaryScore := c.scores.get(c.primary)
+	if primaryScore.Requests < minRequestsToRotate {
+		return
+	}
+
+	best, bestScore := -1, primaryScore.Score()+c.primaryRotationMargin
+	for i, w := range c.witnesses {
+		s := c.scores.get(w)
+		if s.Requests >= minRequestsToRotate && s.Score() > bestScore {
+			best, bestScore = i, s.Score()
+		}
+	}
+	if best == -1 {
+		return
+	}
+
+	c.logger.Info("replacing primary with a better scoring witness", "primary", c.primary,
+		"primaryScore", primaryScore.Score(), "witness", c.witnesses[best], "witnessScore", bestScore)
+	c.primary, c.witnesses[best] = c.witnesses[best], c.primary
+}
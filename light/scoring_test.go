@@ -0,0 +1,145 @@
+package light_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/ostracon/libs/log"
+	"github.com/line/ostracon/light"
+	"github.com/line/ostracon/light/provider"
+	mockp "github.com/line/ostracon/light/provider/mock"
+	dbs "github.com/line/ostracon/light/store/db"
+	"github.com/line/ostracon/types"
+)
+
+// slowProvider delays every light block request.
+type slowProvider struct {
+	provider.Provider
+	delay time.Duration
+}
+
+func (p *slowProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	time.Sleep(p.delay)
+	return p.Provider.LightBlock(ctx, height)
+}
+
+func TestClient_ProviderScores(t *testing.T) {
+	witness := mockp.New(chainID, headerSet, valSet, voterSet)
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		fullNode,
+		[]provider.Provider{witness, deadNode},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+
+	_, err = c.VerifyLightBlockAtHeight(ctx, 3, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+
+	scores := c.ProviderScores()
+	require.Len(t, scores, 3)
+	byProvider := make(map[provider.Provider]light.ProviderScore, len(scores))
+	for _, s := range scores {
+		byProvider[s.Provider] = s
+	}
+	assert.NotZero(t, byProvider[fullNode].Requests)
+	assert.Zero(t, byProvider[fullNode].Failures)
+	assert.NotZero(t, byProvider[witness].Requests)
+	assert.Zero(t, byProvider[witness].Failures)
+	assert.Equal(t, byProvider[deadNode].Requests, byProvider[deadNode].Failures)
+	assert.NotZero(t, byProvider[deadNode].Failures)
+	// the dead node scores the worst
+	assert.Equal(t, deadNode, scores[2].Provider)
+	assert.Less(t, scores[2].Score(), scores[1].Score())
+}
+
+func TestClient_WitnessPool(t *testing.T) {
+	// a witness with a different header at height 2 (no fork)
+	h2 := keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+		hash("app_hash2"), hash("cons_hash"), hash("results_hash"),
+		len(keys), len(keys), types.BlockID{Hash: h1.Hash()}, voterParam)
+	badWitness := mockp.New(
+		chainID,
+		map[int64]*types.SignedHeader{1: h1, 2: h2},
+		map[int64]*types.ValidatorSet{1: vals, 2: vals},
+		map[int64]*types.VoterSet{1: voterSet[1], 2: types.SelectVoter(vals, proofHash(h2), voterParam)},
+	)
+	witness := mockp.New(chainID, headerSet, valSet, voterSet)
+	poolProvider := mockp.New(chainID, headerSet, valSet, voterSet)
+
+	_, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		fullNode,
+		[]provider.Provider{witness},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.WitnessPool([]provider.Provider{mockp.New("other", headerSet, valSet, voterSet)}),
+	)
+	assert.Error(t, err)
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		fullNode,
+		[]provider.Provider{badWitness, witness},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.WitnessPool([]provider.Provider{witness, poolProvider, badWitness}),
+	)
+	require.NoError(t, err)
+
+	// the bad witness is replaced with the provider of the pool
+	_, err = c.VerifyLightBlockAtHeight(ctx, 2, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []provider.Provider{witness, poolProvider}, c.Witnesses())
+
+	scores := c.ProviderScores()
+	require.Len(t, scores, 4)
+	last := scores[len(scores)-1]
+	assert.Equal(t, badWitness, last.Provider)
+	assert.True(t, last.Removed)
+	assert.EqualValues(t, 1, last.Divergences)
+}
+
+func TestClient_PrimaryRotation(t *testing.T) {
+	const numBlocks = 15
+	_, headers, vals, voters := genMockNode(chainID, numBlocks, 3, 0, bTime)
+	primary := &slowProvider{Provider: mockp.New(chainID, headers, vals, voters), delay: 20 * time.Millisecond}
+	witness := mockp.New(chainID, headers, vals, voters)
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		light.TrustOptions{
+			Period: 4 * time.Hour,
+			Height: 1,
+			Hash:   headers[1].Hash(),
+		},
+		primary,
+		[]provider.Provider{witness},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.PrimaryRotation(0.01),
+	)
+	require.NoError(t, err)
+
+	for height := int64(2); height <= numBlocks; height++ {
+		_, err = c.VerifyLightBlockAtHeight(ctx, height, bTime.Add(2*time.Hour))
+		require.NoError(t, err)
+	}
+
+	// the faster witness replaced the primary
+	assert.Equal(t, witness, c.Primary())
+	assert.Equal(t, []provider.Provider{primary}, c.Witnesses())
+}
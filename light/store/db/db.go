@@ -202,19 +202,26 @@ func (s *dbs) LightBlockBefore(height int64) (*types.LightBlock, error) {
 	}
 	defer itr.Close()
 
+	existingHeight := int64(-1)
 	for itr.Valid() {
 		key := itr.Key()
-		_, existingHeight, ok := parseLbKey(key)
+		_, h, ok := parseLbKey(key)
 		if ok {
-			return s.LightBlock(existingHeight)
+			existingHeight = h
+			break
 		}
 		itr.Next()
 	}
 	if err = itr.Error(); err != nil {
 		return nil, err
 	}
+	// The iterator may hold a lock of the db until it's closed.
+	itr.Close()
 
-	return nil, store.ErrLightBlockNotFound
+	if existingHeight == -1 {
+		return nil, store.ErrLightBlockNotFound
+	}
+	return s.LightBlock(existingHeight)
 }
 
 // Prune prunes header & validator set pairs until there are only size pairs
@@ -222,15 +229,16 @@ func (s *dbs) LightBlockBefore(height int64) (*types.LightBlock, error) {
 //
 // Safe for concurrent use by multiple goroutines.
 func (s *dbs) Prune(size uint16) error {
-	// 1) Check how many we need to prune.
-	s.mtx.RLock()
-	sSize := s.size
-	s.mtx.RUnlock()
+	// The lock is held throughout, so that concurrent prunes don't count the
+	// same headers twice.
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
 
-	if sSize <= size { // nothing to prune
+	// 1) Check how many we need to prune.
+	if s.size <= size { // nothing to prune
 		return nil
 	}
-	numToPrune := sSize - size
+	numToPrune := s.size - size
 
 	// 2) Iterate over headers and perform a batch operation.
 	itr, err := s.db.Iterator(
@@ -261,6 +269,8 @@ func (s *dbs) Prune(size uint16) error {
 	if err = itr.Error(); err != nil {
 		return err
 	}
+	// The iterator may hold a lock of the db until it's closed.
+	itr.Close()
 
 	err = b.WriteSync()
 	if err != nil {
@@ -268,9 +278,6 @@ func (s *dbs) Prune(size uint16) error {
 	}
 
 	// 3) Update size.
-	s.mtx.Lock()
-	defer s.mtx.Unlock()
-
 	s.size -= uint16(pruned)
 
 	if wErr := s.db.SetSync(sizeKey, marshalSize(s.size)); wErr != nil {
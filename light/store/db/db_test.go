@@ -13,6 +13,8 @@ import (
 	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/crypto/tmhash"
 	tmrand "github.com/line/ostracon/libs/rand"
+	"github.com/line/ostracon/light/store"
+	"github.com/line/ostracon/light/store/storetest"
 	tmversion "github.com/line/ostracon/proto/ostracon/version"
 	"github.com/line/ostracon/types"
 	"github.com/line/ostracon/version"
@@ -195,3 +197,9 @@ func randLightBlock(height int64) *types.LightBlock {
 		VoterSet: voters,
 	}
 }
+
+func TestStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.Store {
+		return New(dbm.NewMemDB(), "TestStore")
+	})
+}
@@ -0,0 +1,312 @@
+package file
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/line/ostracon/light/store"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/types"
+)
+
+var (
+	lightBlocksBucket = []byte("lb")
+	metaBucket        = []byte("meta")
+	sizeKey           = []byte("size")
+)
+
+// defaultOpenTimeout is how long opening the file waits by default for the
+// file lock held by another process.
+const defaultOpenTimeout = 5 * time.Second
+
+// Store is a store.Store that persists light blocks in a single file, using
+// bbolt. Every write is committed to the file before it returns.
+//
+// bbolt locks the whole file while it is open: a process that opens it for
+// writing holds an exclusive lock, and processes that open it read-only hold
+// a shared one. So the file can be open for writing by a single process, or
+// read-only by any number of processes, but not both at the same time; a
+// reader can't open the file while the writer has it open, and vice versa.
+// Opening the file waits for the lock until the open timeout expires, see
+// OpenTimeout. To read the light blocks while they are written, share the
+// writer's Store within its process instead.
+type Store struct {
+	db *bolt.DB
+}
+
+var _ store.Store = (*Store)(nil)
+
+// Option sets an optional parameter of the Store.
+type Option func(*bolt.Options)
+
+// OpenTimeout sets how long opening the file waits for the file lock held by
+// another process, before failing. Zero waits indefinitely. Default: 5s.
+func OpenTimeout(timeout time.Duration) Option {
+	return func(opts *bolt.Options) {
+		opts.Timeout = timeout
+	}
+}
+
+func open(path string, readOnly bool, options []Option) (*bolt.DB, error) {
+	opts := &bolt.Options{Timeout: defaultOpenTimeout, ReadOnly: readOnly}
+	for _, option := range options {
+		option(opts)
+	}
+	db, err := bolt.Open(path, 0600, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	return db, nil
+}
+
+// New opens the file at the given path for reading and writing, creating it
+// if it doesn't exist. It fails if another process, reader or writer, still
+// has the file open once the open timeout expires.
+func New(path string, options ...Option) (*Store, error) {
+	db, err := open(path, false, options)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(lightBlocksBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(metaBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize %s: %w", path, err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// NewReadOnly opens the existing file at the given path for reading only.
+// Any number of processes may open the same file read-only at the same time,
+// but not while a process has it open for writing: it fails if the writer
+// still has the file open once the open timeout expires. Any write to the
+// returned store fails.
+func NewReadOnly(path string, options ...Option) (*Store, error) {
+	db, err := open(path, true, options)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the file.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// SaveLightBlock persists LightBlock to the file.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) SaveLightBlock(lb *types.LightBlock) error {
+	if lb.Height <= 0 {
+		panic("negative or zero height")
+	}
+
+	lbpb, err := lb.ToProto()
+	if err != nil {
+		return fmt.Errorf("unable to convert light block to protobuf: %w", err)
+	}
+
+	lbBz, err := lbpb.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshaling LightBlock: %w", err)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(lightBlocksBucket)
+		key := lbKey(lb.Height)
+		size := readSize(tx)
+		if b.Get(key) == nil {
+			size++
+		}
+		if err := b.Put(key, lbBz); err != nil {
+			return err
+		}
+		return writeSize(tx, size)
+	})
+}
+
+// DeleteLightBlock deletes the LightBlock from the file.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) DeleteLightBlock(height int64) error {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(lightBlocksBucket)
+		key := lbKey(height)
+		if b.Get(key) == nil {
+			return nil
+		}
+		if err := b.Delete(key); err != nil {
+			return err
+		}
+		return writeSize(tx, readSize(tx)-1)
+	})
+}
+
+// LightBlock retrieves the LightBlock at the given height.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) LightBlock(height int64) (*types.LightBlock, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	var lightBlock *types.LightBlock
+	err := s.db.View(func(tx *bolt.Tx) (err error) {
+		lightBlock, err = unmarshalLightBlock(tx.Bucket(lightBlocksBucket).Get(lbKey(height)))
+		return err
+	})
+	return lightBlock, err
+}
+
+// LastLightBlockHeight returns the last LightBlock height stored.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) LastLightBlockHeight() (int64, error) {
+	height := int64(-1)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if k, _ := tx.Bucket(lightBlocksBucket).Cursor().Last(); k != nil {
+			height = parseLbKey(k)
+		}
+		return nil
+	})
+	return height, err
+}
+
+// FirstLightBlockHeight returns the first LightBlock height stored.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) FirstLightBlockHeight() (int64, error) {
+	height := int64(-1)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if k, _ := tx.Bucket(lightBlocksBucket).Cursor().First(); k != nil {
+			height = parseLbKey(k)
+		}
+		return nil
+	})
+	return height, err
+}
+
+// LightBlockBefore returns the closest LightBlock before the given height. It
+// returns ErrLightBlockNotFound if no such block exists.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) LightBlockBefore(height int64) (*types.LightBlock, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	var lightBlock *types.LightBlock
+	err := s.db.View(func(tx *bolt.Tx) (err error) {
+		c := tx.Bucket(lightBlocksBucket).Cursor()
+		// Seek moves to the first key not less than the given height, or to
+		// nothing if there isn't any.
+		k, _ := c.Seek(lbKey(height))
+		var v []byte
+		if k == nil {
+			_, v = c.Last()
+		} else {
+			_, v = c.Prev()
+		}
+		lightBlock, err = unmarshalLightBlock(v)
+		return err
+	})
+	return lightBlock, err
+}
+
+// Prune removes the oldest light blocks until there are only size light
+// blocks left.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) Prune(size uint16) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		sSize := readSize(tx)
+		if sSize <= size { // nothing to prune
+			return nil
+		}
+		numToPrune := int(sSize - size)
+
+		// Collect the keys first, since deleting moves the cursor.
+		keys := make([][]byte, 0, numToPrune)
+		c := tx.Bucket(lightBlocksBucket).Cursor()
+		for k, _ := c.First(); k != nil && len(keys) < numToPrune; k, _ = c.Next() {
+			keys = append(keys, append([]byte(nil), k...))
+		}
+		for _, k := range keys {
+			if err := tx.Bucket(lightBlocksBucket).Delete(k); err != nil {
+				return err
+			}
+		}
+		return writeSize(tx, sSize-uint16(len(keys)))
+	})
+}
+
+// Size returns the number of light blocks.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) Size() uint16 {
+	size := uint16(0)
+	_ = s.db.View(func(tx *bolt.Tx) error {
+		size = readSize(tx)
+		return nil
+	})
+	return size
+}
+
+// lbKey returns the key of the light block at the given height, whose
+// big-endian encoding keeps the keys ordered by height.
+func lbKey(height int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(height))
+	return key
+}
+
+func parseLbKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key))
+}
+
+func readSize(tx *bolt.Tx) uint16 {
+	bz := tx.Bucket(metaBucket).Get(sizeKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(bz)
+}
+
+func writeSize(tx *bolt.Tx, size uint16) error {
+	bz := make([]byte, 2)
+	binary.LittleEndian.PutUint16(bz, size)
+	return tx.Bucket(metaBucket).Put(sizeKey, bz)
+}
+
+func unmarshalLightBlock(bz []byte) (*types.LightBlock, error) {
+	if len(bz) == 0 {
+		return nil, store.ErrLightBlockNotFound
+	}
+
+	var lbpb tmproto.LightBlock
+	if err := lbpb.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("unmarshal error: %w", err)
+	}
+
+	lightBlock, err := types.LightBlockFromProto(&lbpb)
+	if err != nil {
+		return nil, fmt.Errorf("proto conversion error: %w", err)
+	}
+
+	return lightBlock, nil
+}
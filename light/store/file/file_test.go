@@ -0,0 +1,69 @@
+package file
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/light/store"
+	"github.com/line/ostracon/light/store/storetest"
+)
+
+func TestStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.Store {
+		fileStore, err := New(filepath.Join(t.TempDir(), "light.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { fileStore.Close() })
+		return fileStore
+	})
+}
+
+func TestReadOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "light.db")
+
+	_, err := NewReadOnly(path)
+	require.Error(t, err)
+
+	fileStore, err := New(path)
+	require.NoError(t, err)
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, fileStore.SaveLightBlock(storetest.RandLightBlock(i)))
+	}
+	require.NoError(t, fileStore.Close())
+
+	// reopening keeps the light blocks
+	fileStore, err = New(path)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, fileStore.Size())
+
+	// the writer locks out the readers
+	_, err = NewReadOnly(path, OpenTimeout(100*time.Millisecond))
+	require.Error(t, err)
+	require.NoError(t, fileStore.Close())
+
+	// the file can be shared read-only
+	readers := make([]*Store, 2)
+	for i := range readers {
+		readers[i], err = NewReadOnly(path)
+		require.NoError(t, err)
+		defer readers[i].Close()
+	}
+	for _, reader := range readers {
+		assert.EqualValues(t, 3, reader.Size())
+		lb, err := reader.LightBlockBefore(3)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, lb.Height)
+
+		assert.Error(t, reader.SaveLightBlock(storetest.RandLightBlock(4)))
+		assert.Error(t, reader.DeleteLightBlock(1))
+		assert.Error(t, reader.Prune(0))
+		assert.EqualValues(t, 3, reader.Size())
+	}
+
+	// and the readers lock out the writer
+	_, err = New(path, OpenTimeout(100*time.Millisecond))
+	require.Error(t, err)
+}
@@ -0,0 +1,213 @@
+package memory
+
+import (
+	"container/list"
+	"sort"
+
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/light/store"
+	"github.com/line/ostracon/types"
+)
+
+type entry struct {
+	lb *types.LightBlock
+	// position in the LRU list
+	elem *list.Element
+}
+
+type memStore struct {
+	maxSize uint16
+
+	mtx tmsync.Mutex
+	// light blocks by height
+	entries map[int64]*entry
+	// sorted heights of the light blocks
+	heights []int64
+	// heights from the least to the most recently used
+	lru *list.List
+}
+
+// New returns a Store that keeps light blocks in memory. It holds at most
+// maxSize light blocks; once full, saving a light block evicts the least
+// recently saved or retrieved one.
+//
+// The light blocks are kept as they are, so they must not be modified after
+// they are saved or retrieved.
+func New(maxSize uint16) store.Store {
+	if maxSize == 0 {
+		panic("zero max size")
+	}
+	return &memStore{
+		maxSize: maxSize,
+		entries: make(map[int64]*entry, maxSize),
+		heights: make([]int64, 0, maxSize),
+		lru:     list.New(),
+	}
+}
+
+// SaveLightBlock keeps the LightBlock, evicting the least recently used one
+// if the store is full.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *memStore) SaveLightBlock(lb *types.LightBlock) error {
+	if lb.Height <= 0 {
+		panic("negative or zero height")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if e, ok := s.entries[lb.Height]; ok {
+		e.lb = lb
+		s.lru.MoveToBack(e.elem)
+		return nil
+	}
+
+	if len(s.entries) >= int(s.maxSize) {
+		s.remove(s.lru.Front().Value.(int64))
+	}
+	s.entries[lb.Height] = &entry{lb: lb, elem: s.lru.PushBack(lb.Height)}
+	i := s.search(lb.Height)
+	s.heights = append(s.heights, 0)
+	copy(s.heights[i+1:], s.heights[i:])
+	s.heights[i] = lb.Height
+
+	return nil
+}
+
+// DeleteLightBlock deletes the LightBlock from the store.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *memStore) DeleteLightBlock(height int64) error {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.remove(height)
+
+	return nil
+}
+
+// LightBlock retrieves the LightBlock at the given height.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *memStore) LightBlock(height int64) (*types.LightBlock, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.get(height)
+}
+
+// LastLightBlockHeight returns the last LightBlock height stored.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *memStore) LastLightBlockHeight() (int64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.heights) == 0 {
+		return -1, nil
+	}
+	return s.heights[len(s.heights)-1], nil
+}
+
+// FirstLightBlockHeight returns the first LightBlock height stored.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *memStore) FirstLightBlockHeight() (int64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.heights) == 0 {
+		return -1, nil
+	}
+	return s.heights[0], nil
+}
+
+// LightBlockBefore returns the closest LightBlock before the given height. It
+// returns ErrLightBlockNotFound if no such block exists.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *memStore) LightBlockBefore(height int64) (*types.LightBlock, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	i := s.search(height)
+	if i == 0 {
+		return nil, store.ErrLightBlockNotFound
+	}
+	return s.get(s.heights[i-1])
+}
+
+// Prune removes the oldest light blocks until there are only size light
+// blocks left.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *memStore) Prune(size uint16) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.heights) <= int(size) { // nothing to prune
+		return nil
+	}
+	numToPrune := len(s.heights) - int(size)
+
+	for _, height := range s.heights[:numToPrune] {
+		e := s.entries[height]
+		s.lru.Remove(e.elem)
+		delete(s.entries, height)
+	}
+	s.heights = append(s.heights[:0], s.heights[numToPrune:]...)
+
+	return nil
+}
+
+// Size returns the number of light blocks.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *memStore) Size() uint16 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return uint16(len(s.heights))
+}
+
+// get returns the light block at the given height, marking it as the most
+// recently used. The caller must hold the lock.
+func (s *memStore) get(height int64) (*types.LightBlock, error) {
+	e, ok := s.entries[height]
+	if !ok {
+		return nil, store.ErrLightBlockNotFound
+	}
+	s.lru.MoveToBack(e.elem)
+	return e.lb, nil
+}
+
+// remove removes the light block at the given height, if any. The caller must
+// hold the lock.
+func (s *memStore) remove(height int64) {
+	e, ok := s.entries[height]
+	if !ok {
+		return
+	}
+	s.lru.Remove(e.elem)
+	delete(s.entries, height)
+	i := s.search(height)
+	s.heights = append(s.heights[:i], s.heights[i+1:]...)
+}
+
+// search returns the index of the first height not less than the given one.
+// The caller must hold the lock.
+func (s *memStore) search(height int64) int {
+	return sort.Search(len(s.heights), func(i int) bool { return s.heights[i] >= height })
+}
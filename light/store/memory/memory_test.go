@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/light/store"
+	"github.com/line/ostracon/light/store/storetest"
+)
+
+func TestStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.Store {
+		return New(100)
+	})
+}
+
+func TestLRUEviction(t *testing.T) {
+	assert.Panics(t, func() { New(0) })
+
+	memStore := New(3)
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, memStore.SaveLightBlock(storetest.RandLightBlock(i)))
+	}
+
+	// 1 is used, so 2 is the least recently used
+	_, err := memStore.LightBlock(1)
+	require.NoError(t, err)
+	require.NoError(t, memStore.SaveLightBlock(storetest.RandLightBlock(4)))
+	assert.EqualValues(t, 3, memStore.Size())
+	_, err = memStore.LightBlock(2)
+	assert.ErrorIs(t, err, store.ErrLightBlockNotFound)
+
+	// saving an existing height doesn't evict any
+	require.NoError(t, memStore.SaveLightBlock(storetest.RandLightBlock(3)))
+	assert.EqualValues(t, 3, memStore.Size())
+
+	// 1 is used again by LightBlockBefore, so 4 is the least recently used
+	lb, err := memStore.LightBlockBefore(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, lb.Height)
+	require.NoError(t, memStore.SaveLightBlock(storetest.RandLightBlock(5)))
+	_, err = memStore.LightBlock(4)
+	assert.ErrorIs(t, err, store.ErrLightBlockNotFound)
+
+	height, err := memStore.FirstLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, height)
+	height, err = memStore.LastLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, height)
+}
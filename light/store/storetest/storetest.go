@@ -0,0 +1,242 @@
+// Package storetest provides a conformance test suite every implementation of
+// the light client store.Store must pass.
+package storetest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/tmhash"
+	tmrand "github.com/line/ostracon/libs/rand"
+	"github.com/line/ostracon/light/store"
+	tmversion "github.com/line/ostracon/proto/ostracon/version"
+	"github.com/line/ostracon/types"
+	"github.com/line/ostracon/version"
+)
+
+// Run runs the conformance test suite against stores returned by newStore,
+// which must return a new empty store each time it's called. The stores must
+// be able to hold at least 100 light blocks.
+func Run(t *testing.T, newStore func(t *testing.T) store.Store) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, s store.Store)
+	}{
+		{"FirstLastLightBlockHeight", testFirstLastLightBlockHeight},
+		{"SaveLightBlock", testSaveLightBlock},
+		{"LightBlockBefore", testLightBlockBefore},
+		{"Prune", testPrune},
+		{"Concurrency", testConcurrency},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.test(t, newStore(t))
+		})
+	}
+}
+
+func testFirstLastLightBlockHeight(t *testing.T, s store.Store) {
+	// Empty store
+	height, err := s.LastLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, -1, height)
+
+	height, err = s.FirstLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, -1, height)
+
+	// Heights are ordered, whatever the order they were saved in
+	for _, h := range []int64{5, 1, 9, 3} {
+		require.NoError(t, s.SaveLightBlock(RandLightBlock(h)))
+	}
+
+	height, err = s.LastLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 9, height)
+
+	height, err = s.FirstLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, height)
+
+	require.NoError(t, s.DeleteLightBlock(1))
+	require.NoError(t, s.DeleteLightBlock(9))
+
+	height, err = s.LastLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, height)
+
+	height, err = s.FirstLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, height)
+}
+
+func testSaveLightBlock(t *testing.T, s store.Store) {
+	assert.Panics(t, func() { _ = s.SaveLightBlock(RandLightBlock(0)) })
+	assert.Panics(t, func() { _, _ = s.LightBlock(0) })
+	assert.Panics(t, func() { _ = s.DeleteLightBlock(0) })
+
+	// Empty store
+	lb, err := s.LightBlock(1)
+	assert.ErrorIs(t, err, store.ErrLightBlockNotFound)
+	assert.Nil(t, lb)
+
+	// 1 key
+	saved := RandLightBlock(1)
+	require.NoError(t, s.SaveLightBlock(saved))
+	assert.EqualValues(t, 1, s.Size())
+
+	lb, err = s.LightBlock(1)
+	require.NoError(t, err)
+	if assert.NotNil(t, lb) {
+		assert.Equal(t, saved.Hash(), lb.Hash())
+		assert.Equal(t, saved.VoterSet.Hash(), lb.VoterSet.Hash())
+	}
+
+	// Empty store
+	require.NoError(t, s.DeleteLightBlock(1))
+	assert.EqualValues(t, 0, s.Size())
+
+	lb, err = s.LightBlock(1)
+	assert.ErrorIs(t, err, store.ErrLightBlockNotFound)
+	assert.Nil(t, lb)
+}
+
+func testLightBlockBefore(t *testing.T, s store.Store) {
+	assert.Panics(t, func() { _, _ = s.LightBlockBefore(0) })
+
+	_, err := s.LightBlockBefore(100)
+	assert.ErrorIs(t, err, store.ErrLightBlockNotFound)
+
+	for _, h := range []int64{5, 2, 9} {
+		require.NoError(t, s.SaveLightBlock(RandLightBlock(h)))
+	}
+
+	// the closest light block strictly before the given height is returned
+	for height, expected := range map[int64]int64{100: 9, 10: 9, 9: 5, 6: 5, 5: 2, 3: 2} {
+		lb, err := s.LightBlockBefore(height)
+		require.NoError(t, err, height)
+		if assert.NotNil(t, lb, height) {
+			assert.EqualValues(t, expected, lb.Height, height)
+		}
+	}
+
+	for _, height := range []int64{2, 1} {
+		lb, err := s.LightBlockBefore(height)
+		assert.ErrorIs(t, err, store.ErrLightBlockNotFound, height)
+		assert.Nil(t, lb, height)
+	}
+}
+
+func testPrune(t *testing.T, s store.Store) {
+	// Empty store
+	assert.EqualValues(t, 0, s.Size())
+	require.NoError(t, s.Prune(0))
+
+	// One light block
+	require.NoError(t, s.SaveLightBlock(RandLightBlock(2)))
+	assert.EqualValues(t, 1, s.Size())
+
+	require.NoError(t, s.Prune(1))
+	assert.EqualValues(t, 1, s.Size())
+
+	require.NoError(t, s.Prune(0))
+	assert.EqualValues(t, 0, s.Size())
+
+	// Multiple light blocks; the oldest are pruned first
+	for _, h := range []int64{10, 1, 9, 2, 8, 3, 7, 4, 6, 5} {
+		require.NoError(t, s.SaveLightBlock(RandLightBlock(h)))
+	}
+
+	require.NoError(t, s.Prune(11))
+	assert.EqualValues(t, 10, s.Size())
+
+	require.NoError(t, s.Prune(7))
+	assert.EqualValues(t, 7, s.Size())
+
+	height, err := s.FirstLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, height)
+	height, err = s.LastLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, height)
+
+	_, err = s.LightBlock(3)
+	assert.ErrorIs(t, err, store.ErrLightBlockNotFound)
+	_, err = s.LightBlock(4)
+	assert.NoError(t, err)
+}
+
+func testConcurrency(t *testing.T, s store.Store) {
+	var wg sync.WaitGroup
+	for i := 1; i <= 100; i++ {
+		wg.Add(1)
+		go func(i int64) {
+			defer wg.Done()
+
+			assert.NoError(t, s.SaveLightBlock(RandLightBlock(i)))
+
+			// the other calls may fail as light blocks are pruned and
+			// deleted concurrently
+			_, _ = s.LightBlock(i)
+			_, _ = s.LightBlockBefore(i)
+			_, _ = s.LastLightBlockHeight()
+			_, _ = s.FirstLightBlockHeight()
+			_ = s.Size()
+		}(int64(i))
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 100, s.Size())
+	for i := int64(1); i <= 100; i++ {
+		_, err := s.LightBlock(i)
+		assert.NoError(t, err, i)
+	}
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, s.Prune(uint16(90-i*5)))
+		}(i)
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 45, s.Size())
+	height, err := s.FirstLightBlockHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 56, height)
+}
+
+// RandLightBlock returns a light block at the given height with random
+// header hashes, which doesn't verify.
+func RandLightBlock(height int64) *types.LightBlock {
+	_, voters, _ := types.RandVoterSet(2, 1)
+	return &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: &types.Header{
+				Version:            tmversion.Consensus{Block: version.BlockProtocol, App: version.AppProtocol},
+				ChainID:            tmrand.Str(12),
+				Height:             height,
+				Time:               time.Now(),
+				LastBlockID:        types.BlockID{},
+				LastCommitHash:     crypto.CRandBytes(tmhash.Size),
+				DataHash:           crypto.CRandBytes(tmhash.Size),
+				VotersHash:         crypto.CRandBytes(tmhash.Size),
+				NextValidatorsHash: crypto.CRandBytes(tmhash.Size),
+				ConsensusHash:      crypto.CRandBytes(tmhash.Size),
+				AppHash:            crypto.CRandBytes(tmhash.Size),
+				LastResultsHash:    crypto.CRandBytes(tmhash.Size),
+				EvidenceHash:       crypto.CRandBytes(tmhash.Size),
+				ProposerAddress:    crypto.CRandBytes(crypto.AddressSize),
+			},
+			Commit: &types.Commit{},
+		},
+		VoterSet: voters,
+	}
+}
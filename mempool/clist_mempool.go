@@ -20,6 +20,7 @@ import (
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/ostracon/proxy"
 	"github.com/line/ostracon/types"
+	tmtime "github.com/line/ostracon/types/time"
 )
 
 // TxKeySize is the size of the transaction key index
@@ -70,7 +71,8 @@ type CListMempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache txCache
 
-	logger log.Logger
+	logger   log.Logger
+	eventBus types.MempoolEventPublisher
 
 	metrics *Metrics
 }
@@ -82,10 +84,27 @@ type requestCheckTxAsync struct {
 	checkTxCb func(*abci.Response)
 }
 
-var _ Mempool = &CListMempool{}
+var _ GossipMempool = &CListMempool{}
 
-// CListMempoolOption sets an optional parameter on the mempool.
-type CListMempoolOption func(*CListMempool)
+// CListMempoolOption sets an optional parameter on the mempool. The same
+// options apply to the PriorityMempool.
+type CListMempoolOption func(*mempoolOptions)
+
+// mempoolOptions are the optional parameters of the mempools.
+type mempoolOptions struct {
+	preCheck  PreCheckFunc
+	postCheck PostCheckFunc
+	metrics   *Metrics
+}
+
+// newMempoolOptions returns the optional parameters set by the options.
+func newMempoolOptions(options []CListMempoolOption) mempoolOptions {
+	opts := mempoolOptions{metrics: NopMetrics()}
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
 
 // NewCListMempool returns a new mempool with the given configuration and connection to an application.
 func NewCListMempool(
@@ -101,17 +120,16 @@ func NewCListMempool(
 		height:       height,
 		chReqCheckTx: make(chan *requestCheckTxAsync, config.Size),
 		logger:       log.NewNopLogger(),
-		metrics:      NopMetrics(),
+		eventBus:     types.NopEventBus{},
 	}
+	opts := newMempoolOptions(options)
+	mempool.preCheck, mempool.postCheck, mempool.metrics = opts.preCheck, opts.postCheck, opts.metrics
 	if config.CacheSize > 0 {
 		mempool.cache = newMapTxCache(config.CacheSize)
 	} else {
 		mempool.cache = nopTxCache{}
 	}
 	proxyAppConn.SetGlobalCallback(mempool.globalCb)
-	for _, option := range options {
-		option(mempool)
-	}
 	go mempool.checkTxAsyncReactor()
 	return mempool
 }
@@ -126,23 +144,28 @@ func (mem *CListMempool) SetLogger(l log.Logger) {
 	mem.logger = l
 }
 
+// SetEventBus sets the event bus expired txs are published to.
+func (mem *CListMempool) SetEventBus(b types.MempoolEventPublisher) {
+	mem.eventBus = b
+}
+
 // WithPreCheck sets a filter for the mempool to reject a tx if f(tx) returns
 // false. This is ran before CheckTx. Only applies to the first created block.
 // After that, Update overwrites the existing value.
 func WithPreCheck(f PreCheckFunc) CListMempoolOption {
-	return func(mem *CListMempool) { mem.preCheck = f }
+	return func(opts *mempoolOptions) { opts.preCheck = f }
 }
 
 // WithPostCheck sets a filter for the mempool to reject a tx if f(tx) returns
 // false. This is ran after CheckTx. Only applies to the first created block.
 // After that, Update overwrites the existing value.
 func WithPostCheck(f PostCheckFunc) CListMempoolOption {
-	return func(mem *CListMempool) { mem.postCheck = f }
+	return func(opts *mempoolOptions) { opts.postCheck = f }
 }
 
 // WithMetrics sets the metrics.
 func WithMetrics(metrics *Metrics) CListMempoolOption {
-	return func(mem *CListMempool) { mem.metrics = metrics }
+	return func(opts *mempoolOptions) { opts.metrics = metrics }
 }
 
 func (mem *CListMempool) InitWAL() error {
@@ -259,7 +282,8 @@ func (mem *CListMempool) CheckTxSync(tx types.Tx, txInfo TxInfo) (res *abci.Resp
 }
 
 // cb: A callback from the CheckTx command.
-//     It gets called from another goroutine.
+//
+//	It gets called from another goroutine.
 //
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) CheckTxAsync(tx types.Tx, txInfo TxInfo, prepareCb func(error),
@@ -422,7 +446,7 @@ func (mem *CListMempool) reqResCb(
 }
 
 // Called from:
-//  - resCbFirstTime (lock not held) if tx is valid
+//   - resCbFirstTime (lock not held) if tx is valid
 func (mem *CListMempool) addTx(memTx *mempoolTx) {
 	e := mem.txs.PushBack(memTx)
 	mem.txsMap.Store(TxKey(memTx.tx), e)
@@ -431,8 +455,8 @@ func (mem *CListMempool) addTx(memTx *mempoolTx) {
 }
 
 // Called from:
-//  - Update (lock held) if tx was committed
-// 	- resCbRecheck (lock not held) if tx was invalidated
+//   - Update (lock held) if tx was committed
+//   - resCbRecheck (lock not held) if tx was invalidated
 func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromCache bool) {
 	mem.txs.Remove(elem)
 	elem.DetachPrev()
@@ -514,6 +538,7 @@ func (mem *CListMempool) resCbFirstTime(
 		if r.CheckTx.Code == abci.CodeTypeOK {
 			memTx := &mempoolTx{
 				height:    mem.height,
+				timestamp: tmtime.Now(),
 				gasWanted: r.CheckTx.GasWanted,
 				tx:        tx,
 			}
@@ -729,6 +754,8 @@ func (mem *CListMempool) Update(
 		}
 	}
 
+	mem.purgeExpiredTxs(block.Height)
+
 	if mem.config.Recheck {
 		// recheck non-committed txs to see if they became invalid
 		recheckStartTime := time.Now().UnixNano()
@@ -763,6 +790,39 @@ func (mem *CListMempool) Update(
 	return err
 }
 
+// purgeExpiredTxs removes the txs that outlived the TTL of the mempool at the
+// given height, so that they are not rechecked anymore. Expired txs are removed
+// from the cache as well, so they can be resubmitted.
+//
+// Lock() must be held by the caller during execution.
+func (mem *CListMempool) purgeExpiredTxs(blockHeight int64) {
+	if mem.config.TTLNumBlocks == 0 && mem.config.TTLDuration == 0 {
+		return
+	}
+
+	now := tmtime.Now()
+	for e := mem.txs.Front(); e != nil; {
+		next := e.Next()
+		memTx := e.Value.(*mempoolTx)
+		if memTx.expired(mem.config, blockHeight, now) {
+			mem.removeTx(memTx.tx, e, true)
+			mem.notifyTxExpired(memTx)
+		}
+		e = next
+	}
+}
+
+func (mem *CListMempool) notifyTxExpired(memTx *mempoolTx) {
+	mem.logger.Debug("purged expired transaction", "tx", txID(memTx.tx), "height", memTx.height)
+	mem.metrics.ExpiredTxs.Add(1)
+	if err := mem.eventBus.PublishEventTxExpired(types.EventDataTxExpired{
+		Tx:     memTx.tx,
+		Height: memTx.height,
+	}); err != nil {
+		mem.logger.Error("failed publishing expired transaction", "tx", txID(memTx.tx), "err", err)
+	}
+}
+
 func (mem *CListMempool) recheckTxs() {
 	if mem.Size() == 0 {
 		return
@@ -794,9 +854,10 @@ func (mem *CListMempool) recheckTxs() {
 
 // mempoolTx is a transaction that successfully ran
 type mempoolTx struct {
-	height    int64    // height that this tx had been validated in
-	gasWanted int64    // amount of gas this tx states it will require
-	tx        types.Tx //
+	height    int64     // height that this tx had been validated in
+	timestamp time.Time // time that this tx had been added to the mempool at
+	gasWanted int64     // amount of gas this tx states it will require
+	tx        types.Tx  //
 
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> bool
@@ -808,6 +869,15 @@ func (memTx *mempoolTx) Height() int64 {
 	return atomic.LoadInt64(&memTx.height)
 }
 
+// expired returns true if the tx outlived the TTL configured for the mempool
+// at the given block height and time.
+func (memTx *mempoolTx) expired(config *cfg.MempoolConfig, blockHeight int64, now time.Time) bool {
+	if config.TTLNumBlocks > 0 && blockHeight-memTx.Height() > config.TTLNumBlocks {
+		return true
+	}
+	return config.TTLDuration > 0 && now.Sub(memTx.timestamp) > config.TTLDuration
+}
+
 //--------------------------------------------------------------------------------
 
 type txCache interface {
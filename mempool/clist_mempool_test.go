@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/binary"
@@ -279,6 +280,61 @@ func TestMempool_KeepInvalidTxsInCache(t *testing.T) {
 	}
 }
 
+func TestMempoolExpiredTxs(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	wcfg := cfg.ResetTestRoot("mempool_test")
+	wcfg.Mempool.TTLNumBlocks = 2
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, wcfg)
+	defer cleanup()
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop() //nolint:errcheck // ignore for tests
+	mempool.SetEventBus(eventBus)
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryTxExpired, 10)
+	require.NoError(t, err)
+
+	update := func(height int64) {
+		mempool.Lock()
+		defer mempool.Unlock()
+		err := mempool.Update(newTestBlock(height, nil), abciResponses(0, abci.CodeTypeOK), nil, nil)
+		require.NoError(t, err)
+	}
+
+	// 1. Txs expire once they have been in the mempool for more than
+	// TTLNumBlocks blocks.
+	txs0 := checkTxs(t, mempool, 2, UnknownPeerID)
+	update(1)
+	txs1 := checkTxs(t, mempool, 3, UnknownPeerID)
+	update(2)
+	require.Equal(t, 5, mempool.Size())
+	update(3)
+	require.Equal(t, 3, mempool.Size())
+	assert.ElementsMatch(t, txs1, mempool.ReapMaxTxs(-1))
+
+	for range txs0 {
+		msg := <-sub.Out()
+		edt := msg.Data().(types.EventDataTxExpired)
+		assert.Contains(t, txs0, edt.Tx)
+		assert.EqualValues(t, 0, edt.Height)
+	}
+
+	// Expired txs are removed from the cache, so they can be resubmitted.
+	_, err = mempool.CheckTxSync(txs0[0], TxInfo{})
+	require.NoError(t, err)
+	require.Equal(t, 4, mempool.Size())
+
+	// 2. Txs expire once they have been in the mempool for more than
+	// TTLDuration.
+	mempool.config.TTLNumBlocks = 0
+	mempool.config.TTLDuration = 50 * time.Millisecond
+	time.Sleep(100 * time.Millisecond)
+	txs4 := checkTxs(t, mempool, 1, UnknownPeerID)
+	update(4)
+	assert.Equal(t, txs4, mempool.ReapMaxTxs(-1))
+}
+
 func TestTxsAvailable(t *testing.T) {
 	app := kvstore.NewApplication()
 	cc := proxy.NewLocalClientCreator(app)
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/clist"
+	"github.com/line/ostracon/libs/log"
 	"github.com/line/ostracon/p2p"
 	"github.com/line/ostracon/types"
 )
@@ -81,6 +83,26 @@ type Mempool interface {
 	CloseWAL()
 }
 
+// GossipMempool is a Mempool whose transactions can be broadcast to peers by
+// the Reactor.
+type GossipMempool interface {
+	Mempool
+
+	// SetLogger sets the Logger.
+	SetLogger(l log.Logger)
+
+	// SetEventBus sets the event bus expired txs are published to.
+	SetEventBus(b types.MempoolEventPublisher)
+
+	// TxsFront returns the first transaction in the order they are gossiped,
+	// for peer goroutines to call .NextWait() on.
+	TxsFront() *clist.CElement
+
+	// TxsWaitChan returns a channel which is closed once the mempool is not
+	// empty.
+	TxsWaitChan() <-chan struct{}
+}
+
 //--------------------------------------------------------------------------------
 
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
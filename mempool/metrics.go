@@ -26,6 +26,10 @@ type Metrics struct {
 	RecheckCount metrics.Counter
 	// Time of recheck transactions in the mempool.
 	RecheckTime metrics.Gauge
+	// Number of transactions evicted to make room for higher priority ones.
+	EvictedTxs metrics.Counter
+	// Number of transactions purged for outliving the mempool TTL.
+	ExpiredTxs metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -68,6 +72,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "recheck_time",
 			Help:      "Time of recheck transactions in the mempool in ms.",
 		}, labels).With(labelsAndValues...),
+		EvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evicted_txs",
+			Help:      "Number of transactions evicted to make room for higher priority ones.",
+		}, labels).With(labelsAndValues...),
+		ExpiredTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "expired_txs",
+			Help:      "Number of transactions purged for outliving the mempool TTL.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -79,5 +95,7 @@ func NopMetrics() *Metrics {
 		FailedTxs:    discard.NewCounter(),
 		RecheckCount: discard.NewCounter(),
 		RecheckTime:  discard.NewGauge(),
+		EvictedTxs:   discard.NewCounter(),
+		ExpiredTxs:   discard.NewCounter(),
 	}
 }
@@ -0,0 +1,897 @@
+package mempool
+
+import (
+	"container/heap"
+	"container/list"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	abci "github.com/line/ostracon/abci/types"
+	cfg "github.com/line/ostracon/config"
+	auto "github.com/line/ostracon/libs/autofile"
+	"github.com/line/ostracon/libs/clist"
+	"github.com/line/ostracon/libs/log"
+	tmmath "github.com/line/ostracon/libs/math"
+	tmos "github.com/line/ostracon/libs/os"
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/p2p"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/proxy"
+	"github.com/line/ostracon/types"
+	tmtime "github.com/line/ostracon/types/time"
+)
+
+// PriorityMempool is an in-memory pool for transactions that orders them by
+// the priority the application assigns to them in ResponseCheckTx.
+//
+// Transactions are reaped from the highest priority to the lowest, except that
+// the transactions of a single sender (ResponseCheckTx.Sender) are always
+// reaped in the order they were added. When the mempool is full, a new
+// transaction evicts transactions of lower priority, or is rejected if there
+// are not enough of them.
+//
+// Transactions are gossiped to peers in the order they were added.
+type PriorityMempool struct {
+	// Atomic integers
+	height   int64 // the last block Update()'d to
+	txsBytes int64 // total size of mempool, in bytes
+
+	// notify listeners (ie. consensus) when txs are available
+	notifiedTxsAvailable bool
+	txsAvailable         chan struct{} // fires once for each height, when the mempool is not empty
+
+	config *cfg.MempoolConfig
+
+	// Exclusive mutex for Update method to prevent concurrent execution of
+	// CheckTx or ReapMaxBytesMaxGas(ReapMaxTxs) methods.
+	updateMtx tmsync.RWMutex
+	preCheck  PreCheckFunc
+	postCheck PostCheckFunc
+
+	chReqCheckTx chan *requestCheckTxAsync
+
+	wal          *auto.AutoFile // a log of mempool txs
+	txs          *clist.CList   // concurrent linked-list of good txs, in the order they were added
+	proxyAppConn proxy.AppConnMempool
+
+	// mtx guards the priority index below, which is modified by concurrent
+	// CheckTx responses.
+	mtx tmsync.Mutex
+	// txsMap: txKey -> priorityTx
+	txsMap map[[TxKeySize]byte]*priorityTx
+	// queues holds the txs of each sender in the order they were added.
+	queues  map[senderKey]*list.List
+	nextSeq uint64
+
+	// Keep a cache of already-seen txs.
+	// This reduces the pressure on the proxyApp.
+	cache txCache
+
+	logger   log.Logger
+	eventBus types.MempoolEventPublisher
+
+	metrics *Metrics
+}
+
+var _ GossipMempool = &PriorityMempool{}
+
+// NewPriorityMempool returns a new priority mempool with the given
+// configuration and connection to an application.
+func NewPriorityMempool(
+	config *cfg.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...CListMempoolOption,
+) *PriorityMempool {
+	mempool := &PriorityMempool{
+		config:       config,
+		proxyAppConn: proxyAppConn,
+		txs:          clist.New(),
+		txsMap:       make(map[[TxKeySize]byte]*priorityTx),
+		queues:       make(map[senderKey]*list.List),
+		height:       height,
+		chReqCheckTx: make(chan *requestCheckTxAsync, config.Size),
+		logger:       log.NewNopLogger(),
+		eventBus:     types.NopEventBus{},
+	}
+	opts := newMempoolOptions(options)
+	mempool.preCheck, mempool.postCheck, mempool.metrics = opts.preCheck, opts.postCheck, opts.metrics
+	if config.CacheSize > 0 {
+		mempool.cache = newMapTxCache(config.CacheSize)
+	} else {
+		mempool.cache = nopTxCache{}
+	}
+	proxyAppConn.SetGlobalCallback(mempool.globalCb)
+	go mempool.checkTxAsyncReactor()
+	return mempool
+}
+
+// NOTE: not thread safe - should only be called once, on startup
+func (mem *PriorityMempool) EnableTxsAvailable() {
+	mem.txsAvailable = make(chan struct{}, 1)
+}
+
+// SetLogger sets the Logger.
+func (mem *PriorityMempool) SetLogger(l log.Logger) {
+	mem.logger = l
+}
+
+// SetEventBus sets the event bus expired txs are published to.
+func (mem *PriorityMempool) SetEventBus(b types.MempoolEventPublisher) {
+	mem.eventBus = b
+}
+
+func (mem *PriorityMempool) InitWAL() error {
+	var (
+		walDir  = mem.config.WalDir()
+		walFile = walDir + "/wal"
+	)
+
+	const perm = 0700
+	if err := tmos.EnsureDir(walDir, perm); err != nil {
+		return err
+	}
+
+	af, err := auto.OpenAutoFile(walFile)
+	if err != nil {
+		return fmt.Errorf("can't open autofile %s: %w", walFile, err)
+	}
+
+	mem.wal = af
+	return nil
+}
+
+func (mem *PriorityMempool) CloseWAL() {
+	if err := mem.wal.Close(); err != nil {
+		mem.logger.Error("Error closing WAL", "err", err)
+	}
+	mem.wal = nil
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) Lock() {
+	mem.updateMtx.Lock()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) Unlock() {
+	mem.updateMtx.Unlock()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) Size() int {
+	return mem.txs.Len()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) TxsBytes() int64 {
+	return atomic.LoadInt64(&mem.txsBytes)
+}
+
+// Lock() must be help by the caller during execution.
+func (mem *PriorityMempool) FlushAppConn() error {
+	_, err := mem.proxyAppConn.FlushSync()
+	return err
+}
+
+// XXX: Unsafe! Calling Flush may leave mempool in inconsistent state.
+func (mem *PriorityMempool) Flush() {
+	mem.updateMtx.Lock()
+	defer mem.updateMtx.Unlock()
+
+	mem.mtx.Lock()
+	defer mem.mtx.Unlock()
+
+	_ = atomic.SwapInt64(&mem.txsBytes, 0)
+	mem.cache.Reset()
+
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		mem.txs.Remove(e)
+		e.DetachPrev()
+	}
+
+	mem.txsMap = make(map[[TxKeySize]byte]*priorityTx)
+	mem.queues = make(map[senderKey]*list.List)
+}
+
+// TxsFront returns the first transaction in the ordered list for peer
+// goroutines to call .NextWait() on.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) TxsFront() *clist.CElement {
+	return mem.txs.Front()
+}
+
+// TxsWaitChan returns a channel to wait on transactions. It will be closed
+// once the mempool is not empty (ie. the internal `mem.txs` has at least one
+// element)
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) TxsWaitChan() <-chan struct{} {
+	return mem.txs.WaitChan()
+}
+
+// It blocks if we're waiting on Update() or Reap().
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) CheckTxSync(tx types.Tx, txInfo TxInfo) (res *abci.Response, err error) {
+	mem.updateMtx.RLock()
+	// use defer to unlock mutex because application (*local client*) might panic
+	defer mem.updateMtx.RUnlock()
+
+	if err = mem.prepareCheckTx(tx, txInfo); err != nil {
+		return res, err
+	}
+
+	var r *abci.ResponseCheckTx
+	r, err = mem.proxyAppConn.CheckTxSync(abci.RequestCheckTx{Tx: tx})
+	if err != nil {
+		return res, err
+	}
+
+	res = abci.ToResponseCheckTx(*r)
+	mem.reqResCb(tx, txInfo.SenderID, txInfo.SenderP2PID, res, nil)
+	return res, err
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) CheckTxAsync(tx types.Tx, txInfo TxInfo, prepareCb func(error),
+	checkTxCb func(*abci.Response)) {
+	mem.chReqCheckTx <- &requestCheckTxAsync{tx: tx, txInfo: txInfo, prepareCb: prepareCb, checkTxCb: checkTxCb}
+}
+
+func (mem *PriorityMempool) checkTxAsyncReactor() {
+	for req := range mem.chReqCheckTx {
+		mem.checkTxAsync(req.tx, req.txInfo, req.prepareCb, req.checkTxCb)
+	}
+}
+
+// It blocks if we're waiting on Update() or Reap().
+func (mem *PriorityMempool) checkTxAsync(tx types.Tx, txInfo TxInfo, prepareCb func(error),
+	checkTxCb func(*abci.Response)) {
+	mem.updateMtx.RLock()
+	defer func() {
+		if r := recover(); r != nil {
+			mem.updateMtx.RUnlock()
+			panic(r)
+		}
+	}()
+
+	err := mem.prepareCheckTx(tx, txInfo)
+	if prepareCb != nil {
+		prepareCb(err)
+	}
+	if err != nil {
+		mem.updateMtx.RUnlock()
+		return
+	}
+
+	mem.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{Tx: tx}, func(res *abci.Response) {
+		mem.reqResCb(tx, txInfo.SenderID, txInfo.SenderP2PID, res, func(response *abci.Response) {
+			if checkTxCb != nil {
+				checkTxCb(response)
+			}
+			mem.updateMtx.RUnlock()
+		})
+	})
+}
+
+// Unlike CListMempool, a full mempool does not reject txs here, since the
+// priority of a tx, which decides whether it can evict others, is only known
+// once the app has checked it.
+//
+// CONTRACT: `caller` should held `mem.updateMtx.RLock()`
+func (mem *PriorityMempool) prepareCheckTx(tx types.Tx, txInfo TxInfo) error {
+	mem.mtx.Lock()
+	_, ok := mem.txsMap[TxKey(tx)]
+	mem.mtx.Unlock()
+	if ok {
+		return ErrTxInMap
+	}
+
+	txSize := len(tx)
+	if txSize > mem.config.MaxTxBytes {
+		return ErrTxTooLarge{mem.config.MaxTxBytes, txSize}
+	}
+
+	if mem.preCheck != nil {
+		if err := mem.preCheck(tx); err != nil {
+			return ErrPreCheck{err}
+		}
+	}
+
+	// NOTE: writing to the WAL and calling proxy must be done before adding tx
+	// to the cache. otherwise, if either of them fails, next time CheckTx is
+	// called with tx, ErrTxInCache will be returned without tx being checked at
+	// all even once.
+	if mem.wal != nil {
+		// TODO: Notify administrators when WAL fails
+		_, err := mem.wal.Write(append([]byte(tx), newline...))
+		if err != nil {
+			return fmt.Errorf("wal.Write: %w", err)
+		}
+	}
+
+	// NOTE: proxyAppConn may error if tx buffer is full
+	if err := mem.proxyAppConn.Error(); err != nil {
+		return err
+	}
+
+	if !mem.cache.Push(tx) {
+		// Record a new sender for a tx we've already seen.
+		mem.mtx.Lock()
+		if ptx, ok := mem.txsMap[TxKey(tx)]; ok {
+			ptx.memTx.senders.LoadOrStore(txInfo.SenderID, true)
+		}
+		mem.mtx.Unlock()
+
+		return ErrTxInCache
+	}
+
+	return nil
+}
+
+// Global callback that will be called after every ABCI response. Only
+// rechecks are handled here; see CListMempool.globalCb.
+func (mem *PriorityMempool) globalCb(req *abci.Request, res *abci.Response) {
+	checkTxReq := req.GetCheckTx()
+	if checkTxReq == nil {
+		return
+	}
+
+	if checkTxReq.Type == abci.CheckTxType_Recheck {
+		mem.metrics.RecheckCount.Add(1)
+		mem.resCbRecheck(req, res)
+
+		// update metrics
+		mem.metrics.Size.Set(float64(mem.Size()))
+	}
+}
+
+// Request specific callback that should be set on individual reqRes objects
+// to incorporate local information when processing the response.
+func (mem *PriorityMempool) reqResCb(
+	tx []byte,
+	peerID uint16,
+	peerP2PID p2p.ID,
+	res *abci.Response,
+	externalCb func(*abci.Response),
+) {
+	mem.resCbFirstTime(tx, peerID, peerP2PID, res)
+
+	// update metrics
+	mem.metrics.Size.Set(float64(mem.Size()))
+
+	// passed in by the caller of CheckTx, eg. the RPC
+	if externalCb != nil {
+		externalCb(res)
+	}
+}
+
+// callback, which is called after the app checked the tx for the first time.
+//
+// A valid tx that does not fit in the mempool is rejected with
+// ErrMempoolIsFull set as the MempoolError of the response.
+func (mem *PriorityMempool) resCbFirstTime(
+	tx []byte,
+	peerID uint16,
+	peerP2PID p2p.ID,
+	res *abci.Response,
+) {
+	switch r := res.Value.(type) {
+	case *abci.Response_CheckTx:
+		if r.CheckTx.Code != abci.CodeTypeOK {
+			// ignore bad transaction
+			mem.logger.Debug("rejected bad transaction",
+				"tx", txID(tx), "peerID", peerP2PID, "res", r)
+			mem.metrics.FailedTxs.Add(1)
+			if !mem.config.KeepInvalidTxsInCache {
+				// remove from cache (it might be good later)
+				mem.cache.Remove(tx)
+			}
+			return
+		}
+
+		memTx := &mempoolTx{
+			height:    mem.height,
+			timestamp: tmtime.Now(),
+			gasWanted: r.CheckTx.GasWanted,
+			tx:        tx,
+		}
+		memTx.senders.Store(peerID, true)
+		if err := mem.addTx(memTx, r.CheckTx.Priority, r.CheckTx.Sender); err != nil {
+			mem.logger.Debug("rejected transaction",
+				"tx", txID(tx), "peerID", peerP2PID, "priority", r.CheckTx.Priority, "err", err)
+			r.CheckTx.MempoolError = err.Error()
+			// remove from cache (it might fit later)
+			mem.cache.Remove(tx)
+			return
+		}
+		mem.logger.Debug("added good transaction",
+			"tx", txID(tx),
+			"res", r,
+			"height", memTx.height,
+			"total", mem.Size(),
+		)
+		mem.notifyTxsAvailable()
+	default:
+		// ignore other messages
+	}
+}
+
+// callback, which is called after the app rechecked the tx.
+func (mem *PriorityMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
+	switch r := res.Value.(type) {
+	case *abci.Response_CheckTx:
+		tx := req.GetCheckTx().Tx
+		var postCheckErr error
+		if r.CheckTx.Code == abci.CodeTypeOK {
+			if mem.postCheck == nil {
+				return
+			}
+			postCheckErr = mem.postCheck(tx, r.CheckTx)
+			if postCheckErr == nil {
+				return
+			}
+			r.CheckTx.MempoolError = postCheckErr.Error()
+		}
+
+		mem.mtx.Lock()
+		defer mem.mtx.Unlock()
+
+		ptx, ok := mem.txsMap[TxKey(tx)]
+		if !ok {
+			mem.logger.Debug("re-CheckTx transaction does not exist", "expected", types.Tx(tx))
+			return
+		}
+		// Tx became invalidated due to newly committed block.
+		mem.logger.Debug("tx is no longer valid", "tx", txID(tx), "res", r, "err", postCheckErr)
+		// NOTE: we remove tx from the cache because it might be good later
+		mem.removeTx(ptx, !mem.config.KeepInvalidTxsInCache)
+	default:
+		// ignore other messages
+	}
+}
+
+// addTx adds memTx to the mempool, evicting lower priority txs if the mempool
+// is full.
+func (mem *PriorityMempool) addTx(memTx *mempoolTx, priority int64, sender string) error {
+	mem.mtx.Lock()
+	defer mem.mtx.Unlock()
+
+	ptx := &priorityTx{
+		memTx:    memTx,
+		key:      TxKey(memTx.tx),
+		priority: priority,
+		seq:      mem.nextSeq,
+	}
+	if _, ok := mem.txsMap[ptx.key]; ok {
+		return ErrTxInMap
+	}
+	ptx.sender = senderKey{sender: sender}
+	if sender == "" {
+		// A tx without a sender is not ordered after any other tx.
+		ptx.sender.tx = ptx.key
+	}
+
+	if err := mem.isFull(len(memTx.tx)); err != nil {
+		victims, ok := mem.evictionVictims(ptx)
+		if !ok {
+			return err
+		}
+		for _, victim := range victims {
+			mem.logger.Debug("evicted transaction",
+				"tx", txID(victim.memTx.tx), "priority", victim.priority, "by", txID(memTx.tx))
+			// NOTE: we remove tx from the cache so that it can be resubmitted
+			mem.removeTx(victim, true)
+			mem.metrics.EvictedTxs.Add(1)
+		}
+	}
+
+	queue, ok := mem.queues[ptx.sender]
+	if !ok {
+		queue = list.New()
+		mem.queues[ptx.sender] = queue
+	}
+	ptx.queueElem = queue.PushBack(ptx)
+	ptx.gossipElem = mem.txs.PushBack(memTx)
+	mem.txsMap[ptx.key] = ptx
+	mem.nextSeq++
+	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
+	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+	return nil
+}
+
+// CONTRACT: `caller` should held `mem.mtx`
+func (mem *PriorityMempool) removeTx(ptx *priorityTx, removeFromCache bool) {
+	mem.txs.Remove(ptx.gossipElem)
+	ptx.gossipElem.DetachPrev()
+
+	queue := mem.queues[ptx.sender]
+	queue.Remove(ptx.queueElem)
+	if queue.Len() == 0 {
+		delete(mem.queues, ptx.sender)
+	}
+
+	delete(mem.txsMap, ptx.key)
+	atomic.AddInt64(&mem.txsBytes, int64(-len(ptx.memTx.tx)))
+
+	if removeFromCache {
+		mem.cache.Remove(ptx.memTx.tx)
+	}
+}
+
+// RemoveTxByKey removes a transaction from the mempool by its TxKey index.
+func (mem *PriorityMempool) RemoveTxByKey(txKey [TxKeySize]byte, removeFromCache bool) {
+	mem.mtx.Lock()
+	defer mem.mtx.Unlock()
+
+	if ptx, ok := mem.txsMap[txKey]; ok {
+		mem.removeTx(ptx, removeFromCache)
+	}
+}
+
+func (mem *PriorityMempool) isFull(txSize int) error {
+	var (
+		memSize  = mem.Size()
+		txsBytes = mem.TxsBytes()
+	)
+
+	if memSize >= mem.config.Size || int64(txSize)+txsBytes > mem.config.MaxTxsBytes {
+		return ErrMempoolIsFull{
+			memSize, mem.config.Size,
+			txsBytes, mem.config.MaxTxsBytes,
+		}
+	}
+
+	return nil
+}
+
+// evictionVictims returns the txs that have to be evicted for ptx to fit in
+// the mempool, lowest priority first, or false if evicting every tx with a
+// lower priority than ptx would not make enough room.
+//
+// Only the last tx of a sender can be evicted, so that the remaining txs of
+// each sender have no gaps. For the same reason, txs are never evicted in
+// favour of a later tx from the same sender.
+//
+// CONTRACT: `caller` should held `mem.mtx`
+func (mem *PriorityMempool) evictionVictims(ptx *priorityTx) ([]*priorityTx, bool) {
+	var (
+		numTxs   = mem.Size() + 1
+		txsBytes = mem.TxsBytes() + int64(len(ptx.memTx.tx))
+		victims  []*priorityTx
+	)
+
+	tails := &priorityTxHeap{less: evictedFirst}
+	for sender, queue := range mem.queues {
+		if sender != ptx.sender {
+			tails.txs = append(tails.txs, queue.Back().Value.(*priorityTx))
+		}
+	}
+	heap.Init(tails)
+
+	for numTxs > mem.config.Size || txsBytes > mem.config.MaxTxsBytes {
+		if tails.Len() == 0 {
+			return nil, false
+		}
+		victim := heap.Pop(tails).(*priorityTx)
+		if victim.priority >= ptx.priority {
+			return nil, false
+		}
+		victims = append(victims, victim)
+		numTxs--
+		txsBytes -= int64(len(victim.memTx.tx))
+
+		if prev := victim.queueElem.Prev(); prev != nil {
+			heap.Push(tails, prev.Value.(*priorityTx))
+		}
+	}
+	return victims, true
+}
+
+// forEachByPriority calls fn on the txs from the highest priority to the
+// lowest, keeping the txs of each sender in the order they were added, until
+// fn returns false.
+//
+// CONTRACT: `caller` should held `mem.mtx`
+func (mem *PriorityMempool) forEachByPriority(fn func(*priorityTx) bool) {
+	heads := &priorityTxHeap{
+		txs:  make([]*priorityTx, 0, len(mem.queues)),
+		less: reapedFirst,
+	}
+	for _, queue := range mem.queues {
+		heads.txs = append(heads.txs, queue.Front().Value.(*priorityTx))
+	}
+	heap.Init(heads)
+
+	for heads.Len() > 0 {
+		ptx := heap.Pop(heads).(*priorityTx)
+		if !fn(ptx) {
+			return
+		}
+		if next := ptx.queueElem.Next(); next != nil {
+			heap.Push(heads, next.Value.(*priorityTx))
+		}
+	}
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) TxsAvailable() <-chan struct{} {
+	return mem.txsAvailable
+}
+
+func (mem *PriorityMempool) notifyTxsAvailable() {
+	if mem.Size() == 0 {
+		mem.logger.Info("notified txs available but mempool is empty!")
+	}
+	if mem.txsAvailable != nil && !mem.notifiedTxsAvailable {
+		// channel cap is 1, so this will send once
+		mem.notifiedTxsAvailable = true
+		select {
+		case mem.txsAvailable <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// ReapMaxBytesMaxGas returns the highest priority txs within the limits.
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	return mem.reap(maxBytes, maxGas, -1)
+}
+
+// ReapMaxBytesMaxGasMaxTxs returns the highest priority txs within the
+// limits.
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxBytesMaxGasMaxTxs(maxBytes, maxGas, maxTxs int64) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	if maxTxs <= 0 {
+		maxTxs = -1
+	}
+	return mem.reap(maxBytes, maxGas, maxTxs)
+}
+
+// ReapMaxTxs returns the max highest priority txs.
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxTxs(max int) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	return mem.reap(-1, -1, int64(max))
+}
+
+// reap collects txs by priority until one of them exceeds the size or gas
+// limits, or maxTxs txs are collected. Negative limits are not applied.
+//
+// CONTRACT: `caller` should held `mem.updateMtx.RLock()`
+func (mem *PriorityMempool) reap(maxBytes, maxGas, maxTxs int64) types.Txs {
+	mem.mtx.Lock()
+	defer mem.mtx.Unlock()
+
+	capacity := len(mem.txsMap)
+	if maxTxs > -1 {
+		capacity = tmmath.MinInt(capacity, int(maxTxs))
+	}
+
+	var totalGas int64
+	txs := make([]types.Tx, 0, capacity)
+	protoTxs := tmproto.Data{}
+	mem.forEachByPriority(func(ptx *priorityTx) bool {
+		if maxTxs > -1 && int64(len(txs)) >= maxTxs {
+			return false
+		}
+		memTx := ptx.memTx
+
+		protoTxs.Txs = append(protoTxs.Txs, memTx.tx)
+		// Check total size requirement
+		if maxBytes > -1 && int64(protoTxs.Size()) > maxBytes {
+			return false
+		}
+		// Check total gas requirement.
+		newTotalGas := totalGas + memTx.gasWanted
+		if maxGas > -1 && newTotalGas > maxGas {
+			return false
+		}
+		totalGas = newTotalGas
+		txs = append(txs, memTx.tx)
+		return true
+	})
+	return txs
+}
+
+// Lock() must be held by the caller during execution.
+func (mem *PriorityMempool) Update(
+	block *types.Block,
+	deliverTxResponses []*abci.ResponseDeliverTx,
+	preCheck PreCheckFunc,
+	postCheck PostCheckFunc,
+) (err error) {
+	// Set height
+	mem.height = block.Height
+	mem.notifiedTxsAvailable = false
+
+	if preCheck != nil {
+		mem.preCheck = preCheck
+	}
+	if postCheck != nil {
+		mem.postCheck = postCheck
+	}
+
+	mem.mtx.Lock()
+	for i, tx := range block.Txs {
+		if deliverTxResponses[i].Code == abci.CodeTypeOK {
+			// Add valid committed tx to the cache (if missing).
+			_ = mem.cache.Push(tx)
+		} else if !mem.config.KeepInvalidTxsInCache {
+			// Allow invalid transactions to be resubmitted.
+			mem.cache.Remove(tx)
+		}
+
+		// Remove committed tx from the mempool.
+		if ptx, ok := mem.txsMap[TxKey(tx)]; ok {
+			mem.removeTx(ptx, false)
+		}
+	}
+	mem.purgeExpiredTxs(block.Height)
+	mem.mtx.Unlock()
+
+	if mem.config.Recheck {
+		// recheck non-committed txs to see if they became invalid
+		recheckStartTime := time.Now().UnixNano()
+
+		_, err = mem.proxyAppConn.BeginRecheckTxSync(abci.RequestBeginRecheckTx{
+			Header: types.OC2PB.Header(&block.Header),
+		})
+		if err != nil {
+			mem.logger.Error("error in proxyAppConn.BeginRecheckTxSync", "err", err)
+		}
+		mem.logger.Debug("recheck txs", "numtxs", mem.Size(), "height", block.Height)
+		mem.recheckTxs()
+		_, err = mem.proxyAppConn.EndRecheckTxSync(abci.RequestEndRecheckTx{Height: block.Height})
+		if err != nil {
+			mem.logger.Error("error in proxyAppConn.EndRecheckTxSync", "err", err)
+		}
+
+		recheckEndTime := time.Now().UnixNano()
+
+		recheckTimeMs := float64(recheckEndTime-recheckStartTime) / 1000000
+		mem.metrics.RecheckTime.Set(recheckTimeMs)
+	}
+
+	// notify there're some txs left.
+	if mem.Size() > 0 {
+		mem.notifyTxsAvailable()
+	}
+
+	// Update metrics
+	mem.metrics.Size.Set(float64(mem.Size()))
+
+	return err
+}
+
+// purgeExpiredTxs removes the txs that outlived the TTL of the mempool at the
+// given height, so that they are not rechecked anymore. Expired txs are removed
+// from the cache as well, so they can be resubmitted.
+//
+// CONTRACT: `caller` should held `mem.mtx`
+func (mem *PriorityMempool) purgeExpiredTxs(blockHeight int64) {
+	if mem.config.TTLNumBlocks == 0 && mem.config.TTLDuration == 0 {
+		return
+	}
+
+	now := tmtime.Now()
+	for _, ptx := range mem.txsMap {
+		if ptx.memTx.expired(mem.config, blockHeight, now) {
+			mem.removeTx(ptx, true)
+			mem.notifyTxExpired(ptx.memTx)
+		}
+	}
+}
+
+func (mem *PriorityMempool) notifyTxExpired(memTx *mempoolTx) {
+	mem.logger.Debug("purged expired transaction", "tx", txID(memTx.tx), "height", memTx.height)
+	mem.metrics.ExpiredTxs.Add(1)
+	if err := mem.eventBus.PublishEventTxExpired(types.EventDataTxExpired{
+		Tx:     memTx.tx,
+		Height: memTx.height,
+	}); err != nil {
+		mem.logger.Error("failed publishing expired transaction", "tx", txID(memTx.tx), "err", err)
+	}
+}
+
+// recheckTxs rechecks the txs in the order they were added, which keeps the
+// txs of each sender in order.
+func (mem *PriorityMempool) recheckTxs() {
+	if mem.Size() == 0 {
+		return
+	}
+
+	wg := sync.WaitGroup{}
+
+	// Push txs to proxyAppConn
+	// NOTE: globalCb may be called concurrently.
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		wg.Add(1)
+
+		memTx := e.Value.(*mempoolTx)
+		req := abci.RequestCheckTx{
+			Tx:   memTx.tx,
+			Type: abci.CheckTxType_Recheck,
+		}
+
+		mem.proxyAppConn.CheckTxAsync(req, func(res *abci.Response) {
+			wg.Done()
+		})
+	}
+
+	mem.proxyAppConn.FlushAsync(func(res *abci.Response) {})
+	wg.Wait()
+}
+
+//--------------------------------------------------------------------------------
+
+// senderKey identifies the queue of txs of a sender. Txs without a sender are
+// each given a queue of their own, keyed by their TxKey.
+type senderKey struct {
+	sender string
+	tx     [TxKeySize]byte
+}
+
+// priorityTx is a mempoolTx together with its position in the PriorityMempool.
+type priorityTx struct {
+	memTx    *mempoolTx
+	key      [TxKeySize]byte
+	priority int64
+	sender   senderKey
+	seq      uint64 // insertion order, breaks ties between equal priorities
+
+	gossipElem *clist.CElement
+	queueElem  *list.Element
+}
+
+// reapedFirst orders txs by descending priority, older txs first.
+func reapedFirst(a, b *priorityTx) bool {
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	return a.seq < b.seq
+}
+
+// evictedFirst orders txs by ascending priority, newer txs first.
+func evictedFirst(a, b *priorityTx) bool {
+	if a.priority != b.priority {
+		return a.priority < b.priority
+	}
+	return a.seq > b.seq
+}
+
+// priorityTxHeap implements heap.Interface over txs ordered by less.
+type priorityTxHeap struct {
+	txs  []*priorityTx
+	less func(a, b *priorityTx) bool
+}
+
+var _ heap.Interface = (*priorityTxHeap)(nil)
+
+func (h *priorityTxHeap) Len() int           { return len(h.txs) }
+func (h *priorityTxHeap) Less(i, j int) bool { return h.less(h.txs[i], h.txs[j]) }
+func (h *priorityTxHeap) Swap(i, j int)      { h.txs[i], h.txs[j] = h.txs[j], h.txs[i] }
+
+func (h *priorityTxHeap) Push(x interface{}) {
+	h.txs = append(h.txs, x.(*priorityTx))
+}
+
+func (h *priorityTxHeap) Pop() interface{} {
+	n := len(h.txs)
+	ptx := h.txs[n-1]
+	h.txs[n-1] = nil
+	h.txs = h.txs[:n-1]
+	return ptx
+}
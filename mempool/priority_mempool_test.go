@@ -0,0 +1,183 @@
+package mempool
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/line/ostracon/abci/types"
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/libs/log"
+	"github.com/line/ostracon/proxy"
+	"github.com/line/ostracon/types"
+)
+
+// priorityApp accepts txs of the form "sender/priority/nonce" (sender may be
+// empty) and assigns them the given sender and priority.
+type priorityApp struct {
+	abci.BaseApplication
+}
+
+func (app *priorityApp) CheckTxSync(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	parts := strings.Split(string(req.Tx), "/")
+	if len(parts) != 3 {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	priority, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK, Sender: parts[0], Priority: priority, GasWanted: 1}
+}
+
+func (app *priorityApp) CheckTxAsync(req abci.RequestCheckTx, callback abci.CheckTxCallback) {
+	callback(app.CheckTxSync(req))
+}
+
+func newPriorityMempool(t *testing.T, size int) *PriorityMempool {
+	config := cfg.ResetTestRoot("priority_mempool_test")
+	t.Cleanup(func() { os.RemoveAll(config.RootDir) })
+	config.Mempool.Size = size
+
+	appConnMem, _ := proxy.NewLocalClientCreator(&priorityApp{}).NewABCIClient()
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() { appConnMem.Stop() }) //nolint:errcheck // ignore for tests
+
+	mempool := NewPriorityMempool(config.Mempool, appConnMem, 0)
+	mempool.SetLogger(log.TestingLogger())
+	return mempool
+}
+
+func checkPriorityTxs(t *testing.T, mempool Mempool, txs ...string) {
+	for _, tx := range txs {
+		res, err := mempool.CheckTxSync(types.Tx(tx), TxInfo{})
+		require.NoError(t, err, tx)
+		require.Empty(t, res.GetCheckTx().MempoolError, tx)
+	}
+}
+
+func txStrings(txs types.Txs) []string {
+	strs := make([]string, len(txs))
+	for i, tx := range txs {
+		strs[i] = string(tx)
+	}
+	return strs
+}
+
+func TestPriorityMempoolReapOrder(t *testing.T) {
+	mempool := newPriorityMempool(t, 100)
+
+	checkPriorityTxs(t, mempool,
+		"a/1/0",
+		"b/5/0",
+		"a/10/1",
+		"/7/0",
+		"b/3/1",
+		"/7/1",
+		"c/6/0",
+	)
+	require.Equal(t, 7, mempool.Size())
+
+	// "a/10/1" has the highest priority, but can't be reaped before "a/1/0".
+	expected := []string{"/7/0", "/7/1", "c/6/0", "b/5/0", "b/3/1", "a/1/0", "a/10/1"}
+	assert.Equal(t, expected, txStrings(mempool.ReapMaxTxs(-1)))
+	assert.Equal(t, expected[:3], txStrings(mempool.ReapMaxTxs(3)))
+	assert.Equal(t, expected[:4], txStrings(mempool.ReapMaxBytesMaxGasMaxTxs(-1, 4, 0)))
+	assert.Equal(t, expected[:2], txStrings(mempool.ReapMaxBytesMaxGasMaxTxs(-1, -1, 2)))
+	assert.Equal(t, expected, txStrings(mempool.ReapMaxBytesMaxGas(-1, -1)))
+
+	// gossip order is the insertion order
+	var gossiped []string
+	for e := mempool.TxsFront(); e != nil; e = e.Next() {
+		gossiped = append(gossiped, string(e.Value.(*mempoolTx).tx))
+	}
+	assert.Equal(t, []string{"a/1/0", "b/5/0", "a/10/1", "/7/0", "b/3/1", "/7/1", "c/6/0"}, gossiped)
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	mempool := newPriorityMempool(t, 3)
+
+	checkPriorityTxs(t, mempool, "a/1/0", "a/5/1", "b/2/0")
+
+	// "a/1/0" has the lowest priority, but only the last tx of a sender can be
+	// evicted, so "b/2/0" goes.
+	checkPriorityTxs(t, mempool, "c/3/0")
+	assert.Equal(t, []string{"c/3/0", "a/1/0", "a/5/1"}, txStrings(mempool.ReapMaxTxs(-1)))
+
+	// Txs are only evicted for txs with a higher priority.
+	res, err := mempool.CheckTxSync(types.Tx("d/2/0"), TxInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, ErrMempoolIsFull{3, 3, 15, mempool.config.MaxTxsBytes}.Error(),
+		res.GetCheckTx().MempoolError)
+	assert.Equal(t, []string{"c/3/0", "a/1/0", "a/5/1"}, txStrings(mempool.ReapMaxTxs(-1)))
+
+	checkPriorityTxs(t, mempool, "d/4/0", "a/9/2")
+	assert.Equal(t, []string{"a/1/0", "a/5/1", "a/9/2"}, txStrings(mempool.ReapMaxTxs(-1)))
+
+	// Txs are never evicted for a later tx of the same sender.
+	res, err = mempool.CheckTxSync(types.Tx("a/10/3"), TxInfo{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.GetCheckTx().MempoolError)
+	assert.Equal(t, 3, mempool.Size())
+
+	// Rejected txs are removed from the cache, so they can be resubmitted.
+	mempool.Lock()
+	err = mempool.Update(newTestBlock(1, types.Txs{types.Tx("a/1/0")}),
+		abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	checkPriorityTxs(t, mempool, "d/2/0")
+	assert.Equal(t, []string{"a/5/1", "a/9/2", "d/2/0"}, txStrings(mempool.ReapMaxTxs(-1)))
+}
+
+func TestPriorityMempoolRemoveTx(t *testing.T) {
+	mempool := newPriorityMempool(t, 100)
+
+	checkPriorityTxs(t, mempool, "a/1/0", "a/2/1", "a/3/2", "b/2/0")
+	require.EqualValues(t, 20, mempool.TxsBytes())
+
+	mempool.Lock()
+	err := mempool.Update(newTestBlock(1, types.Txs{types.Tx("a/2/1")}),
+		abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b/2/0", "a/1/0", "a/3/2"}, txStrings(mempool.ReapMaxTxs(-1)))
+
+	mempool.RemoveTxByKey(TxKey(types.Tx("a/1/0")), true)
+	assert.Equal(t, []string{"a/3/2", "b/2/0"}, txStrings(mempool.ReapMaxTxs(-1)))
+	assert.EqualValues(t, 10, mempool.TxsBytes())
+
+	mempool.Flush()
+	assert.Zero(t, mempool.Size())
+	assert.Zero(t, mempool.TxsBytes())
+	assert.Empty(t, mempool.ReapMaxTxs(-1))
+	checkPriorityTxs(t, mempool, "a/3/2")
+}
+
+func TestPriorityMempoolExpiredTxs(t *testing.T) {
+	mempool := newPriorityMempool(t, 100)
+	mempool.config.TTLNumBlocks = 1
+
+	update := func(height int64) {
+		mempool.Lock()
+		defer mempool.Unlock()
+		err := mempool.Update(newTestBlock(height, nil), abciResponses(0, abci.CodeTypeOK), nil, nil)
+		require.NoError(t, err)
+	}
+
+	checkPriorityTxs(t, mempool, "a/1/0", "b/2/0")
+	update(1)
+	checkPriorityTxs(t, mempool, "a/3/1")
+	update(2)
+	assert.Equal(t, []string{"a/3/1"}, txStrings(mempool.ReapMaxTxs(-1)))
+	assert.EqualValues(t, 5, mempool.TxsBytes())
+
+	// Expired txs are removed from the cache, so they can be resubmitted.
+	checkPriorityTxs(t, mempool, "b/2/0")
+	assert.Equal(t, []string{"a/3/1", "b/2/0"}, txStrings(mempool.ReapMaxTxs(-1)))
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	dbm "github.com/tendermint/tm-db"
 
 	abci "github.com/line/ostracon/abci/types"
@@ -25,13 +27,17 @@ import (
 	"github.com/line/ostracon/evidence"
 	tmjson "github.com/line/ostracon/libs/json"
 	"github.com/line/ostracon/libs/log"
+	tmnet "github.com/line/ostracon/libs/net"
 	tmpubsub "github.com/line/ostracon/libs/pubsub"
 	"github.com/line/ostracon/libs/service"
 	"github.com/line/ostracon/light"
 	mempl "github.com/line/ostracon/mempool"
 	"github.com/line/ostracon/p2p"
+	"github.com/line/ostracon/p2p/conn"
 	"github.com/line/ostracon/p2p/pex"
+	"github.com/line/ostracon/p2p/trust"
 	"github.com/line/ostracon/privval"
+	tmgrpc "github.com/line/ostracon/privval/grpc"
 	"github.com/line/ostracon/proxy"
 	rpccore "github.com/line/ostracon/rpc/core"
 	grpccore "github.com/line/ostracon/rpc/grpc"
@@ -73,6 +79,18 @@ func DefaultDBProvider(ctx *DBContext) (dbm.DB, error) {
 	return dbm.NewDB(ctx.ID, dbType, ctx.Config.DBDir())
 }
 
+// ReadOnlyDBProvider returns a database like DefaultDBProvider, opened
+// read-only. Only the goleveldb backend can be opened read-only, so it fails
+// for the other backends rather than opening the database read-write.
+func ReadOnlyDBProvider(ctx *DBContext) (dbm.DB, error) {
+	dbType := dbm.BackendType(ctx.Config.DBBackend)
+	if dbType != dbm.GoLevelDBBackend {
+		return nil, fmt.Errorf("the %s backend can't be opened read-only, only %s can",
+			dbType, dbm.GoLevelDBBackend)
+	}
+	return dbm.NewGoLevelDBWithOpts(ctx.ID, ctx.Config.DBDir(), &opt.Options{ReadOnly: true})
+}
+
 // GenesisDocProvider returns a GenesisDoc.
 // It allows the GenesisDoc to be pulled from sources other than the
 // filesystem, for instance from a distributed key-value store cluster.
@@ -126,7 +144,7 @@ func NewOstraconNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	}
 
 	var privKey types.PrivValidator
-	if config.PrivValidatorListenAddr == "" {
+	if config.PrivValidatorListenAddr == "" && config.PrivValidatorSignerAddrs == "" {
 		privKey = privval.LoadFilePV(
 			config.PrivValidatorKeyFile(),
 			config.PrivValidatorStateFile())
@@ -202,6 +220,11 @@ func CustomReactors(reactors map[string]p2p.Reactor) Option {
 						if err != nil {
 							n.Logger.Debug("AddChannel failed", "err", err)
 						}
+						if n.quicTransport != nil {
+							if err := n.quicTransport.AddChannel(chDesc.ID); err != nil {
+								n.Logger.Debug("AddChannel failed", "err", err)
+							}
+						}
 					}
 				}
 				n.nodeInfo = ni
@@ -220,17 +243,20 @@ type Node struct {
 	service.BaseService
 
 	// config
-	config        *cfg.Config
-	genesisDoc    *types.GenesisDoc   // initial validator set
-	privValidator types.PrivValidator // local node's validator key
+	config               *cfg.Config
+	genesisDoc           *types.GenesisDoc   // initial validator set
+	privValidator        types.PrivValidator // local node's validator key
+	privValidatorClients []io.Closer         // clients of the gRPC or multi-signer remote signers
 
 	// network
-	transport   *p2p.MultiplexTransport
-	sw          *p2p.Switch  // p2p connections
-	addrBook    pex.AddrBook // known peers
-	nodeInfo    p2p.NodeInfo
-	nodeKey     *p2p.NodeKey // our node privkey
-	isListening bool
+	transport     *p2p.MultiplexTransport
+	quicTransport *p2p.QUICTransport // nil if QUIC is disabled
+	sw            *p2p.Switch        // p2p connections
+	addrBook      pex.AddrBook       // known peers
+	trustDB       dbm.DB             // trust history of the peers
+	nodeInfo      p2p.NodeInfo
+	nodeKey       *p2p.NodeKey // our node privkey
+	isListening   bool
 
 	// services
 	eventBus          *types.EventBus // pub/sub for services
@@ -252,6 +278,7 @@ type Node struct {
 	txIndexer         txindex.TxIndexer
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
+	pruner            *sm.Pruner // nil if pruning is disabled
 	prometheusSrv     *http.Server
 }
 
@@ -289,43 +316,47 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	return eventBus, nil
 }
 
-func createAndStartIndexerService(
+// CreateIndexers returns the transaction and block indexers configured by
+// config.TxIndex.
+func CreateIndexers(
 	config *cfg.Config,
 	chainID string,
 	dbProvider DBProvider,
-	eventBus *types.EventBus,
-	logger log.Logger,
-) (*txindex.IndexerService, txindex.TxIndexer, indexer.BlockIndexer, error) {
-
-	var (
-		txIndexer    txindex.TxIndexer
-		blockIndexer indexer.BlockIndexer
-	)
-
+) (txindex.TxIndexer, indexer.BlockIndexer, error) {
 	switch config.TxIndex.Indexer {
 	case "kv":
 		store, err := dbProvider(&DBContext{"tx_index", config})
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, err
 		}
 
-		txIndexer = kv.NewTxIndex(store)
-		blockIndexer = blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+		return kv.NewTxIndex(store), blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events"))), nil
 
 	case "psql":
 		if config.TxIndex.PsqlConn == "" {
-			return nil, nil, nil, errors.New(`no psql-conn is set for the "psql" indexer`)
+			return nil, nil, errors.New(`no psql-conn is set for the "psql" indexer`)
 		}
 		es, err := psql.NewEventSink(config.TxIndex.PsqlConn, chainID)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("creating psql indexer: %w", err)
+			return nil, nil, fmt.Errorf("creating psql indexer: %w", err)
 		}
-		txIndexer = es.TxIndexer()
-		blockIndexer = es.BlockIndexer()
+		return es.TxIndexer(), es.BlockIndexer(), nil
 
 	default:
-		txIndexer = &null.TxIndex{}
-		blockIndexer = &blockidxnull.BlockerIndexer{}
+		return &null.TxIndex{}, &blockidxnull.BlockerIndexer{}, nil
+	}
+}
+
+func createAndStartIndexerService(
+	config *cfg.Config,
+	chainID string,
+	dbProvider DBProvider,
+	eventBus *types.EventBus,
+	logger log.Logger,
+) (*txindex.IndexerService, txindex.TxIndexer, indexer.BlockIndexer, error) {
+	txIndexer, blockIndexer, err := CreateIndexers(config, chainID, dbProvider)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
@@ -392,16 +423,24 @@ func onlyValidatorIsUs(state sm.State, pubKey crypto.PubKey) bool {
 }
 
 func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
-	state sm.State, memplMetrics *mempl.Metrics, logger log.Logger) (*mempl.Reactor, *mempl.CListMempool) {
+	state sm.State, eventBus types.MempoolEventPublisher, memplMetrics *mempl.Metrics,
+	logger log.Logger) (*mempl.Reactor, mempl.GossipMempool, error) {
 
-	mempool := mempl.NewCListMempool(
-		config.Mempool,
-		proxyApp.Mempool(),
-		state.LastBlockHeight,
+	options := []mempl.CListMempoolOption{
 		mempl.WithMetrics(memplMetrics),
 		mempl.WithPreCheck(sm.TxPreCheck(state)),
 		mempl.WithPostCheck(sm.TxPostCheck(state)),
-	)
+	}
+	var mempool mempl.GossipMempool
+	switch config.Mempool.Version {
+	case "v0":
+		mempool = mempl.NewCListMempool(config.Mempool, proxyApp.Mempool(), state.LastBlockHeight, options...)
+	case "v1":
+		mempool = mempl.NewPriorityMempool(config.Mempool, proxyApp.Mempool(), state.LastBlockHeight, options...)
+	default:
+		return nil, nil, fmt.Errorf("unknown mempool version %s", config.Mempool.Version)
+	}
+	mempool.SetEventBus(eventBus)
 	mempoolLogger := logger.With("module", "mempool")
 	mempoolReactor := mempl.NewReactor(config.Mempool, config.P2P.RecvAsync, config.P2P.MempoolRecvBufSize, mempool)
 	mempoolReactor.SetLogger(mempoolLogger)
@@ -409,7 +448,7 @@ func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
 	if config.Consensus.WaitForTxs() {
 		mempool.EnableTxsAvailable()
 	}
-	return mempoolReactor, mempool
+	return mempoolReactor, mempool, nil
 }
 
 func createEvidenceReactor(config *cfg.Config, dbProvider DBProvider,
@@ -457,7 +496,7 @@ func createConsensusReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
 	blockStore sm.BlockStore,
-	mempool *mempl.CListMempool,
+	mempool mempl.Mempool,
 	evidencePool *evidence.Pool,
 	privValidator types.PrivValidator,
 	csMetrics *cs.Metrics,
@@ -489,33 +528,29 @@ func createConsensusReactor(config *cfg.Config,
 
 func createTransport(
 	config *cfg.Config,
+	mConnConfig conn.MConnConfig,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	proxyApp proxy.AppConns,
+	allowedPeerIDs []p2p.ID,
 ) (
 	*p2p.MultiplexTransport,
 	[]p2p.PeerFilterFunc,
 ) {
 	var (
-		mConnConfig = p2p.MConnConfig(config.P2P)
 		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
-		connFilters = []p2p.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
 
-	if !config.P2P.AllowDuplicateIP {
-		connFilters = append(connFilters, p2p.ConnDuplicateIPFilter())
-	}
-
-	// Filter peers by addr or pubkey with an ABCI query.
+	// Filter peers by pubkey with an ABCI query.
 	// If the query return code is OK, add peer.
 	if config.FilterPeers {
-		connFilters = append(
-			connFilters,
-			// ABCI query for address filtering.
-			func(_ p2p.ConnSet, c net.Conn, _ []net.IP) error {
+		peerFilters = append(
+			peerFilters,
+			// ABCI query for ID filtering.
+			func(_ p2p.IPeerSet, p p2p.Peer) error {
 				res, err := proxyApp.Query().QuerySync(abci.RequestQuery{
-					Path: fmt.Sprintf("/p2p/filter/addr/%s", c.RemoteAddr().String()),
+					Path: fmt.Sprintf("/p2p/filter/id/%s", p.ID()),
 				})
 				if err != nil {
 					return err
@@ -527,13 +562,76 @@ func createTransport(
 				return nil
 			},
 		)
+	}
 
-		peerFilters = append(
-			peerFilters,
-			// ABCI query for ID filtering.
-			func(_ p2p.IPeerSet, p p2p.Peer) error {
+	p2p.MultiplexTransportConnFilters(createConnFilters(config, proxyApp)...)(transport)
+	p2p.MultiplexTransportAllowedPeerIDs(allowedPeerIDs)(transport)
+
+	// Limit the number of incoming connections.
+	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
+
+	return transport, peerFilters
+}
+
+// createQUICTransport returns the QUIC transport listening on p2p.quic_laddr,
+// or nil if it's empty.
+func createQUICTransport(
+	config *cfg.Config,
+	mConnConfig conn.MConnConfig,
+	nodeInfo p2p.NodeInfo,
+	nodeKey *p2p.NodeKey,
+	proxyApp proxy.AppConns,
+	allowedPeerIDs []p2p.ID,
+) (*p2p.QUICTransport, error) {
+	if config.P2P.QUICListenAddress == "" {
+		return nil, nil
+	}
+
+	transport, err := p2p.NewQUICTransport(nodeInfo, *nodeKey, mConnConfig)
+	if err != nil {
+		return nil, err
+	}
+	p2p.QUICTransportConnFilters(createConnFilters(config, proxyApp)...)(transport)
+	p2p.QUICTransportAllowedPeerIDs(allowedPeerIDs)(transport)
+
+	return transport, nil
+}
+
+// createAllowedPeerIDs returns the IDs of the only peers to connect with: the
+// sentries in validator mode, or nil for any peer.
+func createAllowedPeerIDs(config *cfg.Config) ([]p2p.ID, error) {
+	if config.P2P.Mode != cfg.P2PModeValidator {
+		return nil, nil
+	}
+	ids := config.P2P.SentryPeerIDs()
+	allowedPeerIDs := make([]p2p.ID, 0, len(ids))
+	for _, id := range ids {
+		if err := p2p.ValidateID(p2p.ID(id)); err != nil {
+			return nil, fmt.Errorf("wrong sentry ID %q: %w", id, err)
+		}
+		allowedPeerIDs = append(allowedPeerIDs, p2p.ID(id))
+	}
+	return allowedPeerIDs, nil
+}
+
+// createConnFilters returns the filters of the connections of every transport.
+func createConnFilters(config *cfg.Config, proxyApp proxy.AppConns) []p2p.ConnFilterFunc {
+	connFilters := []p2p.ConnFilterFunc{}
+
+	if !config.P2P.AllowDuplicateIP {
+		connFilters = append(connFilters, p2p.ConnDuplicateIPFilter())
+	}
+
+	// Filter peers by addr with an ABCI query.
+	// If the query return code is OK, add peer.
+	if config.FilterPeers {
+		connFilters = append(
+			connFilters,
+			// ABCI query for address filtering.
+			func(_ p2p.ConnSet, c net.Conn, _ []net.IP) error {
 				res, err := proxyApp.Query().QuerySync(abci.RequestQuery{
-					Path: fmt.Sprintf("/p2p/filter/id/%s", p.ID()),
+					Path: fmt.Sprintf("/p2p/filter/addr/%s", c.RemoteAddr().String()),
 				})
 				if err != nil {
 					return err
@@ -547,13 +645,7 @@ func createTransport(
 		)
 	}
 
-	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
-
-	// Limit the number of incoming connections.
-	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
-	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
-
-	return transport, peerFilters
+	return connFilters
 }
 
 func createSwitch(config *cfg.Config,
@@ -565,6 +657,7 @@ func createSwitch(config *cfg.Config,
 	stateSyncReactor *statesync.Reactor,
 	consensusReactor *cs.Reactor,
 	evidenceReactor *evidence.Reactor,
+	trustMetricStore *trust.MetricStore,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	p2pLogger log.Logger) *p2p.Switch {
@@ -574,6 +667,7 @@ func createSwitch(config *cfg.Config,
 		transport,
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
+		p2p.SwitchTrustMetricStore(trustMetricStore),
 	)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
@@ -589,10 +683,30 @@ func createSwitch(config *cfg.Config,
 	return sw
 }
 
-func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
+func createTrustMetricStore(config *cfg.Config, dbProvider DBProvider,
+	p2pLogger log.Logger) (*trust.MetricStore, dbm.DB, error) {
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, nil, err
+	}
+	trustMetricStore := trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+	trustMetricStore.SetLogger(p2pLogger)
+	return trustMetricStore, trustHistoryDB, nil
+}
+
+func createAddrBookAndSetOnSwitch(config *cfg.Config, dbProvider DBProvider, sw *p2p.Switch,
 	p2pLogger log.Logger, nodeKey *p2p.NodeKey) (pex.AddrBook, error) {
 
-	addrBook := pex.NewAddrBook(config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
+	var addrBook pex.AddrBook
+	if config.P2P.AddrBookDB {
+		addrBookDB, err := dbProvider(&DBContext{"addrbook", config})
+		if err != nil {
+			return nil, err
+		}
+		addrBook = pex.NewDBAddrBook(addrBookDB, config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
+	} else {
+		addrBook = pex.NewAddrBook(config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
+	}
 	addrBook.SetLogger(p2pLogger.With("book", config.P2P.AddrBookFile()))
 
 	// Add ourselves to addrbook to prevent dialing ourselves
@@ -610,6 +724,13 @@ func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
 		}
 		addrBook.AddOurAddress(addr)
 	}
+	if config.P2P.QUICListenAddress != "" {
+		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.P2P.QUICListenAddress))
+		if err != nil {
+			return nil, fmt.Errorf("p2p.quic_laddr is incorrect: %w", err)
+		}
+		addrBook.AddOurAddress(addr)
+	}
 
 	sw.SetAddrBook(addrBook)
 
@@ -623,8 +744,9 @@ func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
 	pexReactor := pex.NewReactor(addrBook,
 		config.P2P.RecvAsync,
 		&pex.ReactorConfig{
-			Seeds:    splitAndTrimEmpty(config.P2P.Seeds, ",", " "),
-			SeedMode: config.P2P.SeedMode,
+			Seeds:      splitAndTrimEmpty(config.P2P.Seeds, ",", " "),
+			SeedMode:   config.P2P.SeedMode || config.P2P.Mode == cfg.P2PModeSeed,
+			SentryMode: config.P2P.Mode == cfg.P2PModeSentry,
 			// See consensus/reactor.go: blocksToContributeToBecomeGoodPeer 10000
 			// blocks assuming 10s blocks ~ 28 hours.
 			// TODO (melekes): make it dynamic based on the actual block latencies
@@ -747,12 +869,28 @@ func NewNode(config *cfg.Config,
 	}
 
 	// If an address is provided, listen on the socket for a connection from an
-	// external signing process.
-	if config.PrivValidatorListenAddr != "" {
-		// FIXME: we should start services inside OnStart
-		privValidator, err = CreateAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, genDoc.ChainID, logger)
+	// external signing process, or connect to it over gRPC.
+	// Several addresses make a multi-signer cluster.
+	var privValidatorClients []io.Closer
+	if config.PrivValidatorSignerAddrs != "" {
+		privValidator, privValidatorClients, err = CreatePrivValidatorMultiSigner(config.BaseConfig, genDoc.ChainID, logger)
 		if err != nil {
-			return nil, fmt.Errorf("error with private validator socket client: %w", err)
+			return nil, fmt.Errorf("error with private validator multi-signer: %w", err)
+		}
+	} else if config.PrivValidatorListenAddr != "" {
+		protocol, _ := tmnet.ProtocolAndAddress(config.PrivValidatorListenAddr)
+		if protocol == "grpc" {
+			pvsc, err := CreatePrivValidatorGRPCClient(config.BaseConfig, genDoc.ChainID, logger)
+			if err != nil {
+				return nil, fmt.Errorf("error with private validator grpc client: %w", err)
+			}
+			privValidator, privValidatorClients = pvsc, []io.Closer{pvsc}
+		} else {
+			// FIXME: we should start services inside OnStart
+			privValidator, err = CreateAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, genDoc.ChainID, logger)
+			if err != nil {
+				return nil, fmt.Errorf("error with private validator socket client: %w", err)
+			}
 		}
 	}
 
@@ -794,7 +932,10 @@ func NewNode(config *cfg.Config,
 	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
 
 	// Make MempoolReactor
-	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	mempoolReactor, mempool, err := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, memplMetrics, logger)
+	if err != nil {
+		return nil, err
+	}
 
 	// Make Evidence Reactor
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, blockStore, logger)
@@ -812,6 +953,14 @@ func NewNode(config *cfg.Config,
 		sm.BlockExecutorWithMetrics(smMetrics),
 	)
 
+	// Make the pruner of the blocks, states and indexers, if enabled
+	var pruner *sm.Pruner
+	if config.Pruning.Enabled() {
+		pruner = sm.NewPruner(config.Pruning, stateStore, blockStore, txIndexer, blockIndexer,
+			sm.PrunerWithMetrics(smMetrics))
+		pruner.SetLogger(logger.With("module", "pruner"))
+	}
+
 	// Make BlockchainReactor. Don't start fast sync if we're doing a state sync first.
 	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync && !stateSync, logger)
 	if err != nil {
@@ -848,13 +997,35 @@ func NewNode(config *cfg.Config,
 	}
 
 	// Setup Transport.
-	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
+	// Both transports share the bandwidth budgets of the MConnConfig.
+	// In validator mode, both only connect with the sentries.
+	mConnConfig := p2p.MConnConfig(config.P2P)
+	allowedPeerIDs, err := createAllowedPeerIDs(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p2p topology: %w", err)
+	}
+	transport, peerFilters := createTransport(config, mConnConfig, nodeInfo, nodeKey, proxyApp, allowedPeerIDs)
+	quicTransport, err := createQUICTransport(config, mConnConfig, nodeInfo, nodeKey, proxyApp, allowedPeerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("could not create QUIC transport: %w", err)
+	}
+	var swTransport p2p.Transport = transport
+	if quicTransport != nil {
+		swTransport = p2p.NewMultiProtocolTransport(map[string]p2p.Transport{
+			p2p.ProtocolTCP:  transport,
+			p2p.ProtocolQUIC: quicTransport,
+		})
+	}
 
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
+	trustMetricStore, trustDB, err := createTrustMetricStore(config, dbProvider, p2pLogger)
+	if err != nil {
+		return nil, err
+	}
 	sw := createSwitch(
-		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
-		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
+		config, swTransport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
+		stateSyncReactor, consensusReactor, evidenceReactor, trustMetricStore, nodeInfo, nodeKey, p2pLogger,
 	)
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
@@ -867,7 +1038,7 @@ func NewNode(config *cfg.Config,
 		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
 	}
 
-	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, p2pLogger, nodeKey)
+	addrBook, err := createAddrBookAndSetOnSwitch(config, dbProvider, sw, p2pLogger, nodeKey)
 	if err != nil {
 		return nil, fmt.Errorf("could not create addrbook: %w", err)
 	}
@@ -897,15 +1068,18 @@ func NewNode(config *cfg.Config,
 	}
 
 	node := &Node{
-		config:        config,
-		genesisDoc:    genDoc,
-		privValidator: privValidator,
-
-		transport: transport,
-		sw:        sw,
-		addrBook:  addrBook,
-		nodeInfo:  nodeInfo,
-		nodeKey:   nodeKey,
+		config:               config,
+		genesisDoc:           genDoc,
+		privValidator:        privValidator,
+		privValidatorClients: privValidatorClients,
+
+		transport:     transport,
+		quicTransport: quicTransport,
+		sw:            sw,
+		addrBook:      addrBook,
+		trustDB:       trustDB,
+		nodeInfo:      nodeInfo,
+		nodeKey:       nodeKey,
 
 		stateStore:       stateStore,
 		blockStore:       blockStore,
@@ -923,6 +1097,7 @@ func NewNode(config *cfg.Config,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
 		blockIndexer:     blockIndexer,
+		pruner:           pruner,
 		eventBus:         eventBus,
 	}
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
@@ -946,6 +1121,13 @@ func (n *Node) OnStart() error {
 	// Add private IDs to addrbook to block those peers being added
 	n.addrBook.AddPrivateIDs(splitAndTrimEmpty(n.config.P2P.PrivatePeerIDs, ",", " "))
 
+	// Start the addrbook here rather than in the PEX reactor, which may be
+	// disabled, so that the switch knows the banned peers and the addrbook is
+	// closed on stop.
+	if err := n.addrBook.Start(); err != nil {
+		return fmt.Errorf("could not start addrbook: %w", err)
+	}
+
 	// Start the RPC server before the P2P server
 	// so we can eg. receive txs for the first block
 	if n.config.RPC.ListenAddress != "" {
@@ -969,6 +1151,15 @@ func (n *Node) OnStart() error {
 	if err := n.transport.Listen(*addr); err != nil {
 		return err
 	}
+	if n.quicTransport != nil {
+		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(n.nodeKey.ID(), n.config.P2P.QUICListenAddress))
+		if err != nil {
+			return err
+		}
+		if err := n.quicTransport.Listen(*addr); err != nil {
+			return err
+		}
+	}
 
 	n.isListening = true
 
@@ -991,6 +1182,12 @@ func (n *Node) OnStart() error {
 		return fmt.Errorf("could not dial peers from persistent_peers field: %w", err)
 	}
 
+	if n.pruner != nil {
+		if err := n.pruner.Start(); err != nil {
+			return fmt.Errorf("failed to start pruner: %w", err)
+		}
+	}
+
 	// Run state sync
 	if n.stateSync {
 		bcR, ok := n.bcReactor.(fastSyncReactor)
@@ -1020,12 +1217,27 @@ func (n *Node) OnStop() {
 	if err := n.indexerService.Stop(); err != nil {
 		n.Logger.Error("Error closing indexerService", "err", err)
 	}
+	if n.pruner != nil {
+		if err := n.pruner.Stop(); err != nil {
+			n.Logger.Error("Error closing pruner", "err", err)
+		}
+	}
 
 	// now stop the reactors
 	if err := n.sw.Stop(); err != nil {
 		n.Logger.Error("Error closing switch", "err", err)
 	}
 
+	// the PEX reactor stops the addrbook, if enabled
+	if err := n.addrBook.Stop(); err != nil && err != service.ErrAlreadyStopped {
+		n.Logger.Error("Error closing addrbook", "err", err)
+	}
+
+	// the switch stops the trust metric store, which saves to its DB on stop
+	if err := n.trustDB.Close(); err != nil {
+		n.Logger.Error("Error closing trust history DB", "err", err)
+	}
+
 	// stop mempool WAL
 	if n.config.Mempool.WalEnabled() {
 		n.mempool.CloseWAL()
@@ -1034,6 +1246,11 @@ func (n *Node) OnStop() {
 	if err := n.transport.Close(); err != nil {
 		n.Logger.Error("Error closing transport", "err", err)
 	}
+	if n.quicTransport != nil {
+		if err := n.quicTransport.Close(); err != nil {
+			n.Logger.Error("Error closing QUIC transport", "err", err)
+		}
+	}
 
 	n.isListening = false
 
@@ -1050,6 +1267,11 @@ func (n *Node) OnStop() {
 			n.Logger.Error("Error closing private validator", "err", err)
 		}
 	}
+	for _, client := range n.privValidatorClients {
+		if err := client.Close(); err != nil {
+			n.Logger.Error("Error closing private validator client", "err", err)
+		}
+	}
 
 	if n.prometheusSrv != nil {
 		if err := n.prometheusSrv.Shutdown(context.Background()); err != nil {
@@ -1085,6 +1307,7 @@ func (n *Node) ConfigureRPC() error {
 		ConsensusState: n.consensusState,
 		P2PPeers:       n.sw,
 		P2PTransport:   n,
+		AddrBook:       n.addrBook,
 
 		PubKey:           pubKey,
 		GenDoc:           n.genesisDoc,
@@ -1488,6 +1711,83 @@ func CreateAndStartPrivValidatorSocketClient(
 	return pvscWithRetries, nil
 }
 
+// CreatePrivValidatorGRPCClient connects to the remote signer at the grpc://
+// address priv_validator_laddr. The caller must close the client.
+func CreatePrivValidatorGRPCClient(
+	config cfg.BaseConfig,
+	chainID string,
+	logger log.Logger,
+) (*tmgrpc.SignerClient, error) {
+	pvsc, err := tmgrpc.DialRemoteSigner(config, chainID, logger.With("module", "privval"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start private validator: %w", err)
+	}
+
+	// try to get a pubkey from private validate first time
+	_, err = pvsc.GetPubKey()
+	if err != nil {
+		_ = pvsc.Close()
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	return pvsc, nil
+}
+
+// CreatePrivValidatorMultiSigner connects to the signer nodes at
+// priv_validator_signer_laddrs, dialing the grpc:// ones and listening for the
+// others to connect, and returns a MultiSignerPV releasing the signatures
+// priv_validator_signer_threshold of them agree on, along with the clients of
+// the nodes for the caller to close. Unlike a single remote signer, a node
+// that can't be reached is tolerated as long as the others make a quorum.
+func CreatePrivValidatorMultiSigner(
+	config cfg.BaseConfig,
+	chainID string,
+	logger log.Logger,
+) (*privval.MultiSignerPV, []io.Closer, error) {
+	var (
+		nodes   []types.PrivValidator
+		clients []io.Closer
+	)
+	closeClients := func() {
+		for _, client := range clients {
+			_ = client.Close()
+		}
+	}
+
+	for _, addr := range config.PrivValidatorSignerAddresses() {
+		if protocol, _ := tmnet.ProtocolAndAddress(addr); protocol == "grpc" {
+			nodeConfig := config
+			nodeConfig.PrivValidatorListenAddr = addr
+			pvsc, err := tmgrpc.DialRemoteSigner(nodeConfig, chainID, logger.With("module", "privval", "signer", addr))
+			if err != nil {
+				closeClients()
+				return nil, nil, fmt.Errorf("failed to connect to signer node %s: %w", addr, err)
+			}
+			nodes, clients = append(nodes, pvsc), append(clients, pvsc)
+			continue
+		}
+
+		pve, err := privval.NewSignerListener(addr, logger.With("signer", addr))
+		if err != nil {
+			closeClients()
+			return nil, nil, fmt.Errorf("failed to listen for signer node %s: %w", addr, err)
+		}
+		pvsc, err := privval.NewSignerClient(pve, chainID)
+		if err != nil {
+			closeClients()
+			return nil, nil, fmt.Errorf("failed to listen for signer node %s: %w", addr, err)
+		}
+		nodes, clients = append(nodes, pvsc), append(clients, pvsc)
+	}
+
+	pv, err := privval.NewMultiSignerPV(nodes, config.PrivValidatorSignerThreshold)
+	if err != nil {
+		closeClients()
+		return nil, nil, err
+	}
+	return pv, clients, nil
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
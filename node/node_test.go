@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
@@ -117,6 +118,29 @@ func TestSplitAndTrimEmpty(t *testing.T) {
 	}
 }
 
+func TestCreateAllowedPeerIDs(t *testing.T) {
+	config := cfg.ResetTestRoot("node_allowed_peer_ids_test")
+	defer os.RemoveAll(config.RootDir)
+
+	config.P2P.PersistentPeers = "d51fb70907db1c6c2d5237e78379b25cf1a37ab4@1.2.3.4:26656"
+	config.P2P.UnconditionalPeerIDs = "f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4"
+	ids, err := createAllowedPeerIDs(config)
+	require.NoError(t, err)
+	assert.Nil(t, ids, "any peer is allowed out of validator mode")
+
+	config.P2P.Mode = cfg.P2PModeValidator
+	ids, err = createAllowedPeerIDs(config)
+	require.NoError(t, err)
+	assert.Equal(t, []p2p.ID{
+		"d51fb70907db1c6c2d5237e78379b25cf1a37ab4",
+		"f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4",
+	}, ids)
+
+	config.P2P.UnconditionalPeerIDs = "sentry"
+	_, err = createAllowedPeerIDs(config)
+	assert.Error(t, err)
+}
+
 func TestNodeDelayedStart(t *testing.T) {
 	config := cfg.ResetTestRoot("node_delayed_start_test")
 	defer os.RemoveAll(config.RootDir)
@@ -188,6 +212,48 @@ func TestNodeSetPrivValTCP(t *testing.T) {
 	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
 }
 
+func TestNodeSetPrivValMultiSigner(t *testing.T) {
+	config := cfg.ResetTestRoot("node_priv_val_multi_signer_test")
+	defer os.RemoveAll(config.RootDir)
+
+	// Only two of the three signer nodes are up, which makes a quorum.
+	privKey := ed25519.GenPrivKey()
+	addrs := make([]string, 3)
+	for i := range addrs {
+		addrs[i] = "tcp://" + testFreeAddr(t)
+		if i == 2 {
+			continue
+		}
+		dialerEndpoint := privval.NewSignerDialerEndpoint(
+			log.TestingLogger(),
+			privval.DialTCPFn(addrs[i], 100*time.Millisecond, ed25519.GenPrivKey()),
+		)
+		privval.SignerDialerEndpointTimeoutReadWrite(100 * time.Millisecond)(dialerEndpoint)
+		signerServer := privval.NewSignerServer(
+			dialerEndpoint,
+			config.ChainID(),
+			types.NewMockPVWithParams(privKey, false, false),
+		)
+		go func() {
+			err := signerServer.Start()
+			if err != nil {
+				panic(err)
+			}
+		}()
+		defer signerServer.Stop() //nolint:errcheck // ignore for tests
+	}
+	config.BaseConfig.PrivValidatorSignerAddrs = strings.Join(addrs, ",")
+	config.BaseConfig.PrivValidatorSignerThreshold = 2
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &privval.MultiSignerPV{}, n.PrivValidator())
+	pubKey, err := n.PrivValidator().GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+	assert.Len(t, n.privValidatorClients, 3)
+}
+
 // address without a protocol must result in error
 func TestPrivValidatorListenAddrNoProtocol(t *testing.T) {
 	addrNoPrefix := testFreeAddr(t)
@@ -314,12 +380,12 @@ func TestCreateProposalBlock(t *testing.T) {
 		evidencePool,
 	)
 
-	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
+	extCommit := &types.ExtendedCommit{Height: height - 1}
 	message := state.MakeHashMessage(0)
 	proof, _ := privVals[0].GenerateVRFProof(message)
 	block, _ := blockExec.CreateProposalBlock(
 		height,
-		state, commit,
+		state, extCommit,
 		proposerAddr,
 		0,
 		proof,
@@ -387,12 +453,12 @@ func TestMaxProposalBlockSize(t *testing.T) {
 		sm.EmptyEvidencePool{},
 	)
 
-	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
+	extCommit := &types.ExtendedCommit{Height: height - 1}
 	message := state.MakeHashMessage(0)
 	proof, _ := privVals[0].GenerateVRFProof(message)
 	block, _ := blockExec.CreateProposalBlock(
 		height,
-		state, commit,
+		state, extCommit,
 		proposerAddr,
 		0,
 		proof,
@@ -455,6 +521,30 @@ func TestNodeNewNodeCustomReactors(t *testing.T) {
 	assert.Contains(t, channels, cr.Channels[0].ID)
 }
 
+func TestReadOnlyDBProvider(t *testing.T) {
+	config := cfg.ResetTestRoot("node_read_only_db_provider_test")
+	defer os.RemoveAll(config.RootDir)
+
+	// the database must exist to be opened read-only
+	config.DBBackend = string(dbm.GoLevelDBBackend)
+	db, err := DefaultDBProvider(&DBContext{"state", config})
+	require.NoError(t, err)
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+	require.NoError(t, db.Close())
+
+	db, err = ReadOnlyDBProvider(&DBContext{"state", config})
+	require.NoError(t, err)
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+	assert.Error(t, db.Set([]byte("key"), []byte("other")))
+	require.NoError(t, db.Close())
+
+	config.DBBackend = string(dbm.MemDBBackend)
+	_, err = ReadOnlyDBProvider(&DBContext{"state", config})
+	assert.Error(t, err)
+}
+
 func TestNodeNewNodeTxIndexIndexer(t *testing.T) {
 	config := cfg.ResetTestRoot("node_new_node_tx_index_indexer_test")
 	defer os.RemoveAll(config.RootDir)
@@ -637,7 +727,7 @@ func NewInvalidNode(config *cfg.Config,
 		return nil, err
 	}
 
-	transport, _ := createTransport(config, &p2pmocks.NodeInfo{}, nodeKey, n.proxyApp)
+	transport, _ := createTransport(config, p2p.MConnConfig(config.P2P), &p2pmocks.NodeInfo{}, nodeKey, n.proxyApp, nil)
 	n.transport = transport
 
 	for _, option := range options {
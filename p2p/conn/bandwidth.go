@@ -0,0 +1,137 @@
+package conn
+
+import (
+	"time"
+
+	flow "github.com/line/ostracon/libs/flowrate"
+	tmsync "github.com/line/ostracon/libs/sync"
+)
+
+// rateLimiterBurst is how long a RateLimiter may save up its allowance for.
+const rateLimiterBurst = 100 * time.Millisecond
+
+// RateLimiter limits the rate of a flow of transfers, letting a transfer go
+// over the allowance and the next ones make up for it, so that the rate holds
+// whatever the size of the transfers. Unlike flowrate.Monitor.Limit, it is
+// thus fit for rates below a packet per sample.
+//
+// The methods of a nil limiter are no-ops, so that it's unlimited.
+type RateLimiter struct {
+	mtx       tmsync.Mutex
+	rate      float64 // bytes/second
+	allowance float64 // bytes, negative when going over the rate
+	updated   time.Time
+}
+
+// NewRateLimiter returns a limiter of rate bytes/second, or nil if rate is not
+// positive.
+func NewRateLimiter(rate int64) *RateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &RateLimiter{
+		rate:      float64(rate),
+		allowance: float64(rate) * rateLimiterBurst.Seconds(),
+		updated:   time.Now(),
+	}
+}
+
+// Delay returns how long to wait before the next transfer, zero if it may go
+// now.
+func (l *RateLimiter) Delay() time.Duration {
+	if l == nil {
+		return 0
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.refill()
+	if l.allowance > 0 {
+		return 0
+	}
+	return time.Duration((-l.allowance + 1) / l.rate * float64(time.Second))
+}
+
+// Wait blocks until the next transfer may go, or quit is closed.
+func (l *RateLimiter) Wait(quit <-chan struct{}) {
+	d := l.Delay()
+	if d == 0 {
+		return
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-quit:
+	}
+}
+
+// Update records the transfer of n bytes.
+func (l *RateLimiter) Update(n int) {
+	if l == nil {
+		return
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.refill()
+	l.allowance -= float64(n)
+}
+
+// refill adds the allowance earned since the last update.
+// The mutex must be held.
+func (l *RateLimiter) refill() {
+	now := time.Now()
+	l.allowance += now.Sub(l.updated).Seconds() * l.rate
+	l.updated = now
+	if max := l.rate * rateLimiterBurst.Seconds(); l.allowance > max {
+		l.allowance = max
+	}
+}
+
+// BandwidthBudget is a rate limit shared by several connections, e.g. all the
+// peers of a node. The methods of a nil budget are no-ops, so that it's
+// unlimited.
+type BandwidthBudget struct {
+	limiter *RateLimiter
+	monitor *flow.Monitor
+}
+
+// NewBandwidthBudget returns a budget of rate bytes/second, or nil if rate is
+// not positive.
+func NewBandwidthBudget(rate int64) *BandwidthBudget {
+	if rate <= 0 {
+		return nil
+	}
+	return &BandwidthBudget{
+		limiter: NewRateLimiter(rate),
+		monitor: flow.New(0, 0),
+	}
+}
+
+// Wait blocks until a transfer may go without going over the budget, or quit is
+// closed.
+func (b *BandwidthBudget) Wait(quit <-chan struct{}) {
+	if b == nil {
+		return
+	}
+	b.limiter.Wait(quit)
+}
+
+// Update records the transfer of n bytes.
+func (b *BandwidthBudget) Update(n int) {
+	if b == nil {
+		return
+	}
+	b.limiter.Update(n)
+	b.monitor.Update(n)
+}
+
+// Status returns the status of the transfers of all the connections sharing
+// the budget.
+func (b *BandwidthBudget) Status() flow.Status {
+	if b == nil {
+		return flow.Status{}
+	}
+	return b.monitor.Status()
+}
@@ -0,0 +1,59 @@
+package conn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterNilIsUnlimited(t *testing.T) {
+	l := NewRateLimiter(0)
+	require.Nil(t, l)
+	l.Update(1 << 30)
+	assert.Zero(t, l.Delay())
+	l.Wait(nil)
+
+	b := NewBandwidthBudget(-1)
+	require.Nil(t, b)
+	b.Update(1 << 30)
+	b.Wait(nil)
+	assert.Zero(t, b.Status().Bytes)
+}
+
+func TestRateLimiterAmortizesTransfers(t *testing.T) {
+	l := NewRateLimiter(10000)
+	assert.Zero(t, l.Delay())
+
+	// a transfer of a second worth of rate delays the next one for a second,
+	// less the allowance saved up
+	l.Update(10000)
+	d := l.Delay()
+	assert.True(t, d > 800*time.Millisecond && d < time.Second, d)
+
+	quit := make(chan struct{})
+	close(quit)
+	start := time.Now()
+	l.Wait(quit)
+	assert.True(t, time.Since(start) < 100*time.Millisecond)
+
+	// the allowance saved up by an idle limiter is bounded
+	l = NewRateLimiter(100000)
+	time.Sleep(200 * time.Millisecond)
+	l.Update(20000)
+	assert.NotZero(t, l.Delay())
+}
+
+func TestBandwidthBudgetIsShared(t *testing.T) {
+	// the connections sharing the budget use up its allowance together
+	b := NewBandwidthBudget(10000)
+	b.Update(600)
+	assert.Zero(t, b.limiter.Delay())
+	b.Update(2400)
+	assert.NotZero(t, b.limiter.Delay())
+
+	start := time.Now()
+	b.Wait(nil)
+	assert.True(t, time.Since(start) > 100*time.Millisecond)
+}
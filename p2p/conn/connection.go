@@ -63,6 +63,7 @@ The byte id and the relative priorities of each `Channel` are configured upon
 initialization of the connection.
 
 There are two methods for sending messages:
+
 	func (m MConnection) Send(chID byte, msgBytes []byte) bool {}
 	func (m MConnection) TrySend(chID byte, msgBytes []byte}) bool {}
 
@@ -73,6 +74,12 @@ request times out.  The message `msg` is serialized using Protobuf.
 `TrySend(chID, msgBytes)` is a nonblocking call that returns false if the
 channel's queue is full.
 
+Channels with something to send are served by deficit round-robin, each
+sending up to its priority times the maximum payload size in its turn. A channel may also be given send and receive rates of its own, under
+those of the connection: once over its send rate, it makes way for the others,
+and once over its receive rate, the connection is not read until it is back
+under it.
+
 Inbound message bytes are handled with an onReceive callback function.
 */
 type MConnection struct {
@@ -113,6 +120,8 @@ type MConnection struct {
 
 	chStatsTimer *time.Ticker // update channel stats periodically
 
+	sendTurn int // index of the channel whose turn it is to send
+
 	created time.Time // time of creation
 
 	_maxPacketMsgSize int
@@ -137,6 +146,15 @@ type MConnConfig struct {
 
 	// Action method of reactor's receive function
 	RecvAsync bool `mapstructure:"recv_async"`
+
+	// Rates at which packets can be sent and received on a channel, by channel
+	// ID, in bytes/second. They override those of the ChannelDescriptors.
+	ChannelSendRates map[byte]int64 `mapstructure:"-"`
+	ChannelRecvRates map[byte]int64 `mapstructure:"-"`
+
+	// Bandwidth budgets shared with the other connections of the node
+	SendBudget *BandwidthBudget `mapstructure:"-"`
+	RecvBudget *BandwidthBudget `mapstructure:"-"`
 }
 
 // DefaultMConnConfig returns the default config.
@@ -152,6 +170,19 @@ func DefaultMConnConfig() MConnConfig {
 	}
 }
 
+// FillChannelDescriptor returns the descriptor with its defaults filled and
+// its rates overridden by the config.
+func (cfg MConnConfig) FillChannelDescriptor(desc ChannelDescriptor) ChannelDescriptor {
+	desc = desc.FillDefaults()
+	if rate, ok := cfg.ChannelSendRates[desc.ID]; ok {
+		desc.SendRate = rate
+	}
+	if rate, ok := cfg.ChannelRecvRates[desc.ID]; ok {
+		desc.RecvRate = rate
+	}
+	return desc
+}
+
 // NewMConnection wraps net.Conn and creates multiplex connection
 func NewMConnection(
 	conn net.Conn,
@@ -450,6 +481,7 @@ FOR_LOOP:
 				break SELECTION
 			}
 			c.sendMonitor.Update(_n)
+			c.config.SendBudget.Update(_n)
 			c.Logger.Debug("Starting pong timer", "dur", c.config.PongTimeout)
 			c.pongTimer = time.AfterFunc(c.config.PongTimeout, func() {
 				select {
@@ -473,6 +505,7 @@ FOR_LOOP:
 				break SELECTION
 			}
 			c.sendMonitor.Update(_n)
+			c.config.SendBudget.Update(_n)
 			c.flush()
 		case <-c.quitSendRoutine:
 			break FOR_LOOP
@@ -504,12 +537,13 @@ FOR_LOOP:
 }
 
 // Returns true if messages from channels were exhausted.
-// Blocks in accordance to .sendMonitor throttling.
+// Blocks in accordance to .sendMonitor and .config.SendBudget throttling.
 func (c *MConnection) sendSomePacketMsgs() bool {
-	// Block until .sendMonitor says we can write.
+	// Block until .sendMonitor and the budget of the node say we can write.
 	// Once we're ready we send more than we asked for,
 	// but amortized it should even out.
 	c.sendMonitor.Limit(c._maxPacketMsgSize, atomic.LoadInt64(&c.config.SendRate), true)
+	c.config.SendBudget.Wait(c.quitSendRoutine)
 
 	// Now send some PacketMsgs.
 	for i := 0; i < numBatchPacketMsgs; i++ {
@@ -523,40 +557,81 @@ func (c *MConnection) sendSomePacketMsgs() bool {
 // Returns true if messages from channels were exhausted.
 func (c *MConnection) sendPacketMsg() bool {
 	// Choose a channel to create a PacketMsg from.
-	// The chosen channel will be the one whose recentlySent/priority is the least.
-	var leastRatio float32 = math.MaxFloat32
-	var leastChannel *Channel
-	for _, channel := range c.channels {
-		// If nothing to send, skip this channel
-		if !channel.isSendPending() {
-			continue
-		}
-		// Get ratio, and keep track of lowest ratio.
-		ratio := float32(channel.recentlySent) / float32(channel.desc.Priority)
-		if ratio < leastRatio {
-			leastRatio = ratio
-			leastChannel = channel
-		}
-	}
+	// The channels with something to send take turns, each sending in proportion
+	// to its priority, among those which haven't used up their send rate.
+	channel, throttled := c.nextSendChannel()
 
 	// Nothing to send?
-	if leastChannel == nil {
+	if channel == nil {
 		return true
 	}
+	// Every channel with something to send is throttled:
+	// flush what was sent so far, and block until the one to be served first
+	// can send again.
+	if throttled {
+		c.flush()
+		channel.sendLimiter.Wait(c.quitSendRoutine)
+	}
 	// c.Logger.Info("Found a msgPacket to send")
 
 	// Make & send a PacketMsg from this channel
-	_n, err := leastChannel.writePacketMsgTo(c.bufConnWriter)
+	channel.deficit -= channel.nextPacketMsgPayloadSize()
+	_n, err := channel.writePacketMsgTo(c.bufConnWriter)
 	if err != nil {
 		c.Logger.Error("Failed to write PacketMsg", "err", err)
 		c.stopForError(err)
 		return true
 	}
 	c.sendMonitor.Update(_n)
+	c.config.SendBudget.Update(_n)
 	c.flushTimer.Set()
 	return false
 }
 
+// nextSendChannel returns the channel whose turn it is to send, by deficit
+// round-robin: in its turn, a channel sends while its deficit covers the
+// payload of its next packet, and the next channel gets a quantum of its
+// priority times the maximum payload size added to its deficit. The channels
+// with nothing to send and those over their send rate give up their turn.
+// If every channel with something to send is over its send rate, it returns
+// the one which may send again first and throttled is true. It returns nil if
+// no channel has anything to send.
+func (c *MConnection) nextSendChannel() (ch *Channel, throttled bool) {
+	var (
+		leastDelay time.Duration = math.MaxInt64
+		leastTurn                = -1
+	)
+	// going round once more brings back the first channel with a new quantum
+	for i := 0; i <= len(c.channels); i++ {
+		channel := c.channels[c.sendTurn]
+		if channel.isSendPending() {
+			delay := channel.sendLimiter.Delay()
+			if delay == 0 && channel.deficit >= channel.nextPacketMsgPayloadSize() {
+				return channel, false
+			}
+			if delay > 0 {
+				channel.deficit = 0
+				if delay < leastDelay {
+					leastDelay, leastTurn = delay, c.sendTurn
+				}
+			}
+		} else {
+			// an idle channel doesn't save up its quantum
+			channel.deficit = 0
+		}
+		c.sendTurn = (c.sendTurn + 1) % len(c.channels)
+		c.channels[c.sendTurn].deficit += c.channels[c.sendTurn].quantum()
+	}
+	if leastTurn < 0 {
+		return nil, false
+	}
+
+	c.sendTurn = leastTurn
+	ch = c.channels[leastTurn]
+	ch.deficit = ch.quantum()
+	return ch, true
+}
+
 // recvRoutine reads PacketMsgs and reconstructs the message using the channels' "recving" buffer.
 // After a whole message has been assembled, it's pushed to onReceive().
 // Blocks depending on how the connection is throttled.
@@ -568,8 +643,9 @@ func (c *MConnection) recvRoutine() {
 
 FOR_LOOP:
 	for {
-		// Block until .recvMonitor says we can read.
+		// Block until .recvMonitor and the budget of the node say we can read.
 		c.recvMonitor.Limit(c._maxPacketMsgSize, atomic.LoadInt64(&c.config.RecvRate), true)
+		c.config.RecvBudget.Wait(c.quitRecvRoutine)
 
 		// Peek into bufConnReader for debugging
 		/*
@@ -590,6 +666,7 @@ FOR_LOOP:
 
 		_n, err := protoReader.ReadMsg(&packet)
 		c.recvMonitor.Update(_n)
+		c.config.RecvBudget.Update(_n)
 		if err != nil {
 			// stopServices was invoked and we are shutting down
 			// receiving is excpected to fail since we will close the connection
@@ -651,6 +728,15 @@ FOR_LOOP:
 				// NOTE: This means the reactor.Receive runs in the same thread as the p2p recv routine
 				c.onReceive(channelID, msgBytes)
 			}
+
+			// Stop reading the connection until the channel is back under its
+			// receive rate. The reactors never resend a message, so it must not
+			// be dropped; the sender is held up instead, as by recvMonitor.
+			// NOTE: This throttles the other channels as well, there is no reading
+			// of a single channel of the connection.
+			channel.recvMonitor.Update(_n)
+			channel.recvLimiter.Update(_n)
+			channel.recvLimiter.Wait(c.quitRecvRoutine)
 		default:
 			err := fmt.Errorf("unknown message type %v", reflect.TypeOf(packet))
 			c.Logger.Error("Connection failed @ recvRoutine", "conn", c, "err", err)
@@ -700,6 +786,8 @@ type ChannelStatus struct {
 	SendQueueSize     int
 	Priority          int
 	RecentlySent      int64
+	SendMonitor       flow.Status
+	RecvMonitor       flow.Status
 }
 
 func (c *MConnection) Status() ConnectionStatus {
@@ -715,6 +803,8 @@ func (c *MConnection) Status() ConnectionStatus {
 			SendQueueSize:     int(atomic.LoadInt32(&channel.sendQueueSize)),
 			Priority:          channel.desc.Priority,
 			RecentlySent:      atomic.LoadInt64(&channel.recentlySent),
+			SendMonitor:       channel.sendMonitor.Status(),
+			RecvMonitor:       channel.recvMonitor.Status(),
 		}
 	}
 	return status
@@ -728,6 +818,13 @@ type ChannelDescriptor struct {
 	SendQueueCapacity   int
 	RecvBufferCapacity  int
 	RecvMessageCapacity int
+
+	// Rates at which packets can be sent and received on the channel,
+	// in bytes/second. Zero means no limit but the one of the connection.
+	// Once over RecvRate, the connection is not read until the channel is
+	// back under it.
+	SendRate int64
+	RecvRate int64
 }
 
 func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
@@ -754,6 +851,11 @@ type Channel struct {
 	recving       []byte
 	sending       []byte
 	recentlySent  int64 // exponential moving average
+	deficit       int   // payload bytes the channel may still send in its turn
+	sendMonitor   *flow.Monitor
+	recvMonitor   *flow.Monitor
+	sendLimiter   *RateLimiter // nil if no desc.SendRate
+	recvLimiter   *RateLimiter // nil if no desc.RecvRate
 
 	maxPacketMsgPayloadSize int
 
@@ -761,7 +863,7 @@ type Channel struct {
 }
 
 func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
-	desc = desc.FillDefaults()
+	desc = conn.config.FillChannelDescriptor(desc)
 	if desc.Priority <= 0 {
 		panic("Channel default priority must be a positive integer")
 	}
@@ -770,6 +872,10 @@ func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 		desc:                    desc,
 		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
 		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
+		sendMonitor:             flow.New(0, 0),
+		recvMonitor:             flow.New(0, 0),
+		sendLimiter:             NewRateLimiter(desc.SendRate),
+		recvLimiter:             NewRateLimiter(desc.RecvRate),
 		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
 	}
 }
@@ -828,6 +934,18 @@ func (ch *Channel) isSendPending() bool {
 	return true
 }
 
+// Returns the number of bytes the channel may send in its turn.
+// Goroutine-safe
+func (ch *Channel) quantum() int {
+	return ch.desc.Priority * ch.maxPacketMsgPayloadSize
+}
+
+// Returns the size of the payload of the next PacketMsg.
+// Not goroutine-safe
+func (ch *Channel) nextPacketMsgPayloadSize() int {
+	return tmmath.MinInt(ch.maxPacketMsgPayloadSize, len(ch.sending))
+}
+
 // Creates a new PacketMsg to send.
 // Not goroutine-safe
 func (ch *Channel) nextPacketMsg() tmp2p.PacketMsg {
@@ -851,6 +969,8 @@ func (ch *Channel) writePacketMsgTo(w io.Writer) (n int, err error) {
 	packet := ch.nextPacketMsg()
 	n, err = protoio.NewDelimitedWriter(w).WriteMsg(mustWrapPacket(&packet))
 	atomic.AddInt64(&ch.recentlySent, int64(n))
+	ch.sendMonitor.Update(n)
+	ch.sendLimiter.Update(n)
 	return
 }
 
@@ -618,6 +618,152 @@ func TestMConnectionChannelOverflow(t *testing.T) {
 
 }
 
+func TestMConnectionChannelPriorities(t *testing.T) {
+	server, client := NetPipe()
+	t.Cleanup(func() { server.Close(); client.Close() })
+
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 3, SendQueueCapacity: 40},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 40},
+	}
+
+	receivedCh := make(chan byte, 100)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- chID
+	}
+	onError := func(r interface{}) {}
+	mconnClient := NewMConnection(client, chDescs, onReceive, onError)
+	mconnClient.SetLogger(log.TestingLogger())
+	mconnServer := NewMConnection(server, chDescs, onReceive, onError)
+	mconnServer.SetLogger(log.TestingLogger())
+
+	// both channels have a backlog of one packet messages before sending starts
+	msg := make([]byte, mconnClient.config.MaxPacketMsgPayloadSize)
+	for i := 0; i < 30; i++ {
+		require.True(t, mconnClient.channelsIdx[0x01].trySendBytes(msg))
+		require.True(t, mconnClient.channelsIdx[0x02].trySendBytes(msg))
+	}
+	require.NoError(t, mconnClient.Start())
+	require.NoError(t, mconnServer.Start())
+	t.Cleanup(stopAll(t, mconnClient, mconnServer))
+	require.True(t, mconnClient.TrySend(0x02, msg))
+
+	// the channels share the connection in proportion to their priorities
+	var ch1Count int
+	for i := 0; i < 20; i++ {
+		select {
+		case chID := <-receivedCh:
+			if chID == 0x01 {
+				ch1Count++
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("did not receive all the messages")
+		}
+	}
+	assert.InDelta(t, 15, ch1Count, 1)
+}
+
+func TestMConnectionChannelRecvRate(t *testing.T) {
+	server, client := NetPipe()
+	t.Cleanup(func() { server.Close(); client.Close() })
+
+	cfg := DefaultMConnConfig()
+	cfg.ChannelRecvRates = map[byte]int64{0x01: 10240}
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 100}}
+
+	receivedCh := make(chan byte, 200)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- chID
+	}
+	onError := func(r interface{}) {}
+	mconnClient := NewMConnection(client, chDescs, onReceive, onError)
+	mconnClient.SetLogger(log.TestingLogger())
+	mconnServer := NewMConnectionWithConfig(server, chDescs, onReceive, onError, cfg)
+	mconnServer.SetLogger(log.TestingLogger())
+	require.NoError(t, mconnClient.Start())
+	require.NoError(t, mconnServer.Start())
+	t.Cleanup(stopAll(t, mconnClient, mconnServer))
+
+	// 20kB flood the channel limited to 10kB/s
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		require.True(t, mconnClient.TrySend(0x01, make([]byte, 1000)))
+	}
+
+	// none of the flood is dropped, it's received at the channel rate
+	for i := 0; i < 20; i++ {
+		select {
+		case chID := <-receivedCh:
+			require.EqualValues(t, 0x01, chID)
+		case <-time.After(10 * time.Second):
+			t.Fatal("did not receive all the messages")
+		}
+	}
+	assert.Greater(t, time.Since(start), 1500*time.Millisecond)
+}
+
+func TestMConnectionChannelSendRate(t *testing.T) {
+	server, client := NetPipe()
+	t.Cleanup(func() { server.Close(); client.Close() })
+
+	cfg := DefaultMConnConfig()
+	cfg.ChannelSendRates = map[byte]int64{0x01: 10240}
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 10, SendQueueCapacity: 10},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 10},
+	}
+
+	type received struct {
+		chID byte
+		at   time.Time
+	}
+	receivedCh := make(chan received, 20)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- received{chID, time.Now()}
+	}
+	onError := func(r interface{}) {}
+	mconnClient := NewMConnectionWithConfig(client, chDescs, onReceive, onError, cfg)
+	mconnClient.SetLogger(log.TestingLogger())
+	mconnServer := NewMConnectionWithConfig(server, chDescs, onReceive, onError, DefaultMConnConfig())
+	mconnServer.SetLogger(log.TestingLogger())
+	require.NoError(t, mconnClient.Start())
+	require.NoError(t, mconnServer.Start())
+	t.Cleanup(stopAll(t, mconnClient, mconnServer))
+
+	// the rate of the config overrides the one of the descriptor
+	assert.EqualValues(t, 10240, mconnClient.channelsIdx[0x01].desc.SendRate)
+	assert.Zero(t, mconnClient.channelsIdx[0x02].desc.SendRate)
+
+	// 20kB on the throttled channel take about 2s, though it has the higher priority
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		require.True(t, mconnClient.TrySend(0x01, make([]byte, 5120)))
+	}
+	require.True(t, mconnClient.TrySend(0x02, []byte("not starved")))
+
+	var ch1Count int
+	var ch2At time.Time
+	for ch1Count < 4 || ch2At.IsZero() {
+		select {
+		case r := <-receivedCh:
+			if r.chID == 0x01 {
+				ch1Count++
+			} else {
+				ch2At = r.at
+				assert.Less(t, ch1Count, 4, "the other channel was starved")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("did not receive all the messages")
+		}
+	}
+	assert.Less(t, ch2At.Sub(start), time.Second)
+	assert.Greater(t, time.Since(start), 1500*time.Millisecond)
+
+	status := mconnClient.Status()
+	assert.EqualValues(t, 0x01, status.Channels[0].ID)
+	assert.True(t, status.Channels[0].SendMonitor.Active)
+}
+
 type stopper interface {
 	Stop() error
 }
@@ -23,6 +23,10 @@ type Metrics struct {
 	PeerSendBytesTotal metrics.Counter
 	// Pending bytes to be sent to a given peer.
 	PeerPendingSendBytes metrics.Gauge
+	// Rate at which bytes are sent to a given peer on a channel.
+	PeerChannelSendRate metrics.Gauge
+	// Rate at which bytes are received from a given peer on a channel.
+	PeerChannelRecvRate metrics.Gauge
 	// Number of transactions submitted by each peer.
 	NumTxs metrics.Gauge
 	// Number of abandoned peer messages
@@ -64,6 +68,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "peer_pending_send_bytes",
 			Help:      "Number of pending bytes to be sent to a given peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerChannelSendRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_send_rate",
+			Help:      "Rate at which bytes are sent to a given peer on a channel, in bytes/second.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelRecvRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_recv_rate",
+			Help:      "Rate at which bytes are received from a given peer on a channel, in bytes/second.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
 		NumTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -92,6 +108,8 @@ func NopMetrics() *Metrics {
 		PeerReceiveBytesTotal: discard.NewCounter(),
 		PeerSendBytesTotal:    discard.NewCounter(),
 		PeerPendingSendBytes:  discard.NewGauge(),
+		PeerChannelSendRate:   discard.NewGauge(),
+		PeerChannelRecvRate:   discard.NewGauge(),
 		NumTxs:                discard.NewGauge(),
 		NumAbandonedPeerMsgs:  discard.NewCounter(),
 		NumPooledPeerMsgs:     discard.NewGauge(),
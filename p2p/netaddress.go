@@ -20,31 +20,54 @@ import (
 // EmptyNetAddress defines the string representation of an empty NetAddress
 const EmptyNetAddress = "<nil-NetAddress>"
 
+const (
+	// ProtocolTCP is the protocol of the peers connected with the
+	// MultiplexTransport, the default one.
+	ProtocolTCP = ""
+	// ProtocolQUIC is the protocol of the peers connected with the
+	// QUICTransport, written as "quic://" in front of the address.
+	ProtocolQUIC = "quic"
+)
+
 // NetAddress defines information about a peer on the network
-// including its ID, IP address, and port.
+// including its ID, IP address, port and transport protocol.
 type NetAddress struct {
-	ID   ID     `json:"id"`
-	IP   net.IP `json:"ip"`
-	Port uint16 `json:"port"`
+	ID       ID     `json:"id"`
+	IP       net.IP `json:"ip"`
+	Port     uint16 `json:"port"`
+	Protocol string `json:"protocol,omitempty"`
 }
 
 // IDAddressString returns id@hostPort. It strips the leading
-// protocol from protocolHostPort if it exists.
+// protocol from protocolHostPort if it exists, except the QUIC one
+// which is kept in front of the result.
 func IDAddressString(id ID, protocolHostPort string) string {
 	hostPort := removeProtocolIfDefined(protocolHostPort)
+	if protocolOf(protocolHostPort) == ProtocolQUIC {
+		return fmt.Sprintf("%s://%s@%s", ProtocolQUIC, id, hostPort)
+	}
 	return fmt.Sprintf("%s@%s", id, hostPort)
 }
 
-// NewNetAddress returns a new NetAddress using the provided TCP
-// address. When testing, other net.Addr (except TCP) will result in
-// using 0.0.0.0:0. When normal run, other net.Addr (except TCP) will
-// panic. Panics if ID is invalid.
+// NewNetAddress returns a new NetAddress using the provided TCP or
+// UDP (QUIC) address. When testing, other net.Addr will result in
+// using 0.0.0.0:0. When normal run, other net.Addr will panic.
+// Panics if ID is invalid.
 // TODO: socks proxies?
 func NewNetAddress(id ID, addr net.Addr) *NetAddress {
-	tcpAddr, ok := addr.(*net.TCPAddr)
-	if !ok {
+	var (
+		ip       net.IP
+		port     int
+		protocol string
+	)
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		ip, port = addr.IP, addr.Port
+	case *net.UDPAddr:
+		ip, port, protocol = addr.IP, addr.Port, ProtocolQUIC
+	default:
 		if flag.Lookup("test.v") == nil { // normal run
-			panic(fmt.Sprintf("Only TCPAddrs are supported. Got: %v", addr))
+			panic(fmt.Sprintf("Only TCPAddrs and UDPAddrs are supported. Got: %v", addr))
 		} else { // in testing
 			netAddr := NewNetAddressIPPort(net.IP("127.0.0.1"), 0)
 			netAddr.ID = id
@@ -52,19 +75,18 @@ func NewNetAddress(id ID, addr net.Addr) *NetAddress {
 		}
 	}
 
-	if err := validateID(id); err != nil {
+	if err := ValidateID(id); err != nil {
 		panic(fmt.Sprintf("Invalid ID %v: %v (addr: %v)", id, err, addr))
 	}
 
-	ip := tcpAddr.IP
-	port := uint16(tcpAddr.Port)
-	na := NewNetAddressIPPort(ip, port)
+	na := NewNetAddressIPPort(ip, uint16(port))
 	na.ID = id
+	na.Protocol = protocol
 	return na
 }
 
 // NewNetAddressString returns a new NetAddress using the provided address in
-// the form of "ID@IP:Port".
+// the form of "ID@IP:Port", or "quic://ID@IP:Port" for a QUIC peer.
 // Also resolves the host if host is not an IP.
 // Errors are of type ErrNetAddressXxx where Xxx is in (NoID, Invalid, Lookup)
 func NewNetAddressString(addr string) (*NetAddress, error) {
@@ -75,7 +97,7 @@ func NewNetAddressString(addr string) (*NetAddress, error) {
 	}
 
 	// get ID
-	if err := validateID(ID(spl[0])); err != nil {
+	if err := ValidateID(ID(spl[0])); err != nil {
 		return nil, ErrNetAddressInvalid{addrWithoutProtocol, err}
 	}
 	var id ID
@@ -108,6 +130,7 @@ func NewNetAddressString(addr string) (*NetAddress, error) {
 
 	na := NewNetAddressIPPort(ip, uint16(port))
 	na.ID = id
+	na.Protocol = protocolOf(addr)
 	return na, nil
 }
 
@@ -145,10 +168,14 @@ func NetAddressFromProto(pb tmp2p.NetAddress) (*NetAddress, error) {
 	if pb.Port >= 1<<16 {
 		return nil, fmt.Errorf("invalid port number %v", pb.Port)
 	}
+	if pb.Protocol != ProtocolTCP && pb.Protocol != ProtocolQUIC {
+		return nil, fmt.Errorf("unknown protocol %v", pb.Protocol)
+	}
 	return &NetAddress{
-		ID:   ID(pb.ID),
-		IP:   ip,
-		Port: uint16(pb.Port),
+		ID:       ID(pb.ID),
+		IP:       ip,
+		Port:     uint16(pb.Port),
+		Protocol: pb.Protocol,
 	}, nil
 }
 
@@ -179,14 +206,15 @@ func NetAddressesToProto(nas []*NetAddress) []tmp2p.NetAddress {
 // ToProto converts a NetAddress to Protobuf.
 func (na *NetAddress) ToProto() tmp2p.NetAddress {
 	return tmp2p.NetAddress{
-		ID:   string(na.ID),
-		IP:   na.IP.String(),
-		Port: uint32(na.Port),
+		ID:       string(na.ID),
+		IP:       na.IP.String(),
+		Port:     uint32(na.Port),
+		Protocol: na.Protocol,
 	}
 }
 
 // Equals reports whether na and other are the same addresses,
-// including their ID, IP, and Port.
+// including their ID, IP, Port and Protocol.
 func (na *NetAddress) Equals(other interface{}) bool {
 	if o, ok := other.(*NetAddress); ok {
 		return na.String() == o.String()
@@ -207,7 +235,8 @@ func (na *NetAddress) Same(other interface{}) bool {
 	return false
 }
 
-// String representation: <ID>@<IP>:<PORT>
+// String representation: <ID>@<IP>:<PORT>, prefixed with quic:// for a QUIC
+// address.
 func (na *NetAddress) String() string {
 	if na == nil {
 		return EmptyNetAddress
@@ -217,6 +246,9 @@ func (na *NetAddress) String() string {
 	if na.ID != "" {
 		addrStr = IDAddressString(na.ID, addrStr)
 	}
+	if na.Protocol != ProtocolTCP {
+		addrStr = na.Protocol + "://" + addrStr
+	}
 
 	return addrStr
 }
@@ -262,7 +294,7 @@ func (na *NetAddress) Routable() bool {
 // For IPv4 these are either a 0 or all bits set address. For IPv6 a zero
 // address or one that matches the RFC3849 documentation address format.
 func (na *NetAddress) Valid() error {
-	if err := validateID(na.ID); err != nil {
+	if err := ValidateID(na.ID); err != nil {
 		return fmt.Errorf("invalid ID: %w", err)
 	}
 
@@ -405,7 +437,18 @@ func removeProtocolIfDefined(addr string) string {
 
 }
 
-func validateID(id ID) error {
+// protocolOf returns the protocol of the NetAddress for the given address:
+// ProtocolQUIC if it starts with quic://, ProtocolTCP otherwise.
+func protocolOf(addr string) string {
+	if strings.HasPrefix(addr, ProtocolQUIC+"://") {
+		return ProtocolQUIC
+	}
+	return ProtocolTCP
+}
+
+// ValidateID returns an error if id is not a hex-encoded ID of IDByteLength
+// bytes.
+func ValidateID(id ID) error {
 	if len(id) == 0 {
 		return errors.New("no ID")
 	}
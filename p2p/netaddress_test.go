@@ -42,9 +42,14 @@ func TestNewNetAddress(t *testing.T) {
 	addr := NewNetAddress("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", tcpAddr)
 	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", addr.String())
 
+	udpAddr := &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000}
+	addr = NewNetAddress("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", udpAddr)
+	assert.Equal(t, ProtocolQUIC, addr.Protocol)
+	assert.Equal(t, "quic://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8000", addr.String())
+
 	assert.NotPanics(t, func() {
-		NewNetAddress("", &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000})
-	}, "Calling NewNetAddress with UDPAddr should not panic in testing")
+		NewNetAddress("", &net.UnixAddr{Name: "/tmp/ostracon.sock", Net: "unix"})
+	}, "Calling NewNetAddress with UnixAddr should not panic in testing")
 }
 
 func TestNewNetAddressString(t *testing.T) {
@@ -76,6 +81,13 @@ func TestNewNetAddressString(t *testing.T) {
 			"deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080",
 			true,
 		},
+		{
+			"quic input",
+			"quic://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080",
+			"quic://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080",
+			true,
+		},
+		{"no node id w/ quic input", "quic://127.0.0.1:8080", "", false},
 		{"malformed tcp input", "tcp//deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "", false},
 		{"malformed udp input", "udp//deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "", false},
 
@@ -124,6 +136,27 @@ func TestNewNetAddressString(t *testing.T) {
 	}
 }
 
+func TestNetAddressProtocol(t *testing.T) {
+	tcp, err := NewNetAddressString("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080")
+	require.NoError(t, err)
+	quic, err := NewNetAddressString(IDAddressString("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", "quic://127.0.0.1:8080"))
+	require.NoError(t, err)
+	assert.Equal(t, ProtocolTCP, tcp.Protocol)
+	assert.Equal(t, ProtocolQUIC, quic.Protocol)
+	assert.False(t, tcp.Equals(quic))
+	assert.Equal(t, tcp.DialString(), quic.DialString())
+
+	pb := quic.ToProto()
+	assert.Equal(t, ProtocolQUIC, pb.Protocol)
+	fromPb, err := NetAddressFromProto(pb)
+	require.NoError(t, err)
+	assert.Equal(t, quic, fromPb)
+
+	pb.Protocol = "sctp"
+	_, err = NetAddressFromProto(pb)
+	assert.Error(t, err)
+}
+
 func TestNewNetAddressStrings(t *testing.T) {
 	addrs, errs := NewNetAddressStrings([]string{
 		"127.0.0.1:8080",
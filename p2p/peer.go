@@ -362,12 +362,25 @@ func (p *peer) metricsReporter() {
 			}
 
 			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
+			reportChannelRates(p.metrics, p.ID(), status.Channels)
 		case <-p.Quit():
 			return
 		}
 	}
 }
 
+// reportChannelRates reports the current rates of the channels of a peer.
+func reportChannelRates(metrics *Metrics, id ID, channels []tmconn.ChannelStatus) {
+	for _, chStatus := range channels {
+		labels := []string{
+			"peer_id", string(id),
+			"chID", fmt.Sprintf("%#x", chStatus.ID),
+		}
+		metrics.PeerChannelSendRate.With(labels...).Set(float64(chStatus.SendMonitor.CurRate))
+		metrics.PeerChannelRecvRate.With(labels...).Set(float64(chStatus.RecvMonitor.CurRate))
+	}
+}
+
 //------------------------------------------------------------------
 // helper funcs
 
@@ -0,0 +1,465 @@
+package p2p
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/line/ostracon/libs/cmap"
+	flow "github.com/line/ostracon/libs/flowrate"
+	"github.com/line/ostracon/libs/service"
+	tmconn "github.com/line/ostracon/p2p/conn"
+)
+
+// quicSendTimeout is how long Send waits for room in the send queue of a
+// channel, as the MConnection does.
+const quicSendTimeout = 10 * time.Second
+
+// quicChannel is a channel of a quicPeer. Its messages are sent on a
+// unidirectional stream opened on the first message, starting with the
+// channel ID, each message being prefixed with its uvarint length.
+type quicChannel struct {
+	desc        tmconn.ChannelDescriptor
+	sendQueue   chan []byte
+	stream      io.Writer // only used by the send routine of the channel
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	sendLimiter *tmconn.RateLimiter // nil if no desc.SendRate
+	recvLimiter *tmconn.RateLimiter // nil if no desc.RecvRate
+}
+
+// quicPeer implements Peer over a QUIC connection. Each channel sends its
+// messages on a stream of its own, so that a slow channel never delays the
+// others. The rates of the MConnConfig and of the channels are enforced per
+// message.
+type quicPeer struct {
+	service.BaseService
+
+	// raw peerConn and the QUIC connection
+	peerConn
+	conn    QUICConn
+	created time.Time
+
+	// peer's node info and the channel it knows about
+	// channels = nodeInfo.Channels
+	// cached to avoid copying nodeInfo in hasChannel
+	nodeInfo NodeInfo
+	channels []byte
+
+	chByID       map[byte]*quicChannel
+	reactorsByCh map[byte]Reactor
+	onPeerError  func(Peer, interface{})
+	errored      uint32
+	mConfig      tmconn.MConnConfig
+
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	flushc      chan struct{}
+	sendWg      sync.WaitGroup
+
+	// User data
+	Data *cmap.CMap
+
+	metrics       *Metrics
+	metricsTicker *time.Ticker
+}
+
+var _ Peer = (*quicPeer)(nil)
+
+func newQUICPeer(
+	pc peerConn,
+	qc QUICConn,
+	mConfig tmconn.MConnConfig,
+	nodeInfo NodeInfo,
+	reactorsByCh map[byte]Reactor,
+	chDescs []*tmconn.ChannelDescriptor,
+	onPeerError func(Peer, interface{}),
+	metrics *Metrics,
+) *quicPeer {
+	p := &quicPeer{
+		peerConn:      pc,
+		conn:          qc,
+		created:       time.Now(),
+		nodeInfo:      nodeInfo,
+		channels:      nodeInfo.(DefaultNodeInfo).Channels,
+		chByID:        make(map[byte]*quicChannel, len(chDescs)),
+		reactorsByCh:  reactorsByCh,
+		onPeerError:   onPeerError,
+		mConfig:       mConfig,
+		sendMonitor:   flow.New(0, 0),
+		recvMonitor:   flow.New(0, 0),
+		flushc:        make(chan struct{}),
+		Data:          cmap.NewCMap(),
+		metrics:       metrics,
+		metricsTicker: time.NewTicker(metricsTickerDuration),
+	}
+	if p.metrics == nil {
+		p.metrics = NopMetrics()
+	}
+	for _, desc := range chDescs {
+		desc := mConfig.FillChannelDescriptor(*desc)
+		p.chByID[desc.ID] = &quicChannel{
+			desc:        desc,
+			sendQueue:   make(chan []byte, desc.SendQueueCapacity),
+			sendMonitor: flow.New(0, 0),
+			recvMonitor: flow.New(0, 0),
+			sendLimiter: tmconn.NewRateLimiter(desc.SendRate),
+			recvLimiter: tmconn.NewRateLimiter(desc.RecvRate),
+		}
+	}
+	p.BaseService = *service.NewBaseService(nil, "Peer", p)
+
+	return p
+}
+
+// String representation.
+func (p *quicPeer) String() string {
+	if p.outbound {
+		return fmt.Sprintf("Peer{QUIC %v %v out}", p.conn.RemoteAddr(), p.ID())
+	}
+
+	return fmt.Sprintf("Peer{QUIC %v %v in}", p.conn.RemoteAddr(), p.ID())
+}
+
+//---------------------------------------------------
+// Implements service.Service
+
+// OnStart implements BaseService.
+func (p *quicPeer) OnStart() error {
+	if err := p.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	for _, ch := range p.chByID {
+		p.sendWg.Add(1)
+		go p.sendRoutine(ch)
+	}
+	go p.recvRoutine()
+	go p.metricsReporter()
+	return nil
+}
+
+// FlushStop mimics OnStop but additionally ensures that all successful
+// .Send() calls will get written before closing the connection.
+// NOTE: it is not safe to call this method more than once.
+func (p *quicPeer) FlushStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+	close(p.flushc)
+	p.sendWg.Wait()
+	_ = p.conn.Close()
+}
+
+// OnStop implements BaseService.
+func (p *quicPeer) OnStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+	if err := p.conn.Close(); err != nil {
+		p.Logger.Debug("Error while stopping peer", "err", err)
+	}
+}
+
+//---------------------------------------------------
+// Implements Peer
+
+// ID returns the peer's ID - the hex encoded hash of its pubkey.
+func (p *quicPeer) ID() ID {
+	return p.nodeInfo.ID()
+}
+
+// IsOutbound returns true if the connection is outbound, false otherwise.
+func (p *quicPeer) IsOutbound() bool {
+	return p.peerConn.outbound
+}
+
+// IsPersistent returns true if the peer is persitent, false otherwise.
+func (p *quicPeer) IsPersistent() bool {
+	return p.peerConn.persistent
+}
+
+// NodeInfo returns a copy of the peer's NodeInfo.
+func (p *quicPeer) NodeInfo() NodeInfo {
+	return p.nodeInfo
+}
+
+// SocketAddr returns the address of the socket.
+// For outbound peers, it's the address dialed (after DNS resolution).
+// For inbound peers, it's the address returned by the underlying connection
+// (not what's reported in the peer's NodeInfo).
+func (p *quicPeer) SocketAddr() *NetAddress {
+	return p.peerConn.socketAddr
+}
+
+// RemoteAddr returns peer's remote network address.
+func (p *quicPeer) RemoteAddr() net.Addr {
+	return p.conn.RemoteAddr()
+}
+
+// CloseConn closes original connection. Used for cleaning up in cases where the peer had not been started at all.
+func (p *quicPeer) CloseConn() error {
+	return p.peerConn.conn.Close()
+}
+
+// Status returns the peer's ConnectionStatus.
+func (p *quicPeer) Status() tmconn.ConnectionStatus {
+	status := tmconn.ConnectionStatus{
+		Duration:    time.Since(p.created),
+		SendMonitor: p.sendMonitor.Status(),
+		RecvMonitor: p.recvMonitor.Status(),
+		Channels:    make([]tmconn.ChannelStatus, 0, len(p.chByID)),
+	}
+	for _, ch := range p.chByID {
+		status.Channels = append(status.Channels, tmconn.ChannelStatus{
+			ID:                ch.desc.ID,
+			SendQueueCapacity: cap(ch.sendQueue),
+			SendQueueSize:     len(ch.sendQueue),
+			Priority:          ch.desc.Priority,
+			SendMonitor:       ch.sendMonitor.Status(),
+			RecvMonitor:       ch.recvMonitor.Status(),
+		})
+	}
+	return status
+}
+
+// Send msg bytes to the channel identified by chID byte. Returns false if the
+// send queue is full after timeout.
+func (p *quicPeer) Send(chID byte, msgBytes []byte) bool {
+	ch, ok := p.sendableChannel(chID)
+	if !ok {
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+	case <-time.After(quicSendTimeout):
+		return false
+	case <-p.Quit():
+		return false
+	}
+	p.recordSend(chID, msgBytes)
+	return true
+}
+
+// TrySend msg bytes to the channel identified by chID byte. Immediately returns
+// false if the send queue is full.
+func (p *quicPeer) TrySend(chID byte, msgBytes []byte) bool {
+	ch, ok := p.sendableChannel(chID)
+	if !ok {
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+	default:
+		return false
+	}
+	p.recordSend(chID, msgBytes)
+	return true
+}
+
+// Get the data for a given key.
+func (p *quicPeer) Get(key string) interface{} {
+	return p.Data.Get(key)
+}
+
+// Set sets the data for the given key.
+func (p *quicPeer) Set(key string, data interface{}) {
+	p.Data.Set(key, data)
+}
+
+// sendableChannel returns the channel identified by chID, if the peer is
+// running and knows about the channel.
+func (p *quicPeer) sendableChannel(chID byte) (*quicChannel, bool) {
+	if !p.IsRunning() {
+		return nil, false
+	}
+	for _, id := range p.channels {
+		if id == chID {
+			ch, ok := p.chByID[chID]
+			if !ok {
+				p.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+			}
+			return ch, ok
+		}
+	}
+	p.Logger.Debug(
+		"Unknown channel for peer",
+		"channel",
+		chID,
+		"channels",
+		p.channels,
+	)
+	return nil, false
+}
+
+func (p *quicPeer) recordSend(chID byte, msgBytes []byte) {
+	labels := []string{
+		"peer_id", string(p.ID()),
+		"chID", fmt.Sprintf("%#x", chID),
+	}
+	p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+}
+
+// stopForError reports the first error of the connection to the switch, unless
+// the peer is stopping.
+func (p *quicPeer) stopForError(r interface{}) {
+	if !p.IsRunning() {
+		return
+	}
+	if atomic.CompareAndSwapUint32(&p.errored, 0, 1) {
+		p.onPeerError(p, r)
+	}
+}
+
+func (p *quicPeer) sendRoutine(ch *quicChannel) {
+	defer p.sendWg.Done()
+
+	for {
+		select {
+		case msg := <-ch.sendQueue:
+			if err := p.write(ch, msg); err != nil {
+				p.stopForError(err)
+				return
+			}
+		case <-p.flushc:
+			for {
+				select {
+				case msg := <-ch.sendQueue:
+					if err := p.write(ch, msg); err != nil {
+						return
+					}
+				default:
+					return
+				}
+			}
+		case <-p.Quit():
+			return
+		}
+	}
+}
+
+func (p *quicPeer) write(ch *quicChannel, msg []byte) error {
+	if ch.stream == nil {
+		stream, err := p.conn.OpenUniStreamSync(p.conn.Context())
+		if err != nil {
+			return err
+		}
+		if _, err := stream.Write([]byte{ch.desc.ID}); err != nil {
+			return err
+		}
+		ch.stream = stream
+	}
+
+	buf := make([]byte, binary.MaxVarintLen64+len(msg))
+	buf = append(buf[:binary.PutUvarint(buf, uint64(len(msg)))], msg...)
+	p.sendMonitor.Limit(len(buf), p.mConfig.SendRate, true)
+	ch.sendLimiter.Wait(p.Quit())
+	p.mConfig.SendBudget.Wait(p.Quit())
+	n, err := ch.stream.Write(buf)
+	p.sendMonitor.Update(n)
+	ch.sendMonitor.Update(n)
+	ch.sendLimiter.Update(n)
+	p.mConfig.SendBudget.Update(n)
+	return err
+}
+
+func (p *quicPeer) recvRoutine() {
+	for {
+		stream, err := p.conn.AcceptUniStream(p.conn.Context())
+		if err != nil {
+			p.stopForError(err)
+			return
+		}
+		go p.recvStream(stream)
+	}
+}
+
+func (p *quicPeer) recvStream(stream io.Reader) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.Logger.Error("Peer panicked", "err", r, "stack", string(debug.Stack()))
+			p.stopForError(fmt.Errorf("recovered from panic: %v", r))
+		}
+	}()
+
+	r := bufio.NewReader(stream)
+	chID, err := r.ReadByte()
+	if err != nil {
+		p.stopForError(err)
+		return
+	}
+	ch, ok := p.chByID[chID]
+	if !ok || p.reactorsByCh[chID] == nil {
+		p.stopForError(fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			p.stopForError(err)
+			return
+		}
+		if size > uint64(ch.desc.RecvMessageCapacity) {
+			p.stopForError(fmt.Errorf("received message exceeds available capacity: %v < %v",
+				ch.desc.RecvMessageCapacity, size))
+			return
+		}
+		msg := make([]byte, size)
+		if _, err := io.ReadFull(r, msg); err != nil {
+			p.stopForError(err)
+			return
+		}
+		p.recvMonitor.Update(len(msg))
+		ch.recvMonitor.Update(len(msg))
+		ch.recvLimiter.Update(len(msg))
+		p.mConfig.RecvBudget.Update(len(msg))
+		p.receive(chID, msg)
+
+		// Stop reading the stream until the peer and the channel are back under
+		// their receive rates, which leaves the other streams alone if it's the
+		// channel only.
+		p.recvMonitor.Limit(len(msg), p.mConfig.RecvRate, true)
+		ch.recvLimiter.Wait(p.Quit())
+		p.mConfig.RecvBudget.Wait(p.Quit())
+	}
+}
+
+func (p *quicPeer) receive(chID byte, msgBytes []byte) {
+	reactor := p.reactorsByCh[chID]
+	labels := []string{
+		"peer_id", string(p.ID()),
+		"chID", fmt.Sprintf("%#x", chID),
+	}
+	p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+	if p.mConfig.RecvAsync {
+		ch := reactor.GetRecvChan()
+		p.metrics.NumPooledPeerMsgs.With(labels...).Set(float64(len(ch)))
+		// if the channel is full, we are blocking a message until it can send into the channel
+		ch <- &BufferedMsg{ChID: chID, Peer: p, Msg: msgBytes}
+	} else {
+		reactor.Receive(chID, p, msgBytes)
+	}
+}
+
+func (p *quicPeer) metricsReporter() {
+	for {
+		select {
+		case <-p.metricsTicker.C:
+			status := p.Status()
+			var sendQueueSize float64
+			for _, chStatus := range status.Channels {
+				sendQueueSize += float64(chStatus.SendQueueSize)
+			}
+
+			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
+			reportChannelRates(p.metrics, p.ID(), status.Channels)
+		case <-p.Quit():
+			return
+		}
+	}
+}
@@ -10,10 +10,12 @@ import (
 	"math"
 	"math/rand"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/minio/highwayhash"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/line/ostracon/crypto"
 	tmmath "github.com/line/ostracon/libs/math"
@@ -41,6 +43,7 @@ type AddrBook interface {
 	OurAddress(*p2p.NetAddress) bool
 
 	AddPrivateIDs([]string)
+	IsPrivate(p2p.ID) bool
 
 	// Add and remove an address
 	AddAddress(addr *p2p.NetAddress, src *p2p.NetAddress) error
@@ -61,13 +64,24 @@ type AddrBook interface {
 	// Mark address
 	MarkGood(p2p.ID)
 	MarkAttempt(*p2p.NetAddress)
-	MarkBad(*p2p.NetAddress, time.Duration) // Move peer to bad peers list
+	MarkBad(addr *p2p.NetAddress, banTime time.Duration, reason string) // Move peer to bad peers list
 	// Add bad peers back to addrBook
 	ReinstateBadPeers()
 
+	// Ban an address whether it's in the book or not, and lift a ban
+	Ban(addr *p2p.NetAddress, banTime time.Duration, reason string)
+	Unban(p2p.ID) error
+
+	// Record what we learn of an address
+	RecordDial(addr *p2p.NetAddress, latency time.Duration, err error)
+	MarkSeen(id p2p.ID, nodeVersion string, height int64)
+
 	IsGood(*p2p.NetAddress) bool
 	IsBanned(*p2p.NetAddress) bool
 
+	// List the addresses of the book, banned ones included
+	Addresses() []AddrInfo
+
 	// Send a selection of addresses to peers
 	GetSelection() []*p2p.NetAddress
 	// Send a selection of addresses with bias
@@ -100,6 +114,7 @@ type addrBook struct {
 
 	// immutable after creation
 	filePath          string
+	db                dbm.DB // nil unless the book is kept in a database
 	key               string // random prefix for bucket placement
 	routabilityStrict bool
 	hashKey           []byte
@@ -129,8 +144,18 @@ func NewAddrBook(filePath string, routabilityStrict bool) AddrBook {
 	return am
 }
 
+// NewDBAddrBook creates a new address book kept in the database, where every
+// change is written right away. If the database is empty, the address book
+// file at filePath is imported, if any.
+// Use Start to begin processing asynchronous address updates.
+func NewDBAddrBook(db dbm.DB, filePath string, routabilityStrict bool) AddrBook {
+	am := NewAddrBook(filePath, routabilityStrict).(*addrBook)
+	am.db = db
+	return am
+}
+
 // Initialize the buckets.
-// When modifying this, don't forget to update loadFromFile()
+// When modifying this, don't forget to update loadFromFile() and loadFromDB()
 func (a *addrBook) init() {
 	a.key = crypto.CRandHex(24) // 24/2 * 8 = 96 bits
 	// New addr buckets
@@ -150,7 +175,16 @@ func (a *addrBook) OnStart() error {
 	if err := a.BaseService.OnStart(); err != nil {
 		return err
 	}
+	if a.db != nil {
+		if !a.loadFromDB() {
+			a.loadFromFile(a.filePath)
+			a.saveToDB()
+		}
+		a.removePrivateAddrs()
+		return nil
+	}
 	a.loadFromFile(a.filePath)
+	a.removePrivateAddrs()
 
 	// wg.Add to ensure that any invocation of .Wait()
 	// later on will wait for saveRoutine to terminate.
@@ -160,9 +194,16 @@ func (a *addrBook) OnStart() error {
 	return nil
 }
 
-// OnStop implements Service.
+// OnStop implements Service. It closes the database of the book, if any.
 func (a *addrBook) OnStop() {
 	a.BaseService.OnStop()
+	if a.db != nil {
+		a.mtx.Lock()
+		defer a.mtx.Unlock()
+		if err := a.db.Close(); err != nil {
+			a.Logger.Error("Error closing AddrBook DB", "err", err)
+		}
+	}
 }
 
 func (a *addrBook) Wait() {
@@ -193,12 +234,39 @@ func (a *addrBook) OurAddress(addr *p2p.NetAddress) bool {
 	return ok
 }
 
+// AddPrivateIDs implements AddrBook. The addresses of the private IDs already
+// in the book are removed, not to be gossiped.
 func (a *addrBook) AddPrivateIDs(ids []string) {
 	a.mtx.Lock()
 	defer a.mtx.Unlock()
 
 	for _, id := range ids {
 		a.privateIDs[p2p.ID(id)] = struct{}{}
+		if ka := a.addrLookup[p2p.ID(id)]; ka != nil {
+			a.removeFromAllBuckets(ka)
+		}
+	}
+}
+
+// IsPrivate implements AddrBook.
+func (a *addrBook) IsPrivate(id p2p.ID) bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	_, ok := a.privateIDs[id]
+	return ok
+}
+
+// removePrivateAddrs removes the addresses of the private IDs from the book,
+// as the IDs may be added before loading it.
+func (a *addrBook) removePrivateAddrs() {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	for id := range a.privateIDs {
+		if ka := a.addrLookup[id]; ka != nil {
+			a.removeFromAllBuckets(ka)
+		}
 	}
 }
 
@@ -333,6 +401,7 @@ func (a *addrBook) MarkGood(id p2p.ID) {
 			a.Logger.Error("Error moving address to old", "err", err)
 		}
 	}
+	a.persist(ka)
 }
 
 // MarkAttempt implements AddrBook - it marks that an attempt was made to connect to the address.
@@ -345,19 +414,92 @@ func (a *addrBook) MarkAttempt(addr *p2p.NetAddress) {
 		return
 	}
 	ka.markAttempt()
+	a.persist(ka)
 }
 
 // MarkBad implements AddrBook. Kicks address out from book, places
 // the address in the badPeers pool.
-func (a *addrBook) MarkBad(addr *p2p.NetAddress, banTime time.Duration) {
+func (a *addrBook) MarkBad(addr *p2p.NetAddress, banTime time.Duration, reason string) {
 	a.mtx.Lock()
 	defer a.mtx.Unlock()
 
-	if a.addBadPeer(addr, banTime) {
+	if a.addBadPeer(addr, banTime, reason) {
 		a.removeAddress(addr)
 	}
 }
 
+// Ban implements AddrBook. It's MarkBad for any address, in the book or not.
+// The ban of an address already banned is extended to banTime if needed, and
+// its reason replaced.
+func (a *addrBook) Ban(addr *p2p.NetAddress, banTime time.Duration, reason string) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if ka, ok := a.badPeers[addr.ID]; ok {
+		ka.ban(banTime, reason)
+		a.persist(ka)
+		return
+	}
+	if !a.addBadPeer(addr, banTime, reason) {
+		ka := newKnownAddress(addr, addr)
+		ka.ban(banTime, reason)
+		a.badPeers[addr.ID] = ka
+		a.Logger.Info("Add address to blacklist", "addr", addr, "reason", reason)
+		a.persist(ka)
+		return
+	}
+	a.removeAddress(addr)
+}
+
+// Unban implements AddrBook. It lifts the ban of the address with the given ID
+// and puts it back into a new bucket.
+func (a *addrBook) Unban(id p2p.ID) error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka, ok := a.badPeers[id]
+	if !ok {
+		return ErrAddrBookNotBanned{id}
+	}
+	ka.unban()
+	a.reinstate(ka)
+	return nil
+}
+
+// RecordDial implements AddrBook. It appends the outcome of a dial to the
+// history of the address, and keeps the latency of the successful ones.
+func (a *addrBook) RecordDial(addr *p2p.NetAddress, latency time.Duration, err error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[addr.ID]
+	if ka == nil {
+		return
+	}
+	ka.recordDial(latency, err)
+	a.persist(ka)
+}
+
+// MarkSeen implements AddrBook. It records the version of a connected peer,
+// and the last height we know it's at, if any.
+func (a *addrBook) MarkSeen(id p2p.ID, nodeVersion string, height int64) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[id]
+	if ka == nil {
+		return
+	}
+	if height <= ka.LastSeenHeight && nodeVersion == ka.NodeVersion {
+		return
+	}
+	ka.NodeVersion = nodeVersion
+	if height > ka.LastSeenHeight {
+		ka.LastSeenHeight = height
+	}
+	a.persist(ka)
+}
+
 // ReinstateBadPeers removes bad peers from ban list and places them into a new
 // bucket.
 func (a *addrBook) ReinstateBadPeers() {
@@ -368,21 +510,63 @@ func (a *addrBook) ReinstateBadPeers() {
 		if ka.isBanned() {
 			continue
 		}
+		a.reinstate(ka)
+	}
+}
 
-		bucket, err := a.calcNewBucket(ka.Addr, ka.Src)
-		if err != nil {
-			a.Logger.Error("Failed to calculate new bucket (bad peer won't be reinstantiated)",
-				"addr", ka.Addr, "err", err)
-			continue
-		}
+// AddrInfo is what the book knows about an address.
+type AddrInfo struct {
+	Addr        *p2p.NetAddress `json:"addr"`
+	Src         *p2p.NetAddress `json:"src"`
+	Good        bool            `json:"good"` // in an old bucket
+	Attempts    int32           `json:"attempts"`
+	LastAttempt time.Time       `json:"last_attempt"`
+	LastSuccess time.Time       `json:"last_success"`
+
+	DialHistory    []DialRecord  `json:"dial_history"`
+	Latency        time.Duration `json:"latency"`
+	NodeVersion    string        `json:"node_version"`
+	LastSeenHeight int64         `json:"last_seen_height"`
+
+	Banned      bool      `json:"banned"`
+	BannedUntil time.Time `json:"banned_until"`
+	BanReason   string    `json:"ban_reason"`
+}
 
-		if err := a.addToNewBucket(ka, bucket); err != nil {
-			a.Logger.Error("Error adding peer to new bucket", "err", err)
-		}
-		delete(a.badPeers, ka.ID())
+// Addresses implements AddrBook. It returns the addresses of the book and the
+// banned ones, sorted by ID.
+func (a *addrBook) Addresses() []AddrInfo {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
 
-		a.Logger.Info("Reinstated address", "addr", ka.Addr)
+	infos := make([]AddrInfo, 0, len(a.addrLookup)+len(a.badPeers))
+	for _, kas := range []map[p2p.ID]*knownAddress{a.addrLookup, a.badPeers} {
+		for _, ka := range kas {
+			_, banned := a.badPeers[ka.ID()]
+			info := AddrInfo{
+				Addr:           ka.Addr,
+				Src:            ka.Src,
+				Good:           !banned && ka.isOld(),
+				Attempts:       ka.Attempts,
+				LastAttempt:    ka.LastAttempt,
+				LastSuccess:    ka.LastSuccess,
+				DialHistory:    append([]DialRecord(nil), ka.DialHistory...),
+				Latency:        ka.Latency,
+				NodeVersion:    ka.NodeVersion,
+				LastSeenHeight: ka.LastSeenHeight,
+				Banned:         banned,
+			}
+			if banned {
+				info.BannedUntil = ka.LastBanTime
+				info.BanReason = ka.BanReason
+			}
+			infos = append(infos, info)
+		}
 	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Addr.ID < infos[j].Addr.ID
+	})
+	return infos
 }
 
 // GetSelection implements AddrBook.
@@ -487,7 +671,11 @@ func (a *addrBook) size() int {
 //----------------------------------------------------------
 
 // Save persists the address book to disk.
+// It's a no-op for a book kept in a database, which is always up to date.
 func (a *addrBook) Save() {
+	if a.db != nil {
+		return
+	}
 	a.saveToFile(a.filePath) // thread safe
 }
 
@@ -552,6 +740,7 @@ func (a *addrBook) addToNewBucket(ka *knownAddress, bucketIdx int) error {
 
 	// Add it to addrLookup
 	a.addrLookup[ka.ID()] = ka
+	a.persist(ka)
 	return nil
 }
 
@@ -588,6 +777,7 @@ func (a *addrBook) addToOldBucket(ka *knownAddress, bucketIdx int) bool {
 
 	// Ensure in addrLookup
 	a.addrLookup[ka.ID()] = ka
+	a.persist(ka)
 
 	return true
 }
@@ -607,6 +797,7 @@ func (a *addrBook) removeFromBucket(ka *knownAddress, bucketType byte, bucketIdx
 		}
 		delete(a.addrLookup, ka.ID())
 	}
+	a.persist(ka)
 }
 
 func (a *addrBook) removeFromAllBuckets(ka *knownAddress) {
@@ -621,6 +812,7 @@ func (a *addrBook) removeFromAllBuckets(ka *knownAddress) {
 		a.nOld--
 	}
 	delete(a.addrLookup, ka.ID())
+	a.persist(ka)
 }
 
 //----------------------------------------------------------
@@ -806,7 +998,7 @@ func (a *addrBook) removeAddress(addr *p2p.NetAddress) {
 	a.removeFromAllBuckets(ka)
 }
 
-func (a *addrBook) addBadPeer(addr *p2p.NetAddress, banTime time.Duration) bool {
+func (a *addrBook) addBadPeer(addr *p2p.NetAddress, banTime time.Duration, reason string) bool {
 	// check it exists in addrbook
 	ka := a.addrLookup[addr.ID]
 	// check address is not already there
@@ -816,13 +1008,33 @@ func (a *addrBook) addBadPeer(addr *p2p.NetAddress, banTime time.Duration) bool
 
 	if _, alreadyBadPeer := a.badPeers[addr.ID]; !alreadyBadPeer {
 		// add to bad peer list
-		ka.ban(banTime)
+		ka.ban(banTime, reason)
 		a.badPeers[addr.ID] = ka
-		a.Logger.Info("Add address to blacklist", "addr", addr)
+		a.Logger.Info("Add address to blacklist", "addr", addr, "reason", reason)
 	}
 	return true
 }
 
+// reinstate puts a bad peer back into a new bucket.
+func (a *addrBook) reinstate(ka *knownAddress) {
+	bucket, err := a.calcNewBucket(ka.Addr, ka.Src)
+	if err != nil {
+		a.Logger.Error("Failed to calculate new bucket (bad peer won't be reinstantiated)",
+			"addr", ka.Addr, "err", err)
+		return
+	}
+
+	// an old address is out of its buckets once banned, it starts over as new
+	ka.BucketType = bucketTypeNew
+	delete(a.badPeers, ka.ID())
+	if err := a.addToNewBucket(ka, bucket); err != nil {
+		a.Logger.Error("Error adding peer to new bucket", "err", err)
+	}
+	a.persist(ka)
+
+	a.Logger.Info("Reinstated address", "addr", ka.Addr)
+}
+
 //---------------------------------------------------------------------
 // calculate bucket placements
 
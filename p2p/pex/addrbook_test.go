@@ -2,6 +2,8 @@ package pex
 
 import (
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/line/ostracon/libs/log"
 	tmmath "github.com/line/ostracon/libs/math"
@@ -447,10 +450,12 @@ func TestBanBadPeers(t *testing.T) {
 	addr := randIPv4Address(t)
 	_ = book.AddAddress(addr, addr)
 
-	book.MarkBad(addr, 1*time.Second)
+	book.MarkBad(addr, 1*time.Second, "misbehaving")
 	// addr should not reachable
 	assert.False(t, book.HasAddress(addr))
 	assert.True(t, book.IsBanned(addr))
+	require.Len(t, book.Addresses(), 1)
+	assert.Equal(t, "misbehaving", book.Addresses()[0].BanReason)
 
 	err := book.AddAddress(addr, addr)
 	// book should not add address from the blacklist
@@ -464,6 +469,170 @@ func TestBanBadPeers(t *testing.T) {
 	assert.False(t, book.IsGood(addr))
 }
 
+func TestAddrBookRemovesPrivateAddrs(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	addrs := randNetAddressPairs(t, 3)
+	for _, addr := range addrs {
+		require.NoError(t, book.AddAddress(addr.addr, addr.src))
+	}
+
+	// an address already in the book
+	book.AddPrivateIDs([]string{string(addrs[0].addr.ID)})
+	assert.False(t, book.HasAddress(addrs[0].addr))
+	assert.True(t, book.IsPrivate(addrs[0].addr.ID))
+	assert.EqualValues(t, 2, book.Size())
+	book.Save()
+
+	// an address loaded after the private IDs are added
+	reloaded := NewAddrBook(fname, true)
+	reloaded.SetLogger(log.TestingLogger())
+	reloaded.AddPrivateIDs([]string{string(addrs[1].addr.ID)})
+	require.NoError(t, reloaded.Start())
+	defer reloaded.Stop() // nolint:errcheck // ignore for tests
+	assert.False(t, reloaded.HasAddress(addrs[1].addr))
+	assert.True(t, reloaded.HasAddress(addrs[2].addr))
+	assert.EqualValues(t, 1, reloaded.Size())
+}
+
+func TestAddrBookBanAndUnban(t *testing.T) {
+	book := NewAddrBook("", true)
+	book.SetLogger(log.TestingLogger())
+
+	// an address out of the book
+	addr := randIPv4Address(t)
+	book.Ban(addr, time.Hour, "by operator")
+	assert.True(t, book.IsBanned(addr))
+	assert.False(t, book.HasAddress(addr))
+	infos := book.Addresses()
+	require.Len(t, infos, 1)
+	assert.True(t, infos[0].Banned)
+	assert.Equal(t, "by operator", infos[0].BanReason)
+	assert.True(t, infos[0].BannedUntil.After(time.Now().Add(59*time.Minute)))
+
+	// banning again replaces the reason
+	book.Ban(addr, time.Minute, "again")
+	assert.Equal(t, "again", book.Addresses()[0].BanReason)
+
+	require.NoError(t, book.Unban(addr.ID))
+	assert.False(t, book.IsBanned(addr))
+	assert.True(t, book.HasAddress(addr))
+	assert.False(t, book.Addresses()[0].Banned)
+	assert.Equal(t, ErrAddrBookNotBanned{addr.ID}, book.Unban(addr.ID))
+
+	// a good address starts over as new
+	book.MarkGood(addr.ID)
+	assert.True(t, book.IsGood(addr))
+	book.Ban(addr, time.Hour, "")
+	assert.False(t, book.HasAddress(addr))
+	require.NoError(t, book.Unban(addr.ID))
+	assert.True(t, book.HasAddress(addr))
+	assert.False(t, book.IsGood(addr))
+	assert.EqualValues(t, 1, book.Size())
+}
+
+func TestAddrBookRecordDialAndMarkSeen(t *testing.T) {
+	book := NewAddrBook("", true)
+	book.SetLogger(log.TestingLogger())
+
+	addr := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(addr, addr))
+
+	book.RecordDial(addr, 10*time.Millisecond, nil)
+	for i := 0; i < maxDialHistory; i++ {
+		book.RecordDial(addr, time.Second, errors.New("dial failed"))
+	}
+	book.MarkSeen(addr.ID, "1.2.3", 42)
+	book.MarkSeen(addr.ID, "1.2.3", 0) // unknown height
+
+	info := book.Addresses()[0]
+	assert.Len(t, info.DialHistory, maxDialHistory)
+	assert.Equal(t, "dial failed", info.DialHistory[0].Err)
+	assert.Equal(t, 10*time.Millisecond, info.Latency)
+	assert.Equal(t, "1.2.3", info.NodeVersion)
+	assert.EqualValues(t, 42, info.LastSeenHeight)
+
+	// addresses out of the book are ignored
+	other := randIPv4Address(t)
+	book.RecordDial(other, time.Second, nil)
+	book.MarkSeen(other.ID, "1.2.3", 42)
+	assert.Len(t, book.Addresses(), 1)
+}
+
+func TestDBAddrBookSavesEveryChange(t *testing.T) {
+	db := dbm.NewMemDB()
+	book := NewDBAddrBook(db, "", true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+
+	addrs := randNetAddressPairs(t, 10)
+	for _, addr := range addrs {
+		require.NoError(t, book.AddAddress(addr.addr, addr.src))
+	}
+	book.MarkGood(addrs[0].addr.ID)
+	book.MarkAttempt(addrs[1].addr)
+	book.RecordDial(addrs[1].addr, time.Second, errors.New("dial failed"))
+	book.MarkSeen(addrs[2].addr.ID, "1.2.3", 42)
+	book.MarkBad(addrs[3].addr, time.Hour, "misbehaving")
+	book.Ban(randIPv4Address(t), time.Hour, "by operator")
+	book.RemoveAddress(addrs[4].addr)
+
+	// without stopping nor saving the book
+	reloaded := NewDBAddrBook(db, "", true)
+	reloaded.SetLogger(log.TestingLogger())
+	require.NoError(t, reloaded.Start())
+
+	assert.Equal(t, book.Size(), reloaded.Size())
+	assert.True(t, reloaded.IsGood(addrs[0].addr))
+	assert.True(t, reloaded.IsBanned(addrs[3].addr))
+	assert.False(t, reloaded.HasAddress(addrs[4].addr))
+	assert.Equal(t, book.(*addrBook).key, reloaded.(*addrBook).key)
+	expected, err := json.Marshal(book.Addresses())
+	require.NoError(t, err)
+	actual, err := json.Marshal(reloaded.Addresses())
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+}
+
+func TestDBAddrBookImportsFile(t *testing.T) {
+	book, fname := createAddrBookWithMOldAndNNewAddrs(t, 2, 3)
+	defer deleteTempFile(fname)
+	book.Save()
+
+	db := dbm.NewMemDB()
+	dbBook := NewDBAddrBook(db, fname, true)
+	dbBook.SetLogger(log.TestingLogger())
+	require.NoError(t, dbBook.Start())
+	assert.EqualValues(t, 5, dbBook.Size())
+	require.NoError(t, dbBook.Stop())
+
+	// the file is imported once
+	fileBook := NewAddrBook(fname, true)
+	fileBook.SetLogger(log.TestingLogger())
+	addr := randIPv4Address(t)
+	require.NoError(t, fileBook.AddAddress(addr, addr))
+	fileBook.Save()
+	dbBook = NewDBAddrBook(db, fname, true)
+	dbBook.SetLogger(log.TestingLogger())
+	require.NoError(t, dbBook.Start())
+	assert.EqualValues(t, 5, dbBook.Size())
+}
+
+func TestDBAddrBookClosesDBOnStop(t *testing.T) {
+	db, err := dbm.NewGoLevelDB("addrbook", t.TempDir())
+	require.NoError(t, err)
+	book := NewDBAddrBook(db, "", true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	require.NoError(t, book.Stop())
+
+	_, err = db.Get(bucketKeyKey)
+	assert.Error(t, err)
+}
+
 func TestAddrBookEmpty(t *testing.T) {
 	fname := createTempFileName("addrbook_test")
 	defer deleteTempFile(fname)
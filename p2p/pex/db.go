@@ -0,0 +1,116 @@
+package pex
+
+import (
+	"encoding/json"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/line/ostracon/p2p"
+)
+
+/* Loading & Saving to a database */
+
+// Every change to an address is written to the database right away, under
+// addrKeyPrefix + ID, along with the bucket placement key under bucketKeyKey.
+// The banned addresses are those in no bucket.
+
+var (
+	bucketKeyKey  = []byte("bucketKey")
+	addrKeyPrefix = "addr/"
+)
+
+func addrKey(id p2p.ID) []byte {
+	return []byte(addrKeyPrefix + string(id))
+}
+
+// persist writes ka to the database if it's in the book or banned, and deletes
+// it from there otherwise. It does nothing without a database.
+// NOTE: the mutex must be held.
+func (a *addrBook) persist(ka *knownAddress) {
+	if a.db == nil {
+		return
+	}
+
+	id := ka.ID()
+	if a.addrLookup[id] != ka && a.badPeers[id] != ka {
+		if err := a.db.Delete(addrKey(id)); err != nil {
+			a.Logger.Error("Failed to delete address from AddrBook DB", "addr", ka.Addr, "err", err)
+		}
+		return
+	}
+
+	bz, err := json.Marshal(ka)
+	if err != nil {
+		a.Logger.Error("Failed to encode address", "addr", ka.Addr, "err", err)
+		return
+	}
+	if err := a.db.Set(addrKey(id), bz); err != nil {
+		a.Logger.Error("Failed to save address to AddrBook DB", "addr", ka.Addr, "err", err)
+	}
+}
+
+// saveToDB writes the whole book to the database.
+func (a *addrBook) saveToDB() {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	a.Logger.Info("Saving AddrBook to DB", "size", a.size())
+
+	if err := a.db.SetSync(bucketKeyKey, []byte(a.key)); err != nil {
+		a.Logger.Error("Failed to save AddrBook to DB", "err", err)
+		return
+	}
+	for _, ka := range a.addrLookup {
+		a.persist(ka)
+	}
+	for _, ka := range a.badPeers {
+		a.persist(ka)
+	}
+}
+
+// Returns false if the database is empty.
+// cmn.Panics if it is corrupt.
+func (a *addrBook) loadFromDB() bool {
+	key, err := a.db.Get(bucketKeyKey)
+	if err != nil {
+		panic(fmt.Sprintf("Error reading AddrBook DB: %v", err))
+	}
+	if key == nil {
+		return false
+	}
+
+	iter, err := dbm.IteratePrefix(a.db, []byte(addrKeyPrefix))
+	if err != nil {
+		panic(fmt.Sprintf("Error reading AddrBook DB: %v", err))
+	}
+	defer iter.Close()
+
+	// Restore the key
+	a.key = string(key)
+	// Restore .bucketsNew & .bucketsOld, and .badPeers
+	for ; iter.Valid(); iter.Next() {
+		ka := &knownAddress{}
+		if err := json.Unmarshal(iter.Value(), ka); err != nil {
+			panic(fmt.Sprintf("Error reading address %s from AddrBook DB: %v", iter.Key(), err))
+		}
+		if len(ka.Buckets) == 0 {
+			a.badPeers[ka.ID()] = ka
+			continue
+		}
+		for _, bucketIndex := range ka.Buckets {
+			bucket := a.getBucket(ka.BucketType, bucketIndex)
+			bucket[ka.Addr.String()] = ka
+		}
+		a.addrLookup[ka.ID()] = ka
+		if ka.BucketType == bucketTypeNew {
+			a.nNew++
+		} else {
+			a.nOld++
+		}
+	}
+	if err := iter.Error(); err != nil {
+		panic(fmt.Sprintf("Error reading AddrBook DB: %v", err))
+	}
+	return true
+}
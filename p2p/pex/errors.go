@@ -85,5 +85,15 @@ func (err ErrAddressBanned) Error() string {
 	return fmt.Sprintf("Address: %v is currently banned", err.Addr)
 }
 
+// ErrAddrBookNotBanned is returned when lifting the ban of an address which is
+// not banned.
+type ErrAddrBookNotBanned struct {
+	ID p2p.ID
+}
+
+func (err ErrAddrBookNotBanned) Error() string {
+	return fmt.Sprintf("Address with ID %v is not banned", err.ID)
+}
+
 // ErrUnsolicitedList is thrown when a peer provides a list of addresses that have not been asked for.
 var ErrUnsolicitedList = errors.New("unsolicited pexAddrsMessage")
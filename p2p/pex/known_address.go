@@ -17,6 +17,20 @@ type knownAddress struct {
 	LastAttempt time.Time       `json:"last_attempt"`
 	LastSuccess time.Time       `json:"last_success"`
 	LastBanTime time.Time       `json:"last_ban_time"`
+
+	// metadata for operators, not used to pick addresses
+	BanReason      string        `json:"ban_reason,omitempty"`
+	DialHistory    []DialRecord  `json:"dial_history,omitempty"`
+	Latency        time.Duration `json:"latency,omitempty"`
+	NodeVersion    string        `json:"node_version,omitempty"`
+	LastSeenHeight int64         `json:"last_seen_height,omitempty"`
+}
+
+// DialRecord is the outcome of a dial of an address.
+type DialRecord struct {
+	Time    time.Time     `json:"time"`
+	Latency time.Duration `json:"latency"`
+	Err     string        `json:"err,omitempty"`
 }
 
 func newKnownAddress(addr *p2p.NetAddress, src *p2p.NetAddress) *knownAddress {
@@ -55,10 +69,31 @@ func (ka *knownAddress) markGood() {
 	ka.LastSuccess = now
 }
 
-func (ka *knownAddress) ban(banTime time.Duration) {
+func (ka *knownAddress) ban(banTime time.Duration, reason string) {
 	if ka.LastBanTime.Before(time.Now().Add(banTime)) {
 		ka.LastBanTime = time.Now().Add(banTime)
 	}
+	ka.BanReason = reason
+}
+
+func (ka *knownAddress) unban() {
+	ka.LastBanTime = time.Time{}
+	ka.BanReason = ""
+}
+
+// recordDial appends the outcome of a dial to the dial history, which keeps
+// the last maxDialHistory dials only.
+func (ka *knownAddress) recordDial(latency time.Duration, err error) {
+	record := DialRecord{Time: time.Now(), Latency: latency}
+	if err != nil {
+		record.Err = err.Error()
+	} else {
+		ka.Latency = latency
+	}
+	ka.DialHistory = append(ka.DialHistory, record)
+	if len(ka.DialHistory) > maxDialHistory {
+		ka.DialHistory = ka.DialHistory[len(ka.DialHistory)-maxDialHistory:]
+	}
 }
 
 func (ka *knownAddress) isBanned() bool {
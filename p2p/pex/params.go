@@ -52,4 +52,7 @@ const (
 	// max addresses returned by GetSelection
 	// NOTE: this must match "maxMsgSize"
 	maxGetSelection = 250
+
+	// dials kept in the history of an address.
+	maxDialHistory = 10
 )
@@ -3,6 +3,7 @@ package pex
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/line/ostracon/p2p"
 	"github.com/line/ostracon/p2p/conn"
 	tmp2p "github.com/line/ostracon/proto/ostracon/p2p"
+	"github.com/line/ostracon/types"
 )
 
 type Peer = p2p.Peer
@@ -52,6 +54,9 @@ const (
 
 	// if a peer is marked bad, it will be banned for at least this time period
 	defaultBanTime = 24 * time.Hour
+
+	// and at most this time period, the lower its trust score the longer
+	maxBanTime = 7 * 24 * time.Hour
 )
 
 type errMaxAttemptsToDial struct {
@@ -111,6 +116,11 @@ type ReactorConfig struct {
 	// Seed/Crawler mode
 	SeedMode bool
 
+	// Sentry mode, in which the private peers (the validators behind the
+	// sentry) are left out of the address exchange: they're never asked for
+	// addresses, and their PEX messages are ignored.
+	SentryMode bool
+
 	// We want seeds to only advertise good peers. Therefore they should wait at
 	// least as long as we expect it to take for a peer to become good before
 	// disconnecting.
@@ -201,6 +211,8 @@ func (r *Reactor) GetChannels() []*conn.ChannelDescriptor {
 // AddPeer implements Reactor by adding peer to the address book (if inbound)
 // or by requesting more addresses (if outbound).
 func (r *Reactor) AddPeer(p Peer) {
+	defer r.markPeerSeen(p)
+
 	if p.IsOutbound() {
 		// For outbound peers, the address is already in the books -
 		// either via DialPeersAsync or r.Receive.
@@ -231,6 +243,21 @@ func (r *Reactor) RemovePeer(p Peer, reason interface{}) {
 	id := string(p.ID())
 	r.requestsSent.Delete(id)
 	r.lastReceivedRequests.Delete(id)
+	r.markPeerSeen(p)
+}
+
+// markPeerSeen records the version of the peer in the book, and its height if
+// the consensus reactor knows it.
+func (r *Reactor) markPeerSeen(p Peer) {
+	var version string
+	if ni, ok := p.NodeInfo().(p2p.DefaultNodeInfo); ok {
+		version = ni.Version
+	}
+	var height int64
+	if ps, ok := p.Get(types.PeerStateKey).(interface{ GetHeight() int64 }); ok {
+		height = ps.GetHeight()
+	}
+	r.book.MarkSeen(p.ID(), version, height)
 }
 
 func (r *Reactor) logErrAddrBook(err error) {
@@ -247,6 +274,11 @@ func (r *Reactor) logErrAddrBook(err error) {
 
 // Receive implements Reactor by handling incoming PEX messages.
 func (r *Reactor) Receive(chID byte, src Peer, msgBytes []byte) {
+	if r.isSentryOf(src) {
+		r.Logger.Debug("Ignoring message of a private peer", "src", src, "chId", chID)
+		return
+	}
+
 	msg, err := decodeMsg(msgBytes)
 	if err != nil {
 		r.Logger.Error("Error decoding message", "src", src, "chId", chID, "err", err)
@@ -287,7 +319,7 @@ func (r *Reactor) Receive(chID byte, src Peer, msgBytes []byte) {
 			// Check we're not receiving requests too frequently.
 			if err := r.receiveRequest(src); err != nil {
 				r.Switch.StopPeerForError(src, err)
-				r.book.MarkBad(src.SocketAddr(), defaultBanTime)
+				r.book.MarkBad(src.SocketAddr(), r.banTime(src.ID()), err.Error())
 				return
 			}
 			r.SendAddrs(src, r.book.GetSelection())
@@ -298,14 +330,14 @@ func (r *Reactor) Receive(chID byte, src Peer, msgBytes []byte) {
 		addrs, err := p2p.NetAddressesFromProto(msg.Addrs)
 		if err != nil {
 			r.Switch.StopPeerForError(src, err)
-			r.book.MarkBad(src.SocketAddr(), defaultBanTime)
+			r.book.MarkBad(src.SocketAddr(), r.banTime(src.ID()), err.Error())
 			return
 		}
 		err = r.ReceiveAddrs(addrs, src)
 		if err != nil {
 			r.Switch.StopPeerForError(src, err)
 			if err == ErrUnsolicitedList {
-				r.book.MarkBad(src.SocketAddr(), defaultBanTime)
+				r.book.MarkBad(src.SocketAddr(), r.banTime(src.ID()), err.Error())
 			}
 			return
 		}
@@ -353,7 +385,7 @@ func (r *Reactor) receiveRequest(src Peer) error {
 // request out for this peer.
 func (r *Reactor) RequestAddrs(p Peer) {
 	id := string(p.ID())
-	if r.requestsSent.Has(id) {
+	if r.requestsSent.Has(id) || r.isSentryOf(p) {
 		return
 	}
 	r.Logger.Debug("Request addrs", "from", p)
@@ -361,6 +393,12 @@ func (r *Reactor) RequestAddrs(p Peer) {
 	p.Send(PexChannel, mustEncode(&tmp2p.PexRequest{}))
 }
 
+// isSentryOf returns true if we're in sentry mode and p is private, i.e. one of
+// the validators behind us.
+func (r *Reactor) isSentryOf(p Peer) bool {
+	return r.config.SentryMode && r.book.IsPrivate(p.ID())
+}
+
 // ReceiveAddrs adds the given addrs to the addrbook if theres an open
 // request for this peer and deletes the open request.
 // If there's no open request for the src peer, it returns an error.
@@ -448,6 +486,9 @@ func (r *Reactor) ensurePeersRoutine() {
 		select {
 		case <-ticker.C:
 			r.ensurePeers()
+			for _, peer := range r.Switch.Peers().List() {
+				r.markPeerSeen(peer)
+			}
 		case <-r.Quit():
 			ticker.Stop()
 			return
@@ -482,16 +523,18 @@ func (r *Reactor) ensurePeers() {
 	// NOTE: range here is [10, 90]. Too high ?
 	newBias := tmmath.MinInt(out, 8)*10 + 10
 
-	toDial := make(map[p2p.ID]*p2p.NetAddress)
-	// Try maxAttempts times to pick numToDial addresses to dial
+	selected := make(map[p2p.ID]bool)
+	candidates := make([]*p2p.NetAddress, 0, 2*numToDial)
+	// Try maxAttempts times to pick twice as many addresses as numToDial, to
+	// dial the ones of the peers with the highest ranking scores
 	maxAttempts := numToDial * 3
 
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	for i := 0; i < maxAttempts && len(candidates) < 2*numToDial; i++ {
 		try := r.book.PickAddress(newBias)
 		if try == nil {
 			continue
 		}
-		if _, selected := toDial[try.ID]; selected {
+		if selected[try.ID] {
 			continue
 		}
 		if r.Switch.IsDialingOrExistingAddress(try) {
@@ -500,9 +543,19 @@ func (r *Reactor) ensurePeers() {
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialling again, or have dialed too many times already
-		toDial[try.ID] = try
+		selected[try.ID] = true
+		candidates = append(candidates, try)
 	}
 
+	scores := make(map[p2p.ID]int, len(candidates))
+	for _, addr := range candidates {
+		scores[addr.ID] = r.Switch.PeerRankingScore(addr.ID)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i].ID] > scores[candidates[j].ID]
+	})
+	toDial := candidates[:tmmath.MinInt(numToDial, len(candidates))]
+
 	// Dial picked addresses
 	for _, addr := range toDial {
 		go func(addr *p2p.NetAddress) {
@@ -556,7 +609,7 @@ func (r *Reactor) dialAttemptsInfo(addr *p2p.NetAddress) (attempts int, lastDial
 func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 	attempts, lastDialed := r.dialAttemptsInfo(addr)
 	if !r.Switch.IsPeerPersistent(addr) && attempts > maxAttemptsToDial {
-		r.book.MarkBad(addr, defaultBanTime)
+		r.book.MarkBad(addr, r.banTime(addr.ID), errMaxAttemptsToDial{}.Error())
 		return errMaxAttemptsToDial{}
 	}
 
@@ -571,13 +624,15 @@ func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 		}
 	}
 
+	start := time.Now()
 	err := r.Switch.DialPeerWithAddress(addr)
 	if err != nil {
 		if _, ok := err.(p2p.ErrCurrentlyDialingOrExistingAddress); ok {
 			return err
 		}
 
-		markAddrInBookBasedOnErr(addr, r.book, err)
+		r.book.RecordDial(addr, time.Since(start), err)
+		r.markAddrInBookBasedOnErr(addr, err)
 		switch err.(type) {
 		case p2p.ErrSwitchAuthenticationFailure:
 			// NOTE: addr is removed from addrbook in markAddrInBookBasedOnErr
@@ -588,6 +643,8 @@ func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 		return fmt.Errorf("dialing failed (attempts: %d): %w", attempts+1, err)
 	}
 
+	r.book.RecordDial(addr, time.Since(start), nil)
+
 	// cleanup any history
 	r.attemptsToDial.Delete(addr.DialString())
 	return nil
@@ -764,16 +821,24 @@ func (r *Reactor) attemptDisconnects() {
 	}
 }
 
-func markAddrInBookBasedOnErr(addr *p2p.NetAddress, book AddrBook, err error) {
+func (r *Reactor) markAddrInBookBasedOnErr(addr *p2p.NetAddress, err error) {
 	// TODO: detect more "bad peer" scenarios
 	switch err.(type) {
 	case p2p.ErrSwitchAuthenticationFailure:
-		book.MarkBad(addr, defaultBanTime)
+		r.book.MarkBad(addr, r.banTime(addr.ID), err.Error())
 	default:
-		book.MarkAttempt(addr)
+		r.book.MarkAttempt(addr)
 	}
 }
 
+// banTime returns how long to ban the peer with the given ID for, from
+// defaultBanTime for a peer with a perfect trust score to maxBanTime for a
+// peer with a trust score of 0.
+func (r *Reactor) banTime(id p2p.ID) time.Duration {
+	distrust := time.Duration(100 - r.Switch.PeerTrustScore(id))
+	return defaultBanTime + (maxBanTime-defaultBanTime)*distrust/100
+}
+
 //-----------------------------------------------------------------------------
 // Messages
 
@@ -12,11 +12,13 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/line/ostracon/config"
 	"github.com/line/ostracon/libs/log"
 	"github.com/line/ostracon/p2p"
 	"github.com/line/ostracon/p2p/mock"
+	"github.com/line/ostracon/p2p/trust"
 	tmp2p "github.com/line/ostracon/proto/ostracon/p2p"
 )
 
@@ -207,6 +209,31 @@ func TestPEXReactorAddrsMessageAbuse(t *testing.T) {
 	assert.True(t, book.IsBanned(peer.SocketAddr()))
 }
 
+func TestPEXReactorBanTimeScalesWithTrustScore(t *testing.T) {
+	r, book := createReactor(&ReactorConfig{})
+	defer teardownReactor(book)
+
+	trustStore := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	require.NoError(t, trustStore.Start())
+	t.Cleanup(func() { require.NoError(t, trustStore.Stop()) })
+	sw := p2p.MakeSwitch(cfg, 0, "127.0.0.1", "123.123.123",
+		func(i int, sw *p2p.Switch, config *config.P2PConfig) *p2p.Switch { return sw },
+		p2p.SwitchTrustMetricStore(trustStore))
+	sw.AddReactor(r.String(), r)
+
+	id := mock.NewPeer(nil).ID()
+	assert.Equal(t, defaultBanTime, r.banTime(id))
+
+	tm := trustStore.GetPeerTrustMetric(string(id))
+	tm.BadEvents(1)
+	assert.Equal(t, maxBanTime, r.banTime(id))
+
+	tm.GoodEvents(3)
+	banTime := r.banTime(id)
+	assert.Greater(t, banTime, defaultBanTime)
+	assert.Less(t, banTime, maxBanTime)
+}
+
 func TestCheckSeeds(t *testing.T) {
 	// directory to store address books
 	dir, err := ioutil.TempDir("", "pex_reactor")
@@ -497,6 +524,34 @@ func TestPEXReactorDoesNotAddPrivatePeersToAddrBook(t *testing.T) {
 	assert.Equal(t, size, book.Size())
 }
 
+func TestPEXReactorSentryMode(t *testing.T) {
+	pexR, book := createReactor(&ReactorConfig{SentryMode: true})
+	defer teardownReactor(book)
+
+	sw := createSwitchAndAddReactors(pexR)
+	sw.SetAddrBook(book)
+
+	validator := mock.NewPeer(nil)
+	book.AddPrivateIDs([]string{string(validator.ID())})
+	p2p.AddPeerToSwitchPeerSet(sw, validator)
+
+	// the validator is never asked for addresses
+	pexR.RequestAddrs(validator)
+	assert.False(t, pexR.requestsSent.Has(string(validator.ID())))
+
+	// its PEX messages are ignored rather than punished
+	msg := mustEncode(&tmp2p.PexAddrs{Addrs: []tmp2p.NetAddress{validator.SocketAddr().ToProto()}})
+	pexR.Receive(PexChannel, validator, msg)
+	pexR.Receive(PexChannel, validator, msg)
+	assert.True(t, sw.Peers().Has(validator.ID()))
+	assert.False(t, book.IsBanned(validator.SocketAddr()))
+
+	// other peers are as usual
+	peer := mock.NewPeer(nil)
+	pexR.RequestAddrs(peer)
+	assert.True(t, pexR.requestsSent.Has(string(peer.ID())))
+}
+
 func TestPEXReactorDialPeer(t *testing.T) {
 	pexR, book := createReactor(&ReactorConfig{})
 	defer teardownReactor(book)
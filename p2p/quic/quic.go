@@ -0,0 +1,161 @@
+// Package quic provides the QUIC connections of the p2p.QUICTransport, built
+// on quic-go.
+//
+// It is a module of its own, since quic-go requires a more recent Go version
+// than the ostracon module. Importing the package registers its Provider, so
+// that a node built with it can listen on p2p.quic_laddr:
+//
+//	import _ "github.com/line/ostracon/p2p/quic"
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/line/ostracon/p2p"
+)
+
+func init() {
+	p2p.RegisterQUICProvider(Provider{})
+}
+
+// Provider implements p2p.QUICProvider with quic-go.
+type Provider struct{}
+
+var _ p2p.QUICProvider = Provider{}
+
+// Listen implements p2p.QUICProvider.
+func (Provider) Listen(
+	addr *net.UDPAddr,
+	tlsConfig *tls.Config,
+	config p2p.QUICConfig,
+) (p2p.QUICListener, error) {
+	udpConn, err := net.ListenUDP("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	tr := &quic.Transport{Conn: udpConn}
+	ln, err := tr.Listen(tlsConfig, quicConfig(config))
+	if err != nil {
+		_ = udpConn.Close()
+		return nil, err
+	}
+
+	return &listener{
+		transport: tr,
+		listener:  ln,
+		tlsConfig: tlsConfig,
+		config:    quicConfig(config),
+	}, nil
+}
+
+// Dial implements p2p.QUICProvider.
+func (Provider) Dial(
+	ctx context.Context,
+	addr *net.UDPAddr,
+	tlsConfig *tls.Config,
+	config p2p.QUICConfig,
+) (p2p.QUICConn, error) {
+	qc, err := quic.DialAddr(ctx, addr.String(), tlsConfig, quicConfig(config))
+	if err != nil {
+		return nil, err
+	}
+	return conn{qc}, nil
+}
+
+func quicConfig(config p2p.QUICConfig) *quic.Config {
+	return &quic.Config{
+		HandshakeIdleTimeout: config.HandshakeIdleTimeout,
+		MaxIdleTimeout:       config.MaxIdleTimeout,
+		KeepAlivePeriod:      config.KeepAlivePeriod,
+	}
+}
+
+// listener implements p2p.QUICListener. The connections are dialed from the
+// UDP socket of the listener.
+type listener struct {
+	transport *quic.Transport
+	listener  *quic.Listener
+	tlsConfig *tls.Config
+	config    *quic.Config
+}
+
+func (l *listener) Accept(ctx context.Context) (p2p.QUICConn, error) {
+	qc, err := l.listener.Accept(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return conn{qc}, nil
+}
+
+func (l *listener) Dial(ctx context.Context, addr *net.UDPAddr) (p2p.QUICConn, error) {
+	qc, err := l.transport.Dial(ctx, addr, l.tlsConfig, l.config)
+	if err != nil {
+		return nil, err
+	}
+	return conn{qc}, nil
+}
+
+func (l *listener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+func (l *listener) Close() error {
+	// closes the listener as well
+	err := l.transport.Close()
+	if cerr := l.transport.Conn.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// conn implements p2p.QUICConn.
+type conn struct {
+	*quic.Conn
+}
+
+func (c conn) PeerCertificates() []*x509.Certificate {
+	return c.ConnectionState().TLS.PeerCertificates
+}
+
+func (c conn) OpenStreamSync(ctx context.Context) (p2p.QUICStream, error) {
+	stream, err := c.Conn.OpenStreamSync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c conn) AcceptStream(ctx context.Context) (p2p.QUICStream, error) {
+	stream, err := c.Conn.AcceptStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c conn) OpenUniStreamSync(ctx context.Context) (io.Writer, error) {
+	stream, err := c.Conn.OpenUniStreamSync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c conn) AcceptUniStream(ctx context.Context) (io.Reader, error) {
+	stream, err := c.Conn.AcceptUniStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c conn) Close() error {
+	return c.CloseWithError(0, "")
+}
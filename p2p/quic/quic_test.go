@@ -0,0 +1,211 @@
+package quic
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/config"
+	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/crypto/secp256k1"
+	"github.com/line/ostracon/libs/log"
+	tmnet "github.com/line/ostracon/libs/net"
+	"github.com/line/ostracon/p2p"
+	tmconn "github.com/line/ostracon/p2p/conn"
+	"github.com/line/ostracon/version"
+)
+
+var testChannels = []byte{0x00, 0x01}
+
+func testConfig() *config.P2PConfig {
+	cfg := config.DefaultP2PConfig()
+	cfg.AllowDuplicateIP = true
+	return cfg
+}
+
+func testNodeInfo(t *testing.T, id p2p.ID, name string) p2p.DefaultNodeInfo {
+	return p2p.DefaultNodeInfo{
+		ProtocolVersion: p2p.NewProtocolVersion(version.P2PProtocol, version.BlockProtocol, version.AppProtocol),
+		DefaultNodeID:   id,
+		ListenAddr:      fmt.Sprintf("127.0.0.1:%d", freePort(t)),
+		Network:         "testing",
+		Version:         "1.2.3-rc0-deadbeef",
+		Channels:        testChannels,
+		Moniker:         name,
+	}
+}
+
+func freePort(t *testing.T) int {
+	port, err := tmnet.GetFreePort()
+	require.NoError(t, err)
+	return port
+}
+
+// recvReactor records the messages received on the test channels.
+type recvReactor struct {
+	p2p.BaseReactor
+
+	msgs chan []byte
+}
+
+func newRecvReactor() *recvReactor {
+	r := &recvReactor{msgs: make(chan []byte, 10)}
+	r.BaseReactor = *p2p.NewBaseReactor("recvReactor", r, true, 10)
+	return r
+}
+
+func (r *recvReactor) GetChannels() []*tmconn.ChannelDescriptor {
+	descs := make([]*tmconn.ChannelDescriptor, len(testChannels))
+	for i, id := range testChannels {
+		descs[i] = &tmconn.ChannelDescriptor{ID: id, Priority: 10}
+	}
+	return descs
+}
+
+func (r *recvReactor) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
+	r.msgs <- append([]byte{chID}, msgBytes...)
+}
+
+func assertReceived(t *testing.T, r *recvReactor, chID byte, msg []byte) {
+	select {
+	case got := <-r.msgs:
+		assert.Equal(t, append([]byte{chID}, msg...), got)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no message received on channel %X", chID)
+	}
+}
+
+// makeSwitch returns a switch with a recvReactor, listening for QUIC
+// connections, and for TCP ones as well if withTCP.
+func makeSwitch(
+	t *testing.T,
+	name string,
+	withTCP bool,
+	opts ...p2p.QUICTransportOption,
+) (*p2p.Switch, *recvReactor, *p2p.NetAddress) {
+	cfg := testConfig()
+	nodeKey := p2p.NodeKey{PrivKey: ed25519.GenPrivKey()}
+	ni := testNodeInfo(t, nodeKey.ID(), name)
+
+	quicAddr, err := p2p.NewNetAddressString(
+		p2p.IDAddressString(nodeKey.ID(), fmt.Sprintf("quic://127.0.0.1:%d", freePort(t))))
+	require.NoError(t, err)
+	qt, err := p2p.NewQUICTransport(ni, nodeKey, p2p.MConnConfig(cfg))
+	require.NoError(t, err)
+	for _, opt := range opts {
+		opt(qt)
+	}
+	require.NoError(t, qt.Listen(*quicAddr))
+	t.Cleanup(func() { _ = qt.Close() })
+
+	var transport p2p.Transport = qt
+	if withTCP {
+		tcpAddr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), ni.ListenAddr))
+		require.NoError(t, err)
+		tcp := p2p.NewMultiplexTransport(ni, nodeKey, p2p.MConnConfig(cfg))
+		require.NoError(t, tcp.Listen(*tcpAddr))
+		t.Cleanup(func() { _ = tcp.Close() })
+		transport = p2p.NewMultiProtocolTransport(map[string]p2p.Transport{
+			p2p.ProtocolTCP:  tcp,
+			p2p.ProtocolQUIC: qt,
+		})
+	}
+
+	sw := p2p.NewSwitch(cfg, transport)
+	sw.SetLogger(log.TestingLogger().With("switch", name))
+	reactor := newRecvReactor()
+	sw.AddReactor("recv", reactor)
+	sw.SetNodeKey(&nodeKey)
+	sw.SetNodeInfo(ni)
+	sw.SetAddrBook(&p2p.AddrBookMock{
+		Addrs:        map[string]struct{}{},
+		OurAddrs:     map[string]struct{}{},
+		PrivateAddrs: map[string]struct{}{},
+	})
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() { _ = sw.Stop() })
+
+	return sw, reactor, quicAddr
+}
+
+func requireRejected(t *testing.T, err error) p2p.ErrRejected {
+	var e p2p.ErrRejected
+	require.True(t, errors.As(err, &e), "expected ErrRejected, got %v", err)
+	return e
+}
+
+func TestTransportRequiresEd25519NodeKey(t *testing.T) {
+	pv := secp256k1.GenPrivKey()
+	_, err := p2p.NewQUICTransport(testNodeInfo(t, p2p.PubKeyToID(pv.PubKey()), "transport"),
+		p2p.NodeKey{PrivKey: pv}, tmconn.DefaultMConnConfig())
+	assert.Error(t, err)
+}
+
+func TestTransportDialRejectWrongID(t *testing.T) {
+	_, _, addr := makeSwitch(t, "listener", false)
+	dialer, _, _ := makeSwitch(t, "dialer", false)
+
+	wrongAddr := *addr
+	wrongAddr.ID = p2p.PubKeyToID(ed25519.GenPrivKey().PubKey())
+	err := dialer.DialPeerWithAddress(&wrongAddr)
+	assert.True(t, requireRejected(t, err).IsAuthFailure())
+}
+
+func TestTransportRejectSelf(t *testing.T) {
+	sw, _, addr := makeSwitch(t, "self", false)
+
+	err := sw.DialPeerWithAddress(addr)
+	assert.True(t, requireRejected(t, err).IsSelf())
+}
+
+func TestTransportAllowedPeerIDs(t *testing.T) {
+	_, _, addr := makeSwitch(t, "listener", false)
+	dialer, _, _ := makeSwitch(t, "dialer", false,
+		p2p.QUICTransportAllowedPeerIDs([]p2p.ID{p2p.PubKeyToID(ed25519.GenPrivKey().PubKey())}))
+
+	err := dialer.DialPeerWithAddress(addr)
+	assert.True(t, requireRejected(t, err).IsFiltered())
+}
+
+func TestSwitchQUICAndTCPPeersCoexist(t *testing.T) {
+	s1, r1, _ := makeSwitch(t, "node1", true)
+	s2, r2, s2QUICAddr := makeSwitch(t, "node2", true)
+	s3, r3, _ := makeSwitch(t, "node3", true)
+	s3TCPAddr, err := s3.NodeInfo().NetAddress()
+	require.NoError(t, err)
+
+	require.NoError(t, s1.DialPeerWithAddress(s2QUICAddr))
+	require.NoError(t, s1.DialPeerWithAddress(s3TCPAddr))
+	require.Eventually(t, func() bool {
+		return s1.Peers().Size() == 2 && s2.Peers().Size() == 1 && s3.Peers().Size() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	quicPeer := s1.Peers().Get(s2.NodeInfo().ID())
+	require.NotNil(t, quicPeer)
+	assert.Equal(t, p2p.ProtocolQUIC, quicPeer.SocketAddr().Protocol)
+	assert.Equal(t, p2p.ProtocolQUIC, s2.Peers().List()[0].SocketAddr().Protocol)
+	tcpPeer := s1.Peers().Get(s3.NodeInfo().ID())
+	require.NotNil(t, tcpPeer)
+	assert.Equal(t, p2p.ProtocolTCP, tcpPeer.SocketAddr().Protocol)
+
+	// every channel is carried by both protocols, both ways
+	for _, chID := range testChannels {
+		msg := []byte(fmt.Sprintf("channel %X", chID))
+		s1.Broadcast(chID, msg)
+		assertReceived(t, r2, chID, msg)
+		assertReceived(t, r3, chID, msg)
+	}
+	replyMsg := []byte("reply")
+	require.True(t, s2.Peers().List()[0].Send(0x01, replyMsg))
+	assertReceived(t, r1, 0x01, replyMsg)
+
+	// stopping a QUIC peer disconnects it from the other side as well
+	s1.StopPeerGracefully(quicPeer)
+	require.Eventually(t, func() bool {
+		return s1.Peers().Size() == 1 && s2.Peers().Size() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/line/ostracon/libs/rand"
 	"github.com/line/ostracon/libs/service"
 	"github.com/line/ostracon/p2p/conn"
+	"github.com/line/ostracon/p2p/trust"
 )
 
 const (
@@ -27,15 +28,27 @@ const (
 	// ie. 3**10 = 16hrs
 	reconnectBackOffAttempts    = 10
 	reconnectBackOffBaseSeconds = 3
+
+	// an inbound peer is only evicted for a peer whose trust score is higher by
+	// at least this much; a peer without history counts as a neutral score
+	minEvictionScoreGap      = 10
+	unknownPeerEvictionScore = 50
 )
 
 // MConnConfig returns an MConnConfig with fields updated
 // from the P2PConfig.
+//
+// NOTE: every call returns new bandwidth budgets, the transports of a node are
+// to share the same MConnConfig.
 func MConnConfig(cfg *config.P2PConfig) conn.MConnConfig {
 	mConfig := conn.DefaultMConnConfig()
 	mConfig.FlushThrottle = cfg.FlushThrottleTimeout
 	mConfig.SendRate = cfg.SendRate
 	mConfig.RecvRate = cfg.RecvRate
+	mConfig.ChannelSendRates = cfg.ChannelSendRateMap()
+	mConfig.ChannelRecvRates = cfg.ChannelRecvRateMap()
+	mConfig.SendBudget = conn.NewBandwidthBudget(cfg.TotalSendRate)
+	mConfig.RecvBudget = conn.NewBandwidthBudget(cfg.TotalRecvRate)
 	mConfig.MaxPacketMsgPayloadSize = cfg.MaxPacketMsgPayloadSize
 	mConfig.RecvAsync = cfg.RecvAsync
 	return mConfig
@@ -53,6 +66,7 @@ type AddrBook interface {
 	MarkGood(ID)
 	RemoveAddress(*NetAddress)
 	HasAddress(*NetAddress) bool
+	IsBanned(*NetAddress) bool
 	Save()
 }
 
@@ -91,6 +105,9 @@ type Switch struct {
 	rng *rand.Rand // seed for randomizing dial times and orders
 
 	metrics *Metrics
+
+	// trust metrics of the peers, fed with the behaviour of the peers
+	trustStore *trust.MetricStore
 }
 
 // NetAddress returns the address the switch is listening on.
@@ -150,6 +167,14 @@ func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
 }
 
+// SwitchTrustMetricStore sets the store of the trust metrics of the peers. The
+// switch records a bad event for every peer stopped for an error and a good
+// event for every peer marked as good, prefers the inbound peers with the
+// highest trust scores when full, and starts and stops the store.
+func SwitchTrustMetricStore(store *trust.MetricStore) SwitchOption {
+	return func(sw *Switch) { sw.trustStore = store }
+}
+
 //---------------------------------------------------------------------
 // Switch setup
 
@@ -222,6 +247,12 @@ func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
 
 // OnStart implements BaseService. It starts all the reactors and peers.
 func (sw *Switch) OnStart() error {
+	if sw.trustStore != nil {
+		if err := sw.trustStore.Start(); err != nil {
+			return fmt.Errorf("failed to start trust metric store: %w", err)
+		}
+	}
+
 	// Start reactors
 	for _, reactor := range sw.reactors {
 		err := reactor.Start()
@@ -250,6 +281,12 @@ func (sw *Switch) OnStop() {
 			sw.Logger.Error("error while stopped reactor", "reactor", reactor, "error", err)
 		}
 	}
+
+	if sw.trustStore != nil {
+		if err := sw.trustStore.Stop(); err != nil {
+			sw.Logger.Error("error while stopping trust metric store", "error", err)
+		}
+	}
 }
 
 //---------------------------------------------------------------------
@@ -319,7 +356,8 @@ func (sw *Switch) Peers() IPeerSet {
 	return sw.peers
 }
 
-// StopPeerForError disconnects from a peer due to external error.
+// StopPeerForError disconnects from a peer due to external error, recording a
+// bad event in the trust metric of the peer.
 // If the peer is persistent, it will attempt to reconnect.
 // TODO: make record depending on reason.
 func (sw *Switch) StopPeerForError(peer Peer, reason interface{}) {
@@ -328,6 +366,9 @@ func (sw *Switch) StopPeerForError(peer Peer, reason interface{}) {
 	}
 
 	sw.Logger.Error("Stopping peer for error", "peer", peer, "err", reason)
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).BadEvents(1)
+	}
 	sw.stopAndRemovePeer(peer, reason)
 
 	if peer.IsPersistent() {
@@ -371,6 +412,10 @@ func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 	if sw.peers.Remove(peer) {
 		sw.metrics.Peers.Add(float64(-1))
 	}
+
+	if sw.trustStore != nil {
+		sw.trustStore.PeerDisconnected(string(peer.ID()))
+	}
 }
 
 // reconnectToPeer tries to reconnect to the addr, first repeatedly
@@ -436,11 +481,50 @@ func (sw *Switch) SetAddrBook(addrBook AddrBook) {
 }
 
 // MarkPeerAsGood marks the given peer as good when it did something useful
-// like contributed to consensus.
+// like contributed to consensus, recording a good event in its trust metric.
 func (sw *Switch) MarkPeerAsGood(peer Peer) {
 	if sw.addrBook != nil {
 		sw.addrBook.MarkGood(peer.ID())
 	}
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(peer.ID())).GoodEvents(1)
+	}
+}
+
+// PeerTrustScore returns the trust score of the peer with the given ID, between
+// 0 and 100. A peer the switch knows nothing about, or any peer if the switch
+// has no trust metric store, has a perfect score.
+func (sw *Switch) PeerTrustScore(id ID) int {
+	if sw.trustStore == nil {
+		return 100
+	}
+	return sw.trustStore.PeerTrustScore(string(id))
+}
+
+// PeerRankingScore returns the score to rank the peer with the given ID by
+// against other peers, when choosing which peers to dial or to keep: its trust
+// score, or the neutral unknownPeerEvictionScore for a peer without any
+// history, so that never-seen peers don't outrank established ones.
+func (sw *Switch) PeerRankingScore(id ID) int {
+	if sw.trustStore == nil || !sw.trustStore.HasPeerTrustMetric(string(id)) {
+		return unknownPeerEvictionScore
+	}
+	return sw.trustStore.PeerTrustScore(string(id))
+}
+
+// lowestScoringInboundPeer returns the inbound peer with the lowest trust
+// score, or nil if there's none. Persistent and unconditional peers are never
+// returned.
+func (sw *Switch) lowestScoringInboundPeer() (lowest Peer, lowestScore int) {
+	for _, peer := range sw.peers.List() {
+		if peer.IsOutbound() || peer.IsPersistent() || sw.IsPeerUnconditional(peer.ID()) {
+			continue
+		}
+		if score := sw.PeerTrustScore(peer.ID()); lowest == nil || score < lowestScore {
+			lowest, lowestScore = peer, score
+		}
+	}
+	return lowest, lowestScore
 }
 
 //---------------------------------------------------------------------
@@ -583,7 +667,7 @@ func (sw *Switch) AddPersistentPeers(addrs []string) error {
 func (sw *Switch) AddUnconditionalPeerIDs(ids []string) error {
 	sw.Logger.Info("Adding unconditional peer ids", "ids", ids)
 	for i, id := range ids {
-		err := validateID(ID(id))
+		err := ValidateID(ID(id))
 		if err != nil {
 			return fmt.Errorf("wrong ID #%d: %w", i, err)
 		}
@@ -595,7 +679,7 @@ func (sw *Switch) AddUnconditionalPeerIDs(ids []string) error {
 func (sw *Switch) AddPrivatePeerIDs(ids []string) error {
 	validIDs := make([]string, 0, len(ids))
 	for i, id := range ids {
-		err := validateID(ID(id))
+		err := ValidateID(ID(id))
 		if err != nil {
 			return fmt.Errorf("wrong ID #%d: %w", i, err)
 		}
@@ -672,10 +756,18 @@ func (sw *Switch) acceptRoutine() {
 			break
 		}
 
+		var (
+			evictee      Peer
+			evicteeScore int
+		)
 		if !sw.IsPeerUnconditional(p.NodeInfo().ID()) {
-			// Ignore connection if we already have enough peers.
+			// Ignore connection if we already have enough peers, unless we can
+			// evict an inbound peer with a lower trust score.
 			_, in, _ := sw.NumPeers()
 			if in >= sw.config.MaxNumInboundPeers {
+				evictee, evicteeScore = sw.inboundPeerToEvictFor(p)
+			}
+			if in >= sw.config.MaxNumInboundPeers && evictee == nil {
 				sw.Logger.Info(
 					"Ignoring inbound connection: already have enough inbound peers",
 					"address", p.SocketAddr(),
@@ -700,10 +792,35 @@ func (sw *Switch) acceptRoutine() {
 				"err", err,
 				"id", p.ID(),
 			)
+			continue
+		}
+
+		// Only make room once the new peer passed the filters and was added.
+		if evictee != nil && sw.peers.Has(evictee.ID()) {
+			sw.Logger.Info("Evicting inbound peer with a lower trust score",
+				"peer", evictee, "score", evicteeScore, "for", p.ID())
+			sw.stopAndRemovePeer(evictee, ErrPeerEvicted{ID: evictee.ID(), Score: evicteeScore})
 		}
 	}
 }
 
+// inboundPeerToEvictFor returns the inbound peer with the lowest trust score,
+// and its score, to make room for the given peer, if its score is at least
+// minEvictionScoreGap lower than the ranking score of the given peer, so that
+// fresh node IDs can't push out established peers. It returns nil otherwise.
+//
+// The peer is only to be evicted once the given peer was added.
+func (sw *Switch) inboundPeerToEvictFor(p Peer) (Peer, int) {
+	lowest, lowestScore := sw.lowestScoringInboundPeer()
+	if lowest == nil {
+		return nil, 0
+	}
+	if sw.PeerRankingScore(p.ID())-lowestScore < minEvictionScoreGap {
+		return nil, 0
+	}
+	return lowest, lowestScore
+}
+
 // dial the peer; make secret connection; authenticate against the dialed ID;
 // add the peer.
 // if dialing fails, start the reconnect loop. If handshake fails, it's over.
@@ -766,6 +883,10 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	if sw.isBanned(p) {
+		return ErrRejected{id: p.ID(), isBanned: true}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
@@ -788,6 +909,15 @@ func (sw *Switch) filterPeer(p Peer) error {
 	return nil
 }
 
+// isBanned returns true if the address book has banned the peer.
+func (sw *Switch) isBanned(p Peer) bool {
+	if sw.addrBook == nil {
+		return false
+	}
+	addr, err := p.NodeInfo().NetAddress()
+	return err == nil && sw.addrBook.IsBanned(addr)
+}
+
 // addPeer starts up the Peer and adds it to the Switch. Error is returned if
 // the peer is filtered out or failed to start or can't be added.
 func (sw *Switch) addPeer(p Peer) error {
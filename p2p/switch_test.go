@@ -16,13 +16,16 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/line/ostracon/config"
+	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/crypto/ed25519"
 	"github.com/line/ostracon/libs/log"
 	net2 "github.com/line/ostracon/libs/net"
 	tmsync "github.com/line/ostracon/libs/sync"
 	"github.com/line/ostracon/p2p/conn"
+	"github.com/line/ostracon/p2p/trust"
 )
 
 var (
@@ -345,6 +348,43 @@ func TestSwitchPeerFilterDuplicate(t *testing.T) {
 	}
 }
 
+func TestSwitchPeerFilterBanned(t *testing.T) {
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
+	sw.SetAddrBook(&addrBookMock{
+		addrs:    make(map[string]struct{}),
+		ourAddrs: make(map[string]struct{}),
+		banned:   map[ID]struct{}{rp.ID(): {}},
+	})
+	err := sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// a banned peer is neither dialed
+	err = sw.DialPeerWithAddress(rp.Addr())
+	if errRej, ok := err.(ErrRejected); ok {
+		if !errRej.IsBanned() {
+			t.Errorf("expected peer to be banned. got %v", errRej)
+		}
+	} else {
+		t.Errorf("expected ErrRejected, got %v", err)
+	}
+
+	// nor accepted
+	conn, err := rp.Dial(sw.NetAddress())
+	require.NoError(t, err)
+	defer conn.Close()
+	assertNoPeersAfterTimeout(t, sw, 100*time.Millisecond)
+}
+
 func assertNoPeersAfterTimeout(t *testing.T, sw *Switch, timeout time.Duration) {
 	time.Sleep(timeout)
 	if sw.Peers().Size() != 0 {
@@ -670,6 +710,144 @@ func TestSwitchAcceptRoutine(t *testing.T) {
 	}
 }
 
+func TestSwitchTrustMetricStore(t *testing.T) {
+	c := *cfg
+	c.MaxNumInboundPeers = 2
+
+	trustStore := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	sw := MakeSwitch(&c, 1, "testing", "123.123.123", initSwitchFunc, SwitchTrustMetricStore(trustStore))
+	err := sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := sw.Stop()
+		require.NoError(t, err)
+	})
+	assert.True(t, trustStore.IsRunning())
+
+	dial := func() *remotePeer {
+		peer := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: &c}
+		peer.Start()
+		t.Cleanup(peer.Stop)
+		conn, err := peer.Dial(sw.NetAddress())
+		require.NoError(t, err)
+		// spawn a reading routine to prevent connection from closing
+		go func(conn net.Conn) {
+			for {
+				one := make([]byte, 1)
+				_, err := conn.Read(one)
+				if err != nil {
+					return
+				}
+			}
+		}(conn)
+		return peer
+	}
+	hasPeer := func(peer *remotePeer) func() bool {
+		return func() bool { return sw.Peers().Has(peer.ID()) }
+	}
+
+	good, bad := dial(), dial()
+	require.Eventually(t, hasPeer(good), time.Second, 10*time.Millisecond)
+	require.Eventually(t, hasPeer(bad), time.Second, 10*time.Millisecond)
+
+	sw.MarkPeerAsGood(sw.Peers().Get(good.ID()))
+	trustStore.GetPeerTrustMetric(string(bad.ID())).BadEvents(1)
+	assert.Equal(t, 100, sw.PeerTrustScore(good.ID()))
+	assert.Less(t, sw.PeerTrustScore(bad.ID()), 100)
+
+	// the inbound peer with the lowest score makes room for a new one
+	newcomer := dial()
+	require.Eventually(t, hasPeer(newcomer), time.Second, 10*time.Millisecond)
+	assert.False(t, sw.Peers().Has(bad.ID()))
+	assert.True(t, sw.Peers().Has(good.ID()))
+
+	// a peer stopped for an error loses trust
+	sw.StopPeerForError(sw.Peers().Get(good.ID()), errors.New("some err"))
+	assert.Less(t, sw.PeerTrustScore(good.ID()), 100)
+
+	// no inbound peer makes room for a peer with the same score
+	other := dial()
+	require.Eventually(t, hasPeer(other), time.Second, 10*time.Millisecond)
+	dial()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 2, sw.Peers().Size())
+	assert.True(t, sw.Peers().Has(newcomer.ID()))
+	assert.True(t, sw.Peers().Has(other.ID()))
+
+	// a peer without history can't evict an established peer scoring 99
+	tm := trustStore.GetPeerTrustMetric(string(other.ID()))
+	tm.GoodEvents(200)
+	tm.BadEvents(1)
+	require.Equal(t, 99, sw.PeerTrustScore(other.ID()))
+	trustStore.GetPeerTrustMetric(string(newcomer.ID())).GoodEvents(1)
+	fresh := dial()
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, sw.Peers().Has(fresh.ID()))
+	assert.True(t, sw.Peers().Has(newcomer.ID()))
+	assert.True(t, sw.Peers().Has(other.ID()))
+}
+
+func TestSwitchEvictsOnlyForAddedPeers(t *testing.T) {
+	c := *cfg
+	c.MaxNumInboundPeers = 1
+
+	filteredKey := ed25519.GenPrivKey()
+	filteredID := PubKeyToID(filteredKey.PubKey())
+	trustStore := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	sw := MakeSwitch(&c, 1, "testing", "123.123.123", initSwitchFunc, SwitchTrustMetricStore(trustStore),
+		SwitchPeerFilters(func(_ IPeerSet, p Peer) error {
+			if p.ID() == filteredID {
+				return errors.New("filtered")
+			}
+			return nil
+		}))
+	err := sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := sw.Stop()
+		require.NoError(t, err)
+	})
+
+	dial := func(privKey crypto.PrivKey) *remotePeer {
+		peer := &remotePeer{PrivKey: privKey, Config: &c}
+		peer.Start()
+		t.Cleanup(peer.Stop)
+		conn, err := peer.Dial(sw.NetAddress())
+		require.NoError(t, err)
+		// spawn a reading routine to prevent connection from closing
+		go func(conn net.Conn) {
+			for {
+				one := make([]byte, 1)
+				_, err := conn.Read(one)
+				if err != nil {
+					return
+				}
+			}
+		}(conn)
+		return peer
+	}
+
+	bad := dial(ed25519.GenPrivKey())
+	require.Eventually(t, func() bool { return sw.Peers().Has(bad.ID()) }, time.Second, 10*time.Millisecond)
+	trustStore.GetPeerTrustMetric(string(bad.ID())).BadEvents(1)
+
+	// a peer without history ranks as neutral
+	assert.Equal(t, unknownPeerEvictionScore, sw.PeerRankingScore(filteredID))
+
+	// a filtered peer doesn't make room for itself
+	trustStore.GetPeerTrustMetric(string(filteredID)).GoodEvents(1)
+	dial(filteredKey)
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, sw.Peers().Has(filteredID))
+	assert.True(t, sw.Peers().Has(bad.ID()))
+
+	// a peer that is added does
+	newcomer := dial(ed25519.GenPrivKey())
+	require.Eventually(t, func() bool { return !sw.Peers().Has(bad.ID()) }, time.Second, 10*time.Millisecond)
+	assert.True(t, sw.Peers().Has(newcomer.ID()))
+	assert.Equal(t, 1, sw.Peers().Size())
+}
+
 type errorTransport struct {
 	acceptErr error
 }
@@ -840,6 +1018,7 @@ func BenchmarkSwitchBroadcast(b *testing.B) {
 type addrBookMock struct {
 	addrs    map[string]struct{}
 	ourAddrs map[string]struct{}
+	banned   map[ID]struct{}
 }
 
 var _ AddrBook = (*addrBookMock)(nil)
@@ -864,6 +1043,10 @@ func (book *addrBookMock) HasAddress(addr *NetAddress) bool {
 	_, ok := book.addrs[addr.String()]
 	return ok
 }
+func (book *addrBookMock) IsBanned(addr *NetAddress) bool {
+	_, ok := book.banned[addr.ID]
+	return ok
+}
 func (book *addrBookMock) Save() {}
 
 type NormalReactor struct {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/libs/cmap"
 	"github.com/line/ostracon/libs/log"
 	tmnet "github.com/line/ostracon/libs/net"
 	tmrand "github.com/line/ostracon/libs/rand"
@@ -42,6 +43,7 @@ func CreateRandomPeer(outbound bool) Peer {
 		nodeInfo: mockNodeInfo{netAddr},
 		mconn:    &conn.MConnection{},
 		metrics:  NopMetrics(),
+		Data:     cmap.NewCMap(),
 	}
 	if p.Logger == nil {
 		p.SetLogger(log.TestingLogger().With("peer", addr))
@@ -311,6 +313,7 @@ func (book *AddrBookMock) HasAddress(addr *NetAddress) bool {
 	_, ok := book.Addrs[addr.String()]
 	return ok
 }
+func (book *AddrBookMock) IsBanned(addr *NetAddress) bool { return false }
 func (book *AddrBookMock) RemoveAddress(addr *NetAddress) {
 	delete(book.Addrs, addr.String())
 }
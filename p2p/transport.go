@@ -130,6 +130,13 @@ func MultiplexTransportMaxIncomingConnections(n int) MultiplexTransportOption {
 	return func(mt *MultiplexTransport) { mt.maxIncomingConnections = n }
 }
 
+// MultiplexTransportAllowedPeerIDs restricts the peers to the ones with the
+// given IDs, rejecting any other right after the secret connection handshake
+// authenticates it, before the NodeInfo exchange. Default: nil (any peer)
+func MultiplexTransportAllowedPeerIDs(ids []ID) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) { mt.allowedIDs = newIDSet(ids) }
+}
+
 // MultiplexTransport accepts and dials tcp connections and upgrades them to
 // multiplexed peers.
 type MultiplexTransport struct {
@@ -143,6 +150,7 @@ type MultiplexTransport struct {
 	// Lookup table for duplicate ip and id checks.
 	conns       ConnSet
 	connFilters []ConnFilterFunc
+	allowedIDs  map[ID]struct{} // nil for any peer
 
 	dialTimeout      time.Duration
 	filterTimeout    time.Duration
@@ -359,7 +367,19 @@ func (mt *MultiplexTransport) cleanup(c net.Conn) error {
 	return c.Close()
 }
 
-func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
+func (mt *MultiplexTransport) filterConn(c net.Conn) error {
+	return filterConn(c, mt.conns, mt.connFilters, mt.filterTimeout, mt.resolver)
+}
+
+// filterConn runs the filters on the connection, adding it to the set of
+// connections if none of them rejects it. It closes a rejected connection.
+func filterConn(
+	c net.Conn,
+	conns ConnSet,
+	connFilters []ConnFilterFunc,
+	filterTimeout time.Duration,
+	resolver IPResolver,
+) (err error) {
 	defer func() {
 		if err != nil {
 			_ = c.Close()
@@ -367,21 +387,21 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 	}()
 
 	// Reject if connection is already present.
-	if mt.conns.Has(c) {
+	if conns.Has(c) {
 		return ErrRejected{conn: c, isDuplicate: true}
 	}
 
 	// Resolve ips for incoming conn.
-	ips, err := resolveIPs(mt.resolver, c)
+	ips, err := resolveIPs(resolver, c)
 	if err != nil {
 		return err
 	}
 
-	errc := make(chan error, len(mt.connFilters))
+	errc := make(chan error, len(connFilters))
 
-	for _, f := range mt.connFilters {
+	for _, f := range connFilters {
 		go func(f ConnFilterFunc, c net.Conn, ips []net.IP, errc chan<- error) {
-			errc <- f(mt.conns, c, ips)
+			errc <- f(conns, c, ips)
 		}(f, c, ips, errc)
 	}
 
@@ -391,13 +411,13 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 			if err != nil {
 				return ErrRejected{conn: c, err: err, isFiltered: true}
 			}
-		case <-time.After(mt.filterTimeout):
+		case <-time.After(filterTimeout):
 			return ErrFilterTimeout{}
 		}
 
 	}
 
-	mt.conns.Set(c, ips)
+	conns.Set(c, ips)
 
 	return nil
 }
@@ -438,6 +458,10 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
+	if err := checkPeerIDAllowed(c, connID, mt.allowedIDs); err != nil {
+		return nil, nil, err
+	}
+
 	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
 	if err != nil {
 		return nil, nil, ErrRejected{
@@ -447,45 +471,8 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	if err := nodeInfo.Validate(); err != nil {
-		return nil, nil, ErrRejected{
-			conn:              c,
-			err:               err,
-			isNodeInfoInvalid: true,
-		}
-	}
-
-	// Ensure connection key matches self reported key.
-	if connID != nodeInfo.ID() {
-		return nil, nil, ErrRejected{
-			conn: c,
-			id:   connID,
-			err: fmt.Errorf(
-				"conn.ID (%v) NodeInfo.ID (%v) mismatch",
-				connID,
-				nodeInfo.ID(),
-			),
-			isAuthFailure: true,
-		}
-	}
-
-	// Reject self.
-	if mt.nodeInfo.ID() == nodeInfo.ID() {
-		return nil, nil, ErrRejected{
-			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
-			conn:   c,
-			id:     nodeInfo.ID(),
-			isSelf: true,
-		}
-	}
-
-	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
-		return nil, nil, ErrRejected{
-			conn:           c,
-			err:            err,
-			id:             nodeInfo.ID(),
-			isIncompatible: true,
-		}
+	if err := checkPeerNodeInfo(c, connID, mt.nodeInfo, nodeInfo); err != nil {
+		return nil, nil, err
 	}
 
 	return secretConn, nodeInfo, nil
@@ -530,6 +517,83 @@ func (mt *MultiplexTransport) wrapPeer(
 	return p
 }
 
+// newIDSet returns the set of the given IDs, or nil if there are none.
+func newIDSet(ids []ID) map[ID]struct{} {
+	if len(ids) == 0 {
+		return nil
+	}
+	set := make(map[ID]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+// checkPeerIDAllowed rejects the connection authenticated with connID if there
+// are allowed IDs and connID isn't one of them.
+func checkPeerIDAllowed(c net.Conn, connID ID, allowedIDs map[ID]struct{}) error {
+	if allowedIDs == nil {
+		return nil
+	}
+	if _, ok := allowedIDs[connID]; ok {
+		return nil
+	}
+	return ErrRejected{
+		conn:       c,
+		id:         connID,
+		err:        fmt.Errorf("peer ID %v is not allowed", connID),
+		isFiltered: true,
+	}
+}
+
+// checkPeerNodeInfo rejects the NodeInfo received during the handshake over the
+// connection authenticated with connID, if it's invalid, not the one of
+// connID, our own or incompatible with ours.
+func checkPeerNodeInfo(c net.Conn, connID ID, ourNodeInfo, nodeInfo NodeInfo) error {
+	if err := nodeInfo.Validate(); err != nil {
+		return ErrRejected{
+			conn:              c,
+			err:               err,
+			isNodeInfoInvalid: true,
+		}
+	}
+
+	// Ensure connection key matches self reported key.
+	if connID != nodeInfo.ID() {
+		return ErrRejected{
+			conn: c,
+			id:   connID,
+			err: fmt.Errorf(
+				"conn.ID (%v) NodeInfo.ID (%v) mismatch",
+				connID,
+				nodeInfo.ID(),
+			),
+			isAuthFailure: true,
+		}
+	}
+
+	// Reject self.
+	if ourNodeInfo.ID() == nodeInfo.ID() {
+		return ErrRejected{
+			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
+			conn:   c,
+			id:     nodeInfo.ID(),
+			isSelf: true,
+		}
+	}
+
+	if err := ourNodeInfo.CompatibleWith(nodeInfo); err != nil {
+		return ErrRejected{
+			conn:           c,
+			err:            err,
+			id:             nodeInfo.ID(),
+			isIncompatible: true,
+		}
+	}
+
+	return nil
+}
+
 func handshake(
 	c net.Conn,
 	timeout time.Duration,
@@ -0,0 +1,314 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/p2p/conn"
+)
+
+// MemoryNetwork connects the MemoryTransports listening on it, by the ID of
+// their node.
+type MemoryNetwork struct {
+	mtx        tmsync.Mutex
+	transports map[ID]*MemoryTransport
+}
+
+// NewMemoryNetwork returns a MemoryNetwork without any transport.
+func NewMemoryNetwork() *MemoryNetwork {
+	return &MemoryNetwork{transports: make(map[ID]*MemoryTransport)}
+}
+
+func (n *MemoryNetwork) add(mt *MemoryTransport) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	id := mt.nodeKey.ID()
+	if _, ok := n.transports[id]; ok {
+		return fmt.Errorf("a memory transport of %v is already listening", id)
+	}
+	n.transports[id] = mt
+	return nil
+}
+
+func (n *MemoryNetwork) remove(mt *MemoryTransport) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	id := mt.nodeKey.ID()
+	if n.transports[id] == mt {
+		delete(n.transports, id)
+	}
+}
+
+func (n *MemoryNetwork) get(id ID) (*MemoryTransport, bool) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	mt, ok := n.transports[id]
+	return mt, ok
+}
+
+// MemoryTransport connects the peers of several nodes in one process, e.g. in
+// tests and simulations, over in-memory pipes of a MemoryNetwork instead of
+// sockets. The connections are upgraded to secret connections, and the peers
+// exchange their NodeInfo, as with the MultiplexTransport, but no connection
+// filter is applied.
+//
+// A peer is dialed by the ID of its address, and the transport must be
+// listening to dial, for the peer to know where the connection comes from.
+type MemoryTransport struct {
+	network *MemoryNetwork
+	netAddr NetAddress
+
+	acceptc chan accept
+	closec  chan struct{}
+
+	handshakeTimeout time.Duration
+	nodeInfo         NodeInfo
+	nodeKey          NodeKey
+
+	mConfig conn.MConnConfig
+}
+
+// Test MemoryTransport for interface completeness.
+var _ Transport = (*MemoryTransport)(nil)
+var _ transportLifecycle = (*MemoryTransport)(nil)
+
+// NewMemoryTransport returns a transport connecting peers over the given
+// network.
+func NewMemoryTransport(
+	network *MemoryNetwork,
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+) *MemoryTransport {
+	return &MemoryTransport{
+		network:          network,
+		acceptc:          make(chan accept),
+		closec:           make(chan struct{}),
+		handshakeTimeout: defaultHandshakeTimeout,
+		mConfig:          mConfig,
+		nodeInfo:         nodeInfo,
+		nodeKey:          nodeKey,
+	}
+}
+
+// NetAddress implements Transport.
+func (mt *MemoryTransport) NetAddress() NetAddress {
+	return mt.netAddr
+}
+
+// Accept implements Transport.
+func (mt *MemoryTransport) Accept(cfg peerConfig) (Peer, error) {
+	select {
+	case a := <-mt.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+
+		cfg.outbound = false
+
+		return mt.wrapPeer(a.conn, a.nodeInfo, cfg, a.netAddr), nil
+	case <-mt.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport. It connects to the transport listening with the
+// ID of the address, and returns once both ends are upgraded.
+func (mt *MemoryTransport) Dial(
+	addr NetAddress,
+	cfg peerConfig,
+) (Peer, error) {
+	if mt.netAddr.ID == "" {
+		return nil, fmt.Errorf("memory transport of %v must listen to dial", mt.nodeKey.ID())
+	}
+	if addr.ID == mt.nodeKey.ID() {
+		return nil, ErrRejected{addr: addr, id: addr.ID, isSelf: true}
+	}
+	remote, ok := mt.network.get(addr.ID)
+	if !ok {
+		return nil, fmt.Errorf("no memory transport of %v is listening", addr.ID)
+	}
+
+	p1, p2 := net.Pipe()
+	c := &memoryConn{Conn: p1, local: mt.netAddr, remote: remote.netAddr}
+	rc := &memoryConn{Conn: p2, local: remote.netAddr, remote: mt.netAddr}
+
+	go remote.acceptConn(rc)
+
+	secretConn, nodeInfo, err := mt.upgrade(c, &addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return mt.wrapPeer(secretConn, nodeInfo, cfg, &addr), nil
+}
+
+// Close implements transportLifecycle.
+func (mt *MemoryTransport) Close() error {
+	mt.network.remove(mt)
+	close(mt.closec)
+	return nil
+}
+
+// Listen implements transportLifecycle. The address must have the ID of the
+// node; its IP and port are only reported to the peers.
+func (mt *MemoryTransport) Listen(addr NetAddress) error {
+	if addr.ID != mt.nodeKey.ID() {
+		return fmt.Errorf("memory transport of %v can't listen on %v", mt.nodeKey.ID(), addr)
+	}
+
+	mt.netAddr = addr
+	if err := mt.network.add(mt); err != nil {
+		mt.netAddr = NetAddress{}
+		return err
+	}
+
+	return nil
+}
+
+// AddChannel registers a channel to nodeInfo.
+// NOTE: NodeInfo must be of type DefaultNodeInfo else channels won't be updated
+func (mt *MemoryTransport) AddChannel(chID byte) error {
+	ni, ok := mt.nodeInfo.(DefaultNodeInfo)
+	if !ok {
+		return fmt.Errorf("nodeInfo type: %T is not supported", mt.nodeInfo)
+	}
+	if !ni.HasChannel(chID) {
+		ni.Channels = append(ni.Channels, chID)
+	}
+	mt.nodeInfo = ni
+	return nil
+}
+
+// Cleanup implements Transport.
+func (mt *MemoryTransport) Cleanup(p Peer) {
+	_ = p.CloseConn()
+}
+
+// acceptConn upgrades the end of a connection dialed by a peer, and makes it
+// available to Accept.
+func (mt *MemoryTransport) acceptConn(c *memoryConn) {
+	var netAddr *NetAddress
+	secretConn, nodeInfo, err := mt.upgrade(c, nil)
+	if err == nil {
+		netAddr = NewNetAddress(PubKeyToID(secretConn.RemotePubKey()), c.RemoteAddr())
+	}
+
+	select {
+	case mt.acceptc <- accept{netAddr, secretConn, nodeInfo, err}:
+	case <-mt.closec:
+		_ = c.Close()
+	}
+}
+
+func (mt *MemoryTransport) upgrade(
+	c net.Conn,
+	dialedAddr *NetAddress,
+) (secretConn *conn.SecretConnection, nodeInfo NodeInfo, err error) {
+	defer func() {
+		if err != nil {
+			_ = c.Close()
+		}
+	}()
+
+	secretConn, err = upgradeSecretConn(c, mt.handshakeTimeout, mt.nodeKey.PrivKey)
+	if err != nil {
+		return nil, nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("secret conn failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	// For outgoing conns, ensure connection key matches dialed key.
+	connID := PubKeyToID(secretConn.RemotePubKey())
+	if dialedAddr != nil {
+		if dialedID := dialedAddr.ID; connID != dialedID {
+			return nil, nil, ErrRejected{
+				conn: c,
+				id:   connID,
+				err: fmt.Errorf(
+					"conn.ID (%v) dialed ID (%v) mismatch",
+					connID,
+					dialedID,
+				),
+				isAuthFailure: true,
+			}
+		}
+	}
+
+	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
+	if err != nil {
+		return nil, nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("handshake failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	if err := checkPeerNodeInfo(c, connID, mt.nodeInfo, nodeInfo); err != nil {
+		return nil, nil, err
+	}
+
+	return secretConn, nodeInfo, nil
+}
+
+func (mt *MemoryTransport) wrapPeer(
+	c net.Conn,
+	ni NodeInfo,
+	cfg peerConfig,
+	socketAddr *NetAddress,
+) Peer {
+
+	persistent := false
+	if cfg.isPersistent != nil {
+		if cfg.outbound {
+			persistent = cfg.isPersistent(socketAddr)
+		} else {
+			selfReportedAddr, err := ni.NetAddress()
+			if err == nil {
+				persistent = cfg.isPersistent(selfReportedAddr)
+			}
+		}
+	}
+
+	peerConn := newPeerConn(
+		cfg.outbound,
+		persistent,
+		c,
+		socketAddr,
+	)
+
+	return newPeer(
+		peerConn,
+		mt.mConfig,
+		ni,
+		cfg.reactorsByCh,
+		cfg.chDescs,
+		cfg.onPeerError,
+		PeerMetrics(cfg.metrics),
+	)
+}
+
+// memoryConn is an end of an in-memory pipe, with the listening addresses of
+// the transports at both ends as its addresses.
+type memoryConn struct {
+	net.Conn
+
+	local, remote NetAddress
+}
+
+func (c *memoryConn) LocalAddr() net.Addr {
+	return &net.TCPAddr{IP: c.local.IP, Port: int(c.local.Port)}
+}
+
+func (c *memoryConn) RemoteAddr() net.Addr {
+	return &net.TCPAddr{IP: c.remote.IP, Port: int(c.remote.Port)}
+}
@@ -0,0 +1,135 @@
+package p2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/config"
+	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/libs/log"
+	"github.com/line/ostracon/p2p/conn"
+)
+
+// makeMemorySwitch returns a switch listening on the network, with a
+// TestReactor on channel 0x00.
+func makeMemorySwitch(t *testing.T, network *MemoryNetwork, cfg *config.P2PConfig, i int) (*Switch, *NetAddress) {
+	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
+	ni := testNodeInfo(nodeKey.ID(), fmt.Sprintf("node%d", i)).(DefaultNodeInfo)
+	ni.Channels = []byte{0x00}
+
+	addr, err := NewNetAddressString(IDAddressString(nodeKey.ID(), ni.ListenAddr))
+	require.NoError(t, err)
+	mt := NewMemoryTransport(network, ni, nodeKey, MConnConfig(cfg))
+	require.NoError(t, mt.Listen(*addr))
+	t.Cleanup(func() { _ = mt.Close() })
+
+	sw := NewSwitch(cfg, mt)
+	sw.SetLogger(log.TestingLogger().With("switch", i))
+	sw.AddReactor("foo", NewTestReactor([]*conn.ChannelDescriptor{
+		{ID: byte(0x00), Priority: 10},
+	}, cfg.RecvAsync, 1000, true))
+	sw.SetNodeKey(&nodeKey)
+	sw.SetNodeInfo(ni)
+
+	return sw, addr
+}
+
+func TestSwitchMemoryTransport(t *testing.T) {
+	network := NewMemoryNetwork()
+	s1, _ := makeMemorySwitch(t, network, cfg, 1)
+	s2, s2Addr := makeMemorySwitch(t, network, cfg, 2)
+	for _, sw := range []*Switch{s1, s2} {
+		require.NoError(t, sw.Start())
+		sw := sw
+		t.Cleanup(func() { _ = sw.Stop() })
+	}
+
+	require.NoError(t, s1.DialPeerWithAddress(s2Addr))
+	assert.Eventually(t, func() bool {
+		return s1.Peers().Size() == 1 && s2.Peers().Size() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	peer := s1.Peers().Get(s2.NodeInfo().ID())
+	require.NotNil(t, peer)
+	assert.True(t, peer.IsOutbound())
+	require.True(t, peer.Send(0x00, []byte("hello")))
+
+	reactor := s2.Reactor("foo").(*TestReactor)
+	assert.Eventually(t, func() bool {
+		return len(reactor.getMsgs(0x00)) == 1
+	}, time.Second, 10*time.Millisecond)
+	msg := reactor.getMsgs(0x00)[0]
+	assert.Equal(t, s1.NodeInfo().ID(), msg.PeerID)
+	assert.Equal(t, []byte("hello"), msg.Bytes)
+}
+
+func TestTransportMemoryListen(t *testing.T) {
+	network := NewMemoryNetwork()
+	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
+	ni := testNodeInfo(nodeKey.ID(), "transport")
+
+	// with the ID of another node
+	otherID := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	addr, err := NewNetAddressString(IDAddressString(otherID, "127.0.0.1:26656"))
+	require.NoError(t, err)
+	mt := NewMemoryTransport(network, ni, nodeKey, conn.DefaultMConnConfig())
+	assert.Error(t, mt.Listen(*addr))
+
+	addr.ID = nodeKey.ID()
+	require.NoError(t, mt.Listen(*addr))
+
+	// twice with the same ID
+	other := NewMemoryTransport(network, ni, nodeKey, conn.DefaultMConnConfig())
+	assert.Error(t, other.Listen(*addr))
+
+	// the ID is free again once closed
+	require.NoError(t, mt.Close())
+	require.NoError(t, other.Listen(*addr))
+	require.NoError(t, other.Close())
+}
+
+func TestTransportMemoryDial(t *testing.T) {
+	network := NewMemoryNetwork()
+	newTransport := func(name string) (*MemoryTransport, *NetAddress) {
+		nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
+		ni := testNodeInfo(nodeKey.ID(), name)
+		addr, err := NewNetAddressString(IDAddressString(nodeKey.ID(), ni.(DefaultNodeInfo).ListenAddr))
+		require.NoError(t, err)
+		return NewMemoryTransport(network, ni, nodeKey, conn.DefaultMConnConfig()), addr
+	}
+	mt, addr := newTransport("transport")
+	dialer, dialerAddr := newTransport("dialer")
+
+	// not listening
+	_, err := dialer.Dial(*addr, peerConfig{})
+	assert.Error(t, err)
+
+	require.NoError(t, dialer.Listen(*dialerAddr))
+	t.Cleanup(func() { _ = dialer.Close() })
+	_, err = dialer.Dial(*addr, peerConfig{})
+	assert.Error(t, err)
+
+	require.NoError(t, mt.Listen(*addr))
+	t.Cleanup(func() { _ = mt.Close() })
+
+	// self
+	_, err = mt.Dial(*addr, peerConfig{})
+	e, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, e.IsSelf())
+
+	p, err := dialer.Dial(*addr, peerConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, mt.nodeKey.ID(), p.ID())
+	assert.Equal(t, addr.IP.String(), p.RemoteIP().String())
+
+	p, err = mt.Accept(peerConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, dialer.nodeKey.ID(), p.ID())
+	assert.False(t, p.IsOutbound())
+	assert.Equal(t, dialerAddr.IP.String(), p.RemoteIP().String())
+}
@@ -0,0 +1,106 @@
+package p2p
+
+import (
+	"fmt"
+	"sync"
+)
+
+// multiAccept carries a peer accepted by one of the transports of a
+// MultiProtocolTransport to its Accept method.
+type multiAccept struct {
+	peer Peer
+	err  error
+}
+
+// MultiProtocolTransport bundles a transport for each protocol of NetAddress,
+// so that peers of different protocols can coexist. It accepts the peers of
+// all of them, and dials and cleans up a peer with the transport of its
+// protocol.
+//
+// The lifecycle of the bundled transports is left to the caller.
+type MultiProtocolTransport struct {
+	transports map[string]Transport
+
+	acceptOnce sync.Once
+	acceptc    chan multiAccept
+	closeOnce  sync.Once
+	closec     chan struct{}
+}
+
+// Test MultiProtocolTransport for interface completeness.
+var _ Transport = (*MultiProtocolTransport)(nil)
+
+// NewMultiProtocolTransport returns a transport bundling the given transports,
+// by protocol. There must be one for ProtocolTCP.
+func NewMultiProtocolTransport(transports map[string]Transport) *MultiProtocolTransport {
+	if _, ok := transports[ProtocolTCP]; !ok {
+		panic("a multi-protocol transport requires a TCP transport")
+	}
+	return &MultiProtocolTransport{
+		transports: transports,
+		acceptc:    make(chan multiAccept),
+		closec:     make(chan struct{}),
+	}
+}
+
+// NetAddress implements Transport. It's the listening address of the TCP
+// transport.
+func (t *MultiProtocolTransport) NetAddress() NetAddress {
+	return t.transports[ProtocolTCP].NetAddress()
+}
+
+// Accept implements Transport. It returns ErrTransportClosed once any of the
+// transports is closed.
+//
+// NOTE: the transports accept peers with the peerConfig of the first call.
+func (t *MultiProtocolTransport) Accept(cfg peerConfig) (Peer, error) {
+	t.acceptOnce.Do(func() {
+		for _, transport := range t.transports {
+			go t.acceptRoutine(transport, cfg)
+		}
+	})
+
+	select {
+	case a := <-t.acceptc:
+		return a.peer, a.err
+	case <-t.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport.
+func (t *MultiProtocolTransport) Dial(addr NetAddress, cfg peerConfig) (Peer, error) {
+	transport, ok := t.transports[addr.Protocol]
+	if !ok {
+		return nil, fmt.Errorf("no transport for the protocol of %v", addr)
+	}
+	return transport.Dial(addr, cfg)
+}
+
+// Cleanup implements Transport.
+func (t *MultiProtocolTransport) Cleanup(p Peer) {
+	if transport, ok := t.transports[p.SocketAddr().Protocol]; ok {
+		transport.Cleanup(p)
+		return
+	}
+	_ = p.CloseConn()
+}
+
+func (t *MultiProtocolTransport) acceptRoutine(transport Transport, cfg peerConfig) {
+	for {
+		p, err := transport.Accept(cfg)
+		if _, ok := err.(ErrTransportClosed); ok {
+			t.closeOnce.Do(func() { close(t.closec) })
+			return
+		}
+
+		select {
+		case t.acceptc <- multiAccept{p, err}:
+		case <-t.closec:
+			if p != nil {
+				transport.Cleanup(p)
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,579 @@
+package p2p
+
+import (
+	"context"
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/line/ostracon/crypto/ed25519"
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/p2p/conn"
+)
+
+// quicALPN is the application protocol negotiated in the TLS handshake of the
+// QUIC connections between peers.
+const quicALPN = "ostracon-p2p"
+
+// QUICProvider establishes the QUIC connections of the QUICTransport.
+//
+// This module doesn't depend on a QUIC implementation, since the one it would
+// use raises the minimum Go version. The github.com/line/ostracon/p2p/quic
+// module registers one built on quic-go when imported, see
+// RegisterQUICProvider.
+type QUICProvider interface {
+	// Listen listens on the UDP address. Once listening, the connections are
+	// dialed from it as well.
+	Listen(addr *net.UDPAddr, tlsConfig *tls.Config, config QUICConfig) (QUICListener, error)
+	// Dial dials the UDP address from an ephemeral port.
+	Dial(ctx context.Context, addr *net.UDPAddr, tlsConfig *tls.Config, config QUICConfig) (QUICConn, error)
+}
+
+// QUICConfig is the configuration of the QUIC connections.
+type QUICConfig struct {
+	HandshakeIdleTimeout time.Duration
+	MaxIdleTimeout       time.Duration
+	KeepAlivePeriod      time.Duration
+}
+
+// QUICListener accepts QUIC connections on a UDP address, and dials them from
+// it.
+type QUICListener interface {
+	Accept(ctx context.Context) (QUICConn, error)
+	Dial(ctx context.Context, addr *net.UDPAddr) (QUICConn, error)
+	Addr() net.Addr
+	// Close closes the listener and its UDP socket.
+	Close() error
+}
+
+// QUICConn is a QUIC connection.
+type QUICConn interface {
+	LocalAddr() net.Addr
+	RemoteAddr() net.Addr
+	// PeerCertificates returns the certificates the peer presented in the TLS
+	// handshake.
+	PeerCertificates() []*x509.Certificate
+	// OpenStreamSync opens a bidirectional stream, which the peer accepts with
+	// AcceptStream.
+	OpenStreamSync(ctx context.Context) (QUICStream, error)
+	AcceptStream(ctx context.Context) (QUICStream, error)
+	// OpenUniStreamSync opens a unidirectional stream, which the peer accepts
+	// with AcceptUniStream.
+	OpenUniStreamSync(ctx context.Context) (io.Writer, error)
+	AcceptUniStream(ctx context.Context) (io.Reader, error)
+	// Context is done once the connection is closed.
+	Context() context.Context
+	// Close closes the connection, without an error for the peer.
+	Close() error
+}
+
+// QUICStream is a bidirectional stream of a QUICConn.
+type QUICStream interface {
+	io.ReadWriter
+	SetDeadline(t time.Time) error
+	SetReadDeadline(t time.Time) error
+	SetWriteDeadline(t time.Time) error
+}
+
+var (
+	quicProviderMtx tmsync.Mutex
+	quicProvider    QUICProvider
+)
+
+// RegisterQUICProvider sets the provider of the connections of the
+// QUICTransports created afterwards.
+func RegisterQUICProvider(provider QUICProvider) {
+	quicProviderMtx.Lock()
+	defer quicProviderMtx.Unlock()
+	quicProvider = provider
+}
+
+func registeredQUICProvider() QUICProvider {
+	quicProviderMtx.Lock()
+	defer quicProviderMtx.Unlock()
+	return quicProvider
+}
+
+// QUICTransportOption sets an optional parameter on the QUICTransport.
+type QUICTransportOption func(*QUICTransport)
+
+// QUICTransportConnFilters sets the filters for rejection new connections.
+func QUICTransportConnFilters(filters ...ConnFilterFunc) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.connFilters = filters }
+}
+
+// QUICTransportFilterTimeout sets the timeout waited for filter calls to
+// return.
+func QUICTransportFilterTimeout(timeout time.Duration) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.filterTimeout = timeout }
+}
+
+// QUICTransportAllowedPeerIDs restricts the peers to the ones with the given
+// IDs, rejecting any other right after the TLS handshake authenticates it,
+// before the NodeInfo exchange. Default: nil (any peer)
+func QUICTransportAllowedPeerIDs(ids []ID) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.allowedIDs = newIDSet(ids) }
+}
+
+// QUICTransport accepts and dials QUIC connections and upgrades them to peers
+// sending the messages of each channel on a QUIC stream of its own. Peers
+// authenticate with their ed25519 node key, the key of the self-signed
+// certificate they present in the TLS handshake.
+type QUICTransport struct {
+	netAddr  NetAddress
+	provider QUICProvider
+	listener QUICListener // nil until listening
+
+	acceptc chan accept
+	closec  chan struct{}
+
+	// Lookup table for duplicate ip and id checks.
+	conns       ConnSet
+	connFilters []ConnFilterFunc
+	allowedIDs  map[ID]struct{} // nil for any peer
+
+	dialTimeout      time.Duration
+	filterTimeout    time.Duration
+	handshakeTimeout time.Duration
+	nodeInfo         NodeInfo
+	nodeKey          NodeKey
+	resolver         IPResolver
+
+	tlsConfig  *tls.Config
+	quicConfig QUICConfig
+
+	mConfig conn.MConnConfig
+}
+
+// Test QUICTransport for interface completeness.
+var _ Transport = (*QUICTransport)(nil)
+var _ transportLifecycle = (*QUICTransport)(nil)
+
+// NewQUICTransport returns a QUIC connected peer transport, using the
+// registered QUICProvider. It returns an error if none is registered, or if
+// the node key isn't an ed25519 key.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+) (*QUICTransport, error) {
+	provider := registeredQUICProvider()
+	if provider == nil {
+		return nil, errors.New("no QUIC provider registered; " +
+			"build the node with github.com/line/ostracon/p2p/quic to use QUIC")
+	}
+
+	tlsConfig, err := quicTLSConfig(nodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QUICTransport{
+		provider:         provider,
+		acceptc:          make(chan accept),
+		closec:           make(chan struct{}),
+		dialTimeout:      defaultDialTimeout,
+		filterTimeout:    defaultFilterTimeout,
+		handshakeTimeout: defaultHandshakeTimeout,
+		mConfig:          mConfig,
+		nodeInfo:         nodeInfo,
+		nodeKey:          nodeKey,
+		conns:            NewConnSet(),
+		resolver:         net.DefaultResolver,
+		tlsConfig:        tlsConfig,
+		quicConfig: QUICConfig{
+			HandshakeIdleTimeout: defaultHandshakeTimeout,
+			// QUIC keep-alives play the role of the pings of the MConnection
+			MaxIdleTimeout:  mConfig.PingInterval + mConfig.PongTimeout,
+			KeepAlivePeriod: mConfig.PingInterval,
+		},
+	}, nil
+}
+
+// NetAddress implements Transport.
+func (qt *QUICTransport) NetAddress() NetAddress {
+	return qt.netAddr
+}
+
+// Accept implements Transport.
+func (qt *QUICTransport) Accept(cfg peerConfig) (Peer, error) {
+	select {
+	// This case should never have any side-effectful/blocking operations to
+	// ensure that quality peers are ready to be used.
+	case a := <-qt.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+
+		cfg.outbound = false
+
+		return qt.wrapPeer(a.conn.(*quicConn), a.nodeInfo, cfg, a.netAddr), nil
+	case <-qt.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport. Once listening, the connections are dialed from
+// the listening port.
+func (qt *QUICTransport) Dial(
+	addr NetAddress,
+	cfg peerConfig,
+) (Peer, error) {
+	// Dialing ourselves from the listening port would give both ends of the
+	// connection the same address, reject it upfront.
+	if addr.ID == qt.nodeKey.ID() {
+		return nil, ErrRejected{addr: addr, id: addr.ID, isSelf: true}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.dialTimeout+qt.handshakeTimeout)
+	defer cancel()
+
+	var (
+		qc      QUICConn
+		err     error
+		udpAddr = &net.UDPAddr{IP: addr.IP, Port: int(addr.Port)}
+	)
+	if qt.listener != nil {
+		qc, err = qt.listener.Dial(ctx, udpAddr)
+	} else {
+		qc, err = qt.provider.Dial(ctx, udpAddr, qt.tlsConfig, qt.quicConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := &quicConn{conn: qc}
+
+	// TODO(xla): Evaluate if we should apply filters if we explicitly dial.
+	if err := filterConn(c, qt.conns, qt.connFilters, qt.filterTimeout, qt.resolver); err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := qt.upgrade(c, &addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return qt.wrapPeer(c, nodeInfo, cfg, &addr), nil
+}
+
+// Close implements transportLifecycle.
+func (qt *QUICTransport) Close() error {
+	close(qt.closec)
+
+	if qt.listener != nil {
+		return qt.listener.Close()
+	}
+
+	return nil
+}
+
+// Listen implements transportLifecycle.
+func (qt *QUICTransport) Listen(addr NetAddress) error {
+	udpAddr, err := net.ResolveUDPAddr("udp", addr.DialString())
+	if err != nil {
+		return err
+	}
+	ln, err := qt.provider.Listen(udpAddr, qt.tlsConfig, qt.quicConfig)
+	if err != nil {
+		return err
+	}
+
+	qt.netAddr = addr
+	qt.listener = ln
+
+	go qt.acceptPeers()
+
+	return nil
+}
+
+// AddChannel registers a channel to nodeInfo.
+// NOTE: NodeInfo must be of type DefaultNodeInfo else channels won't be updated
+func (qt *QUICTransport) AddChannel(chID byte) error {
+	ni, ok := qt.nodeInfo.(DefaultNodeInfo)
+	if !ok {
+		return fmt.Errorf("nodeInfo type: %T is not supported", qt.nodeInfo)
+	}
+	if !ni.HasChannel(chID) {
+		ni.Channels = append(ni.Channels, chID)
+	}
+	qt.nodeInfo = ni
+	return nil
+}
+
+// Cleanup removes the given address from the connections set and
+// closes the connection.
+func (qt *QUICTransport) Cleanup(p Peer) {
+	qt.conns.RemoveAddr(p.RemoteAddr())
+	_ = p.CloseConn()
+}
+
+func (qt *QUICTransport) acceptPeers() {
+	for {
+		qc, err := qt.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-qt.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			qt.acceptc <- accept{err: err}
+			return
+		}
+
+		// Connection upgrade and filtering are asynchronous as for the
+		// MultiplexTransport, to avoid Head-of-line blocking.
+		go func(c *quicConn) {
+			defer func() {
+				if r := recover(); r != nil {
+					err := ErrRejected{
+						conn:          c,
+						err:           fmt.Errorf("recovered from panic: %v", r),
+						isAuthFailure: true,
+					}
+					select {
+					case qt.acceptc <- accept{err: err}:
+					case <-qt.closec:
+						// Give up if the transport was closed.
+						_ = c.Close()
+						return
+					}
+				}
+			}()
+
+			var (
+				nodeInfo NodeInfo
+				netAddr  *NetAddress
+			)
+
+			err := filterConn(c, qt.conns, qt.connFilters, qt.filterTimeout, qt.resolver)
+			if err == nil {
+				nodeInfo, err = qt.upgrade(c, nil)
+				if err == nil {
+					netAddr = NewNetAddress(nodeInfo.ID(), c.RemoteAddr())
+				}
+			}
+
+			select {
+			case qt.acceptc <- accept{netAddr, c, nodeInfo, err}:
+				// Make the upgraded peer available.
+			case <-qt.closec:
+				// Give up if the transport was closed.
+				_ = c.Close()
+				return
+			}
+		}(&quicConn{conn: qc})
+	}
+}
+
+func (qt *QUICTransport) cleanup(c net.Conn) error {
+	qt.conns.Remove(c)
+
+	return c.Close()
+}
+
+// upgrade exchanges the NodeInfo with the peer on the first bidirectional
+// stream of the connection, opened by the dialer.
+func (qt *QUICTransport) upgrade(
+	c *quicConn,
+	dialedAddr *NetAddress,
+) (nodeInfo NodeInfo, err error) {
+	defer func() {
+		if err != nil {
+			_ = qt.cleanup(c)
+		}
+	}()
+
+	connID, err := quicPeerID(c.conn)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("tls handshake failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	// For outgoing conns, ensure connection key matches dialed key.
+	if dialedAddr != nil {
+		if dialedID := dialedAddr.ID; connID != dialedID {
+			return nil, ErrRejected{
+				conn: c,
+				id:   connID,
+				err: fmt.Errorf(
+					"conn.ID (%v) dialed ID (%v) mismatch",
+					connID,
+					dialedID,
+				),
+				isAuthFailure: true,
+			}
+		}
+	}
+
+	if err := checkPeerIDAllowed(c, connID, qt.allowedIDs); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.handshakeTimeout)
+	defer cancel()
+	if dialedAddr != nil {
+		c.QUICStream, err = c.conn.OpenStreamSync(ctx)
+	} else {
+		c.QUICStream, err = c.conn.AcceptStream(ctx)
+	}
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("handshake stream failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	nodeInfo, err = handshake(c, qt.handshakeTimeout, qt.nodeInfo)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("handshake failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	if err := checkPeerNodeInfo(c, connID, qt.nodeInfo, nodeInfo); err != nil {
+		return nil, err
+	}
+
+	return nodeInfo, nil
+}
+
+func (qt *QUICTransport) wrapPeer(
+	c *quicConn,
+	ni NodeInfo,
+	cfg peerConfig,
+	socketAddr *NetAddress,
+) Peer {
+
+	persistent := false
+	if cfg.isPersistent != nil {
+		if cfg.outbound {
+			persistent = cfg.isPersistent(socketAddr)
+		} else {
+			selfReportedAddr, err := ni.NetAddress()
+			if err == nil {
+				persistent = cfg.isPersistent(selfReportedAddr)
+			}
+		}
+	}
+
+	peerConn := newPeerConn(
+		cfg.outbound,
+		persistent,
+		c,
+		socketAddr,
+	)
+
+	return newQUICPeer(
+		peerConn,
+		c.conn,
+		qt.mConfig,
+		ni,
+		cfg.reactorsByCh,
+		cfg.chDescs,
+		cfg.onPeerError,
+		cfg.metrics,
+	)
+}
+
+// quicConn is the net.Conn of a QUIC connection. It reads and writes the
+// stream on which the peers exchange their NodeInfo, and closing it closes the
+// whole connection.
+type quicConn struct {
+	QUICStream // nil until the handshake stream is opened
+
+	conn QUICConn
+}
+
+func (c *quicConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *quicConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+func (c *quicConn) Close() error {
+	return c.conn.Close()
+}
+
+// quicTLSConfig returns the TLS configuration of the QUIC connections,
+// presenting a certificate self-signed with the node key and requiring one
+// from the peer.
+func quicTLSConfig(nodeKey NodeKey) (*tls.Config, error) {
+	privKey, ok := nodeKey.PrivKey.(ed25519.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("QUIC transport requires an ed25519 node key, got %s", nodeKey.PrivKey.Type())
+	}
+	key := stded25519.PrivateKey(privKey)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(100, 0, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		ClientAuth:   tls.RequireAnyClientCert,
+		// There is no certificate authority: the peer is authenticated by the
+		// key of its certificate, see verifyQUICPeerCertificate.
+		InsecureSkipVerify:    true, //nolint:gosec
+		VerifyPeerCertificate: verifyQUICPeerCertificate,
+		NextProtos:            []string{quicALPN},
+		MinVersion:            tls.VersionTLS13,
+	}, nil
+}
+
+// verifyQUICPeerCertificate accepts a single self-signed certificate of an
+// ed25519 key. The TLS handshake proves the peer owns the key.
+func verifyQUICPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) != 1 {
+		return fmt.Errorf("expected a single certificate, got %d", len(rawCerts))
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return err
+	}
+	if _, ok := cert.PublicKey.(stded25519.PublicKey); !ok {
+		return fmt.Errorf("expected a certificate of an ed25519 key, got %T", cert.PublicKey)
+	}
+	return cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature)
+}
+
+// quicPeerID returns the ID of the peer of the connection, from the key of its
+// certificate.
+func quicPeerID(qc QUICConn) (ID, error) {
+	certs := qc.PeerCertificates()
+	if len(certs) == 0 {
+		return "", errors.New("no peer certificate")
+	}
+	pubKey, ok := certs[0].PublicKey.(stded25519.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("expected a certificate of an ed25519 key, got %T", certs[0].PublicKey)
+	}
+	return PubKeyToID(ed25519.PubKey(pubKey)), nil
+}
@@ -0,0 +1,20 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/p2p/conn"
+)
+
+// The QUICTransport is tested along with the provider of the
+// github.com/line/ostracon/p2p/quic module.
+
+func TestTransportQUICRequiresProvider(t *testing.T) {
+	pv := ed25519.GenPrivKey()
+	_, err := NewQUICTransport(testNodeInfo(PubKeyToID(pv.PubKey()), "transport"), NodeKey{PrivKey: pv},
+		conn.DefaultMConnConfig())
+	assert.Error(t, err)
+}
@@ -12,11 +12,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/crypto/ed25519"
 	"github.com/line/ostracon/libs/protoio"
 	"github.com/line/ostracon/p2p/conn"
 	tmp2p "github.com/line/ostracon/proto/ostracon/p2p"
 	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -467,6 +469,42 @@ func TestTransportMultiplexDialRejectWrongID(t *testing.T) {
 	}
 }
 
+func TestTransportMultiplexAllowedPeerIDs(t *testing.T) {
+	mt := testSetupMultiplexTransport(t)
+
+	allowedPV := ed25519.GenPrivKey()
+	MultiplexTransportAllowedPeerIDs([]ID{PubKeyToID(allowedPV.PubKey())})(mt)
+
+	dial := func(pv crypto.PrivKey) error {
+		dialer := newMultiplexTransport(testNodeInfo(PubKeyToID(pv.PubKey()), "dialer"), NodeKey{PrivKey: pv})
+		_, err := dialer.Dial(*NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr()), peerConfig{})
+		return err
+	}
+
+	// a peer not allowed is rejected on accept
+	errc := make(chan error, 1)
+	go func() { errc <- dial(ed25519.GenPrivKey()) }()
+	_, err := mt.Accept(peerConfig{})
+	e, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, e.IsFiltered())
+	assert.Error(t, <-errc)
+
+	// an allowed one gets through
+	go func() { errc <- dial(allowedPV) }()
+	p, err := mt.Accept(peerConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, PubKeyToID(allowedPV.PubKey()), p.ID())
+	require.NoError(t, <-errc)
+
+	// a peer not allowed is rejected on dial
+	other := testSetupMultiplexTransport(t)
+	_, err = mt.Dial(*NewNetAddress(other.nodeKey.ID(), other.listener.Addr()), peerConfig{})
+	e, ok = err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, e.IsFiltered())
+}
+
 func TestTransportMultiplexRejectIncompatible(t *testing.T) {
 	mt := testSetupMultiplexTransport(t)
 
@@ -119,6 +119,30 @@ func (tms *MetricStore) GetPeerTrustMetric(key string) *Metric {
 	return tm
 }
 
+// PeerTrustScore returns the trust score of the peer identified by the key,
+// always between 0 and 100. A peer without a trust metric has a perfect score,
+// and no trust metric is created for it.
+func (tms *MetricStore) PeerTrustScore(key string) int {
+	tms.mtx.Lock()
+	tm, ok := tms.peerMetrics[key]
+	tms.mtx.Unlock()
+
+	if !ok {
+		return 100
+	}
+	return tm.TrustScore()
+}
+
+// HasPeerTrustMetric returns whether a trust metric is associated with the
+// peer identified by the key, i.e. whether there's any history for the peer.
+func (tms *MetricStore) HasPeerTrustMetric(key string) bool {
+	tms.mtx.Lock()
+	defer tms.mtx.Unlock()
+
+	_, ok := tms.peerMetrics[key]
+	return ok
+}
+
 // PeerDisconnected pauses the trust metric associated with the peer identified by the key
 func (tms *MetricStore) PeerDisconnected(key string) {
 	tms.mtx.Lock()
@@ -141,7 +141,12 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	require.NoError(t, err)
 
 	key := "TestKey"
+	// A peer without a trust metric is innocent, and gets no metric
+	assert.Equal(t, 100, store.PeerTrustScore(key))
+	assert.Zero(t, store.Size())
+	assert.False(t, store.HasPeerTrustMetric(key))
 	tm := store.GetPeerTrustMetric(key)
+	assert.True(t, store.HasPeerTrustMetric(key))
 
 	// This peer is innocent so far
 	first := tm.TrustScore()
@@ -157,6 +162,7 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	if second > first {
 		t.Errorf("a greater number of bad events should lower the trust score")
 	}
+	assert.Equal(t, second, store.PeerTrustScore(key))
 	store.PeerDisconnected(key)
 
 	// We will remember our experiences with this peer
@@ -25,5 +25,13 @@ SignerClient handles remote validator connections that provide signing services.
 In production, it's recommended to wrap it with RetrySignerClient to avoid
 termination in case of temporary errors.
 
+MultiSignerPV
+
+MultiSignerPV coordinates a cluster of signer nodes holding the same key, each
+with its own last sign state, and only releases a signature or VRF proof once
+a quorum of them agrees on it. This protects active-standby validators sharing
+the cluster against double signing. LocalSignerNode is an in-process stand-in
+for the transport to a signer node.
+
 */
 package privval
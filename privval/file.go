@@ -270,9 +270,9 @@ func (pv *FilePV) GetPubKey() (crypto.PubKey, error) {
 }
 
 // SignVote signs a canonical representation of the vote, along with the
-// chainID. Implements PrivValidator.
-func (pv *FilePV) SignVote(chainID string, vote *tmproto.Vote) error {
-	if err := pv.signVote(chainID, vote); err != nil {
+// chainID, and its extension if signExtension is set. Implements PrivValidator.
+func (pv *FilePV) SignVote(chainID string, vote *tmproto.Vote, signExtension bool) error {
+	if err := pv.signVote(chainID, vote, signExtension); err != nil {
 		return fmt.Errorf("error signing vote: %v", err)
 	}
 	return nil
@@ -326,7 +326,7 @@ func (pv *FilePV) String() string {
 // signVote checks if the vote is good to sign and sets the vote signature.
 // It may need to set the timestamp as well if the vote is otherwise the same as
 // a previously signed vote (ie. we crashed after signing but before the vote hit the WAL).
-func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
+func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote, signExtension bool) error {
 	height, round, step := vote.Height, vote.Round, voteToStep(vote)
 
 	lss := pv.LastSignState
@@ -338,6 +338,17 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 
 	signBytes := types.VoteSignBytes(chainID, vote)
 
+	// The vote extension is signed apart from the vote, and is not covered by
+	// the double sign protection: the application may provide a different
+	// extension when we re-sign for the same HRS, so it is always signed anew.
+	if signExtension && types.IsProtoVoteExtendable(vote) {
+		extSig, err := pv.Key.PrivKey.Sign(types.VoteExtensionSignBytes(chainID, vote))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	}
+
 	// We might crash before writing to the wal,
 	// causing us to try to re-sign for the same HRS.
 	// If signbytes are the same, use the last signature.
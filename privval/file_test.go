@@ -115,7 +115,7 @@ func TestResetValidator(t *testing.T) {
 		randBytes := tmrand.Bytes(tmhash.Size)
 		blockID := types.BlockID{Hash: randBytes, PartSetHeader: types.PartSetHeader{}}
 		vote := newVote(privVal.Key.Address, 0, height, round, voteType, blockID)
-		err = privVal.SignVote("mychainid", vote.ToProto())
+		err = privVal.SignVote("mychainid", vote.ToProto(), false)
 		assert.NoError(t, err, "expected no error signing vote")
 
 		// priv val after signing is not same as empty
@@ -234,11 +234,11 @@ func TestSignVote(t *testing.T) {
 		// sign a vote for first time
 		vote := newVote(privVal.Key.Address, 0, height, round, voteType, block1)
 		v := vote.ToProto()
-		err = privVal.SignVote("mychainid", v)
+		err = privVal.SignVote("mychainid", v, false)
 		assert.NoError(err, "expected no error signing vote")
 
 		// try to sign the same vote again; should be fine
-		err = privVal.SignVote("mychainid", v)
+		err = privVal.SignVote("mychainid", v, false)
 		assert.NoError(err, "expected no error on signing same vote")
 
 		// now try some bad votes
@@ -251,19 +251,51 @@ func TestSignVote(t *testing.T) {
 
 		for _, c := range cases {
 			cpb := c.ToProto()
-			err = privVal.SignVote("mychainid", cpb)
+			err = privVal.SignVote("mychainid", cpb, false)
 			assert.Error(err, "expected error on signing conflicting vote")
 		}
 
 		// try signing a vote with a different time stamp
 		sig := vote.Signature
 		vote.Timestamp = vote.Timestamp.Add(time.Duration(1000))
-		err = privVal.SignVote("mychainid", v)
+		err = privVal.SignVote("mychainid", v, false)
 		assert.NoError(err)
 		assert.Equal(sig, vote.Signature)
 	}
 }
 
+func TestSignVoteExtension(t *testing.T) {
+	tempKeyFile, err := ioutil.TempFile("", "priv_validator_key_")
+	require.Nil(t, err)
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.Nil(t, err)
+
+	for _, testing := range testingKeyTypes {
+		privVal, err := GenFilePV(tempKeyFile.Name(), tempStateFile.Name(), testing.keyType)
+		require.Nil(t, err)
+
+		randbytes := tmrand.Bytes(tmhash.Size)
+		blockID := types.BlockID{Hash: randbytes,
+			PartSetHeader: types.PartSetHeader{Total: 5, Hash: randbytes}}
+
+		// the extension is not signed before the extensions are enabled
+		vote := newVote(privVal.Key.Address, 0, 10, 1, tmproto.PrecommitType, blockID)
+		vote.Extension = []byte("extension")
+		v := vote.ToProto()
+		require.NoError(t, privVal.SignVote("mychainid", v, false))
+		assert.NotNil(t, v.Signature)
+		assert.Nil(t, v.ExtensionSignature)
+
+		// but it is afterwards
+		vote = newVote(privVal.Key.Address, 0, 11, 1, tmproto.PrecommitType, blockID)
+		vote.Extension = []byte("extension")
+		v = vote.ToProto()
+		require.NoError(t, privVal.SignVote("mychainid", v, true))
+		assert.True(t, privVal.Key.PubKey.VerifySignature(
+			types.VoteExtensionSignBytes("mychainid", v), v.ExtensionSignature))
+	}
+}
+
 func TestSignProposal(t *testing.T) {
 	assert := assert.New(t)
 
@@ -384,7 +416,7 @@ func TestDifferByTimestamp(t *testing.T) {
 			blockID := types.BlockID{Hash: randbytes, PartSetHeader: types.PartSetHeader{}}
 			vote := newVote(privVal.Key.Address, 0, height, round, voteType, blockID)
 			v := vote.ToProto()
-			err := privVal.SignVote("mychainid", v)
+			err := privVal.SignVote("mychainid", v, false)
 			assert.NoError(t, err, "expected no error signing vote")
 
 			signBytes := types.VoteSignBytes(chainID, v)
@@ -395,7 +427,7 @@ func TestDifferByTimestamp(t *testing.T) {
 			v.Timestamp = v.Timestamp.Add(time.Millisecond)
 			var emptySig []byte
 			v.Signature = emptySig
-			err = privVal.SignVote("mychainid", v)
+			err = privVal.SignVote("mychainid", v, false)
 			assert.NoError(t, err, "expected no error on signing same vote")
 
 			assert.Equal(t, timeStamp, v.Timestamp)
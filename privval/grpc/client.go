@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/ostracon/crypto"
+	cryptoenc "github.com/line/ostracon/crypto/encoding"
+	"github.com/line/ostracon/libs/log"
+	"github.com/line/ostracon/privval"
+	privvalproto "github.com/line/ostracon/proto/ostracon/privval"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/types"
+)
+
+// DefaultRequestTimeout is the time a SignerClient waits for the remote signer
+// to answer a request, including the time to (re)connect to it.
+const DefaultRequestTimeout = 5 * time.Second
+
+// SignerClient implements PrivValidator.
+// Handles remote validator connections that provide signing services over gRPC.
+type SignerClient struct {
+	logger log.Logger
+
+	client  privvalproto.PrivValidatorAPIClient
+	conn    *grpc.ClientConn
+	chainID string
+	timeout time.Duration
+}
+
+var _ types.PrivValidator = (*SignerClient)(nil)
+
+// NewSignerClient returns an instance of SignerClient using the given
+// connection to the remote signer.
+func NewSignerClient(conn *grpc.ClientConn, chainID string, logger log.Logger) *SignerClient {
+	return &SignerClient{
+		logger:  logger,
+		client:  privvalproto.NewPrivValidatorAPIClient(conn),
+		conn:    conn,
+		chainID: chainID,
+		timeout: DefaultRequestTimeout,
+	}
+}
+
+// Close closes the underlying connection
+func (sc *SignerClient) Close() error {
+	return sc.conn.Close()
+}
+
+//--------------------------------------------------------
+// Implement PrivValidator
+
+// GetPubKey retrieves a public key from a remote signer
+// returns an error if client is not able to provide the key
+func (sc *SignerClient) GetPubKey() (crypto.PubKey, error) {
+	ctx, cancel := sc.requestContext()
+	defer cancel()
+
+	resp, err := sc.client.GetPubKey(ctx, &privvalproto.PubKeyRequest{ChainId: sc.chainID}, grpc.WaitForReady(true))
+	if err != nil {
+		sc.logger.Error("SignerClient::GetPubKey", "err", err)
+		return nil, toError(err)
+	}
+
+	pk, err := cryptoenc.PubKeyFromProto(&resp.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
+
+// SignVote requests a remote signer to sign a vote
+func (sc *SignerClient) SignVote(chainID string, vote *tmproto.Vote, signExtension bool) error {
+	ctx, cancel := sc.requestContext()
+	defer cancel()
+
+	resp, err := sc.client.SignVote(ctx, &privvalproto.SignVoteRequest{
+		ChainId: chainID, Vote: vote, SkipExtensionSigning: !signExtension},
+		grpc.WaitForReady(true))
+	if err != nil {
+		sc.logger.Error("SignerClient::SignVote", "err", err)
+		return toError(err)
+	}
+
+	*vote = resp.Vote
+
+	return nil
+}
+
+// SignProposal requests a remote signer to sign a proposal
+func (sc *SignerClient) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	ctx, cancel := sc.requestContext()
+	defer cancel()
+
+	resp, err := sc.client.SignProposal(ctx, &privvalproto.SignProposalRequest{ChainId: chainID, Proposal: proposal},
+		grpc.WaitForReady(true))
+	if err != nil {
+		sc.logger.Error("SignerClient::SignProposal", "err", err)
+		return toError(err)
+	}
+
+	*proposal = resp.Proposal
+
+	return nil
+}
+
+// GenerateVRFProof requests a remote signer to generate a VRF proof
+func (sc *SignerClient) GenerateVRFProof(message []byte) (crypto.Proof, error) {
+	ctx, cancel := sc.requestContext()
+	defer cancel()
+
+	resp, err := sc.client.GenerateVRFProof(ctx, &privvalproto.VRFProofRequest{Message: message},
+		grpc.WaitForReady(true))
+	if err != nil {
+		sc.logger.Error("SignerClient::GenerateVRFProof", "err", err)
+		return nil, toError(err)
+	}
+
+	return resp.Proof, nil
+}
+
+func (sc *SignerClient) requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), sc.timeout)
+}
+
+// toError converts the status of a failed call into an error. Errors returned
+// by the remote signer itself are reported as privval.RemoteSignerError, like
+// the socket based privval.SignerClient does.
+func toError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return err
+	default:
+		return &privval.RemoteSignerError{Code: int(st.Code()), Description: st.Message()}
+	}
+}
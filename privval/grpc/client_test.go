@@ -0,0 +1,141 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/tmhash"
+	"github.com/line/ostracon/libs/log"
+	tmrand "github.com/line/ostracon/libs/rand"
+	"github.com/line/ostracon/privval"
+	tmgrpc "github.com/line/ostracon/privval/grpc"
+	privvalproto "github.com/line/ostracon/proto/ostracon/privval"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/types"
+)
+
+const chainID = "chain-id"
+
+func dialer(t *testing.T, pv types.PrivValidator, logger log.Logger) func(context.Context, string) (net.Conn, error) {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer()
+	s := tmgrpc.NewSignerServer(chainID, pv, logger)
+	privvalproto.RegisterPrivValidatorAPIServer(server, s)
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			panic(err)
+		}
+	}()
+	t.Cleanup(server.Stop)
+
+	return func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+}
+
+func newSignerClient(t *testing.T, pv types.PrivValidator, chainID string) *tmgrpc.SignerClient {
+	logger := log.TestingLogger()
+	conn, err := grpc.DialContext(context.Background(), "",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialer(t, pv, logger)),
+	)
+	require.NoError(t, err)
+
+	client := tmgrpc.NewSignerClient(conn, chainID, logger)
+	t.Cleanup(func() { client.Close() }) //nolint:errcheck // ignore for tests
+	return client
+}
+
+func TestSignerClient_GetPubKey(t *testing.T) {
+	mockPV := types.NewMockPV(types.PrivKeyEd25519)
+	client := newSignerClient(t, mockPV, chainID)
+
+	pk, err := client.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, mockPV.PrivKey.PubKey(), pk)
+
+	// the remote signer refuses requests for another chain
+	client = newSignerClient(t, mockPV, "other-chain-id")
+	_, err = client.GetPubKey()
+	var remoteErr *privval.RemoteSignerError
+	require.ErrorAs(t, err, &remoteErr)
+}
+
+func TestSignerClient_SignVote(t *testing.T) {
+	mockPV := types.NewMockPV(types.PrivKeyEd25519)
+	client := newSignerClient(t, mockPV, chainID)
+
+	ts := time.Now()
+	hash := tmrand.Bytes(tmhash.Size)
+	valAddr := tmrand.Bytes(crypto.AddressSize)
+
+	newVote := func() *tmproto.Vote {
+		return (&types.Vote{
+			Type:             tmproto.PrecommitType,
+			Height:           1,
+			Round:            2,
+			BlockID:          types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Hash: hash, Total: 2}},
+			Timestamp:        ts,
+			ValidatorAddress: valAddr,
+			ValidatorIndex:   1,
+		}).ToProto()
+	}
+	want, have := newVote(), newVote()
+
+	require.NoError(t, mockPV.SignVote(chainID, want, false))
+	require.NoError(t, client.SignVote(chainID, have, false))
+	assert.Equal(t, want.Signature, have.Signature)
+
+	err := client.SignVote("other-chain-id", newVote(), false)
+	var remoteErr *privval.RemoteSignerError
+	require.ErrorAs(t, err, &remoteErr)
+}
+
+func TestSignerClient_SignProposal(t *testing.T) {
+	mockPV := types.NewMockPV(types.PrivKeyEd25519)
+	client := newSignerClient(t, mockPV, chainID)
+
+	ts := time.Now()
+	hash := tmrand.Bytes(tmhash.Size)
+
+	newProposal := func() *tmproto.Proposal {
+		return (&types.Proposal{
+			Type:      tmproto.ProposalType,
+			Height:    1,
+			Round:     2,
+			POLRound:  2,
+			BlockID:   types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Hash: hash, Total: 2}},
+			Timestamp: ts,
+		}).ToProto()
+	}
+	want, have := newProposal(), newProposal()
+
+	require.NoError(t, mockPV.SignProposal(chainID, want))
+	require.NoError(t, client.SignProposal(chainID, have))
+	assert.Equal(t, want.Signature, have.Signature)
+}
+
+func TestSignerClient_GenerateVRFProof(t *testing.T) {
+	mockPV := types.NewMockPV(types.PrivKeyEd25519)
+	client := newSignerClient(t, mockPV, chainID)
+
+	message := []byte("hello, world")
+	proof, err := client.GenerateVRFProof(message)
+	require.NoError(t, err)
+
+	pk, err := client.GetPubKey()
+	require.NoError(t, err)
+	_, err = pk.VRFVerify(proof, message)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,20 @@
+/*
+Package grpc provides an implementation of the types.PrivValidator that talks
+to a remote signer over gRPC, and a gRPC server wrapping any
+types.PrivValidator to act as such a remote signer.
+
+SignerClient
+
+SignerClient implements the types.PrivValidator by calling the
+PrivValidatorAPI service of a remote signer. DialRemoteSigner connects it to
+the grpc:// address configured as priv_validator_laddr with mutual TLS, and
+fails if the client certificate, its key or the CA certificate isn't
+configured.
+
+SignerServer
+
+SignerServer implements the PrivValidatorAPI service on top of a
+types.PrivValidator, e.g. a FilePV. ServerTLS provides the transport
+credentials to require clients to authenticate with a certificate.
+*/
+package grpc
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/ostracon/crypto"
+	cryptoenc "github.com/line/ostracon/crypto/encoding"
+	"github.com/line/ostracon/libs/log"
+	privvalproto "github.com/line/ostracon/proto/ostracon/privval"
+	"github.com/line/ostracon/types"
+)
+
+// SignerServer implements PrivValidatorAPIServer (generated via protobuf services)
+// Handles remote validator connections that provide signing services
+type SignerServer struct {
+	logger  log.Logger
+	chainID string
+	privVal types.PrivValidator
+}
+
+func NewSignerServer(chainID string,
+	privVal types.PrivValidator, log log.Logger) *SignerServer {
+
+	return &SignerServer{
+		logger:  log,
+		chainID: chainID,
+		privVal: privVal,
+	}
+}
+
+var _ privvalproto.PrivValidatorAPIServer = (*SignerServer)(nil)
+
+// GetPubKey receives a request for the pubkey
+// returns the pubkey on success and error on failure
+func (ss *SignerServer) GetPubKey(ctx context.Context, req *privvalproto.PubKeyRequest) (
+	*privvalproto.PubKeyResponse, error) {
+	if req.ChainId != ss.chainID {
+		return nil, status.Errorf(codes.InvalidArgument, "want chainID: %s, got chainID: %s", ss.chainID, req.ChainId)
+	}
+
+	var pubKey crypto.PubKey
+	pubKey, err := ss.privVal.GetPubKey()
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "error getting pubkey: %v", err)
+	}
+
+	pk, err := cryptoenc.PubKeyToProto(pubKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error transitioning pubkey to proto: %v", err)
+	}
+
+	ss.logger.Info("SignerServer: GetPubKey Success")
+
+	return &privvalproto.PubKeyResponse{PubKey: pk}, nil
+}
+
+// SignVote receives a vote sign requests, attempts to sign it
+// returns SignedVoteResponse on success and error on failure
+func (ss *SignerServer) SignVote(ctx context.Context, req *privvalproto.SignVoteRequest) (
+	*privvalproto.SignedVoteResponse, error) {
+	if req.ChainId != ss.chainID {
+		return nil, status.Errorf(codes.InvalidArgument, "want chainID: %s, got chainID: %s", ss.chainID, req.ChainId)
+	}
+	if req.Vote == nil {
+		return nil, status.Error(codes.InvalidArgument, "no vote to sign")
+	}
+	vote := req.Vote
+
+	err := ss.privVal.SignVote(req.ChainId, vote, !req.SkipExtensionSigning)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error signing vote: %v", err)
+	}
+
+	ss.logger.Info("SignerServer: SignVote Success", "height", vote.Height)
+
+	return &privvalproto.SignedVoteResponse{Vote: *vote}, nil
+}
+
+// SignProposal receives a proposal sign requests, attempts to sign it
+// returns SignedProposalResponse on success and error on failure
+func (ss *SignerServer) SignProposal(ctx context.Context, req *privvalproto.SignProposalRequest) (
+	*privvalproto.SignedProposalResponse, error) {
+	if req.ChainId != ss.chainID {
+		return nil, status.Errorf(codes.InvalidArgument, "want chainID: %s, got chainID: %s", ss.chainID, req.ChainId)
+	}
+	if req.Proposal == nil {
+		return nil, status.Error(codes.InvalidArgument, "no proposal to sign")
+	}
+	proposal := req.Proposal
+
+	err := ss.privVal.SignProposal(req.ChainId, proposal)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error signing proposal: %v", err)
+	}
+
+	ss.logger.Info("SignerServer: SignProposal Success", "height", proposal.Height)
+
+	return &privvalproto.SignedProposalResponse{Proposal: *proposal}, nil
+}
+
+// GenerateVRFProof receives a request to prove a message, attempts to generate
+// the proof
+// returns VRFProofResponse on success and error on failure
+func (ss *SignerServer) GenerateVRFProof(ctx context.Context, req *privvalproto.VRFProofRequest) (
+	*privvalproto.VRFProofResponse, error) {
+	proof, err := ss.privVal.GenerateVRFProof(req.Message)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error generating vrf proof: %v", err)
+	}
+
+	ss.logger.Info("SignerServer: GenerateVRFProof Success")
+
+	return &privvalproto.VRFProofResponse{Proof: proof}, nil
+}
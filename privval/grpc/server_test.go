@@ -0,0 +1,34 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/ostracon/libs/log"
+	tmgrpc "github.com/line/ostracon/privval/grpc"
+	privvalproto "github.com/line/ostracon/proto/ostracon/privval"
+	"github.com/line/ostracon/types"
+)
+
+func TestSignerServer_SignVoteWithoutVote(t *testing.T) {
+	s := tmgrpc.NewSignerServer(chainID, types.NewMockPV(types.PrivKeyEd25519), log.TestingLogger())
+
+	resp, err := s.SignVote(context.Background(), &privvalproto.SignVoteRequest{ChainId: chainID})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSignerServer_SignProposalWithoutProposal(t *testing.T) {
+	s := tmgrpc.NewSignerServer(chainID, types.NewMockPV(types.PrivKeyEd25519), log.TestingLogger())
+
+	resp, err := s.SignProposal(context.Background(), &privvalproto.SignProposalRequest{ChainId: chainID})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
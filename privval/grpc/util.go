@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials"
+
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/libs/log"
+	tmnet "github.com/line/ostracon/libs/net"
+)
+
+// DefaultDialOptions constructs a list of grpc dial options
+func DefaultDialOptions(
+	extraOpts ...grpc.DialOption,
+) []grpc.DialOption {
+	const (
+		baseDelay = 100 * time.Millisecond
+		maxDelay  = 5 * time.Second
+	)
+
+	opts := []grpc.DialOption{
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  baseDelay,
+				Multiplier: backoff.DefaultConfig.Multiplier,
+				Jitter:     backoff.DefaultConfig.Jitter,
+				MaxDelay:   maxDelay,
+			},
+		}),
+	}
+
+	opts = append(opts, extraOpts...)
+
+	return opts
+}
+
+// ClientTLS returns the transport credentials for a client connecting to a
+// remote signer with mutual TLS: the client presents the certificate in
+// certPath, and the signer's certificate is verified against the CA in caPath.
+func ClientTLS(certPath, keyPath, caPath string) (credentials.TransportCredentials, error) {
+	certificate, certPool, err := loadTLSFiles(certPath, keyPath, caPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// ServerTLS returns the transport credentials for a remote signer accepting
+// connections with mutual TLS: the signer presents the certificate in
+// certPath, and requires clients to present a certificate signed by the CA in
+// caPath.
+func ServerTLS(certPath, keyPath, caPath string) (credentials.TransportCredentials, error) {
+	certificate, certPool, err := loadTLSFiles(certPath, keyPath, caPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    certPool,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+func loadTLSFiles(certPath, keyPath, caPath string) (tls.Certificate, *x509.CertPool, error) {
+	certificate, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load certificate %s: %w", certPath, err)
+	}
+
+	ca, err := os.ReadFile(caPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to read CA certificate %s: %w", caPath, err)
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(ca) {
+		return tls.Certificate{}, nil, errors.New("failed to append CA certificate")
+	}
+
+	return certificate, certPool, nil
+}
+
+// DialRemoteSigner dials the remote signer at the grpc:// address
+// priv_validator_laddr with mutual TLS. It fails if the client certificate,
+// its key or the CA certificate isn't configured, as the signer would
+// otherwise sign for anyone reaching it.
+func DialRemoteSigner(
+	config cfg.BaseConfig,
+	chainID string,
+	logger log.Logger,
+) (*SignerClient, error) {
+	if !config.ArePrivValidatorSecurityOptionsPresent() {
+		return nil, errors.New("the connection to a gRPC remote signer requires mutual TLS: " +
+			"set priv_validator_client_certificate_file, priv_validator_client_key_file and priv_validator_root_ca_file")
+	}
+	transportSecurity, err := ClientTLS(
+		config.PrivValidatorClientCertificateFile(),
+		config.PrivValidatorClientKeyFile(),
+		config.PrivValidatorRootCAFile(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dialOptions := DefaultDialOptions(grpc.WithTransportCredentials(transportSecurity))
+
+	_, address := tmnet.ProtocolAndAddress(config.PrivValidatorListenAddr)
+	conn, err := grpc.DialContext(context.Background(), address, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote signer %s: %w", address, err)
+	}
+
+	return NewSignerClient(conn, chainID, logger), nil
+}
@@ -0,0 +1,118 @@
+package grpc_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpc "google.golang.org/grpc"
+
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/libs/log"
+	tmgrpc "github.com/line/ostracon/privval/grpc"
+	privvalproto "github.com/line/ostracon/proto/ostracon/privval"
+	"github.com/line/ostracon/types"
+)
+
+// writeCertificate creates a certificate signed by parent (self-signed if
+// parent is nil) and writes it and its key to dir.
+func writeCertificate(
+	t *testing.T,
+	dir, name string,
+	template *x509.Certificate,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	return cert, key
+}
+
+func TestDialRemoteSignerMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	notAfter := time.Now().Add(time.Hour)
+
+	ca, caKey := writeCertificate(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	writeCertificate(t, dir, "server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "server"},
+		NotAfter:     notAfter,
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, caKey)
+	writeCertificate(t, dir, "client", &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	creds, err := tmgrpc.ServerTLS(
+		filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.crt"))
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	mockPV := types.NewMockPV(types.PrivKeyEd25519)
+	server := grpc.NewServer(grpc.Creds(creds))
+	privvalproto.RegisterPrivValidatorAPIServer(server, tmgrpc.NewSignerServer(chainID, mockPV, log.TestingLogger()))
+	go server.Serve(listener) //nolint:errcheck // ignore for tests
+	t.Cleanup(server.Stop)
+
+	config := cfg.TestBaseConfig()
+	config.PrivValidatorListenAddr = "grpc://" + listener.Addr().String()
+	config.PrivValidatorClientCertificate = filepath.Join(dir, "client.crt")
+	config.PrivValidatorClientKey = filepath.Join(dir, "client.key")
+	config.PrivValidatorRootCA = filepath.Join(dir, "ca.crt")
+	require.NoError(t, config.ValidateBasic())
+
+	client, err := tmgrpc.DialRemoteSigner(config, chainID, log.TestingLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() }) //nolint:errcheck // ignore for tests
+
+	pk, err := client.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, mockPV.PrivKey.PubKey(), pk)
+}
+
+func TestDialRemoteSignerRequiresTLS(t *testing.T) {
+	config := cfg.TestBaseConfig()
+	config.PrivValidatorListenAddr = "grpc://127.0.0.1:26659"
+
+	_, err := tmgrpc.DialRemoteSigner(config, chainID, log.TestingLogger())
+	assert.Error(t, err)
+}
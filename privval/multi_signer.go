@@ -0,0 +1,298 @@
+package privval
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/line/ostracon/crypto"
+	tmsync "github.com/line/ostracon/libs/sync"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/types"
+)
+
+// ErrNoSignerQuorum is returned by MultiSignerPV when fewer signer nodes than
+// its threshold agree on a signature.
+var ErrNoSignerQuorum = errors.New("signer nodes did not reach a quorum")
+
+// MultiSignerPV implements PrivValidator by coordinating a cluster of signer
+// nodes, e.g. FilePVs behind SignerClients, that all hold the validator key
+// and each keep their own last sign state.
+//
+// Every request is sent to all the nodes, and a signature (or VRF proof) is
+// only released once threshold nodes have returned the same one. Since a node
+// refuses to sign a height/round/step (HRS) again for different data, and the
+// threshold is more than half of the nodes, no two validator replicas sharing
+// the cluster can both get a quorum for conflicting data at the same HRS.
+type MultiSignerPV struct {
+	nodes     []types.PrivValidator
+	threshold int
+	pubKey    crypto.PubKey
+}
+
+var _ types.PrivValidator = (*MultiSignerPV)(nil)
+
+// NewMultiSignerPV returns a MultiSignerPV releasing signatures agreed on by
+// threshold of the given nodes. The threshold must be more than half the
+// number of nodes. It fails if threshold nodes can't be reached to agree on
+// the public key, or if any node has a different key.
+func NewMultiSignerPV(nodes []types.PrivValidator, threshold int) (*MultiSignerPV, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("no signer nodes given")
+	}
+	if threshold <= len(nodes)/2 || threshold > len(nodes) {
+		return nil, fmt.Errorf("threshold must be more than half of and at most the %d signer nodes, got %d",
+			len(nodes), threshold)
+	}
+
+	// All the nodes are asked for their key in turn, so that one configured
+	// with another key is detected even if a quorum is reached without it.
+	var (
+		pubKey crypto.PubKey
+		count  int
+		errs   []string
+	)
+	for i, node := range nodes {
+		pk, err := node.GetPubKey()
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("node %d: %v", i, err))
+			continue
+		}
+		if pubKey != nil && !pk.Equals(pubKey) {
+			return nil, fmt.Errorf("signer node %d has pubkey %v, expected %v", i, pk, pubKey)
+		}
+		pubKey = pk
+		count++
+	}
+	if count < threshold {
+		return nil, fmt.Errorf("error getting pubkey: %w: %d required out of %d (%s)",
+			ErrNoSignerQuorum, threshold, len(nodes), strings.Join(errs, "; "))
+	}
+
+	return &MultiSignerPV{nodes: nodes, threshold: threshold, pubKey: pubKey}, nil
+}
+
+// GetPubKey returns the public key the signer nodes agreed on.
+// Implements PrivValidator.
+func (pv *MultiSignerPV) GetPubKey() (crypto.PubKey, error) {
+	return pv.pubKey, nil
+}
+
+// SignVote has the signer nodes sign the vote, and sets the signature (and
+// the timestamp, if a node signed the vote before) a quorum agreed on.
+// Implements PrivValidator.
+func (pv *MultiSignerPV) SignVote(chainID string, vote *tmproto.Vote, signExtension bool) error {
+	// Each node signs its own copy, as the requests still pending after a
+	// quorum is reached must not touch the vote the caller gets back.
+	votes := make([]tmproto.Vote, len(pv.nodes))
+	for i := range votes {
+		votes[i] = *vote
+	}
+	i, err := pv.collect(func(i int, node types.PrivValidator) ([]byte, error) {
+		v := &votes[i]
+		if err := node.SignVote(chainID, v, signExtension); err != nil {
+			return nil, err
+		}
+		signBytes := types.VoteSignBytes(chainID, v)
+		if !pv.pubKey.VerifySignature(signBytes, v.Signature) {
+			return nil, errors.New("invalid vote signature")
+		}
+		if signExtension && types.IsProtoVoteExtendable(v) &&
+			!pv.pubKey.VerifySignature(types.VoteExtensionSignBytes(chainID, v), v.ExtensionSignature) {
+			return nil, errors.New("invalid vote extension signature")
+		}
+		return signBytes, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error signing vote: %w", err)
+	}
+
+	vote.Timestamp = votes[i].Timestamp
+	vote.Signature = votes[i].Signature
+	vote.ExtensionSignature = votes[i].ExtensionSignature
+	return nil
+}
+
+// SignProposal has the signer nodes sign the proposal, and sets the signature
+// (and the timestamp, if a node signed the proposal before) a quorum agreed
+// on. Implements PrivValidator.
+func (pv *MultiSignerPV) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	// Each node signs its own copy, as the requests still pending after a
+	// quorum is reached must not touch the proposal the caller gets back.
+	proposals := make([]tmproto.Proposal, len(pv.nodes))
+	for i := range proposals {
+		proposals[i] = *proposal
+	}
+	i, err := pv.collect(func(i int, node types.PrivValidator) ([]byte, error) {
+		p := &proposals[i]
+		if err := node.SignProposal(chainID, p); err != nil {
+			return nil, err
+		}
+		signBytes := types.ProposalSignBytes(chainID, p)
+		if !pv.pubKey.VerifySignature(signBytes, p.Signature) {
+			return nil, errors.New("invalid proposal signature")
+		}
+		return signBytes, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error signing proposal: %w", err)
+	}
+
+	proposal.Timestamp = proposals[i].Timestamp
+	proposal.Signature = proposals[i].Signature
+	return nil
+}
+
+// GenerateVRFProof returns a proof for the message once a quorum of the
+// signer nodes produced proofs of the same output. Proofs themselves may
+// differ between nodes, so it is their outputs that are compared. For a
+// composite key, the proofs are made and verified with its VRF key.
+// Implements PrivValidator.
+func (pv *MultiSignerPV) GenerateVRFProof(message []byte) (crypto.Proof, error) {
+	proofs := make([]crypto.Proof, len(pv.nodes))
+	i, err := pv.collect(func(i int, node types.PrivValidator) ([]byte, error) {
+		proof, err := node.GenerateVRFProof(message)
+		if err != nil {
+			return nil, err
+		}
+		output, err := pv.pubKey.VRFVerify(proof, message)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VRF proof: %w", err)
+		}
+		proofs[i] = proof
+		return output, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error generating VRF proof: %w", err)
+	}
+	return proofs[i], nil
+}
+
+// String returns a string representation of the MultiSignerPV.
+func (pv *MultiSignerPV) String() string {
+	return fmt.Sprintf("MultiSignerPV{%v %d/%d}", pv.pubKey.Address(), pv.threshold, len(pv.nodes))
+}
+
+// collect sends a request to all the signer nodes concurrently, and returns
+// the index of a node whose result is shared by threshold nodes as soon as
+// there is one. The request function is called with the index of the node,
+// and returns the data the nodes must agree on.
+func (pv *MultiSignerPV) collect(request func(i int, node types.PrivValidator) ([]byte, error)) (int, error) {
+	type response struct {
+		node   int
+		result []byte
+		err    error
+	}
+
+	// The channel is buffered so that the requests to the nodes that are still
+	// pending when a quorum is reached don't block forever.
+	responses := make(chan response, len(pv.nodes))
+	for i, node := range pv.nodes {
+		go func(i int, node types.PrivValidator) {
+			result, err := request(i, node)
+			responses <- response{i, result, err}
+		}(i, node)
+	}
+
+	var (
+		results [][]byte
+		counts  []int
+		errs    []string
+	)
+	for pending := len(pv.nodes); pending > 0; pending-- {
+		res := <-responses
+		if res.err != nil {
+			errs = append(errs, fmt.Sprintf("node %d: %v", res.node, res.err))
+			continue
+		}
+
+		j := 0
+		for ; j < len(results); j++ {
+			if bytes.Equal(results[j], res.result) {
+				break
+			}
+		}
+		if j == len(results) {
+			results = append(results, res.result)
+			counts = append(counts, 0)
+		}
+		counts[j]++
+		if counts[j] >= pv.threshold {
+			return res.node, nil
+		}
+	}
+
+	if len(results) > 1 {
+		errs = append(errs, fmt.Sprintf("%d conflicting results", len(results)))
+	}
+	return 0, fmt.Errorf("%w: %d required out of %d (%s)",
+		ErrNoSignerQuorum, pv.threshold, len(pv.nodes), strings.Join(errs, "; "))
+}
+
+//-------------------------------------------------------------------------------
+
+// LocalSignerNode is an in-process stand-in for the transport to a remote
+// signer node of a MultiSignerPV. It serializes the requests to the wrapped
+// PrivValidator, e.g. a FilePV, and can be taken offline to simulate an
+// unreachable node.
+type LocalSignerNode struct {
+	mtx     tmsync.Mutex
+	pv      types.PrivValidator
+	offline bool
+}
+
+var _ types.PrivValidator = (*LocalSignerNode)(nil)
+
+// NewLocalSignerNode returns an online LocalSignerNode for pv.
+func NewLocalSignerNode(pv types.PrivValidator) *LocalSignerNode {
+	return &LocalSignerNode{pv: pv}
+}
+
+// SetOnline sets whether the node is reachable. Requests to an offline node
+// fail with ErrNoConnection.
+func (n *LocalSignerNode) SetOnline(online bool) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.offline = !online
+}
+
+// GetPubKey implements PrivValidator.
+func (n *LocalSignerNode) GetPubKey() (crypto.PubKey, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.offline {
+		return nil, ErrNoConnection
+	}
+	return n.pv.GetPubKey()
+}
+
+// SignVote implements PrivValidator.
+func (n *LocalSignerNode) SignVote(chainID string, vote *tmproto.Vote, signExtension bool) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.offline {
+		return ErrNoConnection
+	}
+	return n.pv.SignVote(chainID, vote, signExtension)
+}
+
+// SignProposal implements PrivValidator.
+func (n *LocalSignerNode) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.offline {
+		return ErrNoConnection
+	}
+	return n.pv.SignProposal(chainID, proposal)
+}
+
+// GenerateVRFProof implements PrivValidator.
+func (n *LocalSignerNode) GenerateVRFProof(message []byte) (crypto.Proof, error) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.offline {
+		return nil, ErrNoConnection
+	}
+	return n.pv.GenerateVRFProof(message)
+}
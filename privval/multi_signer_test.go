@@ -0,0 +1,155 @@
+package privval
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/crypto/tmhash"
+	tmrand "github.com/line/ostracon/libs/rand"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/types"
+)
+
+func newLocalSignerNodes(t *testing.T, privKey crypto.PrivKey, n int) []*LocalSignerNode {
+	dir := t.TempDir()
+	nodes := make([]*LocalSignerNode, n)
+	for i := range nodes {
+		pv := NewFilePV(privKey,
+			filepath.Join(dir, "key.json"), filepath.Join(dir, "state"+string(rune('0'+i))+".json"))
+		nodes[i] = NewLocalSignerNode(pv)
+	}
+	return nodes
+}
+
+func newMultiSignerPV(t *testing.T, nodes []*LocalSignerNode, threshold int) *MultiSignerPV {
+	pvs := make([]types.PrivValidator, len(nodes))
+	for i, node := range nodes {
+		pvs[i] = node
+	}
+	pv, err := NewMultiSignerPV(pvs, threshold)
+	require.NoError(t, err)
+	return pv
+}
+
+func newBlockID() types.BlockID {
+	return types.BlockID{
+		Hash:          tmrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: tmrand.Bytes(tmhash.Size)},
+	}
+}
+
+func TestNewMultiSignerPV(t *testing.T) {
+	nodes := newLocalSignerNodes(t, ed25519.GenPrivKey(), 3)
+	pvs := []types.PrivValidator{nodes[0], nodes[1], nodes[2]}
+
+	for _, threshold := range []int{0, 1, 4} {
+		_, err := NewMultiSignerPV(pvs, threshold)
+		assert.Error(t, err, threshold)
+	}
+
+	nodes[0].SetOnline(false)
+	nodes[1].SetOnline(false)
+	_, err := NewMultiSignerPV(pvs, 2)
+	assert.ErrorIs(t, err, ErrNoSignerQuorum)
+
+	nodes[0].SetOnline(true)
+	pv, err := NewMultiSignerPV(pvs, 2)
+	require.NoError(t, err)
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, nodes[2].pv.(*FilePV).Key.PubKey, pubKey)
+
+	// a node with another key is a misconfiguration
+	other := newLocalSignerNodes(t, ed25519.GenPrivKey(), 1)[0]
+	_, err = NewMultiSignerPV([]types.PrivValidator{nodes[0], nodes[1], other}, 2)
+	assert.Error(t, err)
+}
+
+func TestMultiSignerPVSignVote(t *testing.T) {
+	for _, kt := range testingKeyTypes {
+		t.Run(kt.keyType, func(t *testing.T) {
+			nodes := newLocalSignerNodes(t, kt.privKey, 3)
+			pv := newMultiSignerPV(t, nodes, 2)
+			pubKey, err := pv.GetPubKey()
+			require.NoError(t, err)
+
+			// one node may be unreachable
+			nodes[2].SetOnline(false)
+			vote := newVote(pubKey.Address(), 0, 1, 0, tmproto.PrevoteType, newBlockID()).ToProto()
+			require.NoError(t, pv.SignVote("mychainid", vote, false))
+			assert.True(t, pubKey.VerifySignature(types.VoteSignBytes("mychainid", vote), vote.Signature))
+
+			// but not two
+			nodes[1].SetOnline(false)
+			vote = newVote(pubKey.Address(), 0, 1, 0, tmproto.PrecommitType, newBlockID()).ToProto()
+			assert.ErrorIs(t, pv.SignVote("mychainid", vote, false), ErrNoSignerQuorum)
+			assert.Nil(t, vote.Signature)
+		})
+	}
+}
+
+func TestMultiSignerPVDoubleSignProtection(t *testing.T) {
+	nodes := newLocalSignerNodes(t, ed25519.GenPrivKey(), 3)
+	// two validator replicas sharing the signer nodes
+	active, standby := newMultiSignerPV(t, nodes, 2), newMultiSignerPV(t, nodes, 2)
+	pubKey, err := active.GetPubKey()
+	require.NoError(t, err)
+
+	blockID := newBlockID()
+	nodes[2].SetOnline(false)
+	vote := newVote(pubKey.Address(), 0, 10, 1, tmproto.PrevoteType, blockID).ToProto()
+	require.NoError(t, active.SignVote("mychainid", vote, false))
+
+	// The standby can't get a signature for another block at the same HRS,
+	// even after the nodes that signed are partitioned from it.
+	nodes[2].SetOnline(true)
+	conflicting := newVote(pubKey.Address(), 0, 10, 1, tmproto.PrevoteType, newBlockID()).ToProto()
+	assert.ErrorIs(t, standby.SignVote("mychainid", conflicting, false), ErrNoSignerQuorum)
+	nodes[0].SetOnline(false)
+	conflicting = newVote(pubKey.Address(), 0, 10, 1, tmproto.PrevoteType, newBlockID()).ToProto()
+	assert.ErrorIs(t, standby.SignVote("mychainid", conflicting, false), ErrNoSignerQuorum)
+	assert.Nil(t, conflicting.Signature)
+
+	// The same vote is signed again with the original timestamp.
+	nodes[0].SetOnline(true)
+	same := newVote(pubKey.Address(), 0, 10, 1, tmproto.PrevoteType, blockID).ToProto()
+	same.Timestamp = vote.Timestamp.Add(time.Second)
+	require.NoError(t, standby.SignVote("mychainid", same, false))
+	assert.Equal(t, vote.Timestamp, same.Timestamp)
+	assert.Equal(t, vote.Signature, same.Signature)
+
+	// and so is the same for proposals
+	proposal := newProposal(10, 2, blockID).ToProto()
+	require.NoError(t, active.SignProposal("mychainid", proposal))
+	assert.True(t, pubKey.VerifySignature(types.ProposalSignBytes("mychainid", proposal), proposal.Signature))
+	conflictingProposal := newProposal(10, 2, newBlockID()).ToProto()
+	assert.ErrorIs(t, standby.SignProposal("mychainid", conflictingProposal), ErrNoSignerQuorum)
+}
+
+func TestMultiSignerPVGenerateVRFProof(t *testing.T) {
+	for _, kt := range testingKeyTypes {
+		t.Run(kt.keyType, func(t *testing.T) {
+			nodes := newLocalSignerNodes(t, kt.privKey, 3)
+			pv := newMultiSignerPV(t, nodes, 2)
+			pubKey, err := pv.GetPubKey()
+			require.NoError(t, err)
+
+			message := []byte("hello, world")
+			nodes[0].SetOnline(false)
+			proof, err := pv.GenerateVRFProof(message)
+			require.NoError(t, err)
+			_, err = pubKey.VRFVerify(proof, message)
+			assert.NoError(t, err)
+
+			nodes[1].SetOnline(false)
+			_, err = pv.GenerateVRFProof(message)
+			assert.ErrorIs(t, err, ErrNoSignerQuorum)
+		})
+	}
+}
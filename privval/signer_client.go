@@ -1,6 +1,7 @@
 package privval
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -75,8 +76,9 @@ func (sc *SignerClient) GetPubKey() (crypto.PubKey, error) {
 }
 
 // SignVote requests a remote signer to sign a vote
-func (sc *SignerClient) SignVote(chainID string, vote *tmproto.Vote) error {
-	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.SignVoteRequest{Vote: vote, ChainId: chainID}))
+func (sc *SignerClient) SignVote(chainID string, vote *tmproto.Vote, signExtension bool) error {
+	response, err := sc.endpoint.SendRequest(mustWrapMsg(&privvalproto.SignVoteRequest{
+		Vote: vote, ChainId: chainID, SkipExtensionSigning: !signExtension}))
 	if err != nil {
 		return err
 	}
@@ -128,7 +130,7 @@ func (sc *SignerClient) GenerateVRFProof(message []byte) (crypto.Proof, error) {
 	switch r := response.Sum.(type) {
 	case *privvalproto.Message_VrfProofResponse:
 		if r.VrfProofResponse.Error != nil {
-			return nil, fmt.Errorf(r.VrfProofResponse.Error.Description)
+			return nil, errors.New(r.VrfProofResponse.Error.Description)
 		}
 		return r.VrfProofResponse.Proof, nil
 	default:
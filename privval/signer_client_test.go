@@ -210,13 +210,50 @@ func TestSignerVote(t *testing.T) {
 			}
 		})
 
-		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto()))
-		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto()))
+		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto(), false))
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto(), false))
 
 		assert.Equal(t, want.Signature, have.Signature)
 	}
 }
 
+func TestSignerVoteExtension(t *testing.T) {
+	for _, tc := range getSignerTestCases(t, nil, true) {
+		hash := tmrand.Bytes(tmhash.Size)
+		vote := &types.Vote{
+			Type:             tmproto.PrecommitType,
+			Height:           1,
+			Round:            2,
+			BlockID:          types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Hash: hash, Total: 2}},
+			Timestamp:        time.Now(),
+			ValidatorAddress: tmrand.Bytes(crypto.AddressSize),
+			ValidatorIndex:   1,
+			Extension:        []byte("extension"),
+		}
+
+		tc := tc
+		t.Cleanup(func() {
+			if err := tc.signerServer.Stop(); err != nil {
+				t.Error(err)
+			}
+		})
+		t.Cleanup(func() {
+			if err := tc.signerClient.Close(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		v := vote.ToProto()
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, v, false))
+		assert.NotNil(t, v.Signature)
+		assert.Nil(t, v.ExtensionSignature)
+
+		v = vote.ToProto()
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, v, true))
+		assert.NotNil(t, v.ExtensionSignature)
+	}
+}
+
 func TestSignerVoteResetDeadline(t *testing.T) {
 	for _, tc := range getSignerTestCases(t, nil, true) {
 		ts := time.Now()
@@ -256,8 +293,8 @@ func TestSignerVoteResetDeadline(t *testing.T) {
 
 		time.Sleep(testTimeoutReadWrite2o3)
 
-		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto()))
-		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto()))
+		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto(), false))
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto(), false))
 		assert.Equal(t, want.Signature, have.Signature)
 
 		// TODO(jleni): Clarify what is actually being tested
@@ -265,8 +302,8 @@ func TestSignerVoteResetDeadline(t *testing.T) {
 		// This would exceed the deadline if it was not extended by the previous message
 		time.Sleep(testTimeoutReadWrite2o3)
 
-		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto()))
-		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto()))
+		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto(), false))
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto(), false))
 		assert.Equal(t, want.Signature, have.Signature)
 	}
 }
@@ -317,8 +354,8 @@ func TestSignerVoteKeepAlive(t *testing.T) {
 		time.Sleep(testTimeoutReadWrite * 3)
 		tc.signerServer.Logger.Debug("TEST: Forced Wait DONE---------------------------------------------")
 
-		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto()))
-		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto()))
+		require.NoError(t, tc.mockPV.SignVote(tc.chainID, want.ToProto(), false))
+		require.NoError(t, tc.signerClient.SignVote(tc.chainID, have.ToProto(), false))
 
 		assert.Equal(t, want.Signature, have.Signature)
 	}
@@ -396,13 +433,13 @@ func TestSignerSignVoteErrors(t *testing.T) {
 			}
 		})
 
-		err := tc.signerClient.SignVote(tc.chainID, vote.ToProto())
+		err := tc.signerClient.SignVote(tc.chainID, vote.ToProto(), false)
 		require.Equal(t, err.(*RemoteSignerError).Description, types.ErroringMockPVErr.Error())
 
-		err = tc.mockPV.SignVote(tc.chainID, vote.ToProto())
+		err = tc.mockPV.SignVote(tc.chainID, vote.ToProto(), false)
 		require.Error(t, err)
 
-		err = tc.signerClient.SignVote(tc.chainID, vote.ToProto())
+		err = tc.signerClient.SignVote(tc.chainID, vote.ToProto(), false)
 		require.Error(t, err)
 	}
 }
@@ -452,7 +489,7 @@ func TestSignerUnexpectedResponse(t *testing.T) {
 		ts := time.Now()
 		want := &types.Vote{Timestamp: ts, Type: tmproto.PrecommitType}
 
-		e := tc.signerClient.SignVote(tc.chainID, want.ToProto())
+		e := tc.signerClient.SignVote(tc.chainID, want.ToProto(), false)
 		assert.EqualError(t, e, "empty response")
 	}
 }
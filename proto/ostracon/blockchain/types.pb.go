@@ -116,6 +116,9 @@ func (m *NoBlockResponse) GetHeight() int64 {
 // BlockResponse returns block to the requested
 type BlockResponse struct {
 	Block *types.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// the extended commit of the block, set once the vote extensions are
+	// enabled at its height.
+	ExtCommit *types.ExtendedCommit `protobuf:"bytes,2,opt,name=ext_commit,json=extCommit,proto3" json:"ext_commit,omitempty"`
 }
 
 func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
@@ -158,6 +161,13 @@ func (m *BlockResponse) GetBlock() *types.Block {
 	return nil
 }
 
+func (m *BlockResponse) GetExtCommit() *types.ExtendedCommit {
+	if m != nil {
+		return m.ExtCommit
+	}
+	return nil
+}
+
 // StatusRequest requests the status of a peer.
 type StatusRequest struct {
 }
@@ -384,30 +394,32 @@ func init() {
 func init() { proto.RegisterFile("ostracon/blockchain/types.proto", fileDescriptor_8fcd44ecf244cfa3) }
 
 var fileDescriptor_8fcd44ecf244cfa3 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xb1, 0x4f, 0xc2, 0x40,
-	0x14, 0xc6, 0x5b, 0x0b, 0x98, 0x3c, 0x28, 0x8d, 0x35, 0x1a, 0xc2, 0x50, 0xb5, 0x1a, 0xa3, 0x31,
-	0x69, 0x13, 0x5c, 0x99, 0x98, 0x48, 0x54, 0x86, 0xba, 0xb9, 0x90, 0xb6, 0xb9, 0xd0, 0x46, 0xb8,
-	0x43, 0xde, 0x75, 0xf0, 0xbf, 0x70, 0xf4, 0x4f, 0x72, 0x64, 0x74, 0x34, 0xf0, 0x8f, 0x18, 0xee,
-	0xca, 0x09, 0x4d, 0xed, 0xd6, 0x7b, 0xfd, 0xde, 0xef, 0xfb, 0xfa, 0xe5, 0x0a, 0x67, 0x0c, 0xf9,
-	0x22, 0x8c, 0x19, 0xf5, 0xa3, 0x29, 0x8b, 0x5f, 0xe3, 0x24, 0x4c, 0xa9, 0xcf, 0xdf, 0xe7, 0x04,
-	0xbd, 0xf9, 0x82, 0x71, 0x66, 0x1f, 0x6f, 0x05, 0xde, 0x9f, 0xa0, 0xdb, 0x55, 0x5b, 0x42, 0x2a,
-	0x77, 0xe5, 0x82, 0x7b, 0x0d, 0xad, 0xc1, 0xe6, 0x18, 0x90, 0xb7, 0x8c, 0x20, 0xb7, 0x4f, 0xa1,
-	0x91, 0x90, 0x74, 0x92, 0xf0, 0x8e, 0x7e, 0xae, 0xdf, 0x18, 0x41, 0x7e, 0x72, 0x6f, 0xc1, 0x1a,
-	0xb1, 0x5c, 0x89, 0x73, 0x46, 0x91, 0xfc, 0x2b, 0xed, 0x83, 0xb9, 0x2f, 0xbc, 0x83, 0xba, 0xb0,
-	0x14, 0xba, 0x66, 0xef, 0xc4, 0x53, 0x21, 0x65, 0x74, 0xa9, 0x96, 0x1a, 0xd7, 0x02, 0xf3, 0x99,
-	0x87, 0x3c, 0xc3, 0x3c, 0x91, 0xdb, 0x87, 0xf6, 0x76, 0x50, 0x6d, 0x6c, 0xdb, 0x50, 0x8b, 0x42,
-	0x24, 0x9d, 0x03, 0x31, 0x15, 0xcf, 0xee, 0xa7, 0x01, 0x87, 0x4f, 0x04, 0x31, 0x9c, 0x10, 0x7b,
-	0x08, 0xa6, 0xf0, 0x18, 0x2f, 0x24, 0x3a, 0xcf, 0x73, 0xe1, 0x95, 0x94, 0xe6, 0xed, 0xb6, 0x32,
-	0xd4, 0x82, 0x56, 0xb4, 0xdb, 0x52, 0x00, 0x47, 0x94, 0x8d, 0xb7, 0x30, 0x19, 0x4b, 0xd8, 0x36,
-	0x7b, 0x57, 0xa5, 0xb4, 0x42, 0x77, 0x43, 0x2d, 0xb0, 0x68, 0xa1, 0xce, 0x07, 0x68, 0x17, 0x80,
-	0x86, 0x00, 0xba, 0x55, 0xf1, 0x14, 0xce, 0x8c, 0x8a, 0x30, 0x14, 0xa5, 0xa9, 0x6f, 0xad, 0x55,
-	0xc0, 0xf6, 0x0a, 0xdf, 0xc0, 0x70, 0x77, 0x60, 0x8f, 0xc0, 0x52, 0xb0, 0x3c, 0x5a, 0x5d, 0xd0,
-	0x2e, 0x2b, 0x69, 0x2a, 0x5b, 0x1b, 0xf7, 0x26, 0x83, 0x3a, 0x18, 0x98, 0xcd, 0x06, 0x8f, 0x5f,
-	0x2b, 0x47, 0x5f, 0xae, 0x1c, 0xfd, 0x67, 0xe5, 0xe8, 0x1f, 0x6b, 0x47, 0x5b, 0xae, 0x1d, 0xed,
-	0x7b, 0xed, 0x68, 0x2f, 0xbd, 0x49, 0xca, 0x93, 0x2c, 0xf2, 0x62, 0x36, 0xf3, 0xa7, 0x29, 0x25,
-	0xbe, 0xba, 0xc0, 0xe2, 0xd2, 0xfa, 0x25, 0x7f, 0x41, 0xd4, 0x10, 0xaf, 0xee, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x96, 0x66, 0x60, 0x50, 0x23, 0x03, 0x00, 0x00,
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0xdb, 0x5b, 0xe0, 0xe6, 0x9e, 0x4b, 0x69, 0xac, 0xd1, 0x10, 0x16, 0x55, 0xab, 0x31,
+	0x1a, 0x93, 0x36, 0xc1, 0xad, 0x6e, 0x30, 0x26, 0x24, 0x2a, 0x8b, 0xba, 0x73, 0x43, 0xda, 0x32,
+	0xa1, 0x8d, 0x74, 0x06, 0x99, 0x69, 0x82, 0xf1, 0x25, 0x5c, 0xfa, 0x48, 0x2e, 0x59, 0xba, 0x34,
+	0xf0, 0x22, 0x86, 0x99, 0x32, 0x96, 0x8a, 0xdd, 0x0d, 0x33, 0xdf, 0xf9, 0xf8, 0xcf, 0x99, 0x29,
+	0xec, 0x11, 0xca, 0x26, 0x7e, 0x48, 0xb0, 0x1b, 0x8c, 0x48, 0xf8, 0x18, 0x46, 0x7e, 0x8c, 0x5d,
+	0xf6, 0x3c, 0x46, 0xd4, 0x19, 0x4f, 0x08, 0x23, 0xe6, 0xf6, 0x0a, 0x70, 0xbe, 0x81, 0x56, 0x4b,
+	0x56, 0x71, 0x54, 0xd4, 0x8a, 0x82, 0x1f, 0x67, 0x39, 0x99, 0x7d, 0x0c, 0xf5, 0xce, 0x12, 0xf5,
+	0xd0, 0x53, 0x8a, 0x28, 0x33, 0x77, 0xa1, 0x16, 0xa1, 0x78, 0x18, 0xb1, 0xa6, 0xba, 0xaf, 0x9e,
+	0x68, 0x5e, 0xf6, 0xcb, 0x3e, 0x05, 0xa3, 0x47, 0x32, 0x92, 0x8e, 0x09, 0xa6, 0xe8, 0x57, 0xf4,
+	0x05, 0xf4, 0x75, 0xf0, 0x0c, 0xaa, 0x3c, 0x0e, 0xe7, 0xfe, 0xb7, 0x77, 0x1c, 0xd9, 0x80, 0x48,
+	0x22, 0x68, 0xc1, 0x98, 0x97, 0x00, 0x68, 0xca, 0xfa, 0x21, 0x49, 0x92, 0x98, 0x35, 0xff, 0xf0,
+	0x0a, 0xab, 0x58, 0x71, 0x3d, 0x65, 0x08, 0x0f, 0xd0, 0xe0, 0x8a, 0x53, 0xde, 0x3f, 0x34, 0x65,
+	0x62, 0x69, 0x1b, 0xa0, 0xdf, 0x33, 0x9f, 0xa5, 0x34, 0x6b, 0xc8, 0xbe, 0x80, 0xc6, 0x6a, 0xa3,
+	0x3c, 0xb7, 0x69, 0x42, 0x25, 0xf0, 0x29, 0xe2, 0xff, 0xa9, 0x79, 0x7c, 0x6d, 0xbf, 0x69, 0xf0,
+	0xf7, 0x0e, 0x51, 0xea, 0x0f, 0x91, 0xd9, 0x05, 0x9d, 0x47, 0xec, 0x4f, 0x84, 0x3a, 0x6b, 0xe7,
+	0xc0, 0xd9, 0x70, 0x1f, 0x4e, 0x7e, 0xa8, 0x5d, 0xc5, 0xab, 0x07, 0xf9, 0x21, 0x7b, 0xb0, 0x85,
+	0x49, 0x7f, 0x25, 0x13, 0xb1, 0xb2, 0x56, 0x8f, 0x36, 0xda, 0x0a, 0xa3, 0xef, 0x2a, 0x9e, 0x81,
+	0x0b, 0xb7, 0x71, 0x03, 0x8d, 0x82, 0x50, 0xe3, 0x42, 0xbb, 0x2c, 0x9e, 0xd4, 0xe9, 0x41, 0x51,
+	0x46, 0xf9, 0xd0, 0x64, 0xaf, 0x95, 0x12, 0xd9, 0xda, 0xc0, 0x97, 0x32, 0x9a, 0xdf, 0x30, 0x7b,
+	0x60, 0x48, 0x59, 0x16, 0xad, 0xca, 0x6d, 0x87, 0xa5, 0x36, 0x99, 0xad, 0x41, 0xd7, 0x76, 0x3a,
+	0x55, 0xd0, 0x68, 0x9a, 0x74, 0x6e, 0xdf, 0xe7, 0x96, 0x3a, 0x9b, 0x5b, 0xea, 0xe7, 0xdc, 0x52,
+	0x5f, 0x17, 0x96, 0x32, 0x5b, 0x58, 0xca, 0xc7, 0xc2, 0x52, 0x1e, 0xda, 0xc3, 0x98, 0x45, 0x69,
+	0xe0, 0x84, 0x24, 0x71, 0x47, 0x31, 0x46, 0xae, 0x7c, 0xff, 0xfc, 0xcd, 0xbb, 0x1b, 0x3e, 0xb0,
+	0xa0, 0xc6, 0x8f, 0xce, 0xbf, 0x06, 0x00, 0xd9, 0x42, 0x9e, 0x89, 0x7e, 0x03, 0x00, 0x00,
 }
 
 func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
@@ -486,6 +498,18 @@ func (m *BlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExtCommit != nil {
+		{
+			size, err := m.ExtCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Block != nil {
 		{
 			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
@@ -739,6 +763,10 @@ func (m *BlockResponse) Size() (n int) {
 		l = m.Block.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.ExtCommit != nil {
+		l = m.ExtCommit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1048,6 +1076,42 @@ func (m *BlockResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtCommit == nil {
+				m.ExtCommit = &types.ExtendedCommit{}
+			}
+			if err := m.ExtCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
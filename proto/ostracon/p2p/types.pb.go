@@ -24,9 +24,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type NetAddress struct {
-	ID   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IP   string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	ID       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IP       string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port     uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Protocol string `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
 }
 
 func (m *NetAddress) Reset()         { *m = NetAddress{} }
@@ -83,6 +84,13 @@ func (m *NetAddress) GetPort() uint32 {
 	return 0
 }
 
+func (m *NetAddress) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
 type ProtocolVersion struct {
 	P2P   uint64 `protobuf:"varint,1,opt,name=p2p,proto3" json:"p2p,omitempty"`
 	Block uint64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
@@ -305,37 +313,38 @@ func init() {
 func init() { proto.RegisterFile("ostracon/p2p/types.proto", fileDescriptor_309178781c11bf68) }
 
 var fileDescriptor_309178781c11bf68 = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x3d, 0x8f, 0xd3, 0x40,
-	0x10, 0x8d, 0x1d, 0xe7, 0xe3, 0x26, 0x17, 0x72, 0xac, 0x22, 0xe4, 0x3b, 0x09, 0x3b, 0x4a, 0x15,
-	0x09, 0x29, 0x96, 0x42, 0x45, 0x83, 0x44, 0x08, 0x45, 0x9a, 0x60, 0xad, 0x10, 0x05, 0x4d, 0xe4,
-	0x78, 0xf7, 0x92, 0x55, 0x7c, 0xbb, 0xab, 0xf5, 0x1e, 0x84, 0x7f, 0xc1, 0xcf, 0xba, 0xf2, 0x4a,
-	0x2a, 0x0b, 0x39, 0x05, 0x7f, 0x03, 0x79, 0xed, 0x80, 0x89, 0xae, 0x9b, 0x37, 0x5f, 0x6f, 0xe6,
-	0xe9, 0x81, 0x2b, 0x52, 0xad, 0xa2, 0x58, 0xf0, 0x40, 0xce, 0x64, 0xa0, 0xbf, 0x4b, 0x9a, 0x4e,
-	0xa5, 0x12, 0x5a, 0xa0, 0xcb, 0x53, 0x65, 0x2a, 0x67, 0xf2, 0x66, 0xb8, 0x15, 0x5b, 0x61, 0x0a,
-	0x41, 0x11, 0x95, 0x3d, 0xe3, 0x10, 0x60, 0x45, 0xf5, 0x3b, 0x42, 0x14, 0x4d, 0x53, 0xf4, 0x02,
-	0x6c, 0x46, 0x5c, 0x6b, 0x64, 0x4d, 0x2e, 0xe6, 0xed, 0x3c, 0xf3, 0xed, 0xe5, 0x02, 0xdb, 0x8c,
-	0x98, 0xbc, 0x74, 0xed, 0x5a, 0x3e, 0xc4, 0x36, 0x93, 0x08, 0x81, 0x23, 0x85, 0xd2, 0x6e, 0x73,
-	0x64, 0x4d, 0xfa, 0xd8, 0xc4, 0xe3, 0x4f, 0x30, 0x08, 0x8b, 0xd5, 0xb1, 0x48, 0x3e, 0x53, 0x95,
-	0x32, 0xc1, 0xd1, 0x35, 0x34, 0xe5, 0x4c, 0x9a, 0xbd, 0xce, 0xbc, 0x93, 0x67, 0x7e, 0x33, 0x9c,
-	0x85, 0xb8, 0xc8, 0xa1, 0x21, 0xb4, 0x36, 0x89, 0x88, 0xf7, 0x66, 0xb9, 0x83, 0x4b, 0x80, 0xae,
-	0xa0, 0x19, 0x49, 0x69, 0xd6, 0x3a, 0xb8, 0x08, 0xc7, 0xbf, 0x6d, 0x18, 0x2c, 0xe8, 0x6d, 0x74,
-	0x9f, 0xe8, 0x95, 0x20, 0x74, 0xc9, 0x6f, 0x05, 0x5a, 0xc1, 0x95, 0xac, 0x98, 0xd6, 0x5f, 0x4b,
-	0x2a, 0xc3, 0xd1, 0x9b, 0xbd, 0x9c, 0xd6, 0x5f, 0x9f, 0x9e, 0xdd, 0x33, 0x77, 0x1e, 0x32, 0xbf,
-	0x81, 0x07, 0xf2, 0xec, 0xcc, 0x37, 0x30, 0x20, 0x25, 0xc5, 0x9a, 0x0b, 0x42, 0xd7, 0x8c, 0x54,
-	0x2f, 0x3f, 0xcf, 0x33, 0xbf, 0x5f, 0x67, 0x5f, 0xe0, 0x3e, 0xa9, 0x41, 0x82, 0x7c, 0xe8, 0x25,
-	0x2c, 0xd5, 0x94, 0xaf, 0x23, 0x42, 0x94, 0x39, 0xfc, 0x02, 0x43, 0x99, 0x2a, 0xc4, 0x45, 0x2e,
-	0x74, 0x38, 0xd5, 0xdf, 0x84, 0xda, 0xbb, 0x8e, 0x29, 0x9e, 0x60, 0x51, 0x39, 0x1d, 0xdf, 0x2a,
-	0x2b, 0x15, 0x44, 0x37, 0xd0, 0x8d, 0x77, 0x11, 0xe7, 0x34, 0x49, 0xdd, 0xf6, 0xc8, 0x9a, 0x5c,
-	0xe2, 0xbf, 0xb8, 0x98, 0xba, 0x13, 0x9c, 0xed, 0xa9, 0x72, 0x3b, 0xe5, 0x54, 0x05, 0xd1, 0x5b,
-	0x68, 0x09, 0xbd, 0xa3, 0xca, 0xed, 0x1a, 0x29, 0xc6, 0xff, 0x4b, 0x71, 0xa6, 0xe1, 0xc7, 0xa2,
-	0xb3, 0xd2, 0xa3, 0x1c, 0x1b, 0x6f, 0x60, 0xf8, 0x54, 0x13, 0xba, 0x86, 0xae, 0x3e, 0xac, 0x19,
-	0x27, 0xf4, 0x50, 0x3a, 0x04, 0x77, 0xf4, 0x61, 0x59, 0x40, 0x14, 0x40, 0x4f, 0xc9, 0xd8, 0xbc,
-	0x4e, 0xd3, 0xb4, 0x12, 0xed, 0x59, 0x9e, 0xf9, 0x80, 0xc3, 0xf7, 0x95, 0xb7, 0x30, 0x28, 0x19,
-	0x57, 0xf1, 0xfc, 0xc3, 0x43, 0xee, 0x59, 0x8f, 0xb9, 0x67, 0xfd, 0xca, 0x3d, 0xeb, 0xc7, 0xd1,
-	0x6b, 0x3c, 0x1e, 0xbd, 0xc6, 0xcf, 0xa3, 0xd7, 0xf8, 0xf2, 0x6a, 0xcb, 0xf4, 0xee, 0x7e, 0x33,
-	0x8d, 0xc5, 0x5d, 0x90, 0x30, 0x4e, 0x83, 0x7f, 0xee, 0x36, 0xd6, 0xad, 0x9b, 0x7d, 0xd3, 0x36,
-	0xb9, 0xd7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x34, 0x79, 0x6e, 0x87, 0x03, 0x03, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcf, 0x8f, 0xd2, 0x40,
+	0x14, 0xc7, 0x69, 0x29, 0x3f, 0xf6, 0xb1, 0xc8, 0x3a, 0x21, 0xa6, 0xbb, 0x89, 0x2d, 0xe1, 0x44,
+	0x62, 0x42, 0x13, 0x3c, 0x79, 0x31, 0x11, 0xf1, 0xc0, 0x05, 0xc9, 0xc4, 0x78, 0xf0, 0x42, 0xa0,
+	0x33, 0x0b, 0x13, 0xba, 0x33, 0x93, 0xe9, 0xac, 0xe2, 0x7f, 0xe1, 0x9f, 0xb5, 0xc7, 0x3d, 0x7a,
+	0x6a, 0x4c, 0x39, 0xf8, 0x6f, 0x98, 0x99, 0x96, 0xb5, 0x12, 0x6f, 0xef, 0xfb, 0x7e, 0xf4, 0xfb,
+	0xfa, 0xc9, 0x1b, 0xf0, 0x45, 0xaa, 0xd5, 0x3a, 0x16, 0x3c, 0x92, 0x13, 0x19, 0xe9, 0xef, 0x92,
+	0xa6, 0x63, 0xa9, 0x84, 0x16, 0xe8, 0xf2, 0x54, 0x19, 0xcb, 0x89, 0xbc, 0xe9, 0x6f, 0xc5, 0x56,
+	0xd8, 0x42, 0x64, 0xa2, 0xa2, 0x67, 0x98, 0x00, 0x2c, 0xa8, 0x7e, 0x47, 0x88, 0xa2, 0x69, 0x8a,
+	0x5e, 0x80, 0xcb, 0x88, 0xef, 0x0c, 0x9c, 0xd1, 0xc5, 0xb4, 0x99, 0x67, 0xa1, 0x3b, 0x9f, 0x61,
+	0x97, 0x11, 0x9b, 0x97, 0xbe, 0x5b, 0xc9, 0x2f, 0xb1, 0xcb, 0x24, 0x42, 0xe0, 0x49, 0xa1, 0xb4,
+	0x5f, 0x1f, 0x38, 0xa3, 0x2e, 0xb6, 0x31, 0xba, 0x81, 0xb6, 0xfd, 0x74, 0x2c, 0x12, 0xdf, 0x33,
+	0x13, 0xf8, 0x49, 0x0f, 0x3f, 0x41, 0x6f, 0x59, 0xc6, 0x9f, 0xa9, 0x4a, 0x99, 0xe0, 0xe8, 0x1a,
+	0xea, 0x72, 0x22, 0xad, 0xa7, 0x37, 0x6d, 0xe5, 0x59, 0x58, 0x5f, 0x4e, 0x96, 0xd8, 0xe4, 0x50,
+	0x1f, 0x1a, 0x9b, 0x44, 0xc4, 0x7b, 0x6b, 0xec, 0xe1, 0x42, 0xa0, 0x2b, 0xa8, 0xaf, 0xa5, 0xb4,
+	0x96, 0x1e, 0x36, 0xe1, 0xf0, 0xb7, 0x0b, 0xbd, 0x19, 0xbd, 0x5d, 0xdf, 0x27, 0x7a, 0x21, 0x08,
+	0x9d, 0xf3, 0x5b, 0x81, 0x16, 0x70, 0x75, 0x72, 0x5d, 0x7d, 0x2d, 0xac, 0xac, 0x47, 0x67, 0xf2,
+	0x72, 0x5c, 0xc5, 0x32, 0x3e, 0xdb, 0x67, 0xea, 0x3d, 0x64, 0x61, 0x0d, 0xf7, 0xe4, 0xd9, 0x9a,
+	0x6f, 0xa0, 0x47, 0x0a, 0x8b, 0x15, 0x17, 0x84, 0xae, 0x18, 0x29, 0x71, 0x3c, 0xcf, 0xb3, 0xb0,
+	0x5b, 0x75, 0x9f, 0xe1, 0x2e, 0xa9, 0x48, 0x82, 0x42, 0xe8, 0x24, 0x2c, 0xd5, 0x94, 0xaf, 0xd6,
+	0x84, 0x28, 0xbb, 0xf8, 0x05, 0x86, 0x22, 0x65, 0xc0, 0x23, 0x1f, 0x5a, 0x9c, 0xea, 0x6f, 0x42,
+	0xed, 0x4b, 0x60, 0x27, 0x69, 0x2a, 0xa7, 0xe5, 0x1b, 0x45, 0xa5, 0x94, 0x86, 0x72, 0xbc, 0x5b,
+	0x73, 0x4e, 0x93, 0xd4, 0x6f, 0x0e, 0x9c, 0xd1, 0x25, 0x7e, 0xd2, 0x66, 0xea, 0x4e, 0x70, 0xb6,
+	0xa7, 0xca, 0x6f, 0x15, 0x53, 0xa5, 0x44, 0x6f, 0xa1, 0x21, 0xf4, 0x8e, 0x2a, 0xbf, 0x6d, 0x51,
+	0x0c, 0xff, 0x45, 0x71, 0xc6, 0xf0, 0xa3, 0xe9, 0x2c, 0x79, 0x14, 0x63, 0xc3, 0x0d, 0xf4, 0xff,
+	0xd7, 0x84, 0xae, 0xa1, 0xad, 0x0f, 0x2b, 0xc6, 0x09, 0x3d, 0x14, 0xd7, 0x83, 0x5b, 0xfa, 0x30,
+	0x37, 0x12, 0x45, 0xd0, 0x51, 0x32, 0xb6, 0xbf, 0x4e, 0xd3, 0xb4, 0x84, 0xf6, 0x2c, 0xcf, 0x42,
+	0xc0, 0xcb, 0xf7, 0xe5, 0xdd, 0x61, 0x50, 0x32, 0x2e, 0xe3, 0xe9, 0x87, 0x87, 0x3c, 0x70, 0x1e,
+	0xf3, 0xc0, 0xf9, 0x95, 0x07, 0xce, 0x8f, 0x63, 0x50, 0x7b, 0x3c, 0x06, 0xb5, 0x9f, 0xc7, 0xa0,
+	0xf6, 0xe5, 0xd5, 0x96, 0xe9, 0xdd, 0xfd, 0x66, 0x1c, 0x8b, 0xbb, 0x28, 0x61, 0x9c, 0x46, 0x7f,
+	0x2f, 0xdf, 0x9e, 0x75, 0xf5, 0x21, 0x6c, 0x9a, 0x36, 0xf7, 0xfa, 0xcf, 0x00, 0x98, 0x76, 0x99,
+	0xdb, 0x1f, 0x03, 0x00, 0x00,
 }
 
 func (m *NetAddress) Marshal() (dAtA []byte, err error) {
@@ -358,6 +367,13 @@ func (m *NetAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Protocol)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Port != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Port))
 		i--
@@ -568,6 +584,10 @@ func (m *NetAddress) Size() (n int) {
 	if m.Port != 0 {
 		n += 1 + sovTypes(uint64(m.Port))
 	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -761,6 +781,38 @@ func (m *NetAddress) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -214,8 +214,9 @@ func (m *PubKeyResponse) GetError() *RemoteSignerError {
 
 // SignVoteRequest is a request to sign a vote
 type SignVoteRequest struct {
-	Vote    *types.Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
-	ChainId string      `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Vote                 *types.Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	ChainId              string      `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	SkipExtensionSigning bool        `protobuf:"varint,3,opt,name=skip_extension_signing,json=skipExtensionSigning,proto3" json:"skip_extension_signing,omitempty"`
 }
 
 func (m *SignVoteRequest) Reset()         { *m = SignVoteRequest{} }
@@ -265,6 +266,13 @@ func (m *SignVoteRequest) GetChainId() string {
 	return ""
 }
 
+func (m *SignVoteRequest) GetSkipExtensionSigning() bool {
+	if m != nil {
+		return m.SkipExtensionSigning
+	}
+	return false
+}
+
 // SignedVoteResponse is a response containing a signed vote or an error
 type SignedVoteResponse struct {
 	Vote  types.Vote         `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote"`
@@ -804,60 +812,62 @@ func init() {
 func init() { proto.RegisterFile("ostracon/privval/types.proto", fileDescriptor_abbbbe5131a55005) }
 
 var fileDescriptor_abbbbe5131a55005 = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x86, 0x49, 0x5b, 0x17, 0xe7, 0xc8, 0x17, 0x79, 0xac, 0xa6, 0x8a, 0xd0, 0x28, 0xae, 0xda,
-	0x02, 0x46, 0x0a, 0x50, 0x40, 0xda, 0x4d, 0xba, 0xab, 0x1d, 0x16, 0x12, 0xdc, 0x90, 0xc4, 0x48,
-	0x71, 0x82, 0x76, 0x41, 0x48, 0xd4, 0x98, 0x21, 0x62, 0x73, 0xa6, 0x1c, 0x4a, 0x80, 0x56, 0xdd,
-	0x75, 0xdb, 0x3e, 0x46, 0x1f, 0x25, 0xdd, 0x65, 0xd9, 0x55, 0x51, 0xd8, 0x9b, 0xf6, 0x2d, 0x0a,
-	0xce, 0x0c, 0x2f, 0xa2, 0xe5, 0x34, 0x80, 0x77, 0x9c, 0x73, 0x8e, 0xbe, 0xf3, 0xff, 0x24, 0x7f,
-	0x88, 0xf0, 0x09, 0xe5, 0x71, 0x34, 0xf1, 0x68, 0xd8, 0x67, 0x51, 0xb0, 0x58, 0x4c, 0x2e, 0xfa,
-	0xf1, 0x92, 0x11, 0x6e, 0xb0, 0x88, 0xc6, 0x14, 0x35, 0xd3, 0xae, 0xa1, 0xba, 0x9d, 0x4e, 0x36,
-	0xef, 0x45, 0x4b, 0x16, 0xd3, 0xfe, 0x1b, 0xb2, 0x54, 0xd3, 0x85, 0x9e, 0x60, 0x14, 0x49, 0x9d,
-	0x96, 0x4f, 0x7d, 0x2a, 0x2e, 0xfb, 0xc9, 0x95, 0xac, 0xf6, 0x86, 0xb0, 0x8f, 0xc9, 0x25, 0x8d,
-	0xc9, 0x28, 0xf0, 0x43, 0x12, 0x99, 0x51, 0x44, 0x23, 0x84, 0xa0, 0xe2, 0xd1, 0x19, 0x69, 0xeb,
-	0x87, 0xfa, 0x51, 0x15, 0x8b, 0x6b, 0x74, 0x08, 0x8d, 0x19, 0xe1, 0x5e, 0x14, 0xb0, 0x38, 0xa0,
-	0x61, 0x7b, 0xe3, 0x50, 0x3f, 0xba, 0x87, 0x8b, 0xa5, 0xde, 0x63, 0xd8, 0x71, 0xe6, 0xd3, 0x53,
-	0xb2, 0xc4, 0xe4, 0xa7, 0x39, 0xe1, 0x31, 0x7a, 0x00, 0x5b, 0xde, 0xeb, 0x49, 0x10, 0xba, 0xc1,
-	0x4c, 0xa0, 0xee, 0xe1, 0xba, 0x38, 0x0f, 0x67, 0xbd, 0x5f, 0x74, 0xd8, 0x4d, 0x87, 0x39, 0xa3,
-	0x21, 0x27, 0xe8, 0x29, 0xd4, 0xd9, 0x7c, 0xea, 0xbe, 0x21, 0x4b, 0x31, 0xdc, 0x78, 0xd2, 0x31,
-	0x32, 0xef, 0xd2, 0xa9, 0xe1, 0xcc, 0xa7, 0x17, 0x81, 0x77, 0x4a, 0x96, 0xc7, 0x95, 0xb7, 0x7f,
-	0x3d, 0xd2, 0x70, 0x8d, 0x09, 0x04, 0x7a, 0x0a, 0x55, 0x92, 0x08, 0x17, 0xaa, 0x1a, 0x4f, 0x3e,
-	0x33, 0xca, 0x37, 0xcd, 0xb8, 0xe1, 0x11, 0xcb, 0x5f, 0xf4, 0xce, 0x60, 0x2f, 0xa9, 0x9e, 0xd1,
-	0x98, 0xa4, 0xb2, 0x8f, 0xa0, 0xb2, 0xa0, 0x31, 0x51, 0x2a, 0x5a, 0x39, 0x4c, 0xde, 0x4d, 0x31,
-	0x2a, 0x26, 0x56, 0x0c, 0x6e, 0xac, 0x1a, 0xfc, 0x19, 0x90, 0xd8, 0x36, 0x93, 0x64, 0xe5, 0xd1,
-	0xf8, 0x7f, 0xb4, 0xb2, 0x26, 0x17, 0xdc, 0xc1, 0xd8, 0x39, 0x1c, 0x24, 0x55, 0x27, 0xa2, 0x8c,
-	0xf2, 0xc9, 0x45, 0x6a, 0xee, 0x6b, 0xd8, 0x62, 0xaa, 0xa4, 0x54, 0xb4, 0xcb, 0x2a, 0xb2, 0x9f,
-	0x64, 0x93, 0xef, 0x33, 0xfa, 0xab, 0x0e, 0xf7, 0xa5, 0xd3, 0x7c, 0x95, 0x72, 0xfb, 0xcd, 0x87,
-	0xef, 0x52, 0xae, 0xf3, 0x8d, 0x77, 0x70, 0xfe, 0x25, 0xec, 0x9d, 0xe1, 0xef, 0x9c, 0x88, 0xd2,
-	0xf3, 0xd4, 0x75, 0x1b, 0xea, 0x97, 0x84, 0xf3, 0x89, 0x2f, 0x6f, 0xfd, 0x36, 0x4e, 0x8f, 0x3d,
-	0x0f, 0x9a, 0xf9, 0xb0, 0xd2, 0xdd, 0x82, 0x2a, 0x4b, 0x0a, 0x6a, 0x56, 0x1e, 0xee, 0xa2, 0x68,
-	0x07, 0x1a, 0x4e, 0x10, 0xfa, 0x4a, 0x4d, 0x6f, 0x17, 0xb6, 0xe5, 0x51, 0xee, 0xeb, 0xfd, 0x51,
-	0x83, 0xfa, 0x73, 0xa9, 0x07, 0x0d, 0x61, 0x4f, 0xa5, 0xc0, 0x8d, 0xe4, 0xb8, 0xba, 0x75, 0x8f,
-	0x6e, 0xee, 0x5b, 0x49, 0xdb, 0x40, 0xc3, 0x3b, 0x6c, 0x25, 0x7e, 0xdf, 0x43, 0x33, 0x47, 0xc9,
-	0x55, 0x4a, 0xfb, 0xe1, 0xed, 0x2c, 0x39, 0x37, 0xd0, 0xf0, 0x2e, 0x5b, 0x8d, 0xa7, 0x0d, 0xfb,
-	0x3c, 0xf0, 0x43, 0x37, 0x79, 0x2f, 0x33, 0x69, 0x9b, 0x02, 0xf7, 0xe9, 0x4d, 0x5c, 0x29, 0x53,
-	0x03, 0x0d, 0xef, 0xf1, 0x52, 0xcc, 0x5e, 0x41, 0x8b, 0x8b, 0xf7, 0x26, 0x45, 0x2a, 0x89, 0x15,
-	0xc1, 0xfc, 0x7c, 0x3d, 0x73, 0x35, 0x4f, 0x03, 0x0d, 0x23, 0x7e, 0x33, 0x65, 0x3f, 0xc2, 0x47,
-	0x42, 0x6a, 0xfa, 0x32, 0x65, 0x72, 0xab, 0x02, 0xfd, 0xc5, 0x7a, 0x74, 0x29, 0x29, 0x03, 0x0d,
-	0x1f, 0xf0, 0x35, 0x01, 0x9a, 0x41, 0x5b, 0xc9, 0x2e, 0xe0, 0x95, 0xf4, 0x9a, 0xe0, 0x1f, 0xdd,
-	0x26, 0xbd, 0x1c, 0x90, 0x81, 0x86, 0xef, 0xf3, 0xf5, 0xd1, 0x39, 0x86, 0x6d, 0x16, 0x84, 0x7e,
-	0xa6, 0xbc, 0x2e, 0xc8, 0x0f, 0xd7, 0x3c, 0xb7, 0xfc, 0xbd, 0x1a, 0x68, 0xb8, 0xc1, 0xf2, 0x23,
-	0x32, 0x61, 0x47, 0x31, 0x94, 0xbc, 0x2d, 0x01, 0xe9, 0xde, 0x06, 0xc9, 0x44, 0x6d, 0xb3, 0xc2,
-	0x19, 0x39, 0xb0, 0xbf, 0x88, 0xce, 0x5d, 0x11, 0x82, 0x4c, 0xcf, 0x3f, 0xf5, 0xdb, 0x9e, 0x7c,
-	0x29, 0x7a, 0xc9, 0x93, 0x5f, 0x44, 0xe7, 0x2b, 0x69, 0x1c, 0x01, 0x2a, 0x12, 0x95, 0xba, 0x7f,
-	0x25, 0xb2, 0xf7, 0x3e, 0x64, 0x26, 0xb1, 0x99, 0x33, 0x65, 0xed, 0xb8, 0x0a, 0x9b, 0x7c, 0x7e,
-	0xf9, 0xf8, 0x77, 0x1d, 0x6a, 0x22, 0x7b, 0x1c, 0x21, 0xd8, 0x35, 0x31, 0xb6, 0xf1, 0xc8, 0x7d,
-	0x61, 0x9d, 0x5a, 0xf6, 0x4b, 0xab, 0xa9, 0xa1, 0x2e, 0x74, 0xb2, 0x9a, 0xf9, 0xca, 0x31, 0x4f,
-	0xc6, 0xe6, 0x33, 0x17, 0x9b, 0x23, 0xc7, 0xb6, 0x46, 0x66, 0x53, 0x47, 0x6d, 0x68, 0xa9, 0xbe,
-	0x65, 0xbb, 0x27, 0xb6, 0x65, 0x99, 0x27, 0xe3, 0xa1, 0x6d, 0x35, 0x37, 0xd0, 0x43, 0x78, 0xa0,
-	0x3a, 0x79, 0xd9, 0x1d, 0x0f, 0x9f, 0x9b, 0xf6, 0x8b, 0x71, 0x73, 0x13, 0x7d, 0x0c, 0x07, 0xaa,
-	0x8d, 0xcd, 0x6f, 0x9f, 0x65, 0x8d, 0x4a, 0x81, 0xf8, 0x12, 0x0f, 0xc7, 0x66, 0xd6, 0xa9, 0x1e,
-	0x0f, 0xdf, 0x5e, 0x75, 0xf5, 0x77, 0x57, 0x5d, 0xfd, 0xef, 0xab, 0xae, 0xfe, 0xdb, 0x75, 0x57,
-	0x7b, 0x77, 0xdd, 0xd5, 0xfe, 0xbc, 0xee, 0x6a, 0x3f, 0xf4, 0xfd, 0x20, 0x7e, 0x3d, 0x9f, 0x1a,
-	0x1e, 0xbd, 0xec, 0x5f, 0x04, 0x21, 0xe9, 0x17, 0x3e, 0x11, 0x92, 0xff, 0xef, 0xf2, 0x17, 0xc3,
-	0xb4, 0x26, 0xea, 0x5f, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0x45, 0xc7, 0x81, 0xcc, 0x4c, 0x08,
-	0x00, 0x00,
+	// 869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xc7, 0xed, 0x36, 0x2f, 0xdd, 0x27, 0x7d, 0x49, 0xa7, 0xa1, 0x64, 0x23, 0x36, 0x1b, 0x0c,
+	0x48, 0xd1, 0x22, 0x25, 0xd2, 0xb2, 0x97, 0xe5, 0x46, 0xbb, 0x46, 0x89, 0xca, 0x26, 0xd6, 0x24,
+	0xfb, 0x22, 0x38, 0x58, 0x89, 0x33, 0xf5, 0x5a, 0x6d, 0x3d, 0x83, 0xc7, 0x89, 0xc8, 0x89, 0x1b,
+	0x27, 0x24, 0xf8, 0x18, 0x7c, 0x94, 0xe5, 0xb6, 0x47, 0x4e, 0x08, 0xb5, 0x17, 0xf8, 0x16, 0xc8,
+	0x33, 0xe3, 0x97, 0x38, 0xcd, 0x82, 0xd4, 0xdb, 0xcc, 0xf3, 0x3c, 0xf9, 0xcf, 0xef, 0x79, 0x66,
+	0xfe, 0x8a, 0xe1, 0x23, 0xca, 0xc3, 0x60, 0xe2, 0x50, 0xbf, 0xcb, 0x02, 0x6f, 0xb1, 0x98, 0x5c,
+	0x76, 0xc3, 0x25, 0x23, 0xbc, 0xc3, 0x02, 0x1a, 0x52, 0x54, 0x8d, 0xb3, 0x1d, 0x95, 0x6d, 0x34,
+	0x92, 0x7a, 0x27, 0x58, 0xb2, 0x90, 0x76, 0x2f, 0xc8, 0x52, 0x55, 0x67, 0x72, 0x42, 0x23, 0xab,
+	0xd4, 0xa8, 0xb9, 0xd4, 0xa5, 0x62, 0xd9, 0x8d, 0x56, 0x32, 0x6a, 0xf4, 0xe1, 0x10, 0x93, 0x2b,
+	0x1a, 0x92, 0x91, 0xe7, 0xfa, 0x24, 0x30, 0x83, 0x80, 0x06, 0x08, 0x41, 0xc1, 0xa1, 0x33, 0x52,
+	0xd7, 0x5b, 0x7a, 0xbb, 0x88, 0xc5, 0x1a, 0xb5, 0xa0, 0x32, 0x23, 0xdc, 0x09, 0x3c, 0x16, 0x7a,
+	0xd4, 0xaf, 0x6f, 0xb5, 0xf4, 0xf6, 0x3d, 0x9c, 0x0d, 0x19, 0x8f, 0x60, 0xcf, 0x9a, 0x4f, 0xcf,
+	0xc8, 0x12, 0x93, 0xef, 0xe7, 0x84, 0x87, 0xe8, 0x3e, 0xec, 0x38, 0x6f, 0x26, 0x9e, 0x6f, 0x7b,
+	0x33, 0x21, 0x75, 0x0f, 0x97, 0xc5, 0xbe, 0x3f, 0x33, 0x7e, 0xd2, 0x61, 0x3f, 0x2e, 0xe6, 0x8c,
+	0xfa, 0x9c, 0xa0, 0xa7, 0x50, 0x66, 0xf3, 0xa9, 0x7d, 0x41, 0x96, 0xa2, 0xb8, 0xf2, 0xb8, 0xd1,
+	0x49, 0x7a, 0x97, 0x9d, 0x76, 0xac, 0xf9, 0xf4, 0xd2, 0x73, 0xce, 0xc8, 0xf2, 0xa4, 0xf0, 0xf6,
+	0xcf, 0x87, 0x1a, 0x2e, 0x31, 0x21, 0x81, 0x9e, 0x42, 0x91, 0x44, 0xe0, 0x82, 0xaa, 0xf2, 0xf8,
+	0x93, 0x4e, 0x7e, 0x68, 0x9d, 0xb5, 0x1e, 0xb1, 0xfc, 0x85, 0xf1, 0xb3, 0x0e, 0x07, 0x51, 0xf8,
+	0x25, 0x0d, 0x49, 0xcc, 0xdd, 0x86, 0xc2, 0x82, 0x86, 0x44, 0x61, 0xd4, 0x52, 0x35, 0x39, 0x4e,
+	0x51, 0x2a, 0x2a, 0x56, 0x3a, 0xdc, 0x5a, 0xe9, 0x10, 0x3d, 0x81, 0x63, 0x7e, 0xe1, 0x31, 0x9b,
+	0xfc, 0x10, 0x12, 0x9f, 0x7b, 0xd4, 0xb7, 0xb9, 0xe7, 0xfa, 0x9e, 0xef, 0xd6, 0xb7, 0x5b, 0x7a,
+	0x7b, 0x07, 0xd7, 0xa2, 0xac, 0x19, 0x27, 0x47, 0x32, 0x67, 0xfc, 0x08, 0x28, 0x5a, 0x92, 0x99,
+	0xe4, 0x51, 0xa3, 0xe9, 0xfc, 0x37, 0x90, 0x9a, 0x88, 0xc4, 0xba, 0xc3, 0x3c, 0xce, 0xe1, 0x28,
+	0x8a, 0x5a, 0x01, 0x65, 0x94, 0x4f, 0x2e, 0xe3, 0x91, 0x3c, 0x81, 0x1d, 0xa6, 0x42, 0x8a, 0xa2,
+	0x9e, 0xa7, 0x48, 0x7e, 0x92, 0x54, 0xbe, 0x67, 0x3c, 0xc6, 0x2f, 0x3a, 0x1c, 0xcb, 0x4e, 0xd3,
+	0xa3, 0x54, 0xb7, 0x5f, 0xfe, 0xff, 0xb3, 0x54, 0xd7, 0xe9, 0x89, 0x77, 0xe8, 0xfc, 0x73, 0x38,
+	0x78, 0x89, 0xbf, 0xb6, 0x02, 0x4a, 0xcf, 0xe3, 0xae, 0xeb, 0x50, 0xbe, 0x22, 0x9c, 0x4f, 0x5c,
+	0x39, 0xfa, 0x5d, 0x1c, 0x6f, 0x0d, 0x07, 0xaa, 0x69, 0xb1, 0xe2, 0xae, 0x41, 0x91, 0x45, 0x01,
+	0x55, 0x2b, 0x37, 0x77, 0x21, 0xda, 0x83, 0x8a, 0xe5, 0xf9, 0xae, 0xa2, 0x31, 0xf6, 0x61, 0x57,
+	0x6e, 0xe5, 0x79, 0xc6, 0xef, 0x25, 0x28, 0x3f, 0x97, 0x3c, 0xa8, 0x0f, 0x07, 0xca, 0x3c, 0x76,
+	0x20, 0xcb, 0xd5, 0xe8, 0x1e, 0xae, 0x9f, 0xb7, 0x62, 0xd2, 0x9e, 0x86, 0xf7, 0xd8, 0x8a, 0x6b,
+	0xbf, 0x81, 0x6a, 0x2a, 0x25, 0x8f, 0x52, 0xec, 0xad, 0xcd, 0x5a, 0xb2, 0xae, 0xa7, 0xe1, 0x7d,
+	0xb6, 0xea, 0xea, 0x21, 0x1c, 0x46, 0xef, 0xde, 0x8e, 0xde, 0x65, 0x82, 0xb6, 0x2d, 0xe4, 0x3e,
+	0x5e, 0x97, 0xcb, 0x39, 0xb1, 0xa7, 0xe1, 0x03, 0x9e, 0x33, 0xe7, 0x6b, 0xa8, 0x71, 0xf1, 0x6e,
+	0x62, 0x49, 0x85, 0x58, 0x10, 0x9a, 0x9f, 0xde, 0xae, 0xb9, 0xea, 0xa7, 0x9e, 0x86, 0x11, 0x5f,
+	0x77, 0xd9, 0x77, 0xf0, 0x81, 0x40, 0x8d, 0x1f, 0x53, 0x82, 0x5b, 0x14, 0xd2, 0x9f, 0xdd, 0x2e,
+	0x9d, 0x73, 0x4a, 0x4f, 0xc3, 0x47, 0x7c, 0x3d, 0x8c, 0x66, 0x50, 0x57, 0xd8, 0x19, 0x79, 0x85,
+	0x5e, 0x12, 0xfa, 0xed, 0x4d, 0xe8, 0x79, 0x83, 0xf4, 0x34, 0x7c, 0xcc, 0x6f, 0xb7, 0xce, 0x09,
+	0xec, 0x32, 0xcf, 0x77, 0x13, 0xf2, 0xb2, 0x50, 0x7e, 0x70, 0xcb, 0xbd, 0xa5, 0xef, 0xaa, 0xa7,
+	0xe1, 0x0a, 0x4b, 0xb7, 0xc8, 0x84, 0x3d, 0xa5, 0xa1, 0xf0, 0x76, 0x84, 0x48, 0x73, 0x93, 0x48,
+	0x02, 0xb5, 0xcb, 0x32, 0x7b, 0x64, 0xc1, 0xe1, 0x22, 0x38, 0xb7, 0x85, 0x09, 0x12, 0x9e, 0xbf,
+	0xcb, 0x9b, 0x6e, 0x3e, 0x67, 0xbd, 0xe8, 0xe6, 0x17, 0xc1, 0xf9, 0x8a, 0x1b, 0x47, 0x80, 0xb2,
+	0x8a, 0x8a, 0xee, 0x1f, 0x29, 0x69, 0xbc, 0x4f, 0x32, 0x41, 0xac, 0xa6, 0x9a, 0x32, 0x76, 0x52,
+	0x84, 0x6d, 0x3e, 0xbf, 0x7a, 0xf4, 0x9b, 0x0e, 0x25, 0xe1, 0x3d, 0x8e, 0x10, 0xec, 0x9b, 0x18,
+	0x0f, 0xf1, 0xc8, 0x7e, 0x31, 0x38, 0x1b, 0x0c, 0x5f, 0x0d, 0xaa, 0x1a, 0x6a, 0x42, 0x23, 0x89,
+	0x99, 0xaf, 0x2d, 0xf3, 0x74, 0x6c, 0x3e, 0xb3, 0xb1, 0x39, 0xb2, 0x86, 0x83, 0x91, 0x59, 0xd5,
+	0x51, 0x1d, 0x6a, 0x2a, 0x3f, 0x18, 0xda, 0xa7, 0xc3, 0xc1, 0xc0, 0x3c, 0x1d, 0xf7, 0x87, 0x83,
+	0xea, 0x16, 0x7a, 0x00, 0xf7, 0x55, 0x26, 0x0d, 0xdb, 0xe3, 0xfe, 0x73, 0x73, 0xf8, 0x62, 0x5c,
+	0xdd, 0x46, 0x1f, 0xc2, 0x91, 0x4a, 0x63, 0xf3, 0xab, 0x67, 0x49, 0xa2, 0x90, 0x51, 0x7c, 0x85,
+	0xfb, 0x63, 0x33, 0xc9, 0x14, 0x4f, 0xfa, 0x6f, 0xaf, 0x9b, 0xfa, 0xbb, 0xeb, 0xa6, 0xfe, 0xd7,
+	0x75, 0x53, 0xff, 0xf5, 0xa6, 0xa9, 0xbd, 0xbb, 0x69, 0x6a, 0x7f, 0xdc, 0x34, 0xb5, 0x6f, 0xbb,
+	0xae, 0x17, 0xbe, 0x99, 0x4f, 0x3b, 0x0e, 0xbd, 0xea, 0x5e, 0x7a, 0x3e, 0xe9, 0x66, 0xbe, 0x2c,
+	0xa2, 0xbf, 0xfd, 0xfc, 0x87, 0xc6, 0xb4, 0x24, 0xe2, 0x5f, 0xfc, 0x3b, 0x00, 0xba, 0xcd, 0x10,
+	0x5c, 0x83, 0x08, 0x00, 0x00,
 }
 
 func (m *RemoteSignerError) Marshal() (dAtA []byte, err error) {
@@ -990,6 +1000,16 @@ func (m *SignVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SkipExtensionSigning {
+		i--
+		if m.SkipExtensionSigning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
@@ -1577,6 +1597,9 @@ func (m *SignVoteRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.SkipExtensionSigning {
+		n += 2
+	}
 	return n
 }
 
@@ -2213,6 +2236,26 @@ func (m *SignVoteRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipExtensionSigning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipExtensionSigning = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
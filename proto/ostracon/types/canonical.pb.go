@@ -308,48 +308,121 @@ func (m *CanonicalVote) GetChainID() string {
 	return ""
 }
 
+// CanonicalVoteExtension is the sign bytes of a vote extension, which is
+// signed apart from the vote.
+type CanonicalVoteExtension struct {
+	Extension []byte `protobuf:"bytes,1,opt,name=extension,proto3" json:"extension,omitempty"`
+	Height    int64  `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int64  `protobuf:"fixed64,3,opt,name=round,proto3" json:"round,omitempty"`
+	ChainID   string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *CanonicalVoteExtension) Reset()         { *m = CanonicalVoteExtension{} }
+func (m *CanonicalVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*CanonicalVoteExtension) ProtoMessage()    {}
+func (*CanonicalVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca4a1fbbc6b35f34, []int{4}
+}
+func (m *CanonicalVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanonicalVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanonicalVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanonicalVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalVoteExtension.Merge(m, src)
+}
+func (m *CanonicalVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanonicalVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalVoteExtension proto.InternalMessageInfo
+
+func (m *CanonicalVoteExtension) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *CanonicalVoteExtension) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CanonicalVoteExtension) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CanonicalVoteExtension) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CanonicalBlockID)(nil), "ostracon.types.CanonicalBlockID")
 	proto.RegisterType((*CanonicalPartSetHeader)(nil), "ostracon.types.CanonicalPartSetHeader")
 	proto.RegisterType((*CanonicalProposal)(nil), "ostracon.types.CanonicalProposal")
 	proto.RegisterType((*CanonicalVote)(nil), "ostracon.types.CanonicalVote")
+	proto.RegisterType((*CanonicalVoteExtension)(nil), "ostracon.types.CanonicalVoteExtension")
 }
 
 func init() { proto.RegisterFile("ostracon/types/canonical.proto", fileDescriptor_ca4a1fbbc6b35f34) }
 
 var fileDescriptor_ca4a1fbbc6b35f34 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xc1, 0x6a, 0xdb, 0x4c,
-	0x10, 0xf6, 0x3a, 0xb2, 0x2d, 0x6f, 0xe2, 0xfc, 0xf9, 0x97, 0x10, 0x84, 0xa1, 0x92, 0xf0, 0x21,
-	0xb8, 0x87, 0x4a, 0x34, 0x7d, 0x03, 0xa5, 0xd0, 0x18, 0x5a, 0x1a, 0x36, 0xa6, 0x87, 0x5e, 0xcc,
-	0x5a, 0xda, 0x4a, 0xa2, 0xb2, 0x66, 0x91, 0xd6, 0x87, 0x40, 0x1f, 0x22, 0x4f, 0xd1, 0x67, 0xc9,
-	0x31, 0x97, 0x42, 0x4f, 0x6e, 0x91, 0x5f, 0xa4, 0xec, 0xca, 0x96, 0x93, 0xd0, 0x9c, 0xda, 0x5e,
-	0x96, 0x9d, 0xf9, 0xbe, 0x99, 0xf9, 0xf8, 0x86, 0xc1, 0x36, 0x94, 0xb2, 0x60, 0x21, 0xe4, 0xbe,
-	0xbc, 0x16, 0xbc, 0xf4, 0x43, 0x96, 0x43, 0x9e, 0x86, 0x2c, 0xf3, 0x44, 0x01, 0x12, 0xc8, 0xe1,
-	0x16, 0xf7, 0x34, 0x3e, 0x3c, 0x8e, 0x21, 0x06, 0x0d, 0xf9, 0xea, 0x57, 0xb3, 0x86, 0xc3, 0x47,
-	0x5d, 0xf4, 0xbb, 0xc1, 0x9c, 0x18, 0x20, 0xce, 0xb8, 0xaf, 0xa3, 0xf9, 0xf2, 0x93, 0x2f, 0xd3,
-	0x05, 0x2f, 0x25, 0x5b, 0x88, 0x9a, 0x30, 0xfa, 0x82, 0x8f, 0xce, 0xb7, 0x53, 0x83, 0x0c, 0xc2,
-	0xcf, 0x93, 0xd7, 0x84, 0x60, 0x23, 0x61, 0x65, 0x62, 0x21, 0x17, 0x8d, 0x0f, 0xa8, 0xfe, 0x93,
-	0x29, 0xfe, 0x4f, 0xb0, 0x42, 0xce, 0x4a, 0x2e, 0x67, 0x09, 0x67, 0x11, 0x2f, 0xac, 0xb6, 0x8b,
-	0xc6, 0xfb, 0x67, 0xa7, 0xde, 0x43, 0x91, 0x5e, 0xd3, 0xee, 0x92, 0x15, 0xf2, 0x8a, 0xcb, 0x0b,
-	0xcd, 0x0e, 0x8c, 0xdb, 0x95, 0xd3, 0xa2, 0x03, 0x71, 0x3f, 0x39, 0x0a, 0xf0, 0xc9, 0xef, 0xe9,
-	0xe4, 0x18, 0x77, 0x24, 0x48, 0x96, 0x69, 0x11, 0x03, 0x5a, 0x07, 0x8d, 0xb2, 0xf6, 0x4e, 0xd9,
-	0xe8, 0x5b, 0x1b, 0xff, 0xbf, 0x6b, 0x52, 0x80, 0x80, 0x92, 0x65, 0xe4, 0x25, 0x36, 0x94, 0x1c,
-	0x5d, 0x7e, 0x78, 0xf6, 0xec, 0xb1, 0xc8, 0xab, 0x34, 0xce, 0x79, 0xf4, 0xae, 0x8c, 0xa7, 0xd7,
-	0x82, 0x53, 0x4d, 0x25, 0x27, 0xb8, 0x9b, 0xf0, 0x34, 0x4e, 0xa4, 0x6e, 0x7f, 0x44, 0x37, 0x91,
-	0x92, 0x52, 0xc0, 0x32, 0x8f, 0xac, 0x3d, 0x9d, 0xae, 0x03, 0xf2, 0x1c, 0xf7, 0x05, 0x64, 0xb3,
-	0x1a, 0x31, 0x5c, 0x34, 0xde, 0x0b, 0x0e, 0xaa, 0x95, 0x63, 0x5e, 0xbe, 0x7f, 0x4b, 0x55, 0x8e,
-	0x9a, 0x02, 0x32, 0xfd, 0x23, 0x17, 0xd8, 0x9c, 0x2b, 0x6b, 0x67, 0x69, 0x64, 0x75, 0xb4, 0x69,
-	0xee, 0x93, 0xa6, 0x6d, 0x76, 0x10, 0xec, 0x57, 0x2b, 0xa7, 0xb7, 0x09, 0x68, 0x4f, 0x97, 0x4f,
-	0x22, 0x12, 0xe0, 0x7e, 0xb3, 0x40, 0xab, 0xab, 0x5b, 0x0d, 0xbd, 0x7a, 0xc5, 0xde, 0x76, 0xc5,
-	0xde, 0x74, 0xcb, 0x08, 0x4c, 0xe5, 0xf9, 0xcd, 0x0f, 0x07, 0xd1, 0x5d, 0x19, 0x39, 0xc5, 0x66,
-	0x98, 0xb0, 0x34, 0x57, 0x6a, 0x7a, 0x2e, 0x1a, 0xf7, 0xeb, 0x59, 0xe7, 0x2a, 0xa7, 0x66, 0x69,
-	0x70, 0x12, 0x8d, 0xbe, 0xb6, 0xf1, 0xa0, 0x91, 0xf5, 0x01, 0x24, 0xff, 0xf7, 0x9e, 0xde, 0x37,
-	0xca, 0xf8, 0x7b, 0x46, 0x75, 0xfe, 0xdc, 0xa8, 0xee, 0xd3, 0x46, 0x05, 0x6f, 0x6e, 0x2b, 0x1b,
-	0xdd, 0x55, 0x36, 0xfa, 0x59, 0xd9, 0xe8, 0x66, 0x6d, 0xb7, 0xee, 0xd6, 0x76, 0xeb, 0xfb, 0xda,
-	0x6e, 0x7d, 0x7c, 0x11, 0xa7, 0x32, 0x59, 0xce, 0xbd, 0x10, 0x16, 0x7e, 0x96, 0xe6, 0xdc, 0x6f,
-	0x2e, 0xb5, 0x3e, 0xe2, 0x87, 0x87, 0x3b, 0xef, 0xea, 0xec, 0xab, 0x5f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0x0f, 0xca, 0x3d, 0x17, 0x04, 0x00, 0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0xa4, 0x4e, 0xe2, 0x4c, 0x9b, 0x52, 0x46, 0x55, 0x64, 0x45, 0x60, 0x5b, 0x59, 0x54,
+	0x61, 0x81, 0x2d, 0xca, 0x1f, 0xb8, 0x20, 0x1a, 0x09, 0x44, 0xe5, 0x46, 0x2c, 0xd8, 0x44, 0x13,
+	0x7b, 0xb0, 0x2d, 0x1c, 0x5f, 0xcb, 0x9e, 0x48, 0x54, 0xe2, 0x13, 0x58, 0xf4, 0x2b, 0xf8, 0x96,
+	0x2e, 0xbb, 0x41, 0x62, 0x15, 0x90, 0xf3, 0x23, 0xc8, 0xe3, 0x47, 0x92, 0x8a, 0x20, 0x21, 0xe8,
+	0xc6, 0x9a, 0x7b, 0xce, 0x99, 0x3b, 0x47, 0xe7, 0xca, 0x17, 0xab, 0x90, 0xf2, 0x84, 0x3a, 0x10,
+	0x99, 0xfc, 0x2a, 0x66, 0xa9, 0xe9, 0xd0, 0x08, 0xa2, 0xc0, 0xa1, 0xa1, 0x11, 0x27, 0xc0, 0x81,
+	0x1c, 0x56, 0xbc, 0x21, 0xf8, 0xc1, 0xb1, 0x07, 0x1e, 0x08, 0xca, 0xcc, 0x4f, 0x85, 0x6a, 0x30,
+	0xb8, 0xd3, 0x45, 0x7c, 0x4b, 0x4e, 0xf3, 0x00, 0xbc, 0x90, 0x99, 0xa2, 0x9a, 0x2d, 0x3e, 0x98,
+	0x3c, 0x98, 0xb3, 0x94, 0xd3, 0x79, 0x5c, 0x08, 0x86, 0x9f, 0xf1, 0xd1, 0x59, 0xf5, 0xaa, 0x15,
+	0x82, 0xf3, 0x71, 0xfc, 0x82, 0x10, 0x2c, 0xf9, 0x34, 0xf5, 0x15, 0xa4, 0xa3, 0xd1, 0x81, 0x2d,
+	0xce, 0x64, 0x82, 0x1f, 0xc4, 0x34, 0xe1, 0xd3, 0x94, 0xf1, 0xa9, 0xcf, 0xa8, 0xcb, 0x12, 0xa5,
+	0xa9, 0xa3, 0xd1, 0xfe, 0xe9, 0x89, 0xb1, 0x6d, 0xd2, 0xa8, 0xdb, 0x5d, 0xd0, 0x84, 0x5f, 0x32,
+	0x7e, 0x2e, 0xd4, 0x96, 0x74, 0xb3, 0xd4, 0x1a, 0x76, 0x2f, 0xde, 0x04, 0x87, 0x16, 0xee, 0xff,
+	0x5e, 0x4e, 0x8e, 0x71, 0x8b, 0x03, 0xa7, 0xa1, 0x30, 0xd1, 0xb3, 0x8b, 0xa2, 0x76, 0xd6, 0x5c,
+	0x3b, 0x1b, 0x7e, 0x6b, 0xe2, 0x87, 0xeb, 0x26, 0x09, 0xc4, 0x90, 0xd2, 0x90, 0x3c, 0xc3, 0x52,
+	0x6e, 0x47, 0x5c, 0x3f, 0x3c, 0x7d, 0x7c, 0xd7, 0xe4, 0x65, 0xe0, 0x45, 0xcc, 0x7d, 0x93, 0x7a,
+	0x93, 0xab, 0x98, 0xd9, 0x42, 0x4a, 0xfa, 0xb8, 0xed, 0xb3, 0xc0, 0xf3, 0xb9, 0x68, 0x7f, 0x64,
+	0x97, 0x55, 0x6e, 0x25, 0x81, 0x45, 0xe4, 0x2a, 0x7b, 0x02, 0x2e, 0x0a, 0xf2, 0x04, 0x77, 0x63,
+	0x08, 0xa7, 0x05, 0x23, 0xe9, 0x68, 0xb4, 0x67, 0x1d, 0x64, 0x4b, 0x4d, 0xbe, 0x78, 0xfb, 0xda,
+	0xce, 0x31, 0x5b, 0x8e, 0x21, 0x14, 0x27, 0x72, 0x8e, 0xe5, 0x59, 0x1e, 0xed, 0x34, 0x70, 0x95,
+	0x96, 0x08, 0x4d, 0xdf, 0x19, 0x5a, 0x39, 0x03, 0x6b, 0x3f, 0x5b, 0x6a, 0x9d, 0xb2, 0xb0, 0x3b,
+	0xe2, 0xfa, 0xd8, 0x25, 0x16, 0xee, 0xd6, 0x03, 0x54, 0xda, 0xa2, 0xd5, 0xc0, 0x28, 0x46, 0x6c,
+	0x54, 0x23, 0x36, 0x26, 0x95, 0xc2, 0x92, 0xf3, 0xcc, 0xaf, 0x7f, 0x68, 0xc8, 0x5e, 0x5f, 0x23,
+	0x27, 0x58, 0x76, 0x7c, 0x1a, 0x44, 0xb9, 0x9b, 0x8e, 0x8e, 0x46, 0xdd, 0xe2, 0xad, 0xb3, 0x1c,
+	0xcb, 0xdf, 0x12, 0xe4, 0xd8, 0x1d, 0x7e, 0x6d, 0xe2, 0x5e, 0x6d, 0xeb, 0x1d, 0x70, 0x76, 0xff,
+	0x99, 0x6e, 0x06, 0x25, 0xfd, 0xbf, 0xa0, 0x5a, 0xff, 0x1e, 0x54, 0xfb, 0x0f, 0x41, 0x7d, 0x41,
+	0xb8, 0xbf, 0x15, 0xd4, 0xcb, 0x4f, 0x9c, 0x45, 0x69, 0x00, 0x11, 0x79, 0x84, 0xbb, 0xac, 0x2a,
+	0xca, 0xdf, 0x69, 0x0d, 0xfc, 0x65, 0x38, 0x9b, 0x76, 0xa4, 0xdd, 0x76, 0xac, 0x57, 0x37, 0x99,
+	0x8a, 0x6e, 0x33, 0x15, 0xfd, 0xcc, 0x54, 0x74, 0xbd, 0x52, 0x1b, 0xb7, 0x2b, 0xb5, 0xf1, 0x7d,
+	0xa5, 0x36, 0xde, 0x3f, 0xf5, 0x02, 0xee, 0x2f, 0x66, 0x86, 0x03, 0x73, 0x33, 0x0c, 0x22, 0x66,
+	0xd6, 0x8b, 0xa3, 0xd8, 0x29, 0xdb, 0x7b, 0x64, 0xd6, 0x16, 0xe8, 0xf3, 0x5f, 0x03, 0x00, 0x62,
+	0x33, 0xcc, 0x41, 0xa6, 0x04, 0x00, 0x00,
 }
 
 func (m *CanonicalBlockID) Marshal() (dAtA []byte, err error) {
@@ -566,6 +639,55 @@ func (m *CanonicalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CanonicalVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanonicalVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanonicalVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintCanonical(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Round != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Round))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Height != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Height))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintCanonical(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCanonical(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCanonical(v)
 	base := offset
@@ -667,6 +789,29 @@ func (m *CanonicalVote) Size() (n int) {
 	return n
 }
 
+func (m *CanonicalVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovCanonical(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 9
+	}
+	if m.Round != 0 {
+		n += 9
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCanonical(uint64(l))
+	}
+	return n
+}
+
 func sovCanonical(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1292,6 +1437,142 @@ func (m *CanonicalVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CanonicalVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCanonical
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanonicalVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanonicalVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Round = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCanonical(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCanonical(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34,6 +34,7 @@ type ConsensusParams struct {
 	Evidence  EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence"`
 	Validator ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator"`
 	Version   VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version"`
+	ABCI      ABCIParams      `protobuf:"bytes,5,opt,name=abci,proto3" json:"abci"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -97,6 +98,13 @@ func (m *ConsensusParams) GetVersion() VersionParams {
 	return VersionParams{}
 }
 
+func (m *ConsensusParams) GetABCI() ABCIParams {
+	if m != nil {
+		return m.ABCI
+	}
+	return ABCIParams{}
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -110,6 +118,11 @@ type BlockParams struct {
 	//
 	// Not exposed to the application.
 	TimeIotaMs int64 `protobuf:"varint,3,opt,name=time_iota_ms,json=timeIotaMs,proto3" json:"time_iota_ms,omitempty"`
+	// Percentage of parity parts added to the data parts of the proposal
+	// blocks, which can then be rebuilt from any subset of the parts of the
+	// size of the data. 0 disables the erasure coding.
+	// Note: must be between 0 and 100
+	ErasureParityPercent int64 `protobuf:"varint,4,opt,name=erasure_parity_percent,json=erasureParityPercent,proto3" json:"erasure_parity_percent,omitempty"`
 }
 
 func (m *BlockParams) Reset()         { *m = BlockParams{} }
@@ -166,6 +179,13 @@ func (m *BlockParams) GetTimeIotaMs() int64 {
 	return 0
 }
 
+func (m *BlockParams) GetErasureParityPercent() int64 {
+	if m != nil {
+		return m.ErasureParityPercent
+	}
+	return 0
+}
+
 // EvidenceParams determine how we handle evidence of malfeasance.
 type EvidenceParams struct {
 	// Max age of evidence, in blocks.
@@ -330,19 +350,73 @@ func (m *VersionParams) GetAppVersion() uint64 {
 	return 0
 }
 
+// ABCIParams configure the ABCI calls of the consensus.
+type ABCIParams struct {
+	// First height from which the precommits carry vote extensions, which are
+	// passed to the proposer of the next height. 0 disables the vote
+	// extensions.
+	// Note: must be greater or equal to 0
+	//
+	// The application may set it through ConsensusParamUpdates to a height
+	// after the current one, as long as the current enable height was not
+	// reached. Once enabled, the vote extensions can't be disabled.
+	VoteExtensionsEnableHeight int64 `protobuf:"varint,1,opt,name=vote_extensions_enable_height,json=voteExtensionsEnableHeight,proto3" json:"vote_extensions_enable_height,omitempty"`
+}
+
+func (m *ABCIParams) Reset()         { *m = ABCIParams{} }
+func (m *ABCIParams) String() string { return proto.CompactTextString(m) }
+func (*ABCIParams) ProtoMessage()    {}
+func (*ABCIParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93f70d04c868d295, []int{5}
+}
+func (m *ABCIParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ABCIParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ABCIParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ABCIParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABCIParams.Merge(m, src)
+}
+func (m *ABCIParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ABCIParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABCIParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ABCIParams proto.InternalMessageInfo
+
+func (m *ABCIParams) GetVoteExtensionsEnableHeight() int64 {
+	if m != nil {
+		return m.VoteExtensionsEnableHeight
+	}
+	return 0
+}
+
 // HashedParams is a subset of ConsensusParams.
 //
 // It is hashed into the Header.ConsensusHash.
 type HashedParams struct {
-	BlockMaxBytes int64 `protobuf:"varint,1,opt,name=block_max_bytes,json=blockMaxBytes,proto3" json:"block_max_bytes,omitempty"`
-	BlockMaxGas   int64 `protobuf:"varint,2,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	BlockMaxBytes             int64 `protobuf:"varint,1,opt,name=block_max_bytes,json=blockMaxBytes,proto3" json:"block_max_bytes,omitempty"`
+	BlockMaxGas               int64 `protobuf:"varint,2,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	BlockErasureParityPercent int64 `protobuf:"varint,3,opt,name=block_erasure_parity_percent,json=blockErasureParityPercent,proto3" json:"block_erasure_parity_percent,omitempty"`
 }
 
 func (m *HashedParams) Reset()         { *m = HashedParams{} }
 func (m *HashedParams) String() string { return proto.CompactTextString(m) }
 func (*HashedParams) ProtoMessage()    {}
 func (*HashedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93f70d04c868d295, []int{5}
+	return fileDescriptor_93f70d04c868d295, []int{6}
 }
 func (m *HashedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,53 +459,68 @@ func (m *HashedParams) GetBlockMaxGas() int64 {
 	return 0
 }
 
+func (m *HashedParams) GetBlockErasureParityPercent() int64 {
+	if m != nil {
+		return m.BlockErasureParityPercent
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ConsensusParams)(nil), "ostracon.types.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "ostracon.types.BlockParams")
 	proto.RegisterType((*EvidenceParams)(nil), "ostracon.types.EvidenceParams")
 	proto.RegisterType((*ValidatorParams)(nil), "ostracon.types.ValidatorParams")
 	proto.RegisterType((*VersionParams)(nil), "ostracon.types.VersionParams")
+	proto.RegisterType((*ABCIParams)(nil), "ostracon.types.ABCIParams")
 	proto.RegisterType((*HashedParams)(nil), "ostracon.types.HashedParams")
 }
 
 func init() { proto.RegisterFile("ostracon/types/params.proto", fileDescriptor_93f70d04c868d295) }
 
 var fileDescriptor_93f70d04c868d295 = []byte{
-	// 535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0xaf, 0x97, 0xb1, 0xb5, 0x5f, 0xd7, 0x16, 0x59, 0x48, 0x94, 0x55, 0x24, 0x55, 0x0e, 0x68,
-	0x12, 0x22, 0x91, 0xe0, 0x80, 0x34, 0x09, 0x01, 0x19, 0x68, 0xa0, 0xa9, 0x08, 0x45, 0x88, 0xc3,
-	0x2e, 0x91, 0xd3, 0x9a, 0x2c, 0x5a, 0x1d, 0x47, 0xb1, 0x53, 0xb5, 0x6f, 0xb1, 0x23, 0xc7, 0x1d,
-	0xe1, 0x0d, 0x78, 0x84, 0x1d, 0x77, 0xe4, 0x04, 0xa8, 0xbd, 0xf0, 0x18, 0x28, 0x4e, 0xbc, 0x2e,
-	0xe5, 0x16, 0x7f, 0xbf, 0x3f, 0xce, 0xf7, 0xfb, 0xc9, 0x30, 0xe0, 0x42, 0x66, 0x64, 0xcc, 0x13,
-	0x57, 0x2e, 0x52, 0x2a, 0xdc, 0x94, 0x64, 0x84, 0x09, 0x27, 0xcd, 0xb8, 0xe4, 0xb8, 0xab, 0x41,
-	0x47, 0x81, 0xfb, 0xf7, 0x22, 0x1e, 0x71, 0x05, 0xb9, 0xc5, 0x57, 0xc9, 0xda, 0x37, 0x23, 0xce,
-	0xa3, 0x29, 0x75, 0xd5, 0x29, 0xcc, 0xbf, 0xb8, 0x93, 0x3c, 0x23, 0x32, 0xe6, 0x49, 0x89, 0xdb,
-	0x17, 0x5b, 0xd0, 0x3b, 0xe2, 0x89, 0xa0, 0x89, 0xc8, 0xc5, 0x47, 0xe5, 0x8f, 0x9f, 0xc3, 0x9d,
-	0x70, 0xca, 0xc7, 0xe7, 0x7d, 0x34, 0x44, 0x07, 0xed, 0xa7, 0x03, 0xa7, 0x7e, 0x93, 0xe3, 0x15,
-	0x60, 0xc9, 0xf5, 0xb6, 0xaf, 0x7e, 0x59, 0x0d, 0xbf, 0xe4, 0xe3, 0x57, 0xd0, 0xa4, 0xb3, 0x78,
-	0x42, 0x93, 0x31, 0xed, 0x6f, 0x29, 0xad, 0xb9, 0xa9, 0x7d, 0x5b, 0xe1, 0x35, 0xf9, 0x8d, 0x0a,
-	0x1f, 0x41, 0x6b, 0x46, 0xa6, 0xf1, 0x84, 0x48, 0x9e, 0xf5, 0x0d, 0x65, 0x61, 0x6d, 0x5a, 0x7c,
-	0xd6, 0x84, 0x9a, 0xc7, 0x5a, 0x87, 0x5f, 0xc0, 0xee, 0x8c, 0x66, 0x22, 0xe6, 0x49, 0x7f, 0x5b,
-	0x59, 0x3c, 0xfc, 0xcf, 0xa2, 0x84, 0x6b, 0x06, 0x5a, 0x63, 0x53, 0x68, 0xdf, 0xda, 0x10, 0x0f,
-	0xa0, 0xc5, 0xc8, 0x3c, 0x08, 0x17, 0x92, 0x0a, 0x95, 0x88, 0xe1, 0x37, 0x19, 0x99, 0x7b, 0xc5,
-	0x19, 0xdf, 0x87, 0xdd, 0x02, 0x8c, 0x88, 0x50, 0x0b, 0x1b, 0xfe, 0x0e, 0x23, 0xf3, 0x63, 0x22,
-	0xf0, 0x10, 0xf6, 0x64, 0xcc, 0x68, 0x10, 0x73, 0x49, 0x02, 0x26, 0xd4, 0x2e, 0x86, 0x0f, 0xc5,
-	0xec, 0x3d, 0x97, 0x64, 0x24, 0xec, 0xef, 0x08, 0xba, 0xf5, 0x34, 0xf0, 0x63, 0xc0, 0x85, 0x1b,
-	0x89, 0x68, 0x90, 0xe4, 0x2c, 0x50, 0xa1, 0xea, 0x3b, 0x7b, 0x8c, 0xcc, 0x5f, 0x47, 0xf4, 0x43,
-	0xce, 0xd4, 0xcf, 0x09, 0x3c, 0x82, 0xbb, 0x9a, 0xac, 0x3b, 0xad, 0x42, 0x7f, 0xe0, 0x94, 0xa5,
-	0x3b, 0xba, 0x74, 0xe7, 0x4d, 0x45, 0xf0, 0x9a, 0xc5, 0xaa, 0x5f, 0x7f, 0x5b, 0xc8, 0xef, 0x96,
-	0x7e, 0x1a, 0xa9, 0xaf, 0x69, 0xd4, 0xd7, 0xb4, 0x5f, 0x42, 0x6f, 0x23, 0x75, 0x6c, 0x43, 0x27,
-	0xcd, 0xc3, 0xe0, 0x9c, 0x2e, 0x02, 0x95, 0x69, 0x1f, 0x0d, 0x8d, 0x83, 0x96, 0xdf, 0x4e, 0xf3,
-	0xf0, 0x84, 0x2e, 0x3e, 0x15, 0xa3, 0xc3, 0xe6, 0x8f, 0x4b, 0x0b, 0xfd, 0xbd, 0xb4, 0x90, 0x7d,
-	0x08, 0x9d, 0x5a, 0xe6, 0xd8, 0x82, 0x36, 0x49, 0xd3, 0x40, 0xf7, 0x54, 0xec, 0xb8, 0xed, 0x03,
-	0x49, 0xd3, 0x8a, 0x76, 0x4b, 0x7b, 0x0a, 0x7b, 0xef, 0x88, 0x38, 0xa3, 0x93, 0x4a, 0xfa, 0x08,
-	0x7a, 0x2a, 0x99, 0x60, 0xb3, 0x96, 0x8e, 0x1a, 0x8f, 0x74, 0x37, 0x36, 0x74, 0xd6, 0xbc, 0x75,
-	0x43, 0x6d, 0xcd, 0x3a, 0x26, 0xc2, 0x3b, 0xf9, 0xb6, 0x34, 0xd1, 0xd5, 0xd2, 0x44, 0xd7, 0x4b,
-	0x13, 0xfd, 0x59, 0x9a, 0xe8, 0x62, 0x65, 0x36, 0xae, 0x57, 0x66, 0xe3, 0xe7, 0xca, 0x6c, 0x9c,
-	0x3e, 0x89, 0x62, 0x79, 0x96, 0x87, 0xce, 0x98, 0x33, 0x77, 0x1a, 0x27, 0xd4, 0xbd, 0x79, 0x8f,
-	0xe5, 0x3b, 0xab, 0x3f, 0xcf, 0x70, 0x47, 0x4d, 0x9f, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x3c,
-	0x58, 0x77, 0x3c, 0xb7, 0x03, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xeb, 0xb4, 0x4d, 0x6f, 0x9a, 0xe6, 0xd3, 0xa8, 0xfa, 0x48, 0x53, 0x6a, 0x57, 0x5e,
+	0xa0, 0x4a, 0x08, 0x5b, 0x02, 0x24, 0xa4, 0x0a, 0x54, 0x9a, 0x12, 0xd1, 0xaa, 0x2a, 0x54, 0x16,
+	0x62, 0xc1, 0x66, 0x34, 0x4e, 0x06, 0xc7, 0x6a, 0xec, 0xb1, 0x3c, 0xe3, 0x28, 0x79, 0x0b, 0x96,
+	0x48, 0x6c, 0xba, 0x84, 0x37, 0xe0, 0x11, 0xba, 0x42, 0x5d, 0xb2, 0x2a, 0x28, 0xdd, 0xf0, 0x18,
+	0x68, 0xc6, 0x76, 0x53, 0x07, 0xd8, 0xc5, 0xf7, 0xfc, 0x64, 0xe6, 0xdc, 0xa3, 0x81, 0x4d, 0xc6,
+	0x45, 0x42, 0x7a, 0x2c, 0x72, 0xc4, 0x24, 0xa6, 0xdc, 0x89, 0x49, 0x42, 0x42, 0x6e, 0xc7, 0x09,
+	0x13, 0x0c, 0xad, 0x15, 0xa0, 0xad, 0xc0, 0xf6, 0xba, 0xcf, 0x7c, 0xa6, 0x20, 0x47, 0xfe, 0xca,
+	0x58, 0x6d, 0xc3, 0x67, 0xcc, 0x1f, 0x52, 0x47, 0x7d, 0x79, 0xe9, 0x7b, 0xa7, 0x9f, 0x26, 0x44,
+	0x04, 0x2c, 0xca, 0x70, 0xeb, 0xdb, 0x02, 0x34, 0x0f, 0x58, 0xc4, 0x69, 0xc4, 0x53, 0x7e, 0xaa,
+	0xfc, 0xd1, 0x13, 0x58, 0xf4, 0x86, 0xac, 0x77, 0xd6, 0xd2, 0xb6, 0xb5, 0x9d, 0xfa, 0xc3, 0x4d,
+	0xbb, 0xfc, 0x4f, 0x76, 0x47, 0x82, 0x19, 0xb7, 0x53, 0xbd, 0xb8, 0x32, 0x2b, 0x6e, 0xc6, 0x47,
+	0xcf, 0xa1, 0x46, 0x47, 0x41, 0x9f, 0x46, 0x3d, 0xda, 0x5a, 0x50, 0x5a, 0x63, 0x5e, 0xdb, 0xcd,
+	0xf1, 0x92, 0xfc, 0x46, 0x85, 0x0e, 0x60, 0x65, 0x44, 0x86, 0x41, 0x9f, 0x08, 0x96, 0xb4, 0x74,
+	0x65, 0x61, 0xce, 0x5b, 0xbc, 0x2d, 0x08, 0x25, 0x8f, 0x99, 0x0e, 0x3d, 0x83, 0xe5, 0x11, 0x4d,
+	0x78, 0xc0, 0xa2, 0x56, 0x55, 0x59, 0x6c, 0xfd, 0x61, 0x91, 0xc1, 0x25, 0x83, 0x42, 0x83, 0x9e,
+	0x42, 0x95, 0x78, 0xbd, 0xa0, 0xb5, 0xa8, 0xb4, 0xed, 0x79, 0xed, 0x7e, 0xe7, 0xe0, 0x28, 0x17,
+	0xae, 0x4a, 0xe1, 0xf4, 0xca, 0xac, 0xca, 0x99, 0xab, 0x54, 0xd6, 0x27, 0x0d, 0xea, 0xb7, 0x02,
+	0x42, 0x9b, 0xb0, 0x12, 0x92, 0x31, 0xf6, 0x26, 0x82, 0x72, 0x15, 0xa8, 0xee, 0xd6, 0x42, 0x32,
+	0xee, 0xc8, 0x6f, 0x74, 0x07, 0x96, 0x25, 0xe8, 0x13, 0xae, 0xf2, 0xd2, 0xdd, 0xa5, 0x90, 0x8c,
+	0x5f, 0x12, 0x8e, 0xb6, 0x61, 0x55, 0x04, 0x21, 0xc5, 0x01, 0x13, 0x04, 0x87, 0x5c, 0x45, 0xa1,
+	0xbb, 0x20, 0x67, 0x47, 0x4c, 0x90, 0x13, 0x8e, 0x1e, 0xc3, 0xff, 0x34, 0x21, 0x3c, 0x4d, 0x28,
+	0x8e, 0x49, 0x12, 0x88, 0x09, 0x8e, 0x69, 0xd2, 0xa3, 0x91, 0x50, 0x77, 0xd6, 0xdd, 0xf5, 0x1c,
+	0x3d, 0x55, 0xe0, 0x69, 0x86, 0x59, 0x5f, 0x34, 0x58, 0x2b, 0xaf, 0x00, 0xdd, 0x07, 0x24, 0xcf,
+	0x40, 0x7c, 0x8a, 0xa3, 0x34, 0xc4, 0x6a, 0x93, 0xc5, 0x49, 0x9b, 0x21, 0x19, 0xef, 0xfb, 0xf4,
+	0x55, 0x1a, 0xaa, 0x2b, 0x71, 0x74, 0x02, 0xff, 0x15, 0xe4, 0xa2, 0x48, 0xf9, 0xa6, 0x37, 0xec,
+	0xac, 0x69, 0x76, 0xd1, 0x34, 0xfb, 0x45, 0x4e, 0xe8, 0xd4, 0x64, 0x4c, 0x1f, 0x7f, 0x98, 0x9a,
+	0xbb, 0x96, 0xf9, 0x15, 0x48, 0x39, 0x1c, 0xbd, 0x1c, 0x8e, 0xb5, 0x07, 0xcd, 0xb9, 0x55, 0x23,
+	0x0b, 0x1a, 0x71, 0xea, 0xe1, 0x33, 0x3a, 0xc1, 0x6a, 0x19, 0x2d, 0x6d, 0x5b, 0xdf, 0x59, 0x71,
+	0xeb, 0x71, 0xea, 0x1d, 0xd3, 0xc9, 0x1b, 0x39, 0xda, 0xad, 0x7d, 0x3d, 0x37, 0xb5, 0x5f, 0xe7,
+	0xa6, 0x66, 0xed, 0x42, 0xa3, 0xb4, 0x68, 0x64, 0x42, 0x9d, 0xc4, 0x31, 0x2e, 0xca, 0x21, 0xef,
+	0x58, 0x75, 0x81, 0xc4, 0x71, 0x4e, 0xbb, 0xa5, 0x7d, 0x0d, 0x30, 0x5b, 0x34, 0xda, 0x87, 0xad,
+	0x11, 0x13, 0x14, 0xd3, 0xb1, 0xa0, 0x91, 0x64, 0x72, 0x4c, 0x23, 0xe2, 0x0d, 0x29, 0x1e, 0xd0,
+	0xc0, 0x1f, 0x88, 0x3c, 0xae, 0xb6, 0x24, 0x75, 0x6f, 0x38, 0x5d, 0x45, 0x39, 0x54, 0x0c, 0xd9,
+	0x8b, 0xd5, 0x43, 0xc2, 0x07, 0xb4, 0x9f, 0x7b, 0xde, 0x83, 0xa6, 0xca, 0x1a, 0xcf, 0xd7, 0xa3,
+	0xa1, 0xc6, 0x27, 0x45, 0x47, 0x2c, 0x68, 0xcc, 0x78, 0xb3, 0xa6, 0xd4, 0x0b, 0x96, 0xac, 0xcb,
+	0x1e, 0xdc, 0xcd, 0x38, 0xff, 0xa8, 0x44, 0x16, 0xed, 0x86, 0xe2, 0x74, 0xff, 0xd2, 0x8b, 0xce,
+	0xf1, 0xe7, 0xa9, 0xa1, 0x5d, 0x4c, 0x0d, 0xed, 0x72, 0x6a, 0x68, 0x3f, 0xa7, 0x86, 0xf6, 0xe1,
+	0xda, 0xa8, 0x5c, 0x5e, 0x1b, 0x95, 0xef, 0xd7, 0x46, 0xe5, 0xdd, 0x03, 0x3f, 0x10, 0x83, 0xd4,
+	0xb3, 0x7b, 0x2c, 0x74, 0x86, 0x41, 0x44, 0x9d, 0x9b, 0x77, 0x29, 0x7b, 0x6f, 0xca, 0xcf, 0x94,
+	0xb7, 0xa4, 0xa6, 0x8f, 0x7e, 0x0f, 0x00, 0x5d, 0x34, 0xf3, 0x10, 0xbf, 0x04, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -465,6 +554,9 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Version.Equal(&that1.Version) {
 		return false
 	}
+	if !this.ABCI.Equal(&that1.ABCI) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -495,6 +587,9 @@ func (this *BlockParams) Equal(that interface{}) bool {
 	if this.TimeIotaMs != that1.TimeIotaMs {
 		return false
 	}
+	if this.ErasureParityPercent != that1.ErasureParityPercent {
+		return false
+	}
 	return true
 }
 func (this *EvidenceParams) Equal(that interface{}) bool {
@@ -580,6 +675,30 @@ func (this *VersionParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ABCIParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ABCIParams)
+	if !ok {
+		that2, ok := that.(ABCIParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VoteExtensionsEnableHeight != that1.VoteExtensionsEnableHeight {
+		return false
+	}
+	return true
+}
 func (this *HashedParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -605,6 +724,9 @@ func (this *HashedParams) Equal(that interface{}) bool {
 	if this.BlockMaxGas != that1.BlockMaxGas {
 		return false
 	}
+	if this.BlockErasureParityPercent != that1.BlockErasureParityPercent {
+		return false
+	}
 	return true
 }
 func (m *ConsensusParams) Marshal() (dAtA []byte, err error) {
@@ -627,6 +749,16 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ABCI.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -690,6 +822,11 @@ func (m *BlockParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ErasureParityPercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ErasureParityPercent))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeIotaMs != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.TimeIotaMs))
 		i--
@@ -733,12 +870,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -809,6 +946,34 @@ func (m *VersionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ABCIParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ABCIParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ABCIParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteExtensionsEnableHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteExtensionsEnableHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HashedParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -829,6 +994,11 @@ func (m *HashedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockErasureParityPercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockErasureParityPercent))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.BlockMaxGas != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxGas))
 		i--
@@ -959,6 +1129,8 @@ func (m *ConsensusParams) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.Version.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.ABCI.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -977,6 +1149,9 @@ func (m *BlockParams) Size() (n int) {
 	if m.TimeIotaMs != 0 {
 		n += 1 + sovParams(uint64(m.TimeIotaMs))
 	}
+	if m.ErasureParityPercent != 0 {
+		n += 1 + sovParams(uint64(m.ErasureParityPercent))
+	}
 	return n
 }
 
@@ -1024,6 +1199,18 @@ func (m *VersionParams) Size() (n int) {
 	return n
 }
 
+func (m *ABCIParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteExtensionsEnableHeight != 0 {
+		n += 1 + sovParams(uint64(m.VoteExtensionsEnableHeight))
+	}
+	return n
+}
+
 func (m *HashedParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1036,6 +1223,9 @@ func (m *HashedParams) Size() (n int) {
 	if m.BlockMaxGas != 0 {
 		n += 1 + sovParams(uint64(m.BlockMaxGas))
 	}
+	if m.BlockErasureParityPercent != 0 {
+		n += 1 + sovParams(uint64(m.BlockErasureParityPercent))
+	}
 	return n
 }
 
@@ -1206,6 +1396,39 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ABCI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ABCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1313,6 +1536,25 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErasureParityPercent", wireType)
+			}
+			m.ErasureParityPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErasureParityPercent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1606,6 +1848,75 @@ func (m *VersionParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ABCIParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ABCIParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ABCIParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteExtensionsEnableHeight", wireType)
+			}
+			m.VoteExtensionsEnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteExtensionsEnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HashedParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1673,6 +1984,25 @@ func (m *HashedParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockErasureParityPercent", wireType)
+			}
+			m.BlockErasureParityPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockErasureParityPercent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -499,6 +499,12 @@ type Vote struct {
 	ValidatorAddress []byte        `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	ValidatorIndex   int32         `protobuf:"varint,7,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
 	Signature        []byte        `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Vote extension provided by the application. Only valid for precommits
+	// for a block.
+	Extension []byte `protobuf:"bytes,9,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Signature of the vote extension, which is signed apart from the vote.
+	// Only valid for precommits for a block.
+	ExtensionSignature []byte `protobuf:"bytes,10,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -590,6 +596,20 @@ func (m *Vote) GetSignature() []byte {
 	return nil
 }
 
+func (m *Vote) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *Vote) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
 // Commit contains the evidence that a block was committed by a set of validators.
 type Commit struct {
 	Height     int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -737,6 +757,170 @@ func (m *CommitSig) GetSignature() []byte {
 	return nil
 }
 
+// ExtendedCommit is a Commit with the vote extensions of its precommits.
+type ExtendedCommit struct {
+	Height             int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round              int32               `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	BlockID            BlockID             `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id"`
+	ExtendedSignatures []ExtendedCommitSig `protobuf:"bytes,4,rep,name=extended_signatures,json=extendedSignatures,proto3" json:"extended_signatures"`
+	// Additional field that LINE Blockchain uses for aggregated signature.
+	AggregatedSignature []byte `protobuf:"bytes,1000,opt,name=aggregated_signature,json=aggregatedSignature,proto3" json:"aggregated_signature,omitempty"`
+}
+
+func (m *ExtendedCommit) Reset()         { *m = ExtendedCommit{} }
+func (m *ExtendedCommit) String() string { return proto.CompactTextString(m) }
+func (*ExtendedCommit) ProtoMessage()    {}
+func (*ExtendedCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e52e849a4baef8c, []int{8}
+}
+func (m *ExtendedCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedCommit.Merge(m, src)
+}
+func (m *ExtendedCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedCommit proto.InternalMessageInfo
+
+func (m *ExtendedCommit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExtendedCommit) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ExtendedCommit) GetBlockID() BlockID {
+	if m != nil {
+		return m.BlockID
+	}
+	return BlockID{}
+}
+
+func (m *ExtendedCommit) GetExtendedSignatures() []ExtendedCommitSig {
+	if m != nil {
+		return m.ExtendedSignatures
+	}
+	return nil
+}
+
+func (m *ExtendedCommit) GetAggregatedSignature() []byte {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
+
+// ExtendedCommitSig is a CommitSig with the vote extension of the precommit.
+// The extension signatures are never aggregated.
+type ExtendedCommitSig struct {
+	BlockIdFlag        BlockIDFlag `protobuf:"varint,1,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=ostracon.types.BlockIDFlag" json:"block_id_flag,omitempty"`
+	ValidatorAddress   []byte      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Timestamp          time.Time   `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Signature          []byte      `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Extension          []byte      `protobuf:"bytes,5,opt,name=extension,proto3" json:"extension,omitempty"`
+	ExtensionSignature []byte      `protobuf:"bytes,6,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+}
+
+func (m *ExtendedCommitSig) Reset()         { *m = ExtendedCommitSig{} }
+func (m *ExtendedCommitSig) String() string { return proto.CompactTextString(m) }
+func (*ExtendedCommitSig) ProtoMessage()    {}
+func (*ExtendedCommitSig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e52e849a4baef8c, []int{9}
+}
+func (m *ExtendedCommitSig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedCommitSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedCommitSig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedCommitSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedCommitSig.Merge(m, src)
+}
+func (m *ExtendedCommitSig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedCommitSig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedCommitSig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedCommitSig proto.InternalMessageInfo
+
+func (m *ExtendedCommitSig) GetBlockIdFlag() BlockIDFlag {
+	if m != nil {
+		return m.BlockIdFlag
+	}
+	return BlockIDFlagUnknown
+}
+
+func (m *ExtendedCommitSig) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *ExtendedCommitSig) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ExtendedCommitSig) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *ExtendedCommitSig) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *ExtendedCommitSig) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
 type Proposal struct {
 	Type      SignedMsgType `protobuf:"varint,1,opt,name=type,proto3,enum=ostracon.types.SignedMsgType" json:"type,omitempty"`
 	Height    int64         `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -751,7 +935,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{8}
+	return fileDescriptor_0e52e849a4baef8c, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +1022,7 @@ func (m *SignedHeader) Reset()         { *m = SignedHeader{} }
 func (m *SignedHeader) String() string { return proto.CompactTextString(m) }
 func (*SignedHeader) ProtoMessage()    {}
 func (*SignedHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{9}
+	return fileDescriptor_0e52e849a4baef8c, []int{11}
 }
 func (m *SignedHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1075,7 @@ func (m *LightBlock) Reset()         { *m = LightBlock{} }
 func (m *LightBlock) String() string { return proto.CompactTextString(m) }
 func (*LightBlock) ProtoMessage()    {}
 func (*LightBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{10}
+	return fileDescriptor_0e52e849a4baef8c, []int{12}
 }
 func (m *LightBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -952,7 +1136,7 @@ func (m *BlockMeta) Reset()         { *m = BlockMeta{} }
 func (m *BlockMeta) String() string { return proto.CompactTextString(m) }
 func (*BlockMeta) ProtoMessage()    {}
 func (*BlockMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{11}
+	return fileDescriptor_0e52e849a4baef8c, []int{13}
 }
 func (m *BlockMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1020,7 +1204,7 @@ func (m *TxProof) Reset()         { *m = TxProof{} }
 func (m *TxProof) String() string { return proto.CompactTextString(m) }
 func (*TxProof) ProtoMessage()    {}
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e52e849a4baef8c, []int{12}
+	return fileDescriptor_0e52e849a4baef8c, []int{14}
 }
 func (m *TxProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,6 +1265,8 @@ func init() {
 	proto.RegisterType((*Vote)(nil), "ostracon.types.Vote")
 	proto.RegisterType((*Commit)(nil), "ostracon.types.Commit")
 	proto.RegisterType((*CommitSig)(nil), "ostracon.types.CommitSig")
+	proto.RegisterType((*ExtendedCommit)(nil), "ostracon.types.ExtendedCommit")
+	proto.RegisterType((*ExtendedCommitSig)(nil), "ostracon.types.ExtendedCommitSig")
 	proto.RegisterType((*Proposal)(nil), "ostracon.types.Proposal")
 	proto.RegisterType((*SignedHeader)(nil), "ostracon.types.SignedHeader")
 	proto.RegisterType((*LightBlock)(nil), "ostracon.types.LightBlock")
@@ -1091,95 +1277,100 @@ func init() {
 func init() { proto.RegisterFile("ostracon/types/types.proto", fileDescriptor_0e52e849a4baef8c) }
 
 var fileDescriptor_0e52e849a4baef8c = []byte{
-	// 1397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0x1a, 0xd7,
-	0x17, 0xf6, 0x98, 0xc1, 0xc0, 0x01, 0x6c, 0x7c, 0x7f, 0x8e, 0x43, 0x70, 0x82, 0x11, 0x3f, 0xb5,
-	0x75, 0xd2, 0x14, 0x12, 0x5a, 0x55, 0xad, 0xba, 0x88, 0xc0, 0x76, 0x1c, 0x14, 0x1b, 0xa3, 0x81,
-	0xa4, 0x6a, 0x37, 0xa3, 0x01, 0xae, 0x87, 0x69, 0x60, 0x66, 0x34, 0xf7, 0xe2, 0xda, 0x79, 0x82,
-	0xca, 0xab, 0xbc, 0x80, 0x57, 0xed, 0xa2, 0xbb, 0x4a, 0x7d, 0x80, 0xae, 0xa3, 0xae, 0xb2, 0x6b,
-	0xa5, 0x4a, 0x69, 0xe5, 0x74, 0x91, 0x76, 0xd5, 0x47, 0xa8, 0xee, 0x9f, 0x19, 0x06, 0xb0, 0xa3,
-	0x28, 0x4a, 0x37, 0x68, 0xee, 0x39, 0xdf, 0xb9, 0xf7, 0x9c, 0xef, 0x7c, 0xf7, 0x0f, 0x90, 0x73,
-	0x08, 0xf5, 0x8c, 0xae, 0x63, 0x97, 0xe9, 0xb1, 0x8b, 0x89, 0xf8, 0x2d, 0xb9, 0x9e, 0x43, 0x1d,
-	0xb4, 0xe8, 0xfb, 0x4a, 0xdc, 0x9a, 0x5b, 0x31, 0x1d, 0xd3, 0xe1, 0xae, 0x32, 0xfb, 0x12, 0xa8,
-	0xdc, 0xba, 0xe9, 0x38, 0xe6, 0x00, 0x97, 0xf9, 0xa8, 0x33, 0x3a, 0x28, 0x53, 0x6b, 0x88, 0x09,
-	0x35, 0x86, 0xae, 0x04, 0xac, 0x05, 0x4b, 0x74, 0xbd, 0x63, 0x97, 0x3a, 0x0c, 0xe9, 0x1c, 0x48,
-	0xe7, 0xd5, 0xc0, 0x79, 0x88, 0x3d, 0x62, 0xf9, 0x79, 0x48, 0x6f, 0x7e, 0x2a, 0xbb, 0x43, 0x63,
-	0x60, 0xf5, 0x0c, 0xea, 0x78, 0xd2, 0x3f, 0x9d, 0xfd, 0xa1, 0x43, 0xb1, 0xf4, 0x15, 0x3f, 0x85,
-	0x74, 0xd3, 0xf0, 0x68, 0x0b, 0xd3, 0x7b, 0xd8, 0xe8, 0x61, 0x0f, 0xad, 0x40, 0x94, 0x3a, 0xd4,
-	0x18, 0x64, 0x95, 0x82, 0xb2, 0x91, 0xd6, 0xc4, 0x00, 0x21, 0x50, 0xfb, 0x06, 0xe9, 0x67, 0xe7,
-	0x0b, 0xca, 0x46, 0x4a, 0xe3, 0xdf, 0xc5, 0x03, 0x50, 0x59, 0x28, 0x8b, 0xb0, 0xec, 0x1e, 0x3e,
-	0xf2, 0x23, 0xf8, 0x80, 0x59, 0x3b, 0xc7, 0x14, 0x13, 0x19, 0x22, 0x06, 0xa8, 0x02, 0x51, 0x5e,
-	0x57, 0x36, 0x52, 0x50, 0x36, 0x92, 0x95, 0xd5, 0x52, 0x40, 0x9e, 0xa8, 0xba, 0xd4, 0x64, 0xde,
-	0x9a, 0xfa, 0xf4, 0xf9, 0xfa, 0x9c, 0x26, 0xa0, 0xc5, 0xaf, 0x20, 0x56, 0x1b, 0x38, 0xdd, 0x47,
-	0xf5, 0xad, 0x20, 0x0d, 0x65, 0x9c, 0x06, 0xba, 0x0f, 0x4b, 0xae, 0xe1, 0x51, 0x9d, 0x60, 0xaa,
-	0xf7, 0x79, 0x0d, 0x7c, 0xc9, 0x64, 0xe5, 0x5a, 0x69, 0xb2, 0x33, 0xa5, 0x89, 0x42, 0xe5, 0x1a,
-	0x69, 0x37, 0x6c, 0x2c, 0xfe, 0x18, 0x85, 0x05, 0x49, 0xc4, 0x67, 0x10, 0x93, 0x64, 0xf3, 0xe5,
-	0x92, 0x95, 0xb5, 0xf1, 0x7c, 0xd2, 0x51, 0xda, 0x74, 0x6c, 0x82, 0x6d, 0x32, 0x22, 0x72, 0x36,
-	0x3f, 0x02, 0xbd, 0x0b, 0xf1, 0x6e, 0xdf, 0xb0, 0x6c, 0xdd, 0xea, 0xf1, 0x6c, 0x12, 0xb5, 0xe4,
-	0xd9, 0xf3, 0xf5, 0xd8, 0x26, 0xb3, 0xd5, 0xb7, 0xb4, 0x18, 0x77, 0xd6, 0x7b, 0x68, 0x15, 0x16,
-	0xfa, 0xd8, 0x32, 0xfb, 0x94, 0x13, 0x12, 0xd1, 0xe4, 0x08, 0x7d, 0x02, 0x2a, 0x13, 0x48, 0x56,
-	0xe5, 0x2b, 0xe7, 0x4a, 0x42, 0x3d, 0x25, 0x5f, 0x3d, 0xa5, 0xb6, 0xaf, 0x9e, 0x5a, 0x9c, 0x2d,
-	0xfc, 0xe4, 0xf7, 0x75, 0x45, 0xe3, 0x11, 0xa8, 0x0a, 0xe9, 0x81, 0x41, 0xa8, 0xde, 0x61, 0x94,
-	0xb1, 0xe5, 0xa3, 0x7c, 0x8a, 0xcb, 0xd3, 0x64, 0x48, 0x4a, 0x65, 0xe2, 0x49, 0x16, 0x23, 0x4c,
-	0x3d, 0xb4, 0x01, 0x19, 0x3e, 0x45, 0xd7, 0x19, 0x0e, 0x2d, 0xaa, 0x73, 0xc6, 0x17, 0x38, 0xe3,
-	0x8b, 0xcc, 0xbe, 0xc9, 0xcd, 0xf7, 0x18, 0xf7, 0x6b, 0x90, 0xe8, 0x19, 0xd4, 0x10, 0x90, 0x18,
-	0x87, 0xc4, 0x99, 0x81, 0x3b, 0xdf, 0x83, 0xa5, 0x40, 0x89, 0x44, 0x40, 0xe2, 0x62, 0x96, 0xb1,
-	0x99, 0x03, 0x6f, 0xc1, 0x8a, 0x8d, 0x8f, 0xa8, 0x3e, 0x8d, 0x4e, 0x70, 0x34, 0x62, 0xbe, 0x87,
-	0x93, 0x11, 0xef, 0xc0, 0x62, 0xd7, 0xa7, 0x5e, 0x60, 0x81, 0x63, 0xd3, 0x81, 0x95, 0xc3, 0xae,
-	0x40, 0xdc, 0x70, 0x5d, 0x01, 0x48, 0x72, 0x40, 0xcc, 0x70, 0x5d, 0xee, 0xba, 0x01, 0xcb, 0xbc,
-	0x46, 0x0f, 0x93, 0xd1, 0x80, 0xca, 0x49, 0x52, 0x1c, 0xb3, 0xc4, 0x1c, 0x9a, 0xb0, 0x73, 0xec,
-	0xff, 0x21, 0x8d, 0x0f, 0xad, 0x1e, 0xb6, 0xbb, 0x58, 0xe0, 0xd2, 0x1c, 0x97, 0xf2, 0x8d, 0x1c,
-	0x74, 0x1d, 0x32, 0xae, 0xe7, 0xb8, 0x0e, 0xc1, 0x9e, 0x6e, 0xf4, 0x7a, 0x1e, 0x26, 0x24, 0xbb,
-	0x28, 0xe6, 0xf3, 0xed, 0x55, 0x61, 0x46, 0x97, 0x20, 0xea, 0x39, 0x23, 0xbb, 0x97, 0x7d, 0xc9,
-	0x28, 0x8b, 0x6a, 0x62, 0xc4, 0xcc, 0x62, 0x6f, 0xfc, 0x25, 0x98, 0x14, 0x23, 0x54, 0x80, 0x24,
-	0xdf, 0xb0, 0x32, 0xc7, 0xbf, 0x85, 0x13, 0x84, 0x8d, 0x2d, 0x5d, 0xcc, 0x82, 0xba, 0x65, 0x50,
-	0x03, 0x65, 0x20, 0x42, 0x8f, 0x48, 0x56, 0x29, 0x44, 0x36, 0x52, 0x1a, 0xfb, 0x2c, 0xfe, 0x39,
-	0x0f, 0xea, 0x43, 0x87, 0x62, 0x74, 0x1b, 0x54, 0xd6, 0x76, 0xae, 0xe4, 0xc5, 0xd9, 0x9d, 0xd1,
-	0xb2, 0x4c, 0x1b, 0xf7, 0xf6, 0x88, 0xd9, 0x3e, 0x76, 0xb1, 0xc6, 0xa1, 0x21, 0x69, 0xce, 0x4f,
-	0x48, 0x73, 0xc5, 0xcf, 0x3e, 0x12, 0x4e, 0x7e, 0x13, 0xe2, 0x81, 0xe2, 0xd4, 0x57, 0x2b, 0x6e,
-	0x89, 0x29, 0x8e, 0xed, 0x06, 0x69, 0xd0, 0x62, 0x1d, 0x29, 0xbc, 0x1a, 0x24, 0x82, 0x63, 0x51,
-	0xea, 0xf6, 0xf5, 0xa4, 0x3f, 0x0e, 0x43, 0xef, 0xc3, 0x72, 0xa0, 0xa3, 0xa0, 0x11, 0x42, 0xbd,
-	0x99, 0xc0, 0xe1, 0x77, 0x22, 0x2c, 0x51, 0x5d, 0x1c, 0x62, 0xa2, 0x25, 0x63, 0x89, 0xd6, 0xf9,
-	0x69, 0x76, 0x15, 0x12, 0xc4, 0x32, 0x6d, 0x83, 0x8e, 0x3c, 0x2c, 0x55, 0x3c, 0x36, 0x14, 0xff,
-	0x51, 0x60, 0x41, 0xec, 0x8a, 0x10, 0x6b, 0xca, 0xf9, 0xac, 0xcd, 0x5f, 0xc4, 0x5a, 0xe4, 0x4d,
-	0x59, 0xbb, 0x03, 0x10, 0xa4, 0x42, 0xb2, 0x6a, 0x21, 0xb2, 0x91, 0xac, 0x5c, 0x99, 0x9e, 0x46,
-	0xa4, 0xd7, 0xb2, 0x4c, 0xb9, 0xe1, 0x43, 0x21, 0xa8, 0x02, 0x2b, 0x86, 0x69, 0x7a, 0xd8, 0x34,
-	0x28, 0xee, 0xe9, 0xe3, 0x3a, 0x5f, 0x0a, 0xa9, 0xfd, 0x6f, 0xec, 0x6c, 0x05, 0x25, 0xff, 0xa6,
-	0x40, 0x22, 0x98, 0x13, 0xdd, 0x81, 0xb4, 0x5f, 0x87, 0x7e, 0x30, 0x30, 0x4c, 0xa9, 0xb3, 0xb5,
-	0x0b, 0x8a, 0xb9, 0x3b, 0x30, 0x4c, 0x2d, 0x29, 0xf3, 0x67, 0x83, 0xf3, 0xbb, 0x36, 0x7f, 0x41,
-	0xd7, 0x26, 0x64, 0x12, 0x79, 0x33, 0x99, 0x4c, 0x34, 0x54, 0x9d, 0x6e, 0xe8, 0x0f, 0xf3, 0x10,
-	0x6f, 0xf2, 0x5d, 0x6b, 0x0c, 0xfe, 0xfb, 0xbd, 0xb3, 0x06, 0x09, 0xd7, 0x19, 0xe8, 0xc2, 0xa3,
-	0x72, 0x4f, 0xdc, 0x75, 0x06, 0xda, 0x8c, 0x44, 0xa2, 0x6f, 0x65, 0x63, 0x2d, 0xbc, 0x05, 0xc6,
-	0x62, 0xd3, 0x8c, 0xd9, 0x90, 0x12, 0x44, 0xc8, 0xdb, 0xb3, 0xc4, 0x18, 0xe0, 0x97, 0xb1, 0x32,
-	0x7d, 0xd3, 0x8b, 0xa4, 0x05, 0x4e, 0x93, 0x28, 0x86, 0x17, 0xd7, 0x8d, 0xbc, 0xbc, 0x57, 0xcf,
-	0x17, 0xb0, 0x26, 0x51, 0xc5, 0x9f, 0x15, 0x80, 0x5d, 0xc6, 0x29, 0xaf, 0x95, 0xdd, 0x7a, 0x84,
-	0x2f, 0xaf, 0x4f, 0xac, 0x7a, 0xf5, 0xfc, 0x66, 0xc9, 0xb5, 0x53, 0x24, 0x9c, 0x71, 0x15, 0xd2,
-	0x63, 0x09, 0x12, 0xec, 0x27, 0x32, 0x33, 0x45, 0x70, 0x15, 0xb5, 0x30, 0xd5, 0x52, 0x87, 0xa1,
-	0x11, 0xfa, 0x18, 0x12, 0xfc, 0x58, 0xe6, 0xe1, 0x7c, 0xf7, 0x24, 0x2b, 0xd9, 0x99, 0x78, 0x86,
-	0x60, 0xb1, 0xf1, 0x43, 0xf9, 0x55, 0xfc, 0x49, 0x81, 0x04, 0xaf, 0x63, 0x0f, 0x53, 0x63, 0xa2,
-	0xe3, 0xca, 0x9b, 0x76, 0xfc, 0x1a, 0x80, 0x98, 0x84, 0x58, 0x8f, 0xb1, 0x54, 0x61, 0x82, 0x5b,
-	0x5a, 0xd6, 0x63, 0x8c, 0x3e, 0x0a, 0xda, 0x13, 0x79, 0x55, 0x7b, 0xe4, 0x61, 0xe1, 0x37, 0xe9,
-	0x32, 0xc4, 0xec, 0xd1, 0x50, 0x67, 0x97, 0x8c, 0x2a, 0x74, 0x6d, 0x8f, 0x86, 0xed, 0x23, 0x52,
-	0xec, 0x43, 0xac, 0x7d, 0xc4, 0x9f, 0x6e, 0x4c, 0xcc, 0x9e, 0xe3, 0xc8, 0x57, 0x83, 0x78, 0xa7,
-	0xc5, 0x99, 0x81, 0x5f, 0x92, 0x08, 0x54, 0xf6, 0x3c, 0xf0, 0x9f, 0x91, 0xec, 0x1b, 0xdd, 0x7c,
-	0xad, 0x27, 0xa1, 0xbc, 0x0d, 0x6f, 0xfc, 0xa2, 0x40, 0x32, 0x74, 0x8a, 0xa0, 0xdb, 0x70, 0xa9,
-	0xb6, 0xbb, 0xbf, 0x79, 0x5f, 0xaf, 0x6f, 0xe9, 0x77, 0x77, 0xab, 0x3b, 0xfa, 0x83, 0xc6, 0xfd,
-	0xc6, 0xfe, 0xe7, 0x8d, 0xcc, 0x5c, 0x6e, 0xf5, 0xe4, 0xb4, 0x80, 0x42, 0xd8, 0x07, 0xf6, 0x23,
-	0xdb, 0xf9, 0xda, 0x46, 0x65, 0x58, 0x99, 0x0c, 0xa9, 0xd6, 0x5a, 0xdb, 0x8d, 0x76, 0x46, 0xc9,
-	0x5d, 0x3a, 0x39, 0x2d, 0x2c, 0x87, 0x22, 0xaa, 0x1d, 0x82, 0x6d, 0x3a, 0x1b, 0xb0, 0xb9, 0xbf,
-	0xb7, 0x57, 0x6f, 0x67, 0xe6, 0x67, 0x02, 0xe4, 0x25, 0x70, 0x1d, 0x96, 0x27, 0x03, 0x1a, 0xf5,
-	0xdd, 0x4c, 0x24, 0x87, 0x4e, 0x4e, 0x0b, 0x8b, 0x21, 0x74, 0xc3, 0x1a, 0xe4, 0xe2, 0xdf, 0x7c,
-	0x9b, 0x9f, 0xfb, 0xfe, 0xbb, 0xbc, 0xc2, 0x2a, 0x4b, 0x4f, 0x9c, 0x25, 0xe8, 0x26, 0x5c, 0x6e,
-	0xd5, 0x77, 0x1a, 0xdb, 0x5b, 0xfa, 0x5e, 0x6b, 0x47, 0x6f, 0x7f, 0xd1, 0xdc, 0x0e, 0x55, 0xb7,
-	0x74, 0x72, 0x5a, 0x48, 0xca, 0x92, 0x2e, 0x42, 0x37, 0xb5, 0xed, 0x87, 0xfb, 0xed, 0xed, 0x8c,
-	0x22, 0xd0, 0x4d, 0x0f, 0x33, 0xc9, 0x71, 0xf4, 0x2d, 0xb8, 0x72, 0x0e, 0x3a, 0x28, 0x6c, 0xf9,
-	0xe4, 0xb4, 0x90, 0x6e, 0x7a, 0x58, 0xec, 0x36, 0x1e, 0x51, 0x82, 0xec, 0x6c, 0xc4, 0x7e, 0x73,
-	0xbf, 0x55, 0xdd, 0xcd, 0x14, 0x72, 0x99, 0x93, 0xd3, 0x42, 0xca, 0x3f, 0x32, 0x19, 0x7e, 0x5c,
-	0x59, 0x6d, 0xe7, 0xe9, 0x59, 0x5e, 0x79, 0x76, 0x96, 0x57, 0xfe, 0x38, 0xcb, 0x2b, 0x4f, 0x5e,
-	0xe4, 0xe7, 0x9e, 0xbd, 0xc8, 0xcf, 0xfd, 0xfa, 0x22, 0x3f, 0xf7, 0xe5, 0x07, 0xa6, 0x45, 0xfb,
-	0xa3, 0x4e, 0xa9, 0xeb, 0x0c, 0xcb, 0x03, 0xcb, 0xc6, 0xe5, 0xe0, 0x9f, 0x8a, 0xf8, 0x03, 0x35,
-	0xf9, 0xc7, 0xa5, 0xb3, 0xc0, 0xad, 0x1f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x02, 0x91, 0x1b,
-	0x2b, 0x8f, 0x0d, 0x00, 0x00,
+	// 1475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xce, 0xd8, 0xe3, 0xd7, 0xb1, 0x9d, 0x38, 0xb7, 0x69, 0x3b, 0x75, 0x5a, 0xc7, 0x18, 0x01,
+	0x69, 0x29, 0x4e, 0x1b, 0x10, 0x02, 0xb1, 0xa8, 0xe2, 0x24, 0x4d, 0xad, 0xe6, 0x61, 0x8d, 0xdd,
+	0xf2, 0xd8, 0x8c, 0x26, 0x9e, 0x9b, 0xf1, 0x50, 0x67, 0x66, 0x34, 0x73, 0x1d, 0x92, 0xfe, 0x02,
+	0x94, 0x55, 0xf9, 0x01, 0x59, 0xc1, 0x82, 0x1d, 0x52, 0xd9, 0xb3, 0xae, 0x58, 0x75, 0x07, 0x12,
+	0x52, 0x41, 0xe9, 0xa6, 0xb0, 0xe2, 0x27, 0xa0, 0xfb, 0x98, 0xf1, 0x8c, 0x9d, 0x54, 0xa5, 0x14,
+	0x21, 0xb1, 0xb1, 0xe6, 0x9e, 0xf3, 0x9d, 0x73, 0xcf, 0xe3, 0xbb, 0xc7, 0xf7, 0x42, 0xd9, 0xf1,
+	0x89, 0xa7, 0x77, 0x1d, 0x7b, 0x81, 0x1c, 0xb8, 0xd8, 0xe7, 0xbf, 0x75, 0xd7, 0x73, 0x88, 0x83,
+	0x26, 0x03, 0x5d, 0x9d, 0x49, 0xcb, 0x33, 0xa6, 0x63, 0x3a, 0x4c, 0xb5, 0x40, 0xbf, 0x38, 0xaa,
+	0x3c, 0x67, 0x3a, 0x8e, 0xd9, 0xc7, 0x0b, 0x6c, 0xb5, 0x3d, 0xd8, 0x59, 0x20, 0xd6, 0x2e, 0xf6,
+	0x89, 0xbe, 0xeb, 0x0a, 0xc0, 0x6c, 0xb8, 0x45, 0xd7, 0x3b, 0x70, 0x89, 0x43, 0x91, 0xce, 0x8e,
+	0x50, 0x5e, 0x0c, 0x95, 0x7b, 0xd8, 0xf3, 0xad, 0x20, 0x0e, 0xa1, 0xad, 0x8c, 0x44, 0xb7, 0xa7,
+	0xf7, 0x2d, 0x43, 0x27, 0x8e, 0x27, 0xf4, 0xa3, 0xd1, 0xef, 0x39, 0x04, 0x0b, 0x5d, 0xed, 0x43,
+	0x28, 0xb6, 0x74, 0x8f, 0xb4, 0x31, 0xb9, 0x85, 0x75, 0x03, 0x7b, 0x68, 0x06, 0x52, 0xc4, 0x21,
+	0x7a, 0x5f, 0x91, 0xaa, 0xd2, 0x7c, 0x51, 0xe5, 0x0b, 0x84, 0x40, 0xee, 0xe9, 0x7e, 0x4f, 0x49,
+	0x54, 0xa5, 0xf9, 0x82, 0xca, 0xbe, 0x6b, 0x3b, 0x20, 0x53, 0x53, 0x6a, 0x61, 0xd9, 0x06, 0xde,
+	0x0f, 0x2c, 0xd8, 0x82, 0x4a, 0xb7, 0x0f, 0x08, 0xf6, 0x85, 0x09, 0x5f, 0xa0, 0x45, 0x48, 0xb1,
+	0xbc, 0x94, 0x64, 0x55, 0x9a, 0xcf, 0x2f, 0x9e, 0xab, 0x87, 0xc5, 0xe3, 0x59, 0xd7, 0x5b, 0x54,
+	0xdb, 0x90, 0x1f, 0x3d, 0x99, 0x9b, 0x50, 0x39, 0xb4, 0xf6, 0x39, 0x64, 0x1a, 0x7d, 0xa7, 0x7b,
+	0xaf, 0xb9, 0x12, 0x86, 0x21, 0x0d, 0xc3, 0x40, 0xb7, 0x61, 0xca, 0xd5, 0x3d, 0xa2, 0xf9, 0x98,
+	0x68, 0x3d, 0x96, 0x03, 0xdb, 0x32, 0xbf, 0x78, 0xa9, 0x1e, 0xef, 0x4c, 0x3d, 0x96, 0xa8, 0xd8,
+	0xa3, 0xe8, 0x46, 0x85, 0xb5, 0x87, 0x29, 0x48, 0x8b, 0x42, 0x7c, 0x04, 0x19, 0x51, 0x6c, 0xb6,
+	0x5d, 0x7e, 0x71, 0x76, 0xe8, 0x4f, 0x28, 0xea, 0xcb, 0x8e, 0xed, 0x63, 0xdb, 0x1f, 0xf8, 0xc2,
+	0x5b, 0x60, 0x81, 0xde, 0x84, 0x6c, 0xb7, 0xa7, 0x5b, 0xb6, 0x66, 0x19, 0x2c, 0x9a, 0x5c, 0x23,
+	0x7f, 0xfc, 0x64, 0x2e, 0xb3, 0x4c, 0x65, 0xcd, 0x15, 0x35, 0xc3, 0x94, 0x4d, 0x03, 0x9d, 0x83,
+	0x74, 0x0f, 0x5b, 0x66, 0x8f, 0xb0, 0x82, 0x24, 0x55, 0xb1, 0x42, 0x1f, 0x80, 0x4c, 0x09, 0xa2,
+	0xc8, 0x6c, 0xe7, 0x72, 0x9d, 0xb3, 0xa7, 0x1e, 0xb0, 0xa7, 0xde, 0x09, 0xd8, 0xd3, 0xc8, 0xd2,
+	0x8d, 0x1f, 0xfc, 0x3a, 0x27, 0xa9, 0xcc, 0x02, 0x2d, 0x41, 0xb1, 0xaf, 0xfb, 0x44, 0xdb, 0xa6,
+	0x25, 0xa3, 0xdb, 0xa7, 0x98, 0x8b, 0xf3, 0xa3, 0xc5, 0x10, 0x25, 0x15, 0x81, 0xe7, 0xa9, 0x0d,
+	0x17, 0x19, 0x68, 0x1e, 0x4a, 0xcc, 0x45, 0xd7, 0xd9, 0xdd, 0xb5, 0x88, 0xc6, 0x2a, 0x9e, 0x66,
+	0x15, 0x9f, 0xa4, 0xf2, 0x65, 0x26, 0xbe, 0x45, 0x6b, 0x3f, 0x0b, 0x39, 0x43, 0x27, 0x3a, 0x87,
+	0x64, 0x18, 0x24, 0x4b, 0x05, 0x4c, 0xf9, 0x16, 0x4c, 0x85, 0x4c, 0xf4, 0x39, 0x24, 0xcb, 0xbd,
+	0x0c, 0xc5, 0x0c, 0x78, 0x0d, 0x66, 0x6c, 0xbc, 0x4f, 0xb4, 0x51, 0x74, 0x8e, 0xa1, 0x11, 0xd5,
+	0xdd, 0x8d, 0x5b, 0xbc, 0x01, 0x93, 0xdd, 0xa0, 0xf4, 0x1c, 0x0b, 0x0c, 0x5b, 0x0c, 0xa5, 0x0c,
+	0x76, 0x01, 0xb2, 0xba, 0xeb, 0x72, 0x40, 0x9e, 0x01, 0x32, 0xba, 0xeb, 0x32, 0xd5, 0x15, 0x98,
+	0x66, 0x39, 0x7a, 0xd8, 0x1f, 0xf4, 0x89, 0x70, 0x52, 0x60, 0x98, 0x29, 0xaa, 0x50, 0xb9, 0x9c,
+	0x61, 0x5f, 0x87, 0x22, 0xde, 0xb3, 0x0c, 0x6c, 0x77, 0x31, 0xc7, 0x15, 0x19, 0xae, 0x10, 0x08,
+	0x19, 0xe8, 0x32, 0x94, 0x5c, 0xcf, 0x71, 0x1d, 0x1f, 0x7b, 0x9a, 0x6e, 0x18, 0x1e, 0xf6, 0x7d,
+	0x65, 0x92, 0xfb, 0x0b, 0xe4, 0x4b, 0x5c, 0x8c, 0xce, 0x42, 0xca, 0x73, 0x06, 0xb6, 0xa1, 0x3c,
+	0xa3, 0x25, 0x4b, 0xa9, 0x7c, 0x45, 0xc5, 0xfc, 0x6c, 0xfc, 0xce, 0x2b, 0xc9, 0x57, 0xa8, 0x0a,
+	0x79, 0x76, 0x60, 0x45, 0x8c, 0x7f, 0x70, 0x25, 0x70, 0x19, 0xdd, 0xba, 0xa6, 0x80, 0xbc, 0xa2,
+	0x13, 0x1d, 0x95, 0x20, 0x49, 0xf6, 0x7d, 0x45, 0xaa, 0x26, 0xe7, 0x0b, 0x2a, 0xfd, 0xac, 0x7d,
+	0x9f, 0x04, 0xf9, 0xae, 0x43, 0x30, 0xba, 0x0e, 0x32, 0x6d, 0x3b, 0x63, 0xf2, 0xe4, 0xf8, 0xc9,
+	0x68, 0x5b, 0xa6, 0x8d, 0x8d, 0x0d, 0xdf, 0xec, 0x1c, 0xb8, 0x58, 0x65, 0xd0, 0x08, 0x35, 0x13,
+	0x31, 0x6a, 0xce, 0x04, 0xd1, 0x27, 0xa3, 0xc1, 0x2f, 0x43, 0x36, 0x64, 0x9c, 0xfc, 0x7c, 0xc6,
+	0x4d, 0x51, 0xc6, 0xd1, 0xd3, 0x20, 0x04, 0x6a, 0x66, 0x5b, 0x10, 0xaf, 0x01, 0xb9, 0x70, 0x2c,
+	0x2a, 0xa9, 0xbf, 0x41, 0xfd, 0xa1, 0x19, 0x7a, 0x1b, 0xa6, 0x43, 0x1e, 0x85, 0x8d, 0xe0, 0xec,
+	0x2d, 0x85, 0x8a, 0xa0, 0x13, 0x51, 0x8a, 0x6a, 0x7c, 0x88, 0xf1, 0x96, 0x0c, 0x29, 0xda, 0xa4,
+	0x52, 0x74, 0x11, 0x72, 0xbe, 0x65, 0xda, 0x3a, 0x19, 0x78, 0x58, 0xb0, 0x78, 0x28, 0xa0, 0x5a,
+	0xbc, 0x4f, 0xb0, 0xcd, 0x86, 0x05, 0x67, 0xed, 0x50, 0x80, 0x16, 0xe0, 0x4c, 0xb8, 0xd0, 0x86,
+	0x5e, 0x38, 0x63, 0x51, 0xa8, 0x6a, 0x07, 0x9a, 0xda, 0x9f, 0x12, 0xa4, 0xf9, 0x21, 0x8b, 0x34,
+	0x41, 0x3a, 0xb9, 0x09, 0x89, 0xd3, 0x9a, 0x90, 0x7c, 0xd9, 0x26, 0xdc, 0x00, 0x08, 0x83, 0xf4,
+	0x15, 0xb9, 0x9a, 0x9c, 0xcf, 0x2f, 0x5e, 0x18, 0x75, 0xc3, 0xc3, 0x6b, 0x5b, 0xa6, 0x98, 0x1f,
+	0x11, 0x13, 0xb4, 0x08, 0x33, 0xba, 0x69, 0x7a, 0xd8, 0xd4, 0x09, 0x36, 0x22, 0x09, 0x3f, 0xe3,
+	0xcc, 0x3d, 0x33, 0x54, 0x0e, 0x53, 0xfe, 0x45, 0x82, 0x5c, 0xe8, 0x13, 0xdd, 0x80, 0x62, 0x90,
+	0x87, 0xb6, 0xd3, 0xd7, 0x4d, 0x41, 0xdb, 0xd9, 0x53, 0x92, 0xb9, 0xd9, 0xd7, 0x4d, 0x35, 0x2f,
+	0xe2, 0xa7, 0x8b, 0x93, 0x49, 0x90, 0x38, 0x85, 0x04, 0x31, 0xd6, 0x25, 0x5f, 0x8e, 0x75, 0x31,
+	0x7e, 0xc8, 0x23, 0xfc, 0xa8, 0x7d, 0x95, 0x80, 0xc9, 0x55, 0xda, 0x67, 0x03, 0x1b, 0xff, 0x5d,
+	0x63, 0x3f, 0x11, 0x3c, 0x34, 0xa2, 0x5d, 0x09, 0x3a, 0xfc, 0xda, 0xa8, 0xbf, 0x78, 0xbc, 0xc3,
+	0x4e, 0xa3, 0xc0, 0x47, 0xfb, 0x9f, 0x75, 0xfc, 0x61, 0x02, 0xa6, 0xc7, 0xf6, 0xf8, 0xbf, 0x75,
+	0x3e, 0x3e, 0x19, 0x52, 0x2f, 0x38, 0x19, 0xd2, 0xa7, 0x4e, 0x86, 0xef, 0x12, 0x90, 0x6d, 0xb1,
+	0x7f, 0x13, 0xbd, 0xff, 0xef, 0xcf, 0xf4, 0x59, 0xc8, 0xb9, 0x4e, 0x5f, 0xe3, 0x1a, 0x99, 0x69,
+	0xb2, 0xae, 0xd3, 0x57, 0xc7, 0x28, 0x99, 0x7a, 0x25, 0x03, 0x3f, 0xfd, 0x0a, 0x1a, 0x90, 0x19,
+	0x3d, 0x7a, 0x36, 0x14, 0x78, 0x21, 0xc4, 0xad, 0xae, 0x4e, 0x2b, 0x40, 0xbf, 0x14, 0x69, 0xf4,
+	0x06, 0xca, 0x83, 0xe6, 0x38, 0x35, 0xdd, 0x0b, 0xf1, 0xfc, 0x1a, 0xa4, 0x24, 0x4e, 0xc6, 0x73,
+	0xee, 0xaa, 0x02, 0x55, 0xfb, 0x51, 0x02, 0x58, 0xa7, 0x35, 0x65, 0xb9, 0xd2, 0xdb, 0x98, 0xcf,
+	0xb6, 0xd7, 0x62, 0xbb, 0x5e, 0x3c, 0xb9, 0x59, 0x62, 0xef, 0x82, 0x1f, 0x8d, 0x78, 0x09, 0x8a,
+	0x43, 0x46, 0xfb, 0x38, 0x08, 0x64, 0xcc, 0x45, 0x78, 0x45, 0x6a, 0x63, 0xa2, 0x16, 0xf6, 0x22,
+	0x2b, 0xf4, 0x3e, 0xe4, 0xd8, 0x75, 0x81, 0x99, 0xb3, 0x43, 0x99, 0x5f, 0x54, 0xc6, 0xec, 0x29,
+	0x82, 0xda, 0x66, 0xf7, 0xc4, 0x57, 0xed, 0x07, 0x09, 0x72, 0x2c, 0x8f, 0x0d, 0x4c, 0xf4, 0x58,
+	0xc7, 0xa5, 0x97, 0xed, 0xf8, 0x25, 0x00, 0xee, 0xc4, 0xb7, 0xee, 0x63, 0xc1, 0xc2, 0x1c, 0x93,
+	0xb4, 0xad, 0xfb, 0x18, 0xbd, 0x17, 0xb6, 0x27, 0xf9, 0xbc, 0xf6, 0x88, 0x59, 0x14, 0x34, 0xe9,
+	0x3c, 0x64, 0xec, 0xc1, 0xae, 0x46, 0x2f, 0x3f, 0x32, 0xe7, 0xb5, 0x3d, 0xd8, 0xed, 0xec, 0xfb,
+	0xb5, 0x1e, 0x64, 0x3a, 0xfb, 0xec, 0x49, 0x41, 0xc9, 0xec, 0x39, 0x8e, 0xb8, 0xcd, 0xf2, 0xf7,
+	0x43, 0x96, 0x0a, 0xd8, 0xe5, 0x0d, 0x81, 0x6c, 0xe8, 0x44, 0x0f, 0x9e, 0x37, 0xf4, 0x1b, 0x5d,
+	0x7d, 0xa1, 0xa7, 0x8a, 0xb8, 0xa5, 0x5d, 0xf9, 0x49, 0x82, 0x7c, 0x64, 0x28, 0xa1, 0xeb, 0x70,
+	0xb6, 0xb1, 0xbe, 0xb5, 0x7c, 0x5b, 0x6b, 0xae, 0x68, 0x37, 0xd7, 0x97, 0xd6, 0xb4, 0x3b, 0x9b,
+	0xb7, 0x37, 0xb7, 0x3e, 0xde, 0x2c, 0x4d, 0x94, 0xcf, 0x1d, 0x1e, 0x55, 0x51, 0x04, 0x7b, 0xc7,
+	0xbe, 0x67, 0x3b, 0x5f, 0xd0, 0x69, 0x30, 0x13, 0x37, 0x59, 0x6a, 0xb4, 0x57, 0x37, 0x3b, 0x25,
+	0xa9, 0x7c, 0xf6, 0xf0, 0xa8, 0x3a, 0x1d, 0xb1, 0x58, 0xda, 0xf6, 0xb1, 0x4d, 0xc6, 0x0d, 0x96,
+	0xb7, 0x36, 0x36, 0x9a, 0x9d, 0x52, 0x62, 0xcc, 0x40, 0xfc, 0xe9, 0x5c, 0x86, 0xe9, 0xb8, 0xc1,
+	0x66, 0x73, 0xbd, 0x94, 0x2c, 0xa3, 0xc3, 0xa3, 0xea, 0x64, 0x04, 0xbd, 0x69, 0xf5, 0xcb, 0xd9,
+	0x2f, 0xbf, 0xae, 0x4c, 0x7c, 0xfb, 0x4d, 0x45, 0xa2, 0x99, 0x15, 0x63, 0xb3, 0x04, 0x5d, 0x85,
+	0xf3, 0xed, 0xe6, 0xda, 0xe6, 0xea, 0x8a, 0xb6, 0xd1, 0x5e, 0xd3, 0x3a, 0x9f, 0xb6, 0x56, 0x23,
+	0xd9, 0x4d, 0x1d, 0x1e, 0x55, 0xf3, 0x22, 0xa5, 0xd3, 0xd0, 0x2d, 0x75, 0xf5, 0xee, 0x56, 0x67,
+	0xb5, 0x24, 0x71, 0x74, 0xcb, 0xc3, 0x94, 0x72, 0x0c, 0x7d, 0x0d, 0x2e, 0x9c, 0x80, 0x0e, 0x13,
+	0x9b, 0x3e, 0x3c, 0xaa, 0x16, 0x5b, 0x1e, 0xe6, 0xa7, 0x8d, 0x59, 0xd4, 0x41, 0x19, 0xb7, 0xd8,
+	0x6a, 0x6d, 0xb5, 0x97, 0xd6, 0x4b, 0xd5, 0x72, 0xe9, 0xf0, 0xa8, 0x5a, 0x08, 0x46, 0x26, 0xc5,
+	0x0f, 0x33, 0x6b, 0xac, 0x3d, 0x3a, 0xae, 0x48, 0x8f, 0x8f, 0x2b, 0xd2, 0x6f, 0xc7, 0x15, 0xe9,
+	0xc1, 0xd3, 0xca, 0xc4, 0xe3, 0xa7, 0x95, 0x89, 0x9f, 0x9f, 0x56, 0x26, 0x3e, 0x7b, 0xc7, 0xb4,
+	0x48, 0x6f, 0xb0, 0x5d, 0xef, 0x3a, 0xbb, 0x0b, 0x7d, 0xcb, 0xc6, 0x0b, 0xe1, 0x0b, 0x9a, 0x3f,
+	0xec, 0xe3, 0x0f, 0xea, 0xed, 0x34, 0x93, 0xbe, 0xfb, 0xd7, 0x00, 0x06, 0x8a, 0xdf, 0x39, 0x27,
+	0x10, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1505,6 +1696,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1676,6 +1881,136 @@ func (m *CommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtendedCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatedSignature) > 0 {
+		i -= len(m.AggregatedSignature)
+		copy(dAtA[i:], m.AggregatedSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AggregatedSignature)))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.ExtendedSignatures) > 0 {
+		for iNdEx := len(m.ExtendedSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtendedSignatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.BlockID.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtendedCommitSig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedCommitSig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedCommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockIdFlag != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockIdFlag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1703,12 +2038,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -2128,6 +2463,14 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2180,29 +2523,86 @@ func (m *CommitSig) Size() (n int) {
 	return n
 }
 
-func (m *Proposal) Size() (n int) {
+func (m *ExtendedCommit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovTypes(uint64(m.Type))
-	}
 	if m.Height != 0 {
 		n += 1 + sovTypes(uint64(m.Height))
 	}
 	if m.Round != 0 {
 		n += 1 + sovTypes(uint64(m.Round))
 	}
-	if m.PolRound != 0 {
-		n += 1 + sovTypes(uint64(m.PolRound))
-	}
 	l = m.BlockID.Size()
 	n += 1 + l + sovTypes(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Signature)
+	if len(m.ExtendedSignatures) > 0 {
+		for _, e := range m.ExtendedSignatures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.AggregatedSignature)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtendedCommitSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockIdFlag != 0 {
+		n += 1 + sovTypes(uint64(m.BlockIdFlag))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if m.PolRound != 0 {
+		n += 1 + sovTypes(uint64(m.PolRound))
+	}
+	l = m.BlockID.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Signature)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
@@ -3563,6 +3963,74 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3943,6 +4411,433 @@ func (m *CommitSig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExtendedCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedSignatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedSignatures = append(m.ExtendedSignatures, ExtendedCommitSig{})
+			if err := m.ExtendedSignatures[len(m.ExtendedSignatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignature = append(m.AggregatedSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatedSignature == nil {
+				m.AggregatedSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendedCommitSig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedCommitSig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedCommitSig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockIdFlag", wireType)
+			}
+			m.BlockIdFlag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockIdFlag |= BlockIDFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
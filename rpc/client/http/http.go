@@ -327,6 +327,15 @@ func (c *baseRPCClient) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, err
 	return result, nil
 }
 
+func (c *baseRPCClient) NetAddrBook(ctx context.Context) (*ctypes.ResultNetAddrBook, error) {
+	result := new(ctypes.ResultNetAddrBook)
+	_, err := c.caller.Call(ctx, "net_addrbook", map[string]interface{}{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	result := new(ctypes.ResultDumpConsensusState)
 	_, err := c.caller.Call(ctx, "dump_consensus_state", map[string]interface{}{}, result)
@@ -601,6 +610,22 @@ func (c *baseRPCClient) ValidatorsWithVoters(
 	return result, nil
 }
 
+func (c *baseRPCClient) VoterElectionProof(
+	ctx context.Context,
+	height *int64,
+) (*ctypes.ResultVoterElectionProof, error) {
+	result := new(ctypes.ResultVoterElectionProof)
+	params := make(map[string]interface{})
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "voter_election_proof", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) BroadcastEvidence(
 	ctx context.Context,
 	ev types.Evidence,
@@ -73,6 +73,7 @@ type SignClient interface {
 	Voters(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultVoters, error)
 	ValidatorsWithVoters(
 		ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidatorsWithVoters, error)
+	VoterElectionProof(ctx context.Context, height *int64) (*ctypes.ResultVoterElectionProof, error)
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
 	// TxSearch defines a method to search for a paginated set of transactions by
@@ -111,6 +112,7 @@ type StatusClient interface {
 // usually.
 type NetworkClient interface {
 	NetInfo(context.Context) (*ctypes.ResultNetInfo, error)
+	NetAddrBook(context.Context) (*ctypes.ResultNetAddrBook, error)
 	DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(context.Context) (*ctypes.ResultConsensusState, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
@@ -115,6 +115,10 @@ func (c *Local) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return core.NetInfo(c.ctx)
 }
 
+func (c *Local) NetAddrBook(ctx context.Context) (*ctypes.ResultNetAddrBook, error) {
+	return core.NetAddrBook(c.ctx)
+}
+
 func (c *Local) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return core.DumpConsensusState(c.ctx)
 }
@@ -145,6 +149,14 @@ func (c *Local) DialPeers(
 	return core.UnsafeDialPeers(c.ctx, peers, persistent, unconditional, private)
 }
 
+func (c *Local) BanPeer(ctx context.Context, id, duration, reason string) (*ctypes.ResultUnsafeBanPeer, error) {
+	return core.UnsafeBanPeer(c.ctx, id, duration, reason)
+}
+
+func (c *Local) UnbanPeer(ctx context.Context, id string) (*ctypes.ResultUnsafeUnbanPeer, error) {
+	return core.UnsafeUnbanPeer(c.ctx, id)
+}
+
 func (c *Local) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	return core.BlockchainInfo(c.ctx, minHeight, maxHeight)
 }
@@ -186,6 +198,11 @@ func (c *Local) ValidatorsWithVoters(
 	return core.ValidatorsWithVoters(c.ctx, height, page, perPage)
 }
 
+func (c *Local) VoterElectionProof(
+	ctx context.Context, height *int64) (*ctypes.ResultVoterElectionProof, error) {
+	return core.VoterElectionProof(c.ctx, height)
+}
+
 func (c *Local) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return core.Tx(c.ctx, hash, prove)
 }
@@ -120,6 +120,10 @@ func (c Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return core.NetInfo(&rpctypes.Context{})
 }
 
+func (c Client) NetAddrBook(ctx context.Context) (*ctypes.ResultNetAddrBook, error) {
+	return core.NetAddrBook(&rpctypes.Context{})
+}
+
 func (c Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error) {
 	return core.ConsensusState(&rpctypes.Context{})
 }
@@ -150,6 +154,14 @@ func (c Client) DialPeers(
 	return core.UnsafeDialPeers(&rpctypes.Context{}, peers, persistent, unconditional, private)
 }
 
+func (c Client) BanPeer(ctx context.Context, id, duration, reason string) (*ctypes.ResultUnsafeBanPeer, error) {
+	return core.UnsafeBanPeer(&rpctypes.Context{}, id, duration, reason)
+}
+
+func (c Client) UnbanPeer(ctx context.Context, id string) (*ctypes.ResultUnsafeUnbanPeer, error) {
+	return core.UnsafeUnbanPeer(&rpctypes.Context{}, id)
+}
+
 func (c Client) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	return core.BlockchainInfo(&rpctypes.Context{}, minHeight, maxHeight)
 }
@@ -496,6 +496,29 @@ func (_m *RemoteClient) IsRunning() bool {
 	return r0
 }
 
+// NetAddrBook provides a mock function with given fields: _a0
+func (_m *RemoteClient) NetAddrBook(_a0 context.Context) (*coretypes.ResultNetAddrBook, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultNetAddrBook
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultNetAddrBook); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultNetAddrBook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NetInfo provides a mock function with given fields: _a0
 func (_m *RemoteClient) NetInfo(_a0 context.Context) (*coretypes.ResultNetInfo, error) {
 	ret := _m.Called(_a0)
@@ -862,6 +885,29 @@ func (_m *RemoteClient) ValidatorsWithVoters(ctx context.Context, height *int64,
 	return r0, r1
 }
 
+// VoterElectionProof provides a mock function with given fields: ctx, height
+func (_m *RemoteClient) VoterElectionProof(ctx context.Context, height *int64) (*coretypes.ResultVoterElectionProof, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultVoterElectionProof
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultVoterElectionProof); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultVoterElectionProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Voters provides a mock function with given fields: ctx, height, page, perPage
 func (_m *RemoteClient) Voters(ctx context.Context, height *int64, page *int, perPage *int) (*coretypes.ResultVoters, error) {
 	ret := _m.Called(ctx, height, page, perPage)
@@ -196,6 +196,36 @@ func TestGenesisAndValidators(t *testing.T) {
 	}
 }
 
+func TestVoterElectionProof(t *testing.T) {
+	for i, c := range GetClients() {
+		h := int64(2)
+		err := client.WaitForHeight(c, h, nil)
+		require.NoError(t, err, "%d: %+v", i, err)
+
+		res, err := c.VoterElectionProof(context.Background(), &h)
+		require.NoError(t, err, "%d: %+v", i, err)
+		assert.Equal(t, h, res.BlockHeight)
+		assert.Equal(t, h-1, res.Proof.ProofHeader.Height)
+
+		// the election can be verified against the trusted headers
+		block, err := c.Block(context.Background(), &res.Proof.ProofHeader.Height)
+		require.NoError(t, err, "%d: %+v", i, err)
+		assert.Equal(t, block.Block.Hash(), res.Proof.ProofHeader.Hash())
+		commit, err := c.Commit(context.Background(), &h)
+		require.NoError(t, err, "%d: %+v", i, err)
+		genesis, err := c.Genesis(context.Background())
+		require.NoError(t, err, "%d: %+v", i, err)
+		voters, err := types.VerifyVoterElection(&res.Proof, genesis.Genesis.VoterParams, commit.VotersHash)
+		require.NoError(t, err, "%d: %+v", i, err)
+		assert.Equal(t, 1, voters.Size())
+
+		// there is no proof for the voters of the initial height
+		h = 1
+		_, err = c.VoterElectionProof(context.Background(), &h)
+		assert.Error(t, err)
+	}
+}
+
 func TestGenesisChunked(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
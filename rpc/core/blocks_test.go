@@ -334,5 +334,14 @@ func (mockBlockStore) LoadBlockPart(height int64, index int) *types.Part { retur
 func (mockBlockStore) LoadBlockCommit(height int64) *types.Commit        { return nil }
 func (mockBlockStore) LoadSeenCommit(height int64) *types.Commit         { return nil }
 func (mockBlockStore) PruneBlocks(height int64) (uint64, error)          { return 0, nil }
+func (mockBlockStore) PruneBlocksKeepingEvery(height int64, keepEvery int64) (uint64, error) {
+	return 0, nil
+}
 func (mockBlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
 }
+func (mockBlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	return nil
+}
+func (mockBlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet,
+	seenExtCommit *types.ExtendedCommit) {
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"fmt"
+
 	cm "github.com/line/ostracon/consensus"
 	tmmath "github.com/line/ostracon/libs/math"
 	ctypes "github.com/line/ostracon/rpc/core/types"
@@ -125,6 +127,56 @@ func ValidatorsWithVoters(
 	}, nil
 }
 
+// VoterElectionProof gets the data needed to recompute the election of the
+// voters at the given block height, which can be checked with
+// types.VerifyVoterElection.
+//
+// If no height is provided, it will fetch the proof of the last voter set.
+// The voters of the initial height are elected with the proof hash of the
+// genesis, so there is no proof for them.
+func VoterElectionProof(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultVoterElectionProof, error) {
+	height, err := getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+	if height <= env.BlockStore.Base() {
+		return nil, fmt.Errorf("the block before height %d is not available, lowest height is %d",
+			height, env.BlockStore.Base())
+	}
+
+	validators, _, voterParams, _, err := env.StateStore.LoadVoters(height, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The VRF proof seeding the election is the one of the previous block,
+	// which was made from the proof hash of the block before it.
+	blockMeta := env.BlockStore.LoadBlockMeta(height - 1)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("block meta not found for height %d", height-1)
+	}
+	prevValidators, _, _, previousProofHash, err := env.StateStore.LoadVoters(height-1, nil)
+	if err != nil {
+		return nil, err
+	}
+	_, proposer := prevValidators.GetByAddress(blockMeta.Header.ProposerAddress)
+	if proposer == nil {
+		return nil, fmt.Errorf("proposer %X of height %d not found in its validators",
+			blockMeta.Header.ProposerAddress, height-1)
+	}
+
+	return &ctypes.ResultVoterElectionProof{
+		BlockHeight: height,
+		Proof: types.VoterElectionProof{
+			ProofHeader:       &blockMeta.Header,
+			ProposerPubKey:    proposer.PubKey,
+			PreviousProofHash: previousProofHash,
+			Validators:        validators,
+			VoterParams:       voterParams,
+		},
+	}, nil
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.tendermint.com/master/rpc/#/Info/dump_consensus_state
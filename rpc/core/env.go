@@ -6,12 +6,12 @@ import (
 	"time"
 
 	cfg "github.com/line/ostracon/config"
-	"github.com/line/ostracon/consensus"
 	"github.com/line/ostracon/crypto"
 	tmjson "github.com/line/ostracon/libs/json"
 	"github.com/line/ostracon/libs/log"
 	mempl "github.com/line/ostracon/mempool"
 	"github.com/line/ostracon/p2p"
+	"github.com/line/ostracon/p2p/pex"
 	"github.com/line/ostracon/proxy"
 	sm "github.com/line/ostracon/state"
 	"github.com/line/ostracon/state/indexer"
@@ -64,12 +64,23 @@ type transport interface {
 	NodeInfo() p2p.NodeInfo
 }
 
+type syncReactor interface {
+	WaitSync() bool
+}
+
 type peers interface {
 	AddPersistentPeers([]string) error
 	AddUnconditionalPeerIDs([]string) error
 	AddPrivatePeerIDs([]string) error
 	DialPeersAsync([]string) error
 	Peers() p2p.IPeerSet
+	StopPeerForError(p2p.Peer, interface{})
+}
+
+type addrBook interface {
+	Addresses() []pex.AddrInfo
+	Ban(addr *p2p.NetAddress, banTime time.Duration, reason string)
+	Unban(id p2p.ID) error
 }
 
 //----------------------------------------------
@@ -87,13 +98,14 @@ type Environment struct {
 	ConsensusState Consensus
 	P2PPeers       peers
 	P2PTransport   transport
+	AddrBook       addrBook
 
 	// objects
 	PubKey           crypto.PubKey
 	GenDoc           *types.GenesisDoc // cache the genesis structure
 	TxIndexer        txindex.TxIndexer
 	BlockIndexer     indexer.BlockIndexer
-	ConsensusReactor *consensus.Reactor
+	ConsensusReactor syncReactor
 	EventBus         *types.EventBus // thread safe
 	Mempool          mempl.Mempool
 
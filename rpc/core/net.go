@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/line/ostracon/p2p"
 	ctypes "github.com/line/ostracon/rpc/core/types"
@@ -38,6 +39,94 @@ func NetInfo(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 	}, nil
 }
 
+// NetAddrBook returns the addresses of the address book, along with their dial
+// history, latency, node version and last seen height, and the banned ones.
+func NetAddrBook(ctx *rpctypes.Context) (*ctypes.ResultNetAddrBook, error) {
+	if env.AddrBook == nil {
+		return nil, errors.New("address book is not available")
+	}
+	addrs := env.AddrBook.Addresses()
+	return &ctypes.ResultNetAddrBook{
+		NAddrs: len(addrs),
+		Addrs:  addrs,
+	}, nil
+}
+
+const (
+	defaultBanDuration = 24 * time.Hour
+	defaultBanReason   = "banned by operator"
+)
+
+// UnsafeBanPeer bans the peer with the given ID for duration (e.g. "1h30m",
+// 24h by default), disconnecting it if connected. The peer must be connected
+// or in the address book.
+func UnsafeBanPeer(ctx *rpctypes.Context, id, duration, reason string) (*ctypes.ResultUnsafeBanPeer, error) {
+	if env.AddrBook == nil {
+		return nil, errors.New("address book is not available")
+	}
+	if err := p2p.ValidateID(p2p.ID(id)); err != nil {
+		return nil, err
+	}
+	banTime := defaultBanDuration
+	if duration != "" {
+		var err error
+		if banTime, err = time.ParseDuration(duration); err != nil {
+			return nil, fmt.Errorf("invalid duration: %w", err)
+		}
+		if banTime <= 0 {
+			return nil, fmt.Errorf("duration must be positive, got %v", banTime)
+		}
+	}
+	if reason == "" {
+		reason = defaultBanReason
+	}
+
+	peer := env.P2PPeers.Peers().Get(p2p.ID(id))
+	addr, err := banAddress(p2p.ID(id), peer)
+	if err != nil {
+		return nil, err
+	}
+
+	env.Logger.Info("BanPeer", "addr", addr, "duration", banTime, "reason", reason)
+	env.AddrBook.Ban(addr, banTime, reason)
+	if peer != nil {
+		env.P2PPeers.StopPeerForError(peer, fmt.Errorf("banned: %s", reason))
+	}
+	return &ctypes.ResultUnsafeBanPeer{}, nil
+}
+
+// UnsafeUnbanPeer lifts the ban of the peer with the given ID.
+func UnsafeUnbanPeer(ctx *rpctypes.Context, id string) (*ctypes.ResultUnsafeUnbanPeer, error) {
+	if env.AddrBook == nil {
+		return nil, errors.New("address book is not available")
+	}
+	if err := p2p.ValidateID(p2p.ID(id)); err != nil {
+		return nil, err
+	}
+	env.Logger.Info("UnbanPeer", "id", id)
+	if err := env.AddrBook.Unban(p2p.ID(id)); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultUnsafeUnbanPeer{}, nil
+}
+
+// banAddress returns the address to ban the peer with the given ID by: the one
+// it listens on if connected, or the one in the address book.
+func banAddress(id p2p.ID, peer p2p.Peer) (*p2p.NetAddress, error) {
+	if peer != nil {
+		if addr, err := peer.NodeInfo().NetAddress(); err == nil {
+			return addr, nil
+		}
+		return peer.SocketAddr(), nil
+	}
+	for _, info := range env.AddrBook.Addresses() {
+		if info.Addr.ID == id {
+			return info.Addr, nil
+		}
+	}
+	return nil, fmt.Errorf("peer %v is neither connected nor in the address book", id)
+}
+
 // UnsafeDialSeeds dials the given seeds (comma-separated id@IP:PORT).
 func UnsafeDialSeeds(ctx *rpctypes.Context, seeds []string) (*ctypes.ResultDialSeeds, error) {
 	if len(seeds) == 0 {
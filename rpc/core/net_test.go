@@ -2,6 +2,7 @@ package core
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -9,6 +10,7 @@ import (
 	cfg "github.com/line/ostracon/config"
 	"github.com/line/ostracon/libs/log"
 	"github.com/line/ostracon/p2p"
+	"github.com/line/ostracon/p2p/pex"
 	rpctypes "github.com/line/ostracon/rpc/jsonrpc/types"
 )
 
@@ -139,3 +141,52 @@ func TestGenesisChunked(t *testing.T) {
 	assert.Contains(t, err.Error(), " is invalid")
 	assert.Nil(t, res)
 }
+
+func TestUnsafeBanAndUnbanPeer(t *testing.T) {
+	sw := p2p.MakeSwitch(cfg.DefaultP2PConfig(), 1, "testing", "123.123.123",
+		func(n int, sw *p2p.Switch, config *cfg.P2PConfig) *p2p.Switch { return sw })
+	book := pex.NewAddrBook("", false)
+	book.SetLogger(log.TestingLogger())
+
+	env.Logger = log.TestingLogger()
+	env.P2PPeers = sw
+	env.AddrBook = book
+
+	addr, err := p2p.NewNetAddressString("d51fb70907db1c6c2d5237e78379b25cf1a37ab4@127.0.0.1:41198")
+	require.NoError(t, err)
+	require.NoError(t, book.AddAddress(addr, addr))
+
+	testCases := []struct {
+		id       string
+		duration string
+		isErr    bool
+	}{
+		{"", "", true},
+		{"127.0.0.1:41198", "", true},
+		{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4", "one hour", true},
+		{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4", "-1h", true},
+		{"0000000000000000000000000000000000000000", "", true}, // unknown
+		{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4", "1h", false},
+	}
+	for _, tc := range testCases {
+		_, err := UnsafeBanPeer(&rpctypes.Context{}, tc.id, tc.duration, "")
+		if tc.isErr {
+			assert.Error(t, err, tc)
+		} else {
+			assert.NoError(t, err, tc)
+		}
+	}
+
+	res, err := NetAddrBook(&rpctypes.Context{})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.NAddrs)
+	assert.True(t, res.Addrs[0].Banned)
+	assert.Equal(t, defaultBanReason, res.Addrs[0].BanReason)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), res.Addrs[0].BannedUntil, time.Minute)
+
+	_, err = UnsafeUnbanPeer(&rpctypes.Context{}, "d51fb70907db1c6c2d5237e78379b25cf1a37ab4")
+	require.NoError(t, err)
+	assert.False(t, book.IsBanned(addr))
+	_, err = UnsafeUnbanPeer(&rpctypes.Context{}, "d51fb70907db1c6c2d5237e78379b25cf1a37ab4")
+	assert.Error(t, err)
+}
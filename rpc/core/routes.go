@@ -17,6 +17,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"health":               rpc.NewRPCFunc(Health, ""),
 	"status":               rpc.NewRPCFunc(Status, ""),
 	"net_info":             rpc.NewRPCFunc(NetInfo, ""),
+	"net_addrbook":         rpc.NewRPCFunc(NetAddrBook, ""),
 	"blockchain":           rpc.NewRPCFunc(BlockchainInfo, "minHeight,maxHeight"),
 	"genesis":              rpc.NewRPCFunc(Genesis, ""),
 	"genesis_chunked":      rpc.NewRPCFunc(GenesisChunked, "chunk"),
@@ -31,6 +32,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"validators":           rpc.NewRPCFunc(Validators, "height,page,per_page"),
 	"voters":               rpc.NewRPCFunc(Voters, "height,page,per_page"),
 	"validators_voters":    rpc.NewRPCFunc(ValidatorsWithVoters, "height,page,per_page"),
+	"voter_election_proof": rpc.NewRPCFunc(VoterElectionProof, "height"),
 	"dump_consensus_state": rpc.NewRPCFunc(DumpConsensusState, ""),
 	"consensus_state":      rpc.NewRPCFunc(ConsensusState, ""),
 	"consensus_params":     rpc.NewRPCFunc(ConsensusParams, "height"),
@@ -55,5 +57,7 @@ func AddUnsafeRoutes() {
 	// control API
 	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
 	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent,unconditional,private")
+	Routes["unsafe_ban_peer"] = rpc.NewRPCFunc(UnsafeBanPeer, "id,duration,reason")
+	Routes["unsafe_unban_peer"] = rpc.NewRPCFunc(UnsafeUnbanPeer, "id")
 	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
 }
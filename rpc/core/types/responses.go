@@ -8,6 +8,7 @@ import (
 	"github.com/line/ostracon/crypto"
 	"github.com/line/ostracon/libs/bytes"
 	"github.com/line/ostracon/p2p"
+	"github.com/line/ostracon/p2p/pex"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/line/ostracon/types"
 )
@@ -114,6 +115,12 @@ type ResultNetInfo struct {
 	Peers     []Peer   `json:"peers"`
 }
 
+// Addresses of the address book, banned ones included
+type ResultNetAddrBook struct {
+	NAddrs int            `json:"n_addrs"`
+	Addrs  []pex.AddrInfo `json:"addrs"`
+}
+
 // Log from dialing seeds
 type ResultDialSeeds struct {
 	Log string `json:"log"`
@@ -164,6 +171,13 @@ type ResultValidatorsWithVoters struct {
 	VoterIndices []int32 `json:"voter_indices"`
 }
 
+// ResultVoterElectionProof holds the proof of the election of the voters of
+// a height.
+type ResultVoterElectionProof struct {
+	BlockHeight int64                    `json:"block_height"`
+	Proof       types.VoterElectionProof `json:"proof"`
+}
+
 // ConsensusParams for given height
 type ResultConsensusParams struct {
 	BlockHeight     int64                   `json:"block_height"`
@@ -261,6 +275,8 @@ type ResultBroadcastEvidence struct {
 type (
 	ResultUnsafeFlushMempool struct{}
 	ResultUnsafeProfile      struct{}
+	ResultUnsafeBanPeer      struct{}
+	ResultUnsafeUnbanPeer    struct{}
 	ResultSubscribe          struct{}
 	ResultUnsubscribe        struct{}
 	ResultHealth             struct{}
@@ -1,6 +1,12 @@
 package state
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidVoteExtension is returned when the app rejects a vote extension.
+var ErrInvalidVoteExtension = errors.New("invalid vote extension")
 
 type (
 	ErrInvalidBlock error
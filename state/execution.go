@@ -1,6 +1,7 @@
 package state
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"time"
@@ -119,9 +120,12 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 // and txs from the mempool. The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allcoated for maximum sized evidence.
 // The rest is given to txs, up to the max gas.
+// The txs reaped from the mempool are then passed to the app with
+// PrepareProposal, which may reorder, drop or add txs, along with the vote
+// extensions of the last extended commit.
 func (blockExec *BlockExecutor) CreateProposalBlock(
 	height int64,
-	state State, commit *types.Commit,
+	state State, lastExtCommit *types.ExtendedCommit,
 	proposerAddr []byte,
 	round int32,
 	proof crypto.Proof,
@@ -133,12 +137,143 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	evidence, _ := blockExec.evpool.PendingEvidence(state.ConsensusParams.Evidence.MaxBytes)
 
+	commit := lastExtCommit.ToCommit()
+
 	// Fetch a limited amount of valid txs
 	maxDataBytes := types.MaxDataBytes(maxBytes, commit, evidence)
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGasMaxTxs(maxDataBytes, maxGas, maxTxs)
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr, round, proof)
+	block, partSet := state.MakeBlock(height, txs, commit, evidence, proposerAddr, round, proof)
+
+	// Let the app modify the txs. If it fails or returns invalid txs, the
+	// reaped txs are proposed.
+	preparedTxs, err := blockExec.prepareProposal(state, block, lastExtCommit, maxDataBytes, maxGas)
+	if err != nil {
+		blockExec.logger.Error("error in PrepareProposal, proposing the mempool txs",
+			"height", height, "err", err)
+		return block, partSet
+	}
+	if txsEqual(preparedTxs, txs) {
+		return block, partSet
+	}
+	return state.MakeBlock(height, preparedTxs, commit, evidence, proposerAddr, round, proof)
+}
+
+// prepareProposal asks the app for the txs of the block, and checks that
+// they fit in maxDataBytes and maxGas.
+func (blockExec *BlockExecutor) prepareProposal(
+	state State,
+	block *types.Block,
+	lastExtCommit *types.ExtendedCommit,
+	maxDataBytes int64,
+	maxGas int64,
+) (types.Txs, error) {
+	byzVals := make([]abci.Evidence, 0)
+	for _, evidence := range block.Evidence.Evidence {
+		byzVals = append(byzVals, evidence.ABCI()...)
+	}
+	localLastCommit := buildExtendedCommitInfo(block, lastExtCommit, blockExec.store,
+		state.InitialHeight, state.VoterParams)
+
+	res, err := blockExec.proxyApp.PrepareProposalSync(abci.RequestPrepareProposal{
+		MaxTxBytes:          maxDataBytes,
+		Txs:                 block.Txs.ToSliceOfBytes(),
+		LocalLastCommit:     localLastCommit,
+		ByzantineValidators: byzVals,
+		Height:              block.Height,
+		Time:                block.Time,
+		NextValidatorsHash:  block.NextValidatorsHash,
+		ProposerAddress:     block.ProposerAddress,
+		MaxGas:              maxGas,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txs := types.ToTxs(res.Txs)
+	if size := types.ComputeProtoSizeForTxs(txs); size > maxDataBytes {
+		return nil, fmt.Errorf("txs size %d exceeds the max %d", size, maxDataBytes)
+	}
+	if res.GasWanted < 0 {
+		return nil, fmt.Errorf("negative gas wanted %d", res.GasWanted)
+	}
+	if maxGas > -1 && res.GasWanted > maxGas {
+		return nil, fmt.Errorf("gas wanted %d exceeds the max %d", res.GasWanted, maxGas)
+	}
+	return txs, nil
+}
+
+// ProcessProposal asks the app whether the proposed block is valid. It
+// returns false if the app rejects the block, which must not be prevoted.
+func (blockExec *BlockExecutor) ProcessProposal(state State, block *types.Block) (bool, error) {
+	byzVals := make([]abci.Evidence, 0)
+	for _, evidence := range block.Evidence.Evidence {
+		byzVals = append(byzVals, evidence.ABCI()...)
+	}
+
+	res, err := blockExec.proxyApp.ProcessProposalSync(abci.RequestProcessProposal{
+		Txs:                 block.Txs.ToSliceOfBytes(),
+		ProposedLastCommit:  getBeginBlockValidatorInfo(block, blockExec.store, state.InitialHeight, state.VoterParams),
+		ByzantineValidators: byzVals,
+		Hash:                block.Hash(),
+		Height:              block.Height,
+		Time:                block.Time,
+		NextValidatorsHash:  block.NextValidatorsHash,
+		ProposerAddress:     block.ProposerAddress,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.IsAccepted(), nil
+}
+
+// ExtendVote asks the app for the extension of the given precommit, which the
+// caller must sign along with the vote.
+func (blockExec *BlockExecutor) ExtendVote(vote *types.Vote) ([]byte, error) {
+	res, err := blockExec.proxyApp.ExtendVoteSync(abci.RequestExtendVote{
+		Hash:   vote.BlockID.Hash,
+		Height: vote.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.VoteExtension) > types.MaxVoteExtensionSize {
+		return nil, fmt.Errorf("vote extension size %d exceeds the max %d",
+			len(res.VoteExtension), types.MaxVoteExtensionSize)
+	}
+	return res.VoteExtension, nil
+}
+
+// VerifyVoteExtension asks the app whether the extension of the given
+// precommit of another validator is valid. It returns ErrInvalidVoteExtension
+// if the app rejects it.
+func (blockExec *BlockExecutor) VerifyVoteExtension(vote *types.Vote) error {
+	res, err := blockExec.proxyApp.VerifyVoteExtensionSync(abci.RequestVerifyVoteExtension{
+		Hash:             vote.BlockID.Hash,
+		ValidatorAddress: vote.ValidatorAddress,
+		Height:           vote.Height,
+		VoteExtension:    vote.Extension,
+	})
+	if err != nil {
+		return err
+	}
+	if !res.IsAccepted() {
+		return ErrInvalidVoteExtension
+	}
+	return nil
+}
+
+func txsEqual(txs1, txs2 types.Txs) bool {
+	if len(txs1) != len(txs2) {
+		return false
+	}
+	for i := range txs1 {
+		if !bytes.Equal(txs1[i], txs2[i]) {
+			return false
+		}
+	}
+	return true
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -463,6 +598,28 @@ func getBeginBlockValidatorInfo(block *types.Block, store Store,
 	}
 }
 
+// buildExtendedCommitInfo builds the ExtendedCommitInfo of the last commit of
+// the block for PrepareProposal, with the vote extensions of lastExtCommit.
+func buildExtendedCommitInfo(block *types.Block, lastExtCommit *types.ExtendedCommit, store Store,
+	initialHeight int64, voterParams *types.VoterParams) abci.ExtendedCommitInfo {
+	lastCommitInfo := getBeginBlockValidatorInfo(block, store, initialHeight, voterParams)
+	votes := make([]abci.ExtendedVoteInfo, len(lastCommitInfo.Votes))
+	for i, vote := range lastCommitInfo.Votes {
+		votes[i] = abci.ExtendedVoteInfo{
+			Validator:       vote.Validator,
+			SignedLastBlock: vote.SignedLastBlock,
+		}
+		if sig := lastExtCommit.ExtendedSignatures[i]; sig.ForBlock() {
+			votes[i].VoteExtension = sig.Extension
+		}
+	}
+
+	return abci.ExtendedCommitInfo{
+		Round: lastCommitInfo.Round,
+		Votes: votes,
+	}
+}
+
 func validateValidatorUpdates(abciUpdates []abci.ValidatorUpdate,
 	params tmproto.ValidatorParams) error {
 	for _, valUpdate := range abciUpdates {
@@ -519,9 +676,15 @@ func updateState(
 	nextParams := state.ConsensusParams
 	lastHeightParamsChanged := state.LastHeightConsensusParamsChanged
 	if abciResponses.EndBlock.ConsensusParamUpdates != nil {
+		err := types.ValidateConsensusParamsUpdate(
+			state.ConsensusParams, abciResponses.EndBlock.ConsensusParamUpdates, header.Height)
+		if err != nil {
+			return state, fmt.Errorf("error updating consensus params: %v", err)
+		}
+
 		// NOTE: must not mutate s.ConsensusParams
 		nextParams = types.UpdateConsensusParams(state.ConsensusParams, abciResponses.EndBlock.ConsensusParamUpdates)
-		err := types.ValidateConsensusParams(nextParams)
+		err = types.ValidateConsensusParams(nextParams)
 		if err != nil {
 			return state, fmt.Errorf("error updating consensus params: %v", err)
 		}
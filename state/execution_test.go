@@ -517,6 +517,166 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	assert.NotEmpty(t, state.NextValidators.Validators)
 }
 
+type reapMempool struct {
+	mmock.Mempool
+	txs types.Txs
+}
+
+func (mem reapMempool) ReapMaxBytesMaxGasMaxTxs(_, _, _ int64) types.Txs { return mem.txs }
+
+type prepareProposalApp struct {
+	testApp
+
+	req abci.RequestPrepareProposal
+	res abci.ResponsePrepareProposal
+}
+
+func (app *prepareProposalApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	app.req = req
+	return app.res
+}
+
+func TestCreateProposalBlockPrepareProposal(t *testing.T) {
+	state, stateDB, privVals := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	state.ConsensusParams.Block.MaxGas = 100
+	proposerAddr := state.Validators.Validators[0].Address
+	proof, err := privVals[proposerAddr.String()].GenerateVRFProof(state.MakeHashMessage(0))
+	require.NoError(t, err)
+	extCommit := &types.ExtendedCommit{}
+	mempoolTxs := types.Txs{types.Tx("a"), types.Tx("b"), types.Tx("c")}
+
+	testCases := []struct {
+		name string
+		res  abci.ResponsePrepareProposal
+		txs  types.Txs
+	}{
+		{"pass through", abci.ResponsePrepareProposal{Txs: mempoolTxs.ToSliceOfBytes()}, mempoolTxs},
+		{"reorder and inject",
+			abci.ResponsePrepareProposal{Txs: [][]byte{[]byte("c"), []byte("x"), []byte("a")}, GasWanted: 100},
+			types.Txs{types.Tx("c"), types.Tx("x"), types.Tx("a")}},
+		{"drop all", abci.ResponsePrepareProposal{}, types.Txs{}},
+		{"too large",
+			abci.ResponsePrepareProposal{Txs: [][]byte{make([]byte, state.ConsensusParams.Block.MaxBytes)}},
+			mempoolTxs},
+		{"too much gas", abci.ResponsePrepareProposal{Txs: [][]byte{[]byte("a")}, GasWanted: 101}, mempoolTxs},
+		{"negative gas", abci.ResponsePrepareProposal{Txs: [][]byte{[]byte("a")}, GasWanted: -1}, mempoolTxs},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := &prepareProposalApp{res: tc.res}
+			proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+			require.NoError(t, proxyApp.Start())
+			defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+			blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+				reapMempool{txs: mempoolTxs}, sm.EmptyEvidencePool{})
+			block, partSet := blockExec.CreateProposalBlock(1, state, extCommit, proposerAddr, 0, proof, 0)
+
+			assert.Equal(t, mempoolTxs.ToSliceOfBytes(), app.req.Txs)
+			assert.EqualValues(t, 1, app.req.Height)
+			assert.Equal(t, block.Time, app.req.Time)
+			assert.EqualValues(t, proposerAddr, app.req.ProposerAddress)
+			assert.EqualValues(t, 100, app.req.MaxGas)
+			assert.Equal(t, tc.txs, block.Txs)
+			assert.Equal(t, block.MakePartSet(types.BlockPartSizeBytes).Header(), partSet.Header())
+		})
+	}
+}
+
+type processProposalApp struct {
+	testApp
+
+	req    abci.RequestProcessProposal
+	status abci.ResponseProcessProposal_ProposalStatus
+}
+
+func (app *processProposalApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	app.req = req
+	return abci.ResponseProcessProposal{Status: app.status}
+}
+
+func TestProcessProposal(t *testing.T) {
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	block := makeBlock(state, 1)
+	block.Txs = types.Txs{types.Tx("a"), types.Tx("b")}
+
+	for status, accepted := range map[abci.ResponseProcessProposal_ProposalStatus]bool{
+		abci.ResponseProcessProposal_UNKNOWN: false,
+		abci.ResponseProcessProposal_ACCEPT:  true,
+		abci.ResponseProcessProposal_REJECT:  false,
+	} {
+		app := &processProposalApp{status: status}
+		proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+		require.NoError(t, proxyApp.Start())
+		defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+		blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+			mmock.Mempool{}, sm.EmptyEvidencePool{})
+		ok, err := blockExec.ProcessProposal(state, block)
+		require.NoError(t, err)
+		assert.Equal(t, accepted, ok, status.String())
+		assert.Equal(t, block.Txs.ToSliceOfBytes(), app.req.Txs)
+		assert.EqualValues(t, block.Hash(), app.req.Hash)
+		assert.Equal(t, block.Height, app.req.Height)
+	}
+}
+
+type voteExtensionApp struct {
+	testApp
+
+	extension []byte
+	verified  abci.RequestVerifyVoteExtension
+	status    abci.ResponseVerifyVoteExtension_VerifyStatus
+}
+
+func (app *voteExtensionApp) ExtendVote(req abci.RequestExtendVote) abci.ResponseExtendVote {
+	return abci.ResponseExtendVote{VoteExtension: app.extension}
+}
+
+func (app *voteExtensionApp) VerifyVoteExtension(
+	req abci.RequestVerifyVoteExtension) abci.ResponseVerifyVoteExtension {
+	app.verified = req
+	return abci.ResponseVerifyVoteExtension{Status: app.status}
+}
+
+func TestVoteExtension(t *testing.T) {
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	vote := &types.Vote{
+		Type:             tmproto.PrecommitType,
+		Height:           1,
+		BlockID:          makeBlockID([]byte("hash"), 1, []byte("parts")),
+		ValidatorAddress: state.Validators.Validators[0].Address,
+	}
+
+	app := &voteExtensionApp{extension: []byte("price"), status: abci.ResponseVerifyVoteExtension_ACCEPT}
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mmock.Mempool{}, sm.EmptyEvidencePool{})
+
+	ext, err := blockExec.ExtendVote(vote)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("price"), ext)
+
+	vote.Extension = ext
+	require.NoError(t, blockExec.VerifyVoteExtension(vote))
+	assert.Equal(t, ext, app.verified.VoteExtension)
+	assert.EqualValues(t, vote.ValidatorAddress, app.verified.ValidatorAddress)
+	assert.EqualValues(t, vote.BlockID.Hash, app.verified.Hash)
+
+	app.status = abci.ResponseVerifyVoteExtension_REJECT
+	assert.Equal(t, sm.ErrInvalidVoteExtension, blockExec.VerifyVoteExtension(vote))
+
+	app.extension = make([]byte, types.MaxVoteExtensionSize+1)
+	_, err = blockExec.ExtendVote(vote)
+	assert.Error(t, err)
+}
+
 func makeBlockID(hash []byte, partSetSize uint32, partSetHash []byte) types.BlockID {
 	var (
 		h   = make([]byte, tmhash.Size)
@@ -17,6 +17,9 @@ import (
 	"github.com/line/ostracon/types"
 )
 
+// pruneBatchSize is the maximum number of keys deleted by a batch when pruning.
+const pruneBatchSize = 1000
+
 var _ indexer.BlockIndexer = (*BlockerIndexer)(nil)
 
 // BlockerIndexer implements a block indexer, indexing BeginBlock and EndBlock
@@ -49,6 +52,7 @@ func (idx *BlockerIndexer) Has(height int64) (bool, error) {
 // primary key: encode(block.height | height) => encode(height)
 // BeginBlock events: encode(eventType.eventAttr|eventValue|height|begin_block) => encode(height)
 // EndBlock events: encode(eventType.eventAttr|eventValue|height|end_block) => encode(height)
+// pruning keys: encode(event_keys|height|event key) => nil
 func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 	batch := idx.store.NewBatch()
 	defer batch.Close()
@@ -82,6 +86,9 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 // one or more block heights. In the case of height queries, i.e. block.height=H,
 // if the height is indexed, that height alone will be returned. An error and
 // nil slice is returned. Otherwise, a non-nil slice and nil error is returned.
+//
+// Conditions joined by OR and NOT are evaluated by set union and difference
+// over the heights matching each run of AND-joined conditions.
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
@@ -91,11 +98,45 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions, err := q.Conditions()
+	expr, err := q.Expression()
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse query conditions: %w", err)
 	}
 
+	filteredHeights, err := indexer.EvalExpr(ctx, expr, idx.matchConditions, idx.matchAll)
+	if err != nil {
+		return nil, err
+	}
+
+	// fetch matching heights
+	results = make([]int64, 0, len(filteredHeights))
+	for _, hBz := range filteredHeights {
+		h := int64FromBytes(hBz)
+
+		ok, err := idx.Has(h)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			results = append(results, h)
+		}
+
+		select {
+		case <-ctx.Done():
+			break
+
+		default:
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// matchConditions returns the encoded heights of the blocks matching all of
+// the given conditions.
+func (idx *BlockerIndexer) matchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
 	// If there is an exact height query, return the result immediately
 	// (if it exists).
 	height, ok := lookForHeight(conditions)
@@ -105,11 +146,13 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 			return nil, err
 		}
 
+		filteredHeights := make(map[string][]byte)
 		if ok {
-			return []int64{height}, nil
+			heightBz := int64ToBytes(height)
+			filteredHeights[string(heightBz)] = heightBz
 		}
 
-		return results, nil
+		return filteredHeights, nil
 	}
 
 	var heightsInitialized bool
@@ -184,30 +227,32 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 	}
 
-	// fetch matching heights
-	results = make([]int64, 0, len(filteredHeights))
-	for _, hBz := range filteredHeights {
-		h := int64FromBytes(hBz)
+	return filteredHeights, nil
+}
 
-		ok, err := idx.Has(h)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			results = append(results, h)
-		}
+// matchAll returns the encoded heights of all indexed blocks.
+func (idx *BlockerIndexer) matchAll(ctx context.Context) (map[string][]byte, error) {
+	prefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix key: %w", err)
+	}
 
-		select {
-		case <-ctx.Done():
-			break
+	it, err := dbm.IteratePrefix(idx.store, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix iterator: %w", err)
+	}
+	defer it.Close()
 
-		default:
+	heights := make(map[string][]byte)
+	for ; it.Valid(); it.Next() {
+		heights[string(it.Value())] = it.Value()
+
+		if ctx.Err() != nil {
+			break
 		}
 	}
 
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
-
-	return results, nil
+	return heights, it.Error()
 }
 
 // matchRange returns all matching block heights that match a given QueryRange
@@ -453,6 +498,77 @@ func (idx *BlockerIndexer) match(
 	return filteredHeights, nil
 }
 
+// Prune deletes the primary keys and the BeginBlock and EndBlock event keys of
+// the heights below retainHeight, and returns the number of deleted keys.
+//
+// The keys are found by iterating the height ordered primary and pruning keys
+// below retainHeight, and deleted in batches of at most pruneBatchSize keys.
+func (idx *BlockerIndexer) Prune(retainHeight int64) (uint64, error) {
+	pruned := uint64(0)
+	for _, prefix := range []string{types.BlockHeightKey, eventKeysPrefix} {
+		start, err := orderedcode.Append(nil, prefix)
+		if err != nil {
+			return pruned, err
+		}
+		end, err := orderedcode.Append(nil, prefix, retainHeight)
+		if err != nil {
+			return pruned, err
+		}
+
+		for more := true; more; {
+			var keys [][]byte
+			keys, more, err = idx.prunableKeys(start, end)
+			if err != nil {
+				return pruned, err
+			}
+			if err := idx.deleteKeys(keys); err != nil {
+				return pruned, err
+			}
+			pruned += uint64(len(keys))
+		}
+	}
+	return pruned, nil
+}
+
+// prunableKeys returns the first pruneBatchSize keys in [start, end), along
+// with the event keys listed by the pruning keys among them, and whether there
+// are more keys in the range.
+func (idx *BlockerIndexer) prunableKeys(start, end []byte) (keys [][]byte, more bool, err error) {
+	it, err := idx.store.Iterator(start, end)
+	if err != nil {
+		return nil, false, err
+	}
+	defer it.Close()
+
+	for n := 0; it.Valid(); it.Next() {
+		if n == pruneBatchSize {
+			return keys, true, nil
+		}
+		n++
+		key := it.Key()
+		keys = append(keys, key)
+		if eventKey, ok := parseEventKeyFromEventKeysKey(key); ok {
+			keys = append(keys, eventKey)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, false, err
+	}
+	return keys, false, nil
+}
+
+func (idx *BlockerIndexer) deleteKeys(keys [][]byte) error {
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
 func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, typ string, height int64) error {
 	heightBz := int64ToBytes(height)
 
@@ -482,6 +598,15 @@ func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, typ
 				if err := batch.Set(key, heightBz); err != nil {
 					return err
 				}
+
+				// list the event key for pruning
+				key, err = eventKeysKey(height, key)
+				if err != nil {
+					return fmt.Errorf("failed to create block index pruning key: %w", err)
+				}
+				if err := batch.Set(key, []byte{}); err != nil {
+					return err
+				}
 			}
 		}
 	}
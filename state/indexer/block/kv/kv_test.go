@@ -128,6 +128,30 @@ func TestBlockIndexer(t *testing.T) {
 			q:       query.MustParse("begin_event.proposer CONTAINS 'FCAA001'"),
 			results: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
 		},
+		"end_event.foo = 2 OR end_event.foo = 4": {
+			q:       query.MustParse("end_event.foo = 2 OR end_event.foo = 4"),
+			results: []int64{2, 4},
+		},
+		"block.height = 5 OR block.height = 100": {
+			q:       query.MustParse("block.height = 5 OR block.height = 100"),
+			results: []int64{5},
+		},
+		"NOT end_event.foo EXISTS": {
+			q:       query.MustParse("NOT end_event.foo EXISTS"),
+			results: []int64{3, 5, 7, 9, 11},
+		},
+		"begin_event.proposer = 'FCAA001' AND NOT end_event.foo <= 5": {
+			q:       query.MustParse("begin_event.proposer = 'FCAA001' AND NOT end_event.foo <= 5"),
+			results: []int64{1, 3, 5, 6, 7, 8, 9, 10, 11},
+		},
+		"(block.height = 3 OR end_event.foo >= 100) AND NOT block.height = 1": {
+			q:       query.MustParse("(block.height = 3 OR end_event.foo >= 100) AND NOT block.height = 1"),
+			results: []int64{3},
+		},
+		"end_event.foo >= 100 OR NOT begin_event.proposer EXISTS": {
+			q:       query.MustParse("end_event.foo >= 100 OR NOT begin_event.proposer EXISTS"),
+			results: []int64{1},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -139,3 +163,73 @@ func TestBlockIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockIndexerPrune(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	indexer := blockidxkv.New(store)
+
+	for i := int64(1); i <= 5; i++ {
+		require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: i},
+			ResultBeginBlock: abci.ResponseBeginBlock{
+				Events: []abci.Event{{Type: "begin_event", Attributes: []abci.EventAttribute{
+					{Key: []byte("proposer"), Value: []byte("FCAA001"), Index: true},
+				}}},
+			},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{{Type: "end_event", Attributes: []abci.EventAttribute{
+					{Key: []byte("foo"), Value: []byte(fmt.Sprintf("%d", i)), Index: true},
+				}}},
+			},
+		}))
+	}
+
+	pruned, err := indexer.Prune(3)
+	require.NoError(t, err)
+	// the primary key, and the two event keys with their pruning keys of
+	// heights 1 and 2
+	require.EqualValues(t, 10, pruned)
+
+	for i := int64(1); i <= 5; i++ {
+		has, err := indexer.Has(i)
+		require.NoError(t, err)
+		require.Equal(t, i >= 3, has, "height %d", i)
+	}
+
+	results, err := indexer.Search(context.Background(), query.MustParse("begin_event.proposer = 'FCAA001'"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 4, 5}, results)
+
+	results, err = indexer.Search(context.Background(), query.MustParse("end_event.foo < 3"))
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
+func TestBlockIndexerPruneInBatches(t *testing.T) {
+	indexer := blockidxkv.New(db.NewMemDB())
+
+	for i := int64(1); i <= 600; i++ {
+		require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: i},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{{Type: "end_event", Attributes: []abci.EventAttribute{
+					{Key: []byte("foo"), Value: []byte("bar/baz"), Index: true},
+				}}},
+			},
+		}))
+	}
+
+	// the primary key, the event key and its pruning key of each height
+	pruned, err := indexer.Prune(501)
+	require.NoError(t, err)
+	require.EqualValues(t, 500*3, pruned)
+
+	results, err := indexer.Search(context.Background(), query.MustParse("end_event.foo = 'bar/baz'"))
+	require.NoError(t, err)
+	require.Len(t, results, 100)
+	require.EqualValues(t, 501, results[0])
+
+	pruned, err = indexer.Prune(501)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+}
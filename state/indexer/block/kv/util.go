@@ -49,6 +49,36 @@ func eventKey(compositeKey, typ, eventValue string, height int64) ([]byte, error
 	)
 }
 
+// eventKeysPrefix is the prefix of the keys listing the event keys of a height,
+// which are ordered by height for pruning. Having no '.', it can't be the
+// composite key of an event.
+const eventKeysPrefix = "event_keys"
+
+func eventKeysKey(height int64, eventKey []byte) ([]byte, error) {
+	return orderedcode.Append(
+		nil,
+		eventKeysPrefix,
+		height,
+		string(eventKey),
+	)
+}
+
+// parseEventKeyFromEventKeysKey returns the event key listed by a key made by
+// eventKeysKey.
+func parseEventKeyFromEventKeysKey(key []byte) ([]byte, bool) {
+	var (
+		prefix, eventKey string
+		height           int64
+	)
+
+	remaining, err := orderedcode.Parse(string(key), &prefix, &height, &eventKey)
+	if err != nil || len(remaining) != 0 || prefix != eventKeysPrefix {
+		return nil, false
+	}
+
+	return []byte(eventKey), true
+}
+
 func parseValueFromPrimaryKey(key []byte) (string, error) {
 	var (
 		compositeKey string
@@ -0,0 +1,142 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/line/ostracon/libs/pubsub/query"
+)
+
+// MatchConditions returns the set of indexed entries (e.g. transaction hashes
+// or block heights, keyed by their string representation) that match all the
+// given conditions.
+type MatchConditions func(ctx context.Context, conditions []query.Condition) (map[string][]byte, error)
+
+// MatchAll returns the set of all indexed entries. It is only used to evaluate
+// negations that are not restricted by any other condition.
+type MatchAll func(ctx context.Context) (map[string][]byte, error)
+
+// EvalExpr evaluates a query expression over an index, using matchConditions
+// to look up runs of AND-joined conditions and combining the results with set
+// union (OR) and difference (NOT).
+//
+// A negation is subtracted from the results of the other operands of the
+// enclosing AND where possible, so that matchAll, which requires a full scan
+// of the index, is only called for negations that stand on their own, e.g.
+// "NOT a.b = 1" or "a.b = 1 OR NOT c.d = 2".
+func EvalExpr(
+	ctx context.Context,
+	e *query.Expr,
+	matchConditions MatchConditions,
+	matchAll MatchAll,
+) (map[string][]byte, error) {
+	if conditions, ok := e.Conjunction(); ok {
+		return matchConditions(ctx, conditions)
+	}
+
+	switch e.Kind {
+	case query.ExprOr:
+		union := make(map[string][]byte)
+		for _, child := range e.Children {
+			matches, err := EvalExpr(ctx, child, matchConditions, matchAll)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range matches {
+				union[k] = v
+			}
+			if err := ctx.Err(); err != nil {
+				break
+			}
+		}
+		return union, nil
+
+	case query.ExprNot:
+		all, err := matchAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return subtract(ctx, all, e.Children[0], matchConditions, matchAll)
+
+	case query.ExprAnd:
+		var (
+			conditions []query.Condition
+			positives  []*query.Expr
+			negations  []*query.Expr
+		)
+		for _, child := range e.Children {
+			if cs, ok := child.Conjunction(); ok {
+				conditions = append(conditions, cs...)
+			} else if child.Kind == query.ExprNot {
+				negations = append(negations, child.Children[0])
+			} else {
+				positives = append(positives, child)
+			}
+		}
+
+		var (
+			matches map[string][]byte
+			err     error
+		)
+		if len(conditions) > 0 {
+			if matches, err = matchConditions(ctx, conditions); err != nil {
+				return nil, err
+			}
+		}
+		for _, child := range positives {
+			if matches != nil && len(matches) == 0 {
+				// No need to evaluate the remaining operands (assuming AND operand).
+				return matches, nil
+			}
+			childMatches, err := EvalExpr(ctx, child, matchConditions, matchAll)
+			if err != nil {
+				return nil, err
+			}
+			if matches == nil {
+				matches = childMatches
+				continue
+			}
+			for k := range matches {
+				if _, ok := childMatches[k]; !ok {
+					delete(matches, k)
+				}
+			}
+		}
+		if matches == nil {
+			// Only negations were given, so they are taken from all entries.
+			if matches, err = matchAll(ctx); err != nil {
+				return nil, err
+			}
+		}
+		for _, negation := range negations {
+			if matches, err = subtract(ctx, matches, negation, matchConditions, matchAll); err != nil {
+				return nil, err
+			}
+		}
+		return matches, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected query expression kind %d", e.Kind)
+	}
+}
+
+// subtract removes the entries matching e from matches.
+func subtract(
+	ctx context.Context,
+	matches map[string][]byte,
+	e *query.Expr,
+	matchConditions MatchConditions,
+	matchAll MatchAll,
+) (map[string][]byte, error) {
+	if len(matches) == 0 {
+		return matches, nil
+	}
+	excluded, err := EvalExpr(ctx, e, matchConditions, matchAll)
+	if err != nil {
+		return nil, err
+	}
+	for k := range excluded {
+		delete(matches, k)
+	}
+	return matches, nil
+}
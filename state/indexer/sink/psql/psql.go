@@ -4,7 +4,6 @@ package psql
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -229,24 +228,120 @@ INSERT INTO `+tableTxResults+` (block_id, index, created_at, tx_hash, tx_result)
 	return nil
 }
 
-// SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
+// SearchBlockEvents queries the sink for the heights of blocks whose events
+// match q, in ascending order of height. It is part of the
+// indexer.EventSink interface.
 func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
-	return nil, errors.New("block search is not supported via the postgres event sink")
+	expr, err := q.Expression()
+	if err != nil {
+		return nil, fmt.Errorf("parsing query conditions: %w", err)
+	}
+
+	qb := new(queryBuilder)
+	chainID := qb.arg(es.chainID)
+	ids, err := qb.matchingIDs("block_id", "tx_id IS NULL", expr)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT height FROM `+tableBlocks+`
+  WHERE chain_id = `+chainID+` AND rowid IN (`+ids+`)
+  ORDER BY height;
+`, qb.args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching block events: %w", err)
+	}
+	defer rows.Close()
+
+	var heights []int64
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, fmt.Errorf("reading block height: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	return heights, rows.Err()
 }
 
-// SearchTxEvents is not implemented by this sink, and reports an error for all queries.
+// SearchTxEvents queries the sink for the results of transactions whose events
+// match q, ordered by height and index within the block.
+// It is part of the indexer.EventSink interface.
 func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("tx search is not supported via the postgres event sink")
+	expr, err := q.Expression()
+	if err != nil {
+		return nil, fmt.Errorf("parsing query conditions: %w", err)
+	}
+
+	qb := new(queryBuilder)
+	chainID := qb.arg(es.chainID)
+	ids, err := qb.matchingIDs("tx_id", "tx_id IS NOT NULL", expr)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (`+tableBlocks+`.rowid = `+tableTxResults+`.block_id)
+  WHERE `+tableBlocks+`.chain_id = `+chainID+` AND `+tableTxResults+`.rowid IN (`+ids+`)
+  ORDER BY `+tableBlocks+`.height, `+tableTxResults+`.index;
+`, qb.args...)
+	if err != nil {
+		return nil, fmt.Errorf("searching tx events: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*abci.TxResult
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData); err != nil {
+			return nil, fmt.Errorf("reading tx_result: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(resultData, txr); err != nil {
+			return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+		}
+		results = append(results, txr)
+	}
+	return results, rows.Err()
 }
 
-// GetTxByHash is not implemented by this sink, and reports an error for all queries.
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if no such transaction has been indexed. It is part of the
+// indexer.EventSink interface.
 func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
-	return nil, errors.New("getTxByHash is not supported via the postgres event sink")
+	var resultData []byte
+	err := es.store.QueryRow(`
+SELECT tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (`+tableBlocks+`.rowid = `+tableTxResults+`.block_id)
+  WHERE `+tableTxResults+`.tx_hash = $1 AND `+tableBlocks+`.chain_id = $2
+  ORDER BY `+tableBlocks+`.height DESC
+  LIMIT 1;
+`, fmt.Sprintf("%X", hash), es.chainID).Scan(&resultData)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("looking up tx_result: %w", err)
+	}
+
+	txr := new(abci.TxResult)
+	if err := proto.Unmarshal(resultData, txr); err != nil {
+		return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+	}
+	return txr, nil
 }
 
-// HasBlock is not implemented by this sink, and reports an error for all queries.
+// HasBlock reports whether the block at height h has been indexed. It is part
+// of the indexer.EventSink interface.
 func (es *EventSink) HasBlock(h int64) (bool, error) {
-	return false, errors.New("hasBlock is not supported via the postgres event sink")
+	var found bool
+	if err := es.store.QueryRow(`
+SELECT EXISTS (SELECT 1 FROM `+tableBlocks+` WHERE height = $1 AND chain_id = $2);
+`, h, es.chainID).Scan(&found); err != nil {
+		return false, fmt.Errorf("looking up block: %w", err)
+	}
+	return found, nil
 }
 
 // Stop closes the underlying PostgreSQL database.
@@ -15,6 +15,7 @@ import (
 	"github.com/adlio/schema"
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/pubsub/query"
 	"github.com/line/ostracon/types"
 	"github.com/ory/dockertest"
 	"github.com/ory/dockertest/docker"
@@ -142,13 +143,17 @@ func TestIndexing(t *testing.T) {
 		verifyBlock(t, 1)
 		verifyBlock(t, 2)
 
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(1) })
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(2) })
+		found, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, found)
+		found, err = indexer.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, found)
 
-		verifyNotImplemented(t, "block search", func() (bool, error) {
-			v, err := indexer.SearchBlockEvents(context.Background(), nil)
-			return v != nil, err
-		})
+		heights, err := indexer.SearchBlockEvents(context.Background(),
+			query.MustParse("begin_event.proposer = 'FCAA001'"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, heights)
 
 		require.NoError(t, verifyTimeStamp(tableBlocks))
 
@@ -181,14 +186,15 @@ func TestIndexing(t *testing.T) {
 		require.NoError(t, verifyTimeStamp(tableTxResults))
 		require.NoError(t, verifyTimeStamp(viewTxEvents))
 
-		verifyNotImplemented(t, "getTxByHash", func() (bool, error) {
-			txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
-			return txr != nil, err
-		})
-		verifyNotImplemented(t, "tx search", func() (bool, error) {
-			txr, err := indexer.SearchTxEvents(context.Background(), nil)
-			return txr != nil, err
-		})
+		txr, err = indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+
+		txrs, err := indexer.SearchTxEvents(context.Background(),
+			query.MustParse("account.owner = 'Ivan'"))
+		require.NoError(t, err)
+		require.Len(t, txrs, 1)
+		assert.Equal(t, txResult, txrs[0])
 
 		// try to insert the duplicate tx events.
 		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
@@ -196,6 +202,92 @@ func TestIndexing(t *testing.T) {
 	})
 }
 
+func TestSearch(t *testing.T) {
+	// Use a distinct chain ID so the results are not mixed up with the records
+	// written by other test cases sharing the database.
+	indexer := &EventSink{store: testDB(), chainID: "search-" + chainID}
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, indexer.IndexBlockEvents(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{makeIndexedEvent("end_event.size", fmt.Sprint(height*10))},
+			},
+		}))
+	}
+
+	txrs := []*abci.TxResult{
+		{Height: 1, Index: 0, Tx: types.Tx("tx-a"), Result: abci.ResponseDeliverTx{Events: []abci.Event{
+			makeIndexedEvent("transfer.amount", "5"),
+			makeIndexedEvent("transfer.sender", "alice"),
+		}}},
+		{Height: 2, Index: 0, Tx: types.Tx("tx-b"), Result: abci.ResponseDeliverTx{Events: []abci.Event{
+			makeIndexedEvent("transfer.amount", "50"),
+			makeIndexedEvent("transfer.sender", "bob"),
+		}}},
+		{Height: 2, Index: 1, Tx: types.Tx("tx-c"), Result: abci.ResponseDeliverTx{Events: []abci.Event{
+			makeIndexedEvent("transfer.amount", "not-a-number"),
+			makeIndexedEvent("transfer.memo", "hello"),
+		}}},
+	}
+	require.NoError(t, indexer.IndexTxEvents(txrs))
+
+	txTests := []struct {
+		query string
+		want  []*abci.TxResult
+	}{
+		{"transfer.sender = 'alice'", txrs[:1]},
+		{"transfer.amount > 5", txrs[1:2]},
+		{"transfer.amount >= 5 AND transfer.amount < 50", txrs[:1]},
+		{"transfer.sender CONTAINS 'o'", txrs[1:2]},
+		{"transfer.memo EXISTS", txrs[2:]},
+		{"transfer.amount EXISTS", txrs},
+		{"tx.height = 2", txrs[1:]},
+		{"tx.height >= 2 AND transfer.sender EXISTS", txrs[1:2]},
+		{fmt.Sprintf("tx.hash = '%x'", types.Tx("tx-c").Hash()), txrs[2:]},
+		{"transfer.sender = 'carol'", nil},
+		{"transfer.sender = 'alice' OR transfer.memo EXISTS", []*abci.TxResult{txrs[0], txrs[2]}},
+		{"NOT transfer.sender = 'alice'", txrs[1:]},
+		{"transfer.amount EXISTS AND NOT (transfer.sender = 'bob' OR tx.height = 1)", txrs[2:]},
+	}
+	for _, tc := range txTests {
+		tc := tc
+		t.Run(tc.query, func(t *testing.T) {
+			got, err := indexer.SearchTxEvents(context.Background(), query.MustParse(tc.query))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	blockTests := []struct {
+		query string
+		want  []int64
+	}{
+		{"block.height = 2", []int64{2}},
+		{"end_event.size >= 20", []int64{2, 3}},
+		{"end_event.size EXISTS AND block.height < 3", []int64{1, 2}},
+		{"end_event.size > 100", nil},
+		{"block.height = 1 OR end_event.size = 30", []int64{1, 3}},
+		{"NOT end_event.size = 20", []int64{1, 3}},
+	}
+	for _, tc := range blockTests {
+		tc := tc
+		t.Run(tc.query, func(t *testing.T) {
+			got, err := indexer.SearchBlockEvents(context.Background(), query.MustParse(tc.query))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	_, err := indexer.SearchTxEvents(context.Background(),
+		query.MustParse("transfer.amount > TIME 2013-05-03T14:45:00Z"))
+	assert.Error(t, err)
+
+	txr, err := indexer.GetTxByHash(types.Tx("no such tx").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, txr)
+}
+
 func TestStop(t *testing.T) {
 	indexer := &EventSink{store: testDB()}
 	require.NoError(t, indexer.Stop())
@@ -319,20 +411,6 @@ SELECT type, height, chain_id FROM `+viewBlockEvents+`
 	}
 }
 
-// verifyNotImplemented calls f and verifies that it returns both a
-// false-valued flag and a non-nil error whose string matching the expected
-// "not supported" message with label prefixed.
-func verifyNotImplemented(t *testing.T, label string, f func() (bool, error)) {
-	t.Helper()
-	t.Logf("Verifying that %q reports it is not implemented", label)
-
-	want := label + " is not supported via the postgres event sink"
-	ok, err := f()
-	assert.False(t, ok)
-	require.NotNil(t, err)
-	assert.Equal(t, want, err.Error())
-}
-
 // waitForInterrupt blocks until a SIGINT is received by the process.
 func waitForInterrupt() {
 	ch := make(chan os.Signal, 1)
@@ -0,0 +1,137 @@
+package psql
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/line/ostracon/libs/pubsub/query"
+	"github.com/line/ostracon/types"
+)
+
+// numericPattern matches attribute values that can be safely cast to the
+// Postgres NUMERIC type. Values that do not match are ignored by range
+// conditions, mirroring the behaviour of the kv indexer.
+const numericPattern = `^-?[0-9]+(\.[0-9]+)?$`
+
+// queryBuilder accumulates the positional arguments for a SQL query, so that
+// the clauses generated for each condition can refer to them as $1, $2, ...
+type queryBuilder struct {
+	args []interface{}
+}
+
+// arg registers v as a query argument and returns its positional placeholder.
+func (b *queryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// conditionClause returns a SQL predicate over the event_attributes view that
+// holds for the attribute rows satisfying c.
+func (b *queryBuilder) conditionClause(c query.Condition) (string, error) {
+	key := "composite_key = " + b.arg(c.CompositeKey)
+
+	switch c.Op {
+	case query.OpExists:
+		return key, nil
+
+	case query.OpEqual:
+		value := operandString(c.Operand)
+		if c.CompositeKey == types.TxHashKey {
+			// Transaction hashes are indexed as upper-case hex strings.
+			value = strings.ToUpper(value)
+		}
+		return key + " AND value = " + b.arg(value), nil
+
+	case query.OpContains:
+		s, ok := c.Operand.(string)
+		if !ok {
+			return "", fmt.Errorf("operand of CONTAINS must be a string, got %T", c.Operand)
+		}
+		return key + " AND strpos(value, " + b.arg(s) + ") > 0", nil
+
+	case query.OpLess, query.OpLessEqual, query.OpGreater, query.OpGreaterEqual:
+		switch c.Operand.(type) {
+		case int64, float64:
+		default:
+			return "", fmt.Errorf("range conditions on %q support only numeric operands, got %T",
+				c.CompositeKey, c.Operand)
+		}
+		// The CASE guards the cast so non-numeric values are skipped instead of
+		// failing the whole query.
+		return fmt.Sprintf("%s AND (CASE WHEN value ~ '%s' THEN value::numeric END) %s %s::numeric",
+			key, numericPattern, sqlOperator(c.Op), b.arg(operandString(c.Operand))), nil
+
+	default:
+		return "", fmt.Errorf("unsupported query operator %v", c.Op)
+	}
+}
+
+// matchingIDs returns a SQL subquery selecting the distinct values of column
+// from the event_attributes view for the rows satisfying the fixed filter
+// predicate, whose events match the query expression e. Conditions are
+// combined by the INTERSECT, UNION and EXCEPT set operations.
+func (b *queryBuilder) matchingIDs(column, filter string, e *query.Expr) (string, error) {
+	all := fmt.Sprintf("SELECT %s FROM event_attributes WHERE %s", column, filter)
+
+	switch e.Kind {
+	case query.ExprCondition:
+		clause, err := b.conditionClause(e.Condition)
+		if err != nil {
+			return "", err
+		}
+		return all + " AND " + clause, nil
+
+	case query.ExprAnd, query.ExprOr:
+		op := "\nINTERSECT\n"
+		if e.Kind == query.ExprOr {
+			op = "\nUNION\n"
+		}
+		parts := make([]string, 0, len(e.Children))
+		for _, child := range e.Children {
+			part, err := b.matchingIDs(column, filter, child)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, "("+part+")")
+		}
+		return strings.Join(parts, op), nil
+
+	case query.ExprNot:
+		part, err := b.matchingIDs(column, filter, e.Children[0])
+		if err != nil {
+			return "", err
+		}
+		return "(" + all + ")\nEXCEPT\n(" + part + ")", nil
+
+	default:
+		return "", fmt.Errorf("unexpected query expression kind %d", e.Kind)
+	}
+}
+
+// sqlOperator returns the SQL comparison operator for a range operator.
+func sqlOperator(op query.Operator) string {
+	switch op {
+	case query.OpLess:
+		return "<"
+	case query.OpLessEqual:
+		return "<="
+	case query.OpGreater:
+		return ">"
+	case query.OpGreaterEqual:
+		return ">="
+	default:
+		panic(fmt.Sprintf("not a range operator: %v", op))
+	}
+}
+
+// operandString renders a query operand in the form the sink stores attribute
+// values.
+func operandString(v interface{}) string {
+	switch t := v.(type) {
+	case time.Time:
+		return t.Format(query.TimeLayout)
+	default:
+		return fmt.Sprint(t)
+	}
+}
@@ -25,6 +25,12 @@ type Metrics struct {
 	BlockAppCommitTime metrics.Gauge
 	// Time of update mempool
 	BlockUpdateMempoolTime metrics.Gauge
+	// Retain height of the last pruning of blocks by the Pruner.
+	PruningRetainHeight metrics.Gauge
+	// Number of blocks, along with their states, pruned by the Pruner.
+	PrunedBlocks metrics.Counter
+	// Number of keys pruned from the indexers by the Pruner.
+	PrunedIndexerKeys metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -71,6 +77,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_update_mempool_time",
 			Help:      "Time of update mempool in ms.",
 		}, labels).With(labelsAndValues...),
+		PruningRetainHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruning_retain_height",
+			Help:      "Retain height of the last pruning of blocks.",
+		}, labels).With(labelsAndValues...),
+		PrunedBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruned_blocks",
+			Help:      "Number of blocks pruned, along with their states and ABCI responses.",
+		}, labels).With(labelsAndValues...),
+		PrunedIndexerKeys: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruned_indexer_keys",
+			Help:      "Number of keys pruned from the indexers.",
+		}, append(labels, "indexer")).With(labelsAndValues...),
 	}
 }
 
@@ -82,5 +106,8 @@ func NopMetrics() *Metrics {
 		BlockCommitTime:        discard.NewGauge(),
 		BlockAppCommitTime:     discard.NewGauge(),
 		BlockUpdateMempoolTime: discard.NewGauge(),
+		PruningRetainHeight:    discard.NewGauge(),
+		PrunedBlocks:           discard.NewCounter(),
+		PrunedIndexerKeys:      discard.NewCounter(),
 	}
 }
@@ -0,0 +1,190 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	cfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/libs/service"
+	"github.com/line/ostracon/state/indexer"
+	"github.com/line/ostracon/state/txindex"
+)
+
+// Pruner is a service that periodically prunes the block store, the state
+// store, along with the ABCI responses, and the indexers, according to the
+// node's pruning config.
+//
+// This is independent of the pruning requested by the application with the
+// retain height of Commit, which is done by the consensus state. The blocks
+// and states within the evidence max age are never pruned, as they are needed
+// to verify evidence.
+type Pruner struct {
+	service.BaseService
+
+	config       *cfg.PruningConfig
+	stateStore   Store
+	blockStore   BlockStore
+	txIndexer    txindex.TxIndexer
+	blockIndexer indexer.BlockIndexer
+	metrics      *Metrics
+
+	// the retain height of the last pruning of the indexers, not to prune them
+	// again when it hasn't changed
+	indexerRetainHeight int64
+}
+
+// PrunerOption sets an optional parameter on the Pruner.
+type PrunerOption func(*Pruner)
+
+// PrunerWithMetrics sets the metrics.
+func PrunerWithMetrics(metrics *Metrics) PrunerOption {
+	return func(p *Pruner) { p.metrics = metrics }
+}
+
+// NewPruner returns a new Pruner.
+func NewPruner(
+	config *cfg.PruningConfig,
+	stateStore Store,
+	blockStore BlockStore,
+	txIndexer txindex.TxIndexer,
+	blockIndexer indexer.BlockIndexer,
+	options ...PrunerOption,
+) *Pruner {
+	p := &Pruner{
+		config:       config,
+		stateStore:   stateStore,
+		blockStore:   blockStore,
+		txIndexer:    txIndexer,
+		blockIndexer: blockIndexer,
+		metrics:      NopMetrics(),
+	}
+	p.BaseService = *service.NewBaseService(nil, "Pruner", p)
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// OnStart implements service.Service by starting the pruning routine.
+func (p *Pruner) OnStart() error {
+	go p.pruneRoutine()
+	return nil
+}
+
+func (p *Pruner) pruneRoutine() {
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.Prune(); err != nil {
+				p.Logger.Error("failed to prune", "err", err)
+			}
+		case <-p.Quit():
+			return
+		}
+	}
+}
+
+// Prune prunes the blocks and states, and then the indexers, up to the retain
+// heights given by the latest state and the pruning config.
+func (p *Pruner) Prune() error {
+	state, err := p.stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	if state.IsEmpty() {
+		return nil
+	}
+
+	if p.config.KeepRecent > 0 {
+		if err := p.pruneBlocks(p.blockRetainHeight(state)); err != nil {
+			return err
+		}
+	}
+
+	if p.config.IndexerKeepRecent > 0 {
+		if err := p.pruneIndexers(state.LastBlockHeight - p.config.IndexerKeepRecent + 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Pruner) pruneBlocks(retainHeight int64) error {
+	base := p.blockStore.Base()
+	if retainHeight <= base {
+		return nil
+	}
+
+	pruned, err := p.blockStore.PruneBlocksKeepingEvery(retainHeight, p.config.KeepEvery)
+	if err != nil {
+		return fmt.Errorf("failed to prune block store: %w", err)
+	}
+	if err := p.stateStore.PruneStates(base, retainHeight); err != nil {
+		return fmt.Errorf("failed to prune state database: %w", err)
+	}
+
+	p.metrics.PruningRetainHeight.Set(float64(retainHeight))
+	p.metrics.PrunedBlocks.Add(float64(pruned))
+	p.Logger.Info("pruned blocks", "pruned", pruned, "retain_height", retainHeight)
+	return nil
+}
+
+func (p *Pruner) pruneIndexers(retainHeight int64) error {
+	if retainHeight <= p.indexerRetainHeight {
+		return nil
+	}
+
+	prunedTxs, err := p.txIndexer.Prune(retainHeight)
+	if err != nil {
+		return fmt.Errorf("failed to prune tx indexer: %w", err)
+	}
+	p.metrics.PrunedIndexerKeys.With("indexer", "tx").Add(float64(prunedTxs))
+
+	prunedBlocks, err := p.blockIndexer.Prune(retainHeight)
+	if err != nil {
+		return fmt.Errorf("failed to prune block indexer: %w", err)
+	}
+	p.metrics.PrunedIndexerKeys.With("indexer", "block").Add(float64(prunedBlocks))
+
+	p.indexerRetainHeight = retainHeight
+	p.Logger.Info("pruned indexers", "tx_keys", prunedTxs, "block_keys", prunedBlocks,
+		"retain_height", retainHeight)
+	return nil
+}
+
+// blockRetainHeight returns the lowest height whose block and state must be
+// kept. It keeps the KeepRecent latest blocks, and never prunes any block
+// within the evidence max age.
+func (p *Pruner) blockRetainHeight(state State) int64 {
+	retainHeight := state.LastBlockHeight - p.config.KeepRecent + 1
+	if evidenceHeight := p.evidenceRetainHeight(state); evidenceHeight < retainHeight {
+		retainHeight = evidenceHeight
+	}
+	return retainHeight
+}
+
+// evidenceRetainHeight returns the lowest height evidence can still be
+// committed for, which is the lowest height that is within the evidence max
+// age either in blocks or in time.
+func (p *Pruner) evidenceRetainHeight(state State) int64 {
+	params := state.ConsensusParams.Evidence
+	retainHeight := state.LastBlockHeight - params.MaxAgeNumBlocks
+
+	// The block times increase with the heights, so the lowest block within
+	// the max age duration is looked up by binary search.
+	base := p.blockStore.Base()
+	if base <= 0 || retainHeight <= base {
+		return retainHeight
+	}
+	minTime := state.LastBlockTime.Add(-params.MaxAgeDuration)
+	i := sort.Search(int(retainHeight-base), func(i int) bool {
+		meta := p.blockStore.LoadBlockMeta(base + int64(i))
+		return meta == nil || !meta.Header.Time.Before(minTime)
+	})
+	return base + int64(i)
+}
@@ -0,0 +1,128 @@
+package state_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/line/ostracon/abci/types"
+	cfg "github.com/line/ostracon/config"
+	tmstate "github.com/line/ostracon/proto/ostracon/state"
+	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	sm "github.com/line/ostracon/state"
+	blockidxkv "github.com/line/ostracon/state/indexer/block/kv"
+	"github.com/line/ostracon/state/mocks"
+	"github.com/line/ostracon/state/txindex/kv"
+	"github.com/line/ostracon/types"
+)
+
+// setupPrunerStores returns a state store of 100 heights whose ABCI responses
+// are saved, and a block store whose block at height h was made at genesis
+// time + h seconds.
+func setupPrunerStores(t *testing.T, evidenceParams tmproto.EvidenceParams) (sm.Store, *mocks.BlockStore) {
+	state, stateDB, _ := makeState(1, 101)
+	stateStore := sm.NewStore(stateDB)
+	genesisTime := time.Now()
+
+	state.LastBlockTime = genesisTime.Add(time.Duration(state.LastBlockHeight) * time.Second)
+	state.ConsensusParams.Evidence = evidenceParams
+	require.NoError(t, stateStore.Save(state))
+	for h := int64(1); h <= state.LastBlockHeight; h++ {
+		require.NoError(t, stateStore.SaveABCIResponses(h, &tmstate.ABCIResponses{
+			DeliverTxs: []*abci.ResponseDeliverTx{{Data: []byte{1}}},
+		}))
+	}
+
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("LoadBlockMeta", mock.AnythingOfType("int64")).Return(func(h int64) *types.BlockMeta {
+		return &types.BlockMeta{Header: types.Header{Height: h, Time: genesisTime.Add(time.Duration(h) * time.Second)}}
+	})
+	blockStore.On("PruneBlocksKeepingEvery", mock.AnythingOfType("int64"), mock.AnythingOfType("int64")).Return(
+		func(h int64, _ int64) uint64 { return uint64(h - 1) }, nil)
+	return stateStore, blockStore
+}
+
+func TestPrunerBlocks(t *testing.T) {
+	testCases := map[string]struct {
+		keepRecent         int64
+		keepEvery          int64
+		maxAgeNumBlocks    int64
+		maxAgeDuration     time.Duration
+		expectRetainHeight int64 // 0 if nothing is pruned
+	}{
+		"keep recent":                {10, 0, 5, time.Second, 91},
+		"keep every":                 {10, 25, 5, time.Second, 91},
+		"evidence max age in blocks": {10, 0, 20, time.Second, 80},
+		"evidence max age in time":   {10, 0, 5, 30 * time.Second, 70},
+		"evidence max age in both":   {10, 0, 20, 30 * time.Second, 70},
+		"nothing to prune":           {100, 0, 5, time.Second, 0},
+		"everything within max age":  {10, 0, 99, time.Second, 0},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			stateStore, blockStore := setupPrunerStores(t, tmproto.EvidenceParams{
+				MaxAgeNumBlocks: tc.maxAgeNumBlocks,
+				MaxAgeDuration:  tc.maxAgeDuration,
+				MaxBytes:        1000,
+			})
+
+			config := cfg.TestPruningConfig()
+			config.KeepRecent = tc.keepRecent
+			config.KeepEvery = tc.keepEvery
+			pruner := sm.NewPruner(config, stateStore, blockStore,
+				kv.NewTxIndex(dbm.NewMemDB()), blockidxkv.New(dbm.NewMemDB()))
+			require.NoError(t, pruner.Prune())
+
+			if tc.expectRetainHeight == 0 {
+				blockStore.AssertNotCalled(t, "PruneBlocksKeepingEvery", mock.Anything, mock.Anything)
+				_, err := stateStore.LoadABCIResponses(1)
+				assert.NoError(t, err)
+				return
+			}
+
+			blockStore.AssertCalled(t, "PruneBlocksKeepingEvery", tc.expectRetainHeight, tc.keepEvery)
+			_, err := stateStore.LoadABCIResponses(tc.expectRetainHeight - 1)
+			assert.Error(t, err)
+			_, err = stateStore.LoadABCIResponses(tc.expectRetainHeight)
+			assert.NoError(t, err)
+			_, err = stateStore.LoadValidators(tc.expectRetainHeight)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPrunerIndexers(t *testing.T) {
+	stateStore, blockStore := setupPrunerStores(t, types.DefaultEvidenceParams())
+
+	txIndexer := kv.NewTxIndex(dbm.NewMemDB())
+	blockIndexer := blockidxkv.New(dbm.NewMemDB())
+	for h := int64(90); h <= 100; h++ {
+		require.NoError(t, txIndexer.Index(&abci.TxResult{Height: h, Tx: types.Tx(fmt.Sprint(h))}))
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{Header: types.Header{Height: h}}))
+	}
+
+	config := cfg.TestPruningConfig()
+	config.IndexerKeepRecent = 5
+	pruner := sm.NewPruner(config, stateStore, blockStore, txIndexer, blockIndexer)
+	require.NoError(t, pruner.Prune())
+
+	// the blocks are within the evidence max age, and not pruned anyway
+	blockStore.AssertNotCalled(t, "PruneBlocksKeepingEvery", mock.Anything, mock.Anything)
+
+	for h := int64(90); h <= 100; h++ {
+		res, err := txIndexer.Get(types.Tx(fmt.Sprint(h)).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, h > 95, res != nil, "height %d", h)
+
+		has, err := blockIndexer.Has(h)
+		require.NoError(t, err)
+		assert.Equal(t, h > 95, has, "height %d", h)
+	}
+}
@@ -296,7 +296,7 @@ func (state State) MakeBlock(
 		proof,
 	)
 
-	return block, block.MakePartSet(types.BlockPartSizeBytes)
+	return block, block.MakePartSetWithParity(types.BlockPartSizeBytes, state.ConsensusParams.Block.ErasureParityPercent)
 }
 
 // MedianTime computes a median time for a given Commit (based on Timestamp field of votes messages) and the
@@ -1197,6 +1197,26 @@ func TestConsensusParamsChangesSaveLoad(t *testing.T) {
 	}
 }
 
+func TestVoteExtensionsEnableHeightUpdate(t *testing.T) {
+	tearDown, _, state := setupTestCase(t)
+	defer tearDown(t)
+
+	// The app enables the vote extensions from the next height.
+	cp := state.ConsensusParams
+	cp.ABCI.VoteExtensionsEnableHeight = state.LastBlockHeight + 2
+	header, blockID, responses := makeHeaderPartsResponsesParams(state, cp)
+	state, err := sm.UpdateState(state, blockID, &header, responses, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, header.Height+1, state.ConsensusParams.ABCI.VoteExtensionsEnableHeight)
+	assert.EqualValues(t, header.Height+1, state.LastHeightConsensusParamsChanged)
+
+	// but it can't disable them once enabled.
+	cp.ABCI.VoteExtensionsEnableHeight = 0
+	header, blockID, responses = makeHeaderPartsResponsesParams(state, cp)
+	_, err = sm.UpdateState(state, blockID, &header, responses, nil)
+	assert.Error(t, err)
+}
+
 func TestStateProto(t *testing.T) {
 	tearDown, _, state := setupTestCase(t)
 	defer tearDown(t)
@@ -20,8 +20,16 @@ import (
 
 const (
 	tagKeySeparator = "/"
+
+	// pruneBatchSize is the number of deleted keys from which a batch of
+	// deletions is written when pruning.
+	pruneBatchSize = 1000
 )
 
+// pruneHeightKey is the key of the lowest height not pruned yet. Having no
+// separator, it's neither a height nor an event key.
+var pruneHeightKey = []byte("pruneHeight")
+
 var _ txindex.TxIndexer = (*TxIndex)(nil)
 
 // TxIndex is the simplest possible indexer, backed by key-value storage (levelDB).
@@ -132,7 +140,124 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 	return b.WriteSync()
 }
 
-func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Batch) error {
+// Prune deletes the transactions of the heights below retainHeight, along with
+// the keys indexing them by height and by events. It returns the number of
+// deleted keys.
+//
+// The heights are pruned in order from the lowest one not pruned yet, which is
+// saved along with each batch of deletions, and the event keys of a transaction
+// are made again from its events.
+func (txi *TxIndex) Prune(retainHeight int64) (uint64, error) {
+	height, err := txi.pruneHeight()
+	if err != nil || height >= retainHeight {
+		return 0, err
+	}
+
+	batch := txi.store.NewBatch()
+	defer func() { batch.Close() }()
+
+	pruned, batchSize := uint64(0), 0
+	for ; height < retainHeight; height++ {
+		keys, err := txi.prunableKeys(height)
+		if err != nil {
+			return pruned, err
+		}
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				return pruned, err
+			}
+		}
+		batchSize += len(keys)
+
+		if batchSize >= pruneBatchSize {
+			if err := txi.writePruneBatch(batch, height+1); err != nil {
+				return pruned, err
+			}
+			batch.Close()
+			batch = txi.store.NewBatch()
+			pruned += uint64(batchSize)
+			batchSize = 0
+		}
+	}
+	if err := txi.writePruneBatch(batch, height); err != nil {
+		return pruned, err
+	}
+	return pruned + uint64(batchSize), nil
+}
+
+// pruneHeight returns the lowest height not pruned yet.
+func (txi *TxIndex) pruneHeight() (int64, error) {
+	bz, err := txi.store.Get(pruneHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) == 0 {
+		return 1, nil
+	}
+	return strconv.ParseInt(string(bz), 10, 64)
+}
+
+// writePruneBatch writes the deletions of batch, and saves height as the lowest
+// height not pruned yet.
+func (txi *TxIndex) writePruneBatch(batch dbm.Batch, height int64) error {
+	if err := batch.Set(pruneHeightKey, []byte(strconv.FormatInt(height, 10))); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// prunableKeys returns the height keys of the transactions of the given height,
+// along with their event keys, and their hash keys unless they have been
+// indexed again at another height.
+func (txi *TxIndex) prunableKeys(height int64) ([][]byte, error) {
+	heightPrefix := startKey(types.TxHeightKey, height, height)
+	it, err := dbm.IteratePrefix(txi.store, heightPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var (
+		keys   [][]byte
+		hashes [][]byte
+	)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+		hashes = append(hashes, it.Value())
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	it.Close()
+
+	for i, hash := range hashes {
+		index, err := strconv.ParseUint(string(keys[i][len(heightPrefix):]), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tx height key %q: %w", keys[i], err)
+		}
+		result, err := txi.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			continue
+		}
+
+		// The same tx may have been indexed again at another height, in which
+		// case its hash key is kept, and only the events it had then are known.
+		indexed := *result
+		indexed.Height, indexed.Index = height, uint32(index)
+		keys = append(keys, txi.eventKeys(&indexed)...)
+		if result.Height == height && result.Index == uint32(index) {
+			keys = append(keys, hash)
+		}
+	}
+	return keys, nil
+}
+
+// eventKeys returns the keys indexing the transaction by its events.
+func (txi *TxIndex) eventKeys(result *abci.TxResult) [][]byte {
+	var keys [][]byte
 	for _, event := range result.Result.Events {
 		// only index events with a non-empty type
 		if len(event.Type) == 0 {
@@ -140,18 +265,21 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 		}
 
 		for _, attr := range event.Attributes {
-			if len(attr.Key) == 0 {
+			if len(attr.Key) == 0 || !attr.GetIndex() {
 				continue
 			}
-
-			// index if `index: true` is set
 			compositeTag := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
-			if attr.GetIndex() {
-				err := store.Set(keyForEvent(compositeTag, attr.Value, result), hash)
-				if err != nil {
-					return err
-				}
-			}
+			keys = append(keys, keyForEvent(compositeTag, attr.Value, result))
+		}
+	}
+	return keys
+}
+
+func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Batch) error {
+	// index if `index: true` is set
+	for _, key := range txi.eventKeys(result) {
+		if err := store.Set(key, hash); err != nil {
+			return err
 		}
 	}
 
@@ -160,12 +288,11 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 
 // Search performs a search using the given query.
 //
-// It breaks the query into conditions (like "tx.height > 5"). For each
-// condition, it queries the DB index. One special use cases here: (1) if
-// "tx.hash" is found, it returns tx result for it (2) for range queries it is
-// better for the client to provide both lower and upper bounds, so we are not
-// performing a full scan. Results from querying indexes are then intersected
-// and returned to the caller, in no particular order.
+// The query is evaluated as a tree of conditions joined by AND, OR and NOT.
+// Each run of AND-joined conditions is looked up by matchConditions, and the
+// results are then combined by set union (OR) and difference (NOT). A NOT that
+// is not restricted by any other condition of an AND requires a scan over all
+// indexed transactions.
 //
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
@@ -177,15 +304,47 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
-	var hashesInitialized bool
-	filteredHashes := make(map[string][]byte)
-
-	// get a list of conditions (like "tx.height > 5")
-	conditions, err := q.Conditions()
+	expr, err := q.Expression()
 	if err != nil {
 		return nil, fmt.Errorf("error during parsing conditions from query: %w", err)
 	}
 
+	filteredHashes, err := indexer.EvalExpr(ctx, expr, txi.matchConditions, txi.matchAll)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(filteredHashes))
+	for _, h := range filteredHashes {
+		res, err := txi.Get(h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+		}
+		results = append(results, res)
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break
+		default:
+		}
+	}
+
+	return results, nil
+}
+
+// matchConditions returns the hashes of the transactions matching all of the
+// given conditions.
+//
+// It queries the DB index for each condition. One special use cases here: (1)
+// if "tx.hash" is found, it returns the hash of that tx alone (2) for range
+// queries it is better for the client to provide both lower and upper bounds,
+// so we are not performing a full scan. Results from querying indexes are then
+// intersected and returned to the caller, in no particular order.
+func (txi *TxIndex) matchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
+	var hashesInitialized bool
+	filteredHashes := make(map[string][]byte)
+
 	// if there is a hash condition, return the result immediately
 	hash, ok, err := lookForHash(conditions)
 	if err != nil {
@@ -194,12 +353,11 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		res, err := txi.Get(hash)
 		switch {
 		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
-		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
+			return nil, fmt.Errorf("error while retrieving the result: %w", err)
+		case res != nil:
+			filteredHashes[string(hash)] = hash
 		}
+		return filteredHashes, nil
 	}
 
 	// conditions to skip because they're handled before "everything else"
@@ -251,23 +409,30 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	for _, h := range filteredHashes {
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
-		}
-		results = append(results, res)
+	return filteredHashes, nil
+}
+
+// matchAll returns the hashes of all indexed transactions, using the height
+// index which is written for every transaction.
+func (txi *TxIndex) matchAll(ctx context.Context) (map[string][]byte, error) {
+	hashes := make(map[string][]byte)
+
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		hashes[string(it.Value())] = it.Value()
 
 		// Potentially exit early.
-		select {
-		case <-ctx.Done():
+		if ctx.Err() != nil {
 			break
-		default:
 		}
 	}
 
-	return results, nil
+	return hashes, it.Error()
 }
 
 func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
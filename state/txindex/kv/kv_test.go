@@ -313,6 +313,154 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchDisjunctionAndNegation(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	transfer := func(tx, sender, recipient string, height int64) *abci.TxResult {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "transfer", Attributes: []abci.EventAttribute{
+				{Key: []byte("sender"), Value: []byte(sender), Index: true},
+				{Key: []byte("recipient"), Value: []byte(recipient), Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(tx)
+		txResult.Height = height
+		return txResult
+	}
+	txs := []*abci.TxResult{
+		transfer("tx1", "alice", "bob", 1),
+		transfer("tx2", "bob", "carol", 2),
+		transfer("tx3", "carol", "alice", 3),
+		transfer("tx4", "dave", "erin", 4),
+	}
+	for _, txResult := range txs {
+		require.NoError(t, indexer.Index(txResult))
+	}
+
+	testCases := []struct {
+		q       string
+		results []string
+	}{
+		{"transfer.sender = 'alice' OR transfer.recipient = 'alice'", []string{"tx1", "tx3"}},
+		{"NOT transfer.sender = 'alice'", []string{"tx2", "tx3", "tx4"}},
+		{"transfer.sender EXISTS AND NOT transfer.sender = 'alice'", []string{"tx2", "tx3", "tx4"}},
+		{"NOT (transfer.sender = 'alice' OR transfer.recipient = 'alice')", []string{"tx2", "tx4"}},
+		{"(transfer.sender = 'bob' OR transfer.recipient = 'bob') AND tx.height > 1", []string{"tx2"}},
+		{"(transfer.sender = 'bob' OR transfer.sender = 'carol') AND NOT transfer.recipient = 'alice'", []string{"tx2"}},
+		{"tx.height < 2 OR tx.height > 3", []string{"tx1", "tx4"}},
+		{"transfer.sender = 'nobody' OR NOT transfer.recipient EXISTS", []string{}},
+		{"NOT tx.height >= 1", []string{}},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(ctx, query.MustParse(tc.q))
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(results))
+			for _, txr := range results {
+				got = append(got, string(txr.Tx))
+			}
+			assert.ElementsMatch(t, tc.results, got)
+		})
+	}
+}
+
+func TestTxIndexPrune(t *testing.T) {
+	store := db.NewMemDB()
+	indexer := NewTxIndex(store)
+
+	txResult := func(tx string, height int64, index uint32) *abci.TxResult {
+		return &abci.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     types.Tx(tx),
+			Result: abci.ResponseDeliverTx{
+				Code: abci.CodeTypeOK,
+				Events: []abci.Event{{Type: "account", Attributes: []abci.EventAttribute{
+					{Key: []byte("owner"), Value: []byte("addr/" + tx), Index: true},
+				}}},
+			},
+		}
+	}
+	for h := int64(1); h <= 5; h++ {
+		require.NoError(t, indexer.Index(txResult(fmt.Sprintf("tx%d", h), h, 0)))
+	}
+	// "tx1" is included again at a retained height
+	require.NoError(t, indexer.Index(txResult("tx1", 4, 1)))
+
+	pruned, err := indexer.Prune(3)
+	require.NoError(t, err)
+	// the height and event keys of "tx1" and "tx2", and the hash key of "tx2"
+	assert.EqualValues(t, 5, pruned)
+
+	for h, expected := range map[int64]bool{2: false, 3: true, 5: true} {
+		res, err := indexer.Get(types.Tx(fmt.Sprintf("tx%d", h)).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, expected, res != nil, "tx%d", h)
+	}
+	res, err := indexer.Get(types.Tx("tx1").Hash())
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.EqualValues(t, 4, res.Height)
+
+	// the event keys are pruned even with a separator in their value
+	for _, key := range []string{"account.owner/addr/tx1/1/0", "account.owner/addr/tx2/2/0"} {
+		has, err := store.Has([]byte(key))
+		require.NoError(t, err)
+		assert.False(t, has, key)
+	}
+	results, err := indexer.Search(context.Background(), query.MustParse("account.owner = 'addr/tx1'"))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.EqualValues(t, 4, results[0].Height)
+
+	results, err = indexer.Search(context.Background(), query.MustParse("tx.height < 3"))
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	// nothing is left to prune
+	pruned, err = indexer.Prune(3)
+	require.NoError(t, err)
+	assert.Zero(t, pruned)
+}
+
+func TestTxIndexPruneInBatches(t *testing.T) {
+	store := db.NewMemDB()
+	indexer := NewTxIndex(store)
+
+	for h := int64(1); h <= 600; h++ {
+		for i := uint32(0); i < 2; i++ {
+			txResult := txResultWithEvents([]abci.Event{{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: []byte("number"), Value: []byte("1"), Index: true},
+			}}})
+			txResult.Tx = types.Tx(fmt.Sprintf("tx%d/%d", h, i))
+			txResult.Height, txResult.Index = h, i
+			require.NoError(t, indexer.Index(txResult))
+		}
+	}
+
+	// the height, event and hash keys of two txs by height
+	pruned, err := indexer.Prune(501)
+	require.NoError(t, err)
+	assert.EqualValues(t, 500*6, pruned)
+
+	bz, err := store.Get(pruneHeightKey)
+	require.NoError(t, err)
+	assert.Equal(t, "501", string(bz))
+
+	results, err := indexer.Search(context.Background(), query.MustParse("account.number = 1"))
+	require.NoError(t, err)
+	assert.Len(t, results, 200)
+
+	pruned, err = indexer.Prune(400)
+	require.NoError(t, err)
+	assert.Zero(t, pruned)
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
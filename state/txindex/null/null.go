@@ -32,3 +32,8 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
 	return []*abci.TxResult{}, nil
 }
+
+// Prune is a noop and always returns 0.
+func (txi *TxIndex) Prune(retainHeight int64) (uint64, error) {
+	return 0, nil
+}
@@ -0,0 +1,31 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/line/ostracon/types"
+)
+
+// ReplayVoterElections replays the voter elections of the heights from to to
+// (inclusive) from the validator sets, proof hashes and voter params stored
+// in ss. If voterParams is not nil, it is used instead of the stored voter
+// params, to see how the elections would have turned out with it.
+func ReplayVoterElections(
+	ss Store,
+	from, to int64,
+	voterParams *types.VoterParams,
+) (*types.VoterElectionStats, error) {
+	if from <= 0 || from > to {
+		return nil, fmt.Errorf("invalid height range [%d, %d]", from, to)
+	}
+
+	stats := types.NewVoterElectionStats()
+	for height := from; height <= to; height++ {
+		validators, voters, params, _, err := ss.LoadVoters(height, voterParams)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load voters at height %d: %w", height, err)
+		}
+		stats.AddElection(validators, voters, params)
+	}
+	return stats, nil
+}
@@ -0,0 +1,38 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/state"
+	"github.com/line/ostracon/types"
+)
+
+func TestReplayVoterElections(t *testing.T) {
+	stateStore := setupStateStore(t, 100)
+	s, err := stateStore.Load()
+	require.NoError(t, err)
+
+	stats, err := state.ReplayVoterElections(stateStore, 100, 101, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats.Elections)
+	require.Len(t, stats.Validators, s.Validators.Size())
+	for _, val := range stats.Validators {
+		assert.Equal(t, 2, val.Candidacies)
+		assert.Equal(t, 2, val.Elected)
+	}
+	assert.InDelta(t, 0.4, stats.MaxByzantineWeight, 1e-9)
+
+	// the stored voter params can be overridden
+	voterParams := &types.VoterParams{VoterElectionThreshold: 1, MaxTolerableByzantinePercentage: 1}
+	stats, err = state.ReplayVoterElections(stateStore, 100, 101, voterParams)
+	require.NoError(t, err)
+	assert.Less(t, stats.MaxByzantineWeight, float64(1)/3)
+
+	_, err = state.ReplayVoterElections(stateStore, 100, 110, nil)
+	assert.Error(t, err)
+	_, err = state.ReplayVoterElections(stateStore, 101, 100, nil)
+	assert.Error(t, err)
+}
@@ -195,7 +195,7 @@ func setupVars(t *testing.T) {
 		BlockID:          blockId,
 	}
 	v := vote.ToProto()
-	require.NoError(t, (*privVals[index]).SignVote(chainId, v))
+	require.NoError(t, (*privVals[index]).SignVote(chainId, v, false))
 	vote.Signature = v.Signature
 	vote.Timestamp = v.Timestamp
 	// commit
@@ -107,6 +107,13 @@ func (bs *BlockStore) LoadBlock(height int64) *types.Block {
 		}
 		buf = append(buf, part.Bytes...)
 	}
+	// The parts of an erasure coded block hold the length of the block, the
+	// block and a padding, followed by the parity parts.
+	if len(buf) > blockMeta.BlockSize {
+		if data, err := types.ErasureCodedData(buf); err == nil && len(data) == blockMeta.BlockSize {
+			buf = data
+		}
+	}
 	err := proto.Unmarshal(buf, pbb)
 	if err != nil {
 		// NOTE: The existence of meta should imply the existence of the
@@ -244,8 +251,41 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 	return commit
 }
 
+// LoadBlockExtendedCommit returns the ExtendedCommit for the given height,
+// which carries the vote extensions of the precommits. It is only saved when
+// the vote extensions are enabled at that height, so the proposer of the next
+// height can hand them to the app.
+// If no extended commit is found for the given height, it returns nil.
+func (bs *BlockStore) LoadBlockExtendedCommit(height int64) *types.ExtendedCommit {
+	var pbec = new(tmproto.ExtendedCommit)
+	bz, err := bs.db.Get(calcExtCommitKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil
+	}
+	err = proto.Unmarshal(bz, pbec)
+	if err != nil {
+		panic(fmt.Errorf("error reading block extended commit: %w", err))
+	}
+	extCommit, err := types.ExtendedCommitFromProto(pbec)
+	if err != nil {
+		panic(fmt.Errorf("error from proto extended commit: %w", err))
+	}
+	return extCommit
+}
+
 // PruneBlocks removes block up to (but not including) a height. It returns number of blocks pruned.
 func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
+	return bs.PruneBlocksKeepingEvery(height, 0)
+}
+
+// PruneBlocksKeepingEvery removes block up to (but not including) a height,
+// except the blocks at the heights that are multiples of keepEvery, if it's
+// positive. The base becomes height, and the kept blocks can still be loaded
+// by height or hash. It returns number of blocks pruned.
+func (bs *BlockStore) PruneBlocksKeepingEvery(height int64, keepEvery int64) (uint64, error) {
 	if height <= 0 {
 		return 0, fmt.Errorf("height must be greater than 0")
 	}
@@ -267,8 +307,11 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	flush := func(batch dbm.Batch, base int64) error {
 		// We can't trust batches to be atomic, so update base first to make sure noone
 		// tries to access missing blocks.
+		// The base is never lowered, in case blocks are pruned concurrently.
 		bs.mtx.Lock()
-		bs.base = base
+		if base > bs.base {
+			bs.base = base
+		}
 		bs.mtx.Unlock()
 		bs.saveState()
 
@@ -281,6 +324,9 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	}
 
 	for h := base; h < height; h++ {
+		if keepEvery > 0 && h%keepEvery == 0 {
+			continue
+		}
 		meta := bs.LoadBlockMeta(h)
 		if meta == nil { // assume already deleted
 			continue
@@ -297,6 +343,9 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
 			return 0, err
 		}
+		if err := batch.Delete(calcExtCommitKey(h)); err != nil {
+			return 0, err
+		}
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
 			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
 				return 0, err
@@ -329,6 +378,25 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 //             we need this to reload the precommits to catch-up nodes to the
 //             most recent height.  Otherwise they'd stall at H-1.
 func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
+	bs.saveBlock(block, blockParts, seenCommit, nil)
+}
+
+// SaveBlockWithExtendedCommit persists the given block and blockParts like
+// SaveBlock, along with the given extended commit, whose commit is saved as
+// the seen commit.
+func (bs *BlockStore) SaveBlockWithExtendedCommit(block *types.Block, blockParts *types.PartSet,
+	seenExtCommit *types.ExtendedCommit) {
+	if seenExtCommit == nil {
+		panic("BlockStore can only save a non-nil extended commit")
+	}
+	if err := seenExtCommit.EnsureExtensions(); err != nil {
+		panic(fmt.Errorf("invalid extended commit: %w", err))
+	}
+	bs.saveBlock(block, blockParts, seenExtCommit.ToCommit(), seenExtCommit)
+}
+
+func (bs *BlockStore) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit,
+	seenExtCommit *types.ExtendedCommit) {
 	if block == nil {
 		panic("BlockStore can only save a non-nil block")
 	}
@@ -381,6 +449,14 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 		panic(err)
 	}
 
+	// Save the extended commit, if any, for the proposer of the next height.
+	if seenExtCommit != nil {
+		extCommitBytes := mustEncode(seenExtCommit.ToProto())
+		if err := bs.db.Set(calcExtCommitKey(height), extCommitBytes); err != nil {
+			panic(err)
+		}
+	}
+
 	// Done!
 	bs.mtx.Lock()
 	bs.height = height
@@ -446,6 +522,10 @@ func calcSeenCommitKey(height int64) []byte {
 	return []byte(fmt.Sprintf("SC:%v", height))
 }
 
+func calcExtCommitKey(height int64) []byte {
+	return []byte(fmt.Sprintf("EC:%v", height))
+}
+
 func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
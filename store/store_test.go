@@ -510,6 +510,47 @@ func TestPruneBlocks(t *testing.T) {
 	assert.Nil(t, bs.LoadBlock(1501))
 }
 
+func TestPruneBlocksKeepingEvery(t *testing.T) {
+	config := cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	stateStore := sm.NewStore(dbm.NewMemDB())
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	bs := NewBlockStore(dbm.NewMemDB())
+
+	for h := int64(1); h <= 100; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		partSet := block.MakePartSet(2)
+		seenCommit := makeTestCommit(h, tmtime.Now())
+		bs.SaveBlock(block, partSet, seenCommit)
+	}
+
+	pruned, err := bs.PruneBlocksKeepingEvery(50, 20)
+	require.NoError(t, err)
+	assert.EqualValues(t, 47, pruned)
+	assert.EqualValues(t, 50, bs.Base())
+
+	// pruning again doesn't touch the blocks kept below the base
+	pruned, err = bs.PruneBlocksKeepingEvery(70, 20)
+	require.NoError(t, err)
+	assert.EqualValues(t, 19, pruned)
+	assert.EqualValues(t, 70, bs.Base())
+
+	for h := int64(1); h < 70; h++ {
+		if h%20 == 0 {
+			block := bs.LoadBlock(h)
+			require.NotNil(t, block, "block %d", h)
+			require.NotNil(t, bs.LoadBlockByHash(block.Hash()), "block %d", h)
+			require.NotNil(t, bs.LoadBlockCommit(h), "block %d", h)
+		} else {
+			require.Nil(t, bs.LoadBlock(h), "block %d", h)
+		}
+	}
+	for h := int64(70); h <= 100; h++ {
+		require.NotNil(t, bs.LoadBlock(h), "block %d", h)
+	}
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := freshBlockStore()
 	height := int64(10)
@@ -579,6 +620,60 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	require.Nil(t, blockAtHeightPlus2, "expecting an unsuccessful load of Height()+2")
 }
 
+func TestLoadErasureCodedBlock(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewOCLogger(new(bytes.Buffer)))
+	defer cleanup()
+	block := makeBlock(bs.Height()+1, state, new(types.Commit))
+
+	partSet := block.MakePartSetWithParity(64, 50)
+	bs.SaveBlock(block, partSet, makeTestCommit(10, tmtime.Now()))
+
+	loaded := bs.LoadBlock(block.Height)
+	require.NotNil(t, loaded)
+	require.Equal(t, block.Hash(), loaded.Hash())
+	require.Equal(t, partSet.Header(), bs.LoadBlockMeta(block.Height).BlockID.PartSetHeader)
+}
+
+func TestSaveBlockWithExtendedCommit(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewOCLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	block := makeBlock(bs.Height()+1, state, new(types.Commit))
+	partSet := block.MakePartSet(2)
+	seenCommit := makeTestCommit(block.Height, tmtime.Now())
+	extCommit := &types.ExtendedCommit{
+		Height:  seenCommit.Height,
+		Round:   seenCommit.Round,
+		BlockID: types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()},
+		ExtendedSignatures: []types.ExtendedCommitSig{{
+			CommitSig:          seenCommit.Signatures[0],
+			Extension:          []byte("extension"),
+			ExtensionSignature: []byte("ExtensionSignature"),
+		}},
+	}
+
+	// The extended commit must carry the extensions.
+	missing := *extCommit
+	missing.ExtendedSignatures = []types.ExtendedCommitSig{{CommitSig: seenCommit.Signatures[0]}}
+	require.Panics(t, func() { bs.SaveBlockWithExtendedCommit(block, partSet, &missing) })
+
+	bs.SaveBlockWithExtendedCommit(block, partSet, extCommit)
+	require.Equal(t, block.Hash(), bs.LoadBlock(block.Height).Hash())
+	require.Equal(t, extCommit, bs.LoadBlockExtendedCommit(block.Height))
+	require.Equal(t, extCommit.ToCommit().Hash(), bs.LoadSeenCommit(block.Height).Hash())
+
+	// A block saved without extended commit has none.
+	next := makeBlock(bs.Height()+1, state, new(types.Commit))
+	bs.SaveBlock(next, next.MakePartSet(2), makeTestCommit(next.Height, tmtime.Now()))
+	require.Nil(t, bs.LoadBlockExtendedCommit(next.Height))
+
+	// The extended commit is pruned along with the block.
+	pruned, err := bs.PruneBlocks(next.Height)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, pruned)
+	require.Nil(t, bs.LoadBlockExtendedCommit(block.Height))
+}
+
 func doFn(fn func() (interface{}, error)) (res interface{}, err error, panicErr error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -114,7 +114,7 @@ func benchmarkSignVote(b *testing.B, pv types.PrivValidator, pubKey crypto.PubKe
 	// performance measurement
 	b.Run("SignVote", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
-			err = pv.SignVote(chainID, pb)
+			err = pv.SignVote(chainID, pb, false)
 		}
 	})
 
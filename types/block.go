@@ -167,6 +167,13 @@ func (b *Block) Hash() tmbytes.HexBytes {
 // This is the form in which the block is gossipped to peers.
 // CONTRACT: partSize is greater than zero.
 func (b *Block) MakePartSet(partSize uint32) *PartSet {
+	return b.MakePartSetWithParity(partSize, 0)
+}
+
+// MakePartSetWithParity returns a PartSet containing parts of a serialized
+// block, erasure coded with the given parity percentage if it is positive.
+// CONTRACT: partSize is greater than zero.
+func (b *Block) MakePartSetWithParity(partSize uint32, parityPercent int64) *PartSet {
 	if b == nil {
 		return nil
 	}
@@ -181,7 +188,7 @@ func (b *Block) MakePartSet(partSize uint32) *PartSet {
 	if err != nil {
 		panic(err)
 	}
-	return NewPartSetFromData(bz, partSize)
+	return NewPartSetFromDataWithParity(bz, partSize, parityPercent)
 }
 
 // HashesTo is a convenience function that checks if a block hashes to the given argument.
@@ -1232,6 +1239,325 @@ func CommitFromProto(cp *tmproto.Commit) (*Commit, error) {
 	return commit, commit.ValidateBasic()
 }
 
+//-------------------------------------
+
+// ExtendedCommitSig is a CommitSig with the vote extension of the precommit.
+// The extension signatures are never aggregated, so that each of them can be
+// verified on its own.
+type ExtendedCommitSig struct {
+	CommitSig
+	Extension          []byte `json:"extension"`
+	ExtensionSignature []byte `json:"extension_signature"`
+}
+
+// NewExtendedCommitSigAbsent returns new ExtendedCommitSig with
+// BlockIDFlagAbsent. Other fields are all empty.
+func NewExtendedCommitSigAbsent() ExtendedCommitSig {
+	return ExtendedCommitSig{
+		CommitSig: NewCommitSigAbsent(),
+	}
+}
+
+// String returns a string representation of ExtendedCommitSig.
+//
+// 1. commit sig
+// 2. first 6 bytes of vote extension
+// 3. first 6 bytes of vote extension signature
+func (ecs ExtendedCommitSig) String() string {
+	return fmt.Sprintf("ExtendedCommitSig{%s with %X %X}",
+		ecs.CommitSig,
+		tmbytes.Fingerprint(ecs.Extension),
+		tmbytes.Fingerprint(ecs.ExtensionSignature))
+}
+
+// ValidateBasic performs basic validation.
+func (ecs ExtendedCommitSig) ValidateBasic() error {
+	if err := ecs.CommitSig.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !ecs.ForBlock() {
+		if len(ecs.Extension) != 0 {
+			return errors.New("vote extension is present for commit sig without block ID flag commit")
+		}
+		if len(ecs.ExtensionSignature) != 0 {
+			return errors.New("vote extension signature is present for commit sig without block ID flag commit")
+		}
+		return nil
+	}
+
+	if len(ecs.Extension) > MaxVoteExtensionSize {
+		return fmt.Errorf("vote extension is too big %d (max: %d)", len(ecs.Extension), MaxVoteExtensionSize)
+	}
+	if len(ecs.ExtensionSignature) > MaxSignatureSize {
+		return fmt.Errorf("vote extension signature is too big %d (max: %d)",
+			len(ecs.ExtensionSignature), MaxSignatureSize)
+	}
+	return nil
+}
+
+// EnsureExtension checks that a commit sig for the block carries an extension
+// signature.
+func (ecs ExtendedCommitSig) EnsureExtension() error {
+	if ecs.ForBlock() && len(ecs.ExtensionSignature) == 0 {
+		return ErrVoteExtensionAbsent
+	}
+	return nil
+}
+
+// ToProto converts ExtendedCommitSig to protobuf
+func (ecs *ExtendedCommitSig) ToProto() *tmproto.ExtendedCommitSig {
+	if ecs == nil {
+		return nil
+	}
+
+	return &tmproto.ExtendedCommitSig{
+		BlockIdFlag:        tmproto.BlockIDFlag(ecs.BlockIDFlag),
+		ValidatorAddress:   ecs.ValidatorAddress,
+		Timestamp:          ecs.Timestamp,
+		Signature:          ecs.Signature,
+		Extension:          ecs.Extension,
+		ExtensionSignature: ecs.ExtensionSignature,
+	}
+}
+
+// FromProto sets a protobuf ExtendedCommitSig to the given pointer.
+// It returns an error if the ExtendedCommitSig is invalid.
+func (ecs *ExtendedCommitSig) FromProto(ecsp tmproto.ExtendedCommitSig) error {
+	ecs.BlockIDFlag = BlockIDFlag(ecsp.BlockIdFlag)
+	ecs.ValidatorAddress = ecsp.ValidatorAddress
+	ecs.Timestamp = ecsp.Timestamp
+	ecs.Signature = ecsp.Signature
+	ecs.Extension = ecsp.Extension
+	ecs.ExtensionSignature = ecsp.ExtensionSignature
+
+	return ecs.ValidateBasic()
+}
+
+//-------------------------------------
+
+// ExtendedCommit is a Commit with the vote extensions of its precommits. It is
+// kept by the block store apart from the block, to hand the extensions of a
+// height to the proposer of the next one.
+type ExtendedCommit struct {
+	Height             int64               `json:"height"`
+	Round              int32               `json:"round"`
+	BlockID            BlockID             `json:"block_id"`
+	ExtendedSignatures []ExtendedCommitSig `json:"extended_signatures"`
+
+	AggregatedSignature []byte `json:"aggregated_signature"`
+}
+
+// ToCommit converts the ExtendedCommit to a Commit by dropping the vote
+// extensions. The aggregated signature is kept as is.
+func (ec *ExtendedCommit) ToCommit() *Commit {
+	sigs := make([]CommitSig, len(ec.ExtendedSignatures))
+	for i, ecs := range ec.ExtendedSignatures {
+		sigs[i] = ecs.CommitSig
+	}
+	commit := NewCommit(ec.Height, ec.Round, ec.BlockID, sigs)
+	commit.AggregatedSignature = ec.AggregatedSignature
+	return commit
+}
+
+// AggregateSignatures aggregates the BLS signatures of the precommits, as
+// Commit.AggregateSignatures does. The extension signatures are left alone.
+func (ec *ExtendedCommit) AggregateSignatures() {
+	commit := ec.ToCommit()
+	commit.AggregateSignatures()
+	for i := range ec.ExtendedSignatures {
+		ec.ExtendedSignatures[i].Signature = commit.Signatures[i].Signature
+	}
+	ec.AggregatedSignature = commit.AggregatedSignature
+}
+
+// GetExtendedVote converts the ExtendedCommitSig for the given valIdx to a
+// Vote with its extension.
+// Panics if valIdx >= extCommit.Size().
+func (ec *ExtendedCommit) GetExtendedVote(valIdx int32) *Vote {
+	ecs := ec.ExtendedSignatures[valIdx]
+	return &Vote{
+		Type:               tmproto.PrecommitType,
+		Height:             ec.Height,
+		Round:              ec.Round,
+		BlockID:            ecs.BlockID(ec.BlockID),
+		Timestamp:          ecs.Timestamp,
+		ValidatorAddress:   ecs.ValidatorAddress,
+		ValidatorIndex:     valIdx,
+		Signature:          ecs.Signature,
+		Extension:          ecs.Extension,
+		ExtensionSignature: ecs.ExtensionSignature,
+	}
+}
+
+// Type returns the vote type of the extended commit, which is always
+// VoteTypePrecommit.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) Type() byte {
+	return byte(tmproto.PrecommitType)
+}
+
+// GetHeight returns height of the extended commit.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) GetHeight() int64 {
+	return ec.Height
+}
+
+// GetRound returns round of the extended commit.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) GetRound() int32 {
+	return ec.Round
+}
+
+// Size returns the number of signatures in the extended commit.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) Size() int {
+	if ec == nil {
+		return 0
+	}
+	return len(ec.ExtendedSignatures)
+}
+
+// BitArray returns a BitArray of which validators voted for BlockID or nil in
+// this extended commit.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) BitArray() *bits.BitArray {
+	bitArray := bits.NewBitArray(len(ec.ExtendedSignatures))
+	for i, ecs := range ec.ExtendedSignatures {
+		bitArray.SetIndex(i, !ecs.Absent())
+	}
+	return bitArray
+}
+
+// GetByIndex returns the vote, with its extension, corresponding to a given
+// validator index.
+// Panics if `index >= extCommit.Size()`.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) GetByIndex(valIdx int32) *Vote {
+	return ec.GetExtendedVote(valIdx)
+}
+
+// IsCommit returns true if there is at least one signature.
+// Implements VoteSetReader.
+func (ec *ExtendedCommit) IsCommit() bool {
+	return len(ec.ExtendedSignatures) != 0
+}
+
+// EnsureExtensions checks that every precommit for the block carries an
+// extension signature.
+func (ec *ExtendedCommit) EnsureExtensions() error {
+	for i, ecs := range ec.ExtendedSignatures {
+		if err := ecs.EnsureExtension(); err != nil {
+			return fmt.Errorf("wrong ExtendedCommitSig #%d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation that doesn't involve state data.
+// Does not actually check the cryptographic signatures.
+func (ec *ExtendedCommit) ValidateBasic() error {
+	for i, ecs := range ec.ExtendedSignatures {
+		if err := ecs.ValidateBasic(); err != nil {
+			return fmt.Errorf("wrong ExtendedCommitSig #%d: %v", i, err)
+		}
+	}
+	return ec.ToCommit().ValidateBasic()
+}
+
+// ToProto converts ExtendedCommit to protobuf
+func (ec *ExtendedCommit) ToProto() *tmproto.ExtendedCommit {
+	if ec == nil {
+		return nil
+	}
+
+	c := new(tmproto.ExtendedCommit)
+	sigs := make([]tmproto.ExtendedCommitSig, len(ec.ExtendedSignatures))
+	for i := range ec.ExtendedSignatures {
+		sigs[i] = *ec.ExtendedSignatures[i].ToProto()
+	}
+	c.ExtendedSignatures = sigs
+
+	c.Height = ec.Height
+	c.Round = ec.Round
+	c.BlockID = ec.BlockID.ToProto()
+
+	c.AggregatedSignature = ec.AggregatedSignature
+	return c
+}
+
+// ExtendedCommitFromProto constructs an ExtendedCommit from the given
+// protobuf. It returns an error if the extended commit is invalid.
+func ExtendedCommitFromProto(ecp *tmproto.ExtendedCommit) (*ExtendedCommit, error) {
+	if ecp == nil {
+		return nil, errors.New("nil ExtendedCommit")
+	}
+
+	extCommit := new(ExtendedCommit)
+
+	bi, err := BlockIDFromProto(&ecp.BlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]ExtendedCommitSig, len(ecp.ExtendedSignatures))
+	for i := range ecp.ExtendedSignatures {
+		if err := sigs[i].FromProto(ecp.ExtendedSignatures[i]); err != nil {
+			return nil, err
+		}
+	}
+	extCommit.ExtendedSignatures = sigs
+
+	extCommit.Height = ecp.Height
+	extCommit.Round = ecp.Round
+	extCommit.BlockID = *bi
+	extCommit.AggregatedSignature = ecp.AggregatedSignature
+
+	return extCommit, extCommit.ValidateBasic()
+}
+
+// ExtendedCommitToVoteSet constructs a VoteSet with the vote extensions enabled
+// from the ExtendedCommit and validator set, verifying the aggregated
+// signature and each extension signature.
+// Panics if signatures from the extended commit can't be added to the voteset.
+// Inverse of VoteSet.MakeExtendedCommit().
+func ExtendedCommitToVoteSet(chainID string, extCommit *ExtendedCommit, voters *VoterSet) *VoteSet {
+	voteSet := NewExtendedVoteSet(chainID, extCommit.Height, extCommit.Round, tmproto.PrecommitType, voters)
+	blsPubKeys := make([]bls.PubKey, 0, len(extCommit.ExtendedSignatures))
+	msgs := make([][]byte, 0, len(extCommit.ExtendedSignatures))
+	for idx, ecs := range extCommit.ExtendedSignatures {
+		if ecs.Absent() {
+			continue // OK, some precommits can be missing.
+		}
+		vote := extCommit.GetExtendedVote(int32(idx))
+		if vote.Signature != nil {
+			added, err := voteSet.AddVote(vote)
+			if !added || err != nil {
+				panic(fmt.Sprintf("Failed to reconstruct LastCommit from Votes: %v", err))
+			}
+		} else {
+			added, err := voteSet.AddAggregatedVote(vote)
+			if !added || err != nil {
+				panic(fmt.Sprintf("Failed to reconstruct LastCommit from AggregatedVotes : %v", err))
+			}
+			_, voter := voters.GetByIndex(vote.ValidatorIndex)
+			blsPubKey := GetSignatureKey(voter.PubKey)
+			if blsPubKey == nil {
+				panic(fmt.Errorf("signature %d has been omitted, even though it is not a BLS key", idx))
+			}
+			blsPubKeys = append(blsPubKeys, *blsPubKey)
+			msgs = append(msgs, VoteSignBytes(chainID, vote.ToProto()))
+		}
+	}
+	if extCommit.AggregatedSignature != nil {
+		err := bls.VerifyAggregatedSignature(extCommit.AggregatedSignature, blsPubKeys, msgs)
+		if err != nil {
+			panic(fmt.Sprintf("Failed to VerifyAggregatedSignature : %v", err))
+		}
+	}
+	return voteSet
+}
+
 //-----------------------------------------------------------------------------
 
 // Data contains the set of transactions included in the block
@@ -439,7 +439,7 @@ func TestMaxCommitBytes(t *testing.T) {
 		Signature:        tmrand.Bytes(MaxSignatureSize),
 	}
 	pbVote1 := vote1.ToProto()
-	assert.NoError(t, pv1.SignVote(chainID, pbVote1))
+	assert.NoError(t, pv1.SignVote(chainID, pbVote1, false))
 
 	vote2 := &Vote{
 		ValidatorAddress: pub2.Address(),
@@ -452,7 +452,7 @@ func TestMaxCommitBytes(t *testing.T) {
 		Signature:        tmrand.Bytes(MaxSignatureSize),
 	}
 	pbVote2 := vote2.ToProto()
-	assert.NoError(t, pv2.SignVote(chainID, pbVote2))
+	assert.NoError(t, pv2.SignVote(chainID, pbVote2, false))
 
 	vote3 := &Vote{
 		ValidatorAddress: pub2.Address(),
@@ -824,7 +824,7 @@ func TestCommitSigNumOfBytes(t *testing.T) {
 		BlockID:          blockID,
 	}
 	pbVote1 := vote1.ToProto()
-	assert.NoError(t, pv1.SignVote(chainID, pbVote1))
+	assert.NoError(t, pv1.SignVote(chainID, pbVote1, false))
 
 	vote2 := &Vote{
 		ValidatorAddress: pub2.Address(),
@@ -836,7 +836,7 @@ func TestCommitSigNumOfBytes(t *testing.T) {
 		BlockID:          blockID,
 	}
 	pbVote2 := vote2.ToProto()
-	assert.NoError(t, pv2.SignVote(chainID, pbVote2))
+	assert.NoError(t, pv2.SignVote(chainID, pbVote2, false))
 
 	vote3 := &Vote{
 		ValidatorAddress: pub3.Address(),
@@ -848,7 +848,7 @@ func TestCommitSigNumOfBytes(t *testing.T) {
 		BlockID:          blockID,
 	}
 	pbVote3 := vote3.ToProto()
-	assert.NoError(t, pv3.SignVote(chainID, pbVote3))
+	assert.NoError(t, pv3.SignVote(chainID, pbVote3, false))
 
 	commitSig1 := NewCommitSigForBlock(pbVote1.Signature, pub1.Address(), timestamp)
 	commitSig2 := NewCommitSigForBlock(pbVote2.Signature, pub2.Address(), timestamp)
@@ -893,7 +893,7 @@ func TestMaxCommitBytesMany(t *testing.T) {
 			Type:             tmproto.PrecommitType,
 			BlockID:          blockID,
 		}
-		assert.NoError(t, pv.SignVote(chainID, vote.ToProto()))
+		assert.NoError(t, pv.SignVote(chainID, vote.ToProto(), false))
 		commitSig[i] = NewCommitSigForBlock(vote.Signature, pub.Address(), timestamp)
 	}
 	commit := NewCommit(math.MaxInt64, math.MaxInt32, blockID, commitSig)
@@ -957,7 +957,7 @@ func TestMaxCommitBytesMixed(t *testing.T) {
 		}
 		// sign only if key type is ed25519
 		if keyType == PrivKeyEd25519 {
-			assert.NoError(t, pv.SignVote(chainID, vote.ToProto()))
+			assert.NoError(t, pv.SignVote(chainID, vote.ToProto(), false))
 		}
 		commitSig[i] = NewCommitSigForBlock(vote.Signature, pub.Address(), timestamp)
 	}
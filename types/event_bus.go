@@ -187,6 +187,20 @@ func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	return b.pubsub.PublishWithEvents(ctx, data, events)
 }
 
+// PublishEventTxExpired publishes tx expired event with the predefined keys
+// EventTypeKey and TxHashKey, so that subscribers can wait for a specific tx.
+func (b *EventBus) PublishEventTxExpired(data EventDataTxExpired) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey: {EventTxExpired},
+		TxHashKey:    {fmt.Sprintf("%X", data.Tx.Hash())},
+	}
+
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
 func (b *EventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return b.Publish(EventNewRoundStep, data)
 }
@@ -267,6 +281,10 @@ func (NopEventBus) PublishEventTx(data EventDataTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventTxExpired(data EventDataTxExpired) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return nil
 }
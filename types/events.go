@@ -20,6 +20,7 @@ const (
 	EventNewBlockHeader      = "NewBlockHeader"
 	EventNewEvidence         = "NewEvidence"
 	EventTx                  = "Tx"
+	EventTxExpired           = "TxExpired"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
 	// Internal consensus events.
@@ -50,6 +51,7 @@ func init() {
 	tmjson.RegisterType(EventDataNewBlockHeader{}, "ostracon/event/NewBlockHeader")
 	tmjson.RegisterType(EventDataNewEvidence{}, "ostracon/event/NewEvidence")
 	tmjson.RegisterType(EventDataTx{}, "ostracon/event/Tx")
+	tmjson.RegisterType(EventDataTxExpired{}, "ostracon/event/TxExpired")
 	tmjson.RegisterType(EventDataRoundState{}, "ostracon/event/RoundState")
 	tmjson.RegisterType(EventDataNewRound{}, "ostracon/event/NewRound")
 	tmjson.RegisterType(EventDataCompleteProposal{}, "ostracon/event/CompleteProposal")
@@ -87,6 +89,14 @@ type EventDataTx struct {
 	abci.TxResult
 }
 
+// EventDataTxExpired is fired for each tx purged from the mempool for
+// outliving its TTL, so that it can be resubmitted.
+type EventDataTxExpired struct {
+	Tx Tx `json:"tx"`
+
+	Height int64 `json:"height"` // height the tx was added to the mempool at
+}
+
 // NOTE: This goes into the replay WAL
 type EventDataRoundState struct {
 	Height int64  `json:"height"`
@@ -155,6 +165,7 @@ var (
 	EventQueryTimeoutPropose      = QueryForEvent(EventTimeoutPropose)
 	EventQueryTimeoutWait         = QueryForEvent(EventTimeoutWait)
 	EventQueryTx                  = QueryForEvent(EventTx)
+	EventQueryTxExpired           = QueryForEvent(EventTxExpired)
 	EventQueryUnlock              = QueryForEvent(EventUnlock)
 	EventQueryValidatorSetUpdates = QueryForEvent(EventValidatorSetUpdates)
 	EventQueryValidBlock          = QueryForEvent(EventValidBlock)
@@ -181,3 +192,8 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
+
+// MempoolEventPublisher publishes mempool related events
+type MempoolEventPublisher interface {
+	PublishEventTxExpired(EventDataTxExpired) error
+}
@@ -75,12 +75,16 @@ func NewDuplicateVoteEvidence(vote1, vote2 *Vote, blockTime time.Time, voterSet
 	}
 
 	if strings.Compare(vote1.BlockID.Key(), vote2.BlockID.Key()) == -1 {
-		voteA = vote1
-		voteB = vote2
+		voteA = vote1.Copy()
+		voteB = vote2.Copy()
 	} else {
-		voteA = vote2
-		voteB = vote1
+		voteA = vote2.Copy()
+		voteB = vote1.Copy()
 	}
+	// The vote extensions are not part of the equivocation, and are signed
+	// apart from the votes.
+	voteA.StripExtension()
+	voteB.StripExtension()
 	return &DuplicateVoteEvidence{
 		VoteA:            voteA,
 		VoteB:            voteB,
@@ -152,6 +156,10 @@ func (dve *DuplicateVoteEvidence) ValidateBasic() error {
 	if err := dve.VoteB.ValidateBasic(); err != nil {
 		return fmt.Errorf("invalid VoteB: %w", err)
 	}
+	if len(dve.VoteA.Extension) > 0 || len(dve.VoteA.ExtensionSignature) > 0 ||
+		len(dve.VoteB.Extension) > 0 || len(dve.VoteB.ExtensionSignature) > 0 {
+		return errors.New("vote extensions must be stripped from the duplicate votes")
+	}
 	// Enforce Votes are lexicographically sorted on blockID
 	if strings.Compare(dve.VoteA.BlockID.Key(), dve.VoteB.BlockID.Key()) >= 0 {
 		return errors.New("duplicate votes in invalid order")
@@ -587,11 +595,11 @@ func NewMockDuplicateVoteEvidenceWithValidator(height int64, time time.Time,
 	val := NewValidator(pubKey, 10)
 	voteA := makeMockVote(height, 0, 0, pubKey.Address(), randBlockID(), time)
 	vA := voteA.ToProto()
-	_ = pv.SignVote(chainID, vA)
+	_ = pv.SignVote(chainID, vA, false)
 	voteA.Signature = vA.Signature
 	voteB := makeMockVote(height, 0, 0, pubKey.Address(), randBlockID(), time)
 	vB := voteB.ToProto()
-	_ = pv.SignVote(chainID, vB)
+	_ = pv.SignVote(chainID, vB, false)
 	voteB.Signature = vB.Signature
 	return NewDuplicateVoteEvidence(voteA, voteB, time, ToVoterAll([]*Validator{val}))
 }
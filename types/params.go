@@ -21,6 +21,10 @@ const (
 	// MaxBlockPartsCount is the maximum number of block parts.
 	MaxBlockPartsCount = (MaxBlockSizeBytes / BlockPartSizeBytes) + 1
 
+	// MaxErasureBlockPartsCount is the maximum number of block parts, with
+	// the parity parts of the erasure coding.
+	MaxErasureBlockPartsCount = 2 * MaxBlockPartsCount
+
 	DefaultVoterElectionThreshold          = 33 // 33 is a statistically tested threshold
 	DefaultMaxTolerableByzantinePercentage = 33 // 20 is a statistically tested percentage, but we recommend using 33
 )
@@ -32,6 +36,7 @@ func DefaultConsensusParams() *tmproto.ConsensusParams {
 		Evidence:  DefaultEvidenceParams(),
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
+		ABCI:      DefaultABCIParams(),
 	}
 }
 
@@ -74,6 +79,20 @@ func DefaultVersionParams() tmproto.VersionParams {
 	}
 }
 
+// DefaultABCIParams returns a default ABCIParams, which disables the vote
+// extensions.
+func DefaultABCIParams() tmproto.ABCIParams {
+	return tmproto.ABCIParams{
+		VoteExtensionsEnableHeight: 0,
+	}
+}
+
+// VoteExtensionsEnabled returns true if the precommits of the given height
+// carry vote extensions.
+func VoteExtensionsEnabled(params tmproto.ABCIParams, height int64) bool {
+	return params.VoteExtensionsEnableHeight > 0 && height >= params.VoteExtensionsEnableHeight
+}
+
 func IsValidPubkeyType(params tmproto.ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -105,6 +124,11 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 			params.Block.TimeIotaMs)
 	}
 
+	if params.Block.ErasureParityPercent < 0 || params.Block.ErasureParityPercent > 100 {
+		return fmt.Errorf("block.ErasureParityPercent must be between 0 and 100. Got %d",
+			params.Block.ErasureParityPercent)
+	}
+
 	if params.Evidence.MaxAgeNumBlocks <= 0 {
 		return fmt.Errorf("evidence.MaxAgeNumBlocks must be greater than 0. Got %d",
 			params.Evidence.MaxAgeNumBlocks)
@@ -125,6 +149,11 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 			params.Evidence.MaxBytes)
 	}
 
+	if params.ABCI.VoteExtensionsEnableHeight < 0 {
+		return fmt.Errorf("abci.VoteExtensionsEnableHeight must be greater or equal to 0. Got %d",
+			params.ABCI.VoteExtensionsEnableHeight)
+	}
+
 	if len(params.Validator.PubKeyTypes) == 0 {
 		return errors.New("len(Validator.PubKeyTypes) must be greater than 0")
 	}
@@ -142,15 +171,17 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 }
 
 // Hash returns a hash of a subset of the parameters to store in the block header.
-// Only the Block.MaxBytes and Block.MaxGas are included in the hash.
+// Only the Block.MaxBytes, Block.MaxGas and Block.ErasureParityPercent are
+// included in the hash.
 // This allows the ConsensusParams to evolve more without breaking the block
 // protocol. No need for a Merkle tree here, just a small struct to hash.
 func HashConsensusParams(params tmproto.ConsensusParams) []byte {
 	hasher := tmhash.New()
 
 	hp := tmproto.HashedParams{
-		BlockMaxBytes: params.Block.MaxBytes,
-		BlockMaxGas:   params.Block.MaxGas,
+		BlockMaxBytes:             params.Block.MaxBytes,
+		BlockMaxGas:               params.Block.MaxGas,
+		BlockErasureParityPercent: params.Block.ErasureParityPercent,
 	}
 
 	bz, err := hp.Marshal()
@@ -178,6 +209,7 @@ func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *abci.Consens
 	if params2.Block != nil {
 		res.Block.MaxBytes = params2.Block.MaxBytes
 		res.Block.MaxGas = params2.Block.MaxGas
+		res.Block.ErasureParityPercent = params2.Block.ErasureParityPercent
 	}
 	if params2.Evidence != nil {
 		res.Evidence.MaxAgeNumBlocks = params2.Evidence.MaxAgeNumBlocks
@@ -192,5 +224,36 @@ func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *abci.Consens
 	if params2.Version != nil {
 		res.Version.AppVersion = params2.Version.AppVersion
 	}
+	if params2.ABCI != nil {
+		res.ABCI.VoteExtensionsEnableHeight = params2.ABCI.VoteExtensionsEnableHeight
+	}
 	return res
 }
+
+// ValidateConsensusParamsUpdate checks that the updates of the application at
+// the given height may be applied to the params. The vote extensions enable
+// height may only move to a height after the given one, and only while the
+// current enable height is not reached. Once enabled, the vote extensions
+// can't be disabled.
+func ValidateConsensusParamsUpdate(params tmproto.ConsensusParams, params2 *abci.ConsensusParams, height int64) error {
+	if params2 == nil || params2.ABCI == nil {
+		return nil
+	}
+
+	current, updated := params.ABCI.VoteExtensionsEnableHeight, params2.ABCI.VoteExtensionsEnableHeight
+	if current == updated {
+		return nil
+	}
+	if current != 0 && updated == 0 {
+		return errors.New("abci.VoteExtensionsEnableHeight can't be reset to 0 once set")
+	}
+	if updated <= height {
+		return fmt.Errorf("abci.VoteExtensionsEnableHeight must be greater than the current height %d. Got %d",
+			height, updated)
+	}
+	if current != 0 && current <= height {
+		return fmt.Errorf("abci.VoteExtensionsEnableHeight can't be changed once reached at height %d. Got %d",
+			current, updated)
+	}
+	return nil
+}
@@ -42,6 +42,22 @@ func TestConsensusParamsValidation(t *testing.T) {
 		// test invalid pubkey type provided
 		14: {makeParams(1, 0, 10, 2, 0, []string{"potatoes make good pubkeys"}), false},
 	}
+	for _, percent := range []int64{-1, 0, 50, 100, 101} {
+		params := makeParams(1, 0, 10, 2, 0, valEd25519)
+		params.Block.ErasureParityPercent = percent
+		testCases = append(testCases, struct {
+			params tmproto.ConsensusParams
+			valid  bool
+		}{params, percent >= 0 && percent <= 100})
+	}
+	for _, height := range []int64{-1, 0, 1, 100} {
+		params := makeParams(1, 0, 10, 2, 0, valEd25519)
+		params.ABCI.VoteExtensionsEnableHeight = height
+		testCases = append(testCases, struct {
+			params tmproto.ConsensusParams
+			valid  bool
+		}{params, height >= 0})
+	}
 	for i, tc := range testCases {
 		if tc.valid {
 			assert.NoErrorf(t, ValidateConsensusParams(tc.params), "expected no error for valid params (#%d)", i)
@@ -51,6 +67,25 @@ func TestConsensusParamsValidation(t *testing.T) {
 	}
 }
 
+func TestVoteExtensionsEnabled(t *testing.T) {
+	testCases := []struct {
+		enableHeight int64
+		height       int64
+		enabled      bool
+	}{
+		{0, 1, false},
+		{0, 100, false},
+		{10, 9, false},
+		{10, 10, true},
+		{10, 11, true},
+		{1, 1, true},
+	}
+	for i, tc := range testCases {
+		params := tmproto.ABCIParams{VoteExtensionsEnableHeight: tc.enableHeight}
+		assert.Equal(t, tc.enabled, VoteExtensionsEnabled(params, tc.height), "#%d", i)
+	}
+}
+
 func makeParams(
 	blockBytes, blockGas int64,
 	blockTimeIotaMs int64,
@@ -87,6 +122,10 @@ func TestConsensusParamsHash(t *testing.T) {
 		makeParams(4, 6, 10, 5, 1, valEd25519),
 	}
 
+	withParity := makeParams(4, 2, 10, 3, 1, valEd25519)
+	withParity.Block.ErasureParityPercent = 50
+	params = append(params, withParity)
+
 	hashes := make([][]byte, len(params))
 	for i := range params {
 		hashes[i] = HashConsensusParams(params[i])
@@ -150,6 +189,63 @@ func TestConsensusParamsUpdate_AppVersion(t *testing.T) {
 	assert.EqualValues(t, 77, updated.Version.AppVersion)
 }
 
+func TestConsensusParamsUpdate_VoteExtensionsEnableHeight(t *testing.T) {
+	params := makeParams(1, 2, 10, 3, 0, valEd25519)
+
+	assert.EqualValues(t, 0, params.ABCI.VoteExtensionsEnableHeight)
+
+	updated := UpdateConsensusParams(params,
+		&abci.ConsensusParams{ABCI: &tmproto.ABCIParams{VoteExtensionsEnableHeight: 10}})
+
+	assert.EqualValues(t, 10, updated.ABCI.VoteExtensionsEnableHeight)
+}
+
+func TestConsensusParamsUpdate_ErasureParityPercent(t *testing.T) {
+	params := makeParams(1, 2, 10, 3, 0, valEd25519)
+
+	assert.EqualValues(t, 0, params.Block.ErasureParityPercent)
+
+	updated := UpdateConsensusParams(params,
+		&abci.ConsensusParams{Block: &abci.BlockParams{MaxBytes: 1, MaxGas: 2, ErasureParityPercent: 50}})
+
+	assert.EqualValues(t, 50, updated.Block.ErasureParityPercent)
+	assert.NotEqual(t, HashConsensusParams(params), HashConsensusParams(updated))
+}
+
+func TestConsensusParamsValidateUpdate(t *testing.T) {
+	testCases := []struct {
+		enableHeight int64
+		updated      *tmproto.ABCIParams
+		height       int64
+		valid        bool
+	}{
+		{0, nil, 5, true},
+		{0, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 0}, 5, true},
+		// enable after the current height
+		{0, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 6}, 5, true},
+		{0, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 5}, 5, false},
+		{0, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 4}, 5, false},
+		// move the enable height before it is reached
+		{10, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 20}, 5, true},
+		{10, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 6}, 5, true},
+		{10, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 0}, 5, false},
+		// but not once it is reached
+		{10, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 10}, 10, true},
+		{10, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 20}, 10, false},
+		{10, &tmproto.ABCIParams{VoteExtensionsEnableHeight: 0}, 15, false},
+	}
+	for i, tc := range testCases {
+		params := makeParams(1, 2, 10, 3, 0, valEd25519)
+		params.ABCI.VoteExtensionsEnableHeight = tc.enableHeight
+		err := ValidateConsensusParamsUpdate(params, &abci.ConsensusParams{ABCI: tc.updated}, tc.height)
+		if tc.valid {
+			assert.NoErrorf(t, err, "expected no error for valid update (#%d)", i)
+		} else {
+			assert.Errorf(t, err, "expected error for non valid update (#%d)", i)
+		}
+	}
+}
+
 func TestVoterParamsValidate(t *testing.T) {
 	errorCases := []VoterParams{
 		{
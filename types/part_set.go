@@ -158,6 +158,8 @@ type PartSet struct {
 	// a count of the total size (in bytes). Used to ensure that the
 	// part set doesn't exceed the maximum block bytes
 	byteSize int64
+	// nil if the part set is not erasure coded
+	erasure *erasureState
 }
 
 // Returns an immutable, full PartSet from the data bytes.
@@ -166,22 +168,29 @@ type PartSet struct {
 func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 	// divide data into 4kb parts.
 	total := (uint32(len(data)) + partSize - 1) / partSize
-	parts := make([]*Part, total)
 	partsBytes := make([][]byte, total)
-	partsBitArray := bits.NewBitArray(int(total))
 	for i := uint32(0); i < total; i++ {
-		part := &Part{
-			Index: i,
-			Bytes: data[i*partSize : tmmath.MinInt(len(data), int((i+1)*partSize))],
-		}
-		parts[i] = part
-		partsBytes[i] = part.Bytes
-		partsBitArray.SetIndex(int(i), true)
+		partsBytes[i] = data[i*partSize : tmmath.MinInt(len(data), int((i+1)*partSize))]
 	}
+	return newFullPartSet(partsBytes)
+}
+
+// newFullPartSet returns a full PartSet of the given parts bytes.
+func newFullPartSet(partsBytes [][]byte) *PartSet {
+	total := uint32(len(partsBytes))
+	parts := make([]*Part, total)
+	partsBitArray := bits.NewBitArray(int(total))
+	byteSize := int64(0)
 	// Compute merkle proofs
 	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
 	for i := uint32(0); i < total; i++ {
-		parts[i].Proof = *proofs[i]
+		parts[i] = &Part{
+			Index: i,
+			Bytes: partsBytes[i],
+			Proof: *proofs[i],
+		}
+		partsBitArray.SetIndex(int(i), true)
+		byteSize += int64(len(partsBytes[i]))
 	}
 	return &PartSet{
 		total:         total,
@@ -189,7 +198,7 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 		parts:         parts,
 		partsBitArray: partsBitArray,
 		count:         total,
-		byteSize:      int64(len(data)),
+		byteSize:      byteSize,
 	}
 }
 
@@ -280,6 +289,12 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 		return false, nil
 	}
 
+	// The parts of an erasure coded part set that failed to rebuild are
+	// inconsistent.
+	if ps.erasure != nil && ps.erasure.invalid {
+		return false, ErrPartSetInvalidErasureCoding
+	}
+
 	// Check hash proof
 	if part.Proof.Verify(ps.Hash(), part.Bytes) != nil {
 		return false, ErrPartSetInvalidProof
@@ -290,6 +305,9 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.partsBitArray.SetIndex(int(part.Index), true)
 	ps.count++
 	ps.byteSize += int64(len(part.Bytes))
+	if ps.erasure != nil {
+		return true, ps.addErasurePart(part)
+	}
 	return true, nil
 }
 
@@ -307,6 +325,9 @@ func (ps *PartSet) GetReader() io.Reader {
 	if !ps.IsComplete() {
 		panic("Cannot GetReader() on incomplete PartSet")
 	}
+	if ps.erasure != nil {
+		return ps.erasureReader()
+	}
 	return NewPartSetReader(ps.parts)
 }
 
@@ -0,0 +1,295 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/line/ostracon/crypto/merkle"
+	"github.com/line/ostracon/libs/reedsolomon"
+)
+
+// ErrPartSetInvalidErasureCoding is returned when the parts rebuilt from an
+// erasure coded part set do not hash to the part set hash.
+var ErrPartSetInvalidErasureCoding = errors.New("error part set invalid erasure coding")
+
+// An erasure coded part set holds the k data parts of the data, followed by
+// n-k parity parts, with
+//
+//	n = k + ceil(k * parityPercent / 100)
+//
+// The data parts hold the uvarint length of the data, the data and a zero
+// padding up to the size of the k parts. As a Reed-Solomon code is limited
+// to reedsolomon.MaxShards shards, the parts are split in groups, each of
+// which holds a contiguous range of the data parts and of the parity parts,
+// and can be rebuilt from any of its parts as many as its data parts.
+//
+// The hash of the part set is the merkle root of the n parts, and the total
+// of the header is n, from which k is derived. So the header of an erasure
+// coded part set looks like any other, and the parity percentage, which is
+// a consensus param, tells how to read it.
+type erasureLayout struct {
+	dataParts int
+	groups    []erasureGroup
+}
+
+type erasureGroup struct {
+	dataStart, dataParts     int
+	parityStart, parityParts int
+}
+
+// erasurePartsTotal returns the number of parts of an erasure coded part set
+// of the given number of data parts.
+func erasurePartsTotal(dataParts int, parityPercent int64) int {
+	return dataParts + int((int64(dataParts)*parityPercent+99)/100)
+}
+
+func newErasureLayout(dataParts int, parityPercent int64) *erasureLayout {
+	// Groups of at most MaxShards-2 parts, so that splitting the data and
+	// parity parts evenly yields groups of at most MaxShards parts.
+	total := erasurePartsTotal(dataParts, parityPercent)
+	parityParts := total - dataParts
+	numGroups := (total + reedsolomon.MaxShards - 3) / (reedsolomon.MaxShards - 2)
+
+	layout := &erasureLayout{dataParts: dataParts, groups: make([]erasureGroup, numGroups)}
+	dataStart, parityStart := 0, dataParts
+	for i := range layout.groups {
+		group := erasureGroup{
+			dataStart:   dataStart,
+			dataParts:   splitSize(dataParts, numGroups, i),
+			parityStart: parityStart,
+			parityParts: splitSize(parityParts, numGroups, i),
+		}
+		dataStart += group.dataParts
+		parityStart += group.parityParts
+		layout.groups[i] = group
+	}
+	return layout
+}
+
+// erasureLayoutFromTotal returns the layout of an erasure coded part set of
+// total parts.
+func erasureLayoutFromTotal(total uint32, parityPercent int64) (*erasureLayout, error) {
+	// the total strictly increases with the number of data parts
+	dataParts := sort.Search(int(total), func(k int) bool {
+		return erasurePartsTotal(k, parityPercent) >= int(total)
+	})
+	if dataParts == 0 || erasurePartsTotal(dataParts, parityPercent) != int(total) {
+		return nil, fmt.Errorf("%d is not the total of an erasure coded part set with %d%% parity",
+			total, parityPercent)
+	}
+	return newErasureLayout(dataParts, parityPercent), nil
+}
+
+// splitSize returns the size of the i-th of n even chunks of size.
+func splitSize(size, n, i int) int {
+	if i < size%n {
+		return size/n + 1
+	}
+	return size / n
+}
+
+func (layout *erasureLayout) total() int {
+	last := layout.groups[len(layout.groups)-1]
+	return last.parityStart + last.parityParts
+}
+
+// group returns the group of the part of the given index.
+func (layout *erasureLayout) group(index int) int {
+	if index < layout.dataParts {
+		return sort.Search(len(layout.groups), func(i int) bool {
+			g := layout.groups[i]
+			return index < g.dataStart+g.dataParts
+		})
+	}
+	return sort.Search(len(layout.groups), func(i int) bool {
+		g := layout.groups[i]
+		return index < g.parityStart+g.parityParts
+	})
+}
+
+// shards returns the data and parity parts of the group, in the order of
+// the Reed-Solomon code.
+func (group erasureGroup) shards(parts [][]byte) [][]byte {
+	shards := make([][]byte, 0, group.dataParts+group.parityParts)
+	shards = append(shards, parts[group.dataStart:group.dataStart+group.dataParts]...)
+	return append(shards, parts[group.parityStart:group.parityStart+group.parityParts]...)
+}
+
+// setShards sets the parts of the group from its shards.
+func (group erasureGroup) setShards(parts [][]byte, shards [][]byte) {
+	copy(parts[group.dataStart:group.dataStart+group.dataParts], shards[:group.dataParts])
+	copy(parts[group.parityStart:group.parityStart+group.parityParts], shards[group.dataParts:])
+}
+
+// NewPartSetFromDataWithParity returns an immutable, full PartSet from the
+// data bytes, which is erasure coded with the given parity percentage if it
+// is positive.
+// CONTRACT: partSize is greater than zero.
+func NewPartSetFromDataWithParity(data []byte, partSize uint32, parityPercent int64) *PartSet {
+	if parityPercent <= 0 {
+		return NewPartSetFromData(data, partSize)
+	}
+
+	size := int(partSize)
+	prefix := make([]byte, binary.MaxVarintLen64)
+	prefix = prefix[:binary.PutUvarint(prefix, uint64(len(data)))]
+	dataParts := (len(prefix) + len(data) + size - 1) / size
+	padded := make([]byte, dataParts*size)
+	copy(padded[copy(padded, prefix):], data)
+
+	layout := newErasureLayout(dataParts, parityPercent)
+	partsBytes := make([][]byte, layout.total())
+	for i := 0; i < dataParts; i++ {
+		partsBytes[i] = padded[i*size : (i+1)*size]
+	}
+	for _, group := range layout.groups {
+		code, err := reedsolomon.New(group.dataParts, group.parityParts)
+		if err != nil {
+			panic(err)
+		}
+		parity, err := code.Encode(partsBytes[group.dataStart : group.dataStart+group.dataParts])
+		if err != nil {
+			panic(err)
+		}
+		copy(partsBytes[group.parityStart:], parity)
+	}
+
+	ps := newFullPartSet(partsBytes)
+	ps.erasure = &erasureState{layout: layout, groupCounts: make([]int, len(layout.groups))}
+	return ps
+}
+
+// NewPartSetFromHeaderWithParity returns an empty PartSet ready to be
+// populated, which is erasure coded with the given parity percentage if it
+// is positive. It returns an error if the header total is not that of an
+// erasure coded part set.
+func NewPartSetFromHeaderWithParity(header PartSetHeader, parityPercent int64) (*PartSet, error) {
+	ps := NewPartSetFromHeader(header)
+	if parityPercent <= 0 {
+		return ps, nil
+	}
+	layout, err := erasureLayoutFromTotal(header.Total, parityPercent)
+	if err != nil {
+		return nil, err
+	}
+	ps.erasure = &erasureState{layout: layout, groupCounts: make([]int, len(layout.groups))}
+	return ps, nil
+}
+
+// MaxPartSetByteSize returns the maximum size of the parts of a block of
+// maxBytes, with the given parity percentage.
+func MaxPartSetByteSize(maxBytes int64, partSize uint32, parityPercent int64) int64 {
+	if parityPercent <= 0 {
+		return maxBytes
+	}
+	dataParts := (int64(binary.MaxVarintLen64) + maxBytes + int64(partSize) - 1) / int64(partSize)
+	return int64(erasurePartsTotal(int(dataParts), parityPercent)) * int64(partSize)
+}
+
+// ErasureCodedData returns the data of the concatenated data parts of an
+// erasure coded part set.
+func ErasureCodedData(bz []byte) ([]byte, error) {
+	size, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return nil, errors.New("invalid data length")
+	}
+	if size > uint64(len(bz)-n) {
+		return nil, fmt.Errorf("data length %d exceeds the parts size %d", size, len(bz)-n)
+	}
+	return bz[n : n+int(size)], nil
+}
+
+type erasureState struct {
+	layout *erasureLayout
+	// the number of parts received of each group
+	groupCounts []int
+	// set when the rebuilt parts did not match the part set hash
+	invalid bool
+}
+
+// addErasurePart records the part added to the part set, and rebuilds the
+// missing parts once enough of each group were added.
+// CONTRACT: the part set mutex is held.
+func (ps *PartSet) addErasurePart(part *Part) error {
+	es := ps.erasure
+	layout := es.layout
+	es.groupCounts[layout.group(int(part.Index))]++
+	if ps.count == ps.total {
+		return nil
+	}
+	for i, group := range layout.groups {
+		if es.groupCounts[i] < group.dataParts {
+			return nil
+		}
+	}
+
+	partsBytes := make([][]byte, ps.total)
+	for i, part := range ps.parts {
+		if part != nil {
+			partsBytes[i] = part.Bytes
+		}
+	}
+	for _, group := range layout.groups {
+		code, err := reedsolomon.New(group.dataParts, group.parityParts)
+		if err != nil {
+			return err
+		}
+		shards := group.shards(partsBytes)
+		if err := code.Reconstruct(shards); err != nil {
+			es.invalid = true
+			return fmt.Errorf("%w: %v", ErrPartSetInvalidErasureCoding, err)
+		}
+		group.setShards(partsBytes, shards)
+	}
+
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	if !bytes.Equal(root, ps.hash) {
+		es.invalid = true
+		return ErrPartSetInvalidErasureCoding
+	}
+	for i, part := range ps.parts {
+		if part != nil {
+			continue
+		}
+		ps.parts[i] = &Part{Index: uint32(i), Bytes: partsBytes[i], Proof: *proofs[i]}
+		ps.partsBitArray.SetIndex(i, true)
+		ps.byteSize += int64(len(partsBytes[i]))
+	}
+	ps.count = ps.total
+	for i, group := range layout.groups {
+		es.groupCounts[i] = group.dataParts + group.parityParts
+	}
+	return nil
+}
+
+// erasureReader returns a reader of the data of the data parts.
+// CONTRACT: the part set is complete.
+func (ps *PartSet) erasureReader() io.Reader {
+	dataParts := ps.parts[:ps.erasure.layout.dataParts]
+	bz, err := io.ReadAll(NewPartSetReader(dataParts))
+	if err != nil {
+		return errReader{err}
+	}
+	data, err := ErasureCodedData(bz)
+	if err != nil {
+		return errReader{err}
+	}
+	return bytes.NewReader(data)
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// IsErasureCoded returns true if the part set is erasure coded.
+func (ps *PartSet) IsErasureCoded() bool {
+	return ps != nil && ps.erasure != nil
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tmrand "github.com/line/ostracon/libs/rand"
+	"github.com/line/ostracon/libs/reedsolomon"
+)
+
+func TestErasureLayout(t *testing.T) {
+	for _, parityPercent := range []int64{1, 25, 50, 100} {
+		prevTotal := 0
+		for dataParts := 1; dataParts <= 600; dataParts++ {
+			layout := newErasureLayout(dataParts, parityPercent)
+			total := layout.total()
+			require.Greater(t, total, prevTotal)
+			prevTotal = total
+
+			layout2, err := erasureLayoutFromTotal(uint32(total), parityPercent)
+			require.NoError(t, err)
+			require.Equal(t, layout, layout2)
+
+			index := 0
+			for i, group := range layout.groups {
+				require.Positive(t, group.dataParts)
+				require.LessOrEqual(t, group.dataParts+group.parityParts, reedsolomon.MaxShards)
+				for j := 0; j < group.dataParts; j++ {
+					require.Equal(t, i, layout.group(group.dataStart+j))
+				}
+				for j := 0; j < group.parityParts; j++ {
+					require.Equal(t, i, layout.group(group.parityStart+j))
+				}
+				index += group.dataParts + group.parityParts
+			}
+			require.Equal(t, total, index)
+		}
+	}
+
+	_, err := erasureLayoutFromTotal(1, 50)
+	assert.Error(t, err)
+	_, err = erasureLayoutFromTotal(4, 50)
+	assert.Error(t, err)
+}
+
+func TestErasurePartSet(t *testing.T) {
+	const partSize = 64
+	rng := rand.New(rand.NewSource(1))
+	testCases := []struct {
+		size          int
+		parityPercent int64
+	}{
+		{0, 50},
+		{10, 100},
+		{partSize * 100, 25},
+		{partSize*300 + 7, 50},
+	}
+	for _, tc := range testCases {
+		data := tmrand.Bytes(tc.size)
+		partSet := NewPartSetFromDataWithParity(data, partSize, tc.parityPercent)
+		require.True(t, partSet.IsErasureCoded())
+		require.True(t, partSet.IsComplete())
+		layout := partSet.erasure.layout
+		assert.EqualValues(t, layout.total(), partSet.Total())
+
+		data2, err := ioutil.ReadAll(partSet.GetReader())
+		require.NoError(t, err)
+		assert.Equal(t, data, data2)
+
+		// add the parts in random order until the block can be rebuilt
+		partSet2, err := NewPartSetFromHeaderWithParity(partSet.Header(), tc.parityPercent)
+		require.NoError(t, err)
+		added := 0
+		for _, i := range rng.Perm(int(partSet.Total())) {
+			ok, err := partSet2.AddPart(partSet.GetPart(i))
+			require.NoError(t, err)
+			require.True(t, ok)
+			added++
+			if partSet2.IsComplete() {
+				break
+			}
+		}
+		require.True(t, partSet2.IsComplete())
+		assert.GreaterOrEqual(t, added, layout.dataParts)
+		assert.Equal(t, partSet.BitArray(), partSet2.BitArray())
+		for i := 0; i < int(partSet.Total()); i++ {
+			assert.Equal(t, partSet.GetPart(i), partSet2.GetPart(i))
+		}
+
+		data2, err = ioutil.ReadAll(partSet2.GetReader())
+		require.NoError(t, err)
+		assert.Equal(t, data, data2)
+	}
+}
+
+func TestErasurePartSetFromDataParts(t *testing.T) {
+	data := tmrand.Bytes(1000)
+	partSet := NewPartSetFromDataWithParity(data, 64, 50)
+	partSet2, err := NewPartSetFromHeaderWithParity(partSet.Header(), 50)
+	require.NoError(t, err)
+
+	// any subset of the size of the data parts rebuilds the block
+	layout := partSet.erasure.layout
+	for i := int(partSet.Total()) - 1; i >= int(partSet.Total())-layout.dataParts; i-- {
+		_, err := partSet2.AddPart(partSet.GetPart(i))
+		require.NoError(t, err)
+	}
+	require.True(t, partSet2.IsComplete())
+	data2, err := ioutil.ReadAll(partSet2.GetReader())
+	require.NoError(t, err)
+	assert.Equal(t, data, data2)
+}
+
+func TestErasurePartSetInvalidParity(t *testing.T) {
+	partSet := NewPartSetFromDataWithParity(tmrand.Bytes(1000), 64, 50)
+	layout := partSet.erasure.layout
+
+	// a part set whose parity does not match its data
+	partsBytes := make([][]byte, partSet.Total())
+	for i := range partsBytes {
+		partsBytes[i] = append([]byte(nil), partSet.GetPart(i).Bytes...)
+	}
+	partsBytes[layout.dataParts][0] ^= 0xff
+	invalid := newFullPartSet(partsBytes)
+
+	partSet2, err := NewPartSetFromHeaderWithParity(invalid.Header(), 50)
+	require.NoError(t, err)
+	for i := 0; i < layout.dataParts-1; i++ {
+		_, err := partSet2.AddPart(invalid.GetPart(i))
+		require.NoError(t, err)
+	}
+	added, err := partSet2.AddPart(invalid.GetPart(layout.dataParts - 1))
+	assert.True(t, added)
+	assert.ErrorIs(t, err, ErrPartSetInvalidErasureCoding)
+	assert.False(t, partSet2.IsComplete())
+
+	added, err = partSet2.AddPart(invalid.GetPart(layout.dataParts))
+	assert.False(t, added)
+	assert.ErrorIs(t, err, ErrPartSetInvalidErasureCoding)
+}
+
+func TestMaxPartSetByteSize(t *testing.T) {
+	assert.EqualValues(t, 1000, MaxPartSetByteSize(1000, 64, 0))
+	for _, maxBytes := range []int64{1, 1000, 100000} {
+		data := make([]byte, maxBytes)
+		partSet := NewPartSetFromDataWithParity(data, 64, 50)
+		assert.LessOrEqual(t, partSet.ByteSize(), MaxPartSetByteSize(maxBytes, 64, 50))
+	}
+}
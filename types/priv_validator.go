@@ -19,7 +19,9 @@ import (
 type PrivValidator interface {
 	GetPubKey() (crypto.PubKey, error)
 
-	SignVote(chainID string, vote *tmproto.Vote) error
+	// SignVote signs the vote, and its extension when signExtension is set,
+	// i.e. once the vote extensions are enabled at the vote's height.
+	SignVote(chainID string, vote *tmproto.Vote, signExtension bool) error
 	SignProposal(chainID string, proposal *tmproto.Proposal) error
 
 	GenerateVRFProof(message []byte) (crypto.Proof, error)
@@ -105,7 +107,7 @@ func (pv MockPV) GetPubKey() (crypto.PubKey, error) {
 }
 
 // Implements PrivValidator.
-func (pv MockPV) SignVote(chainID string, vote *tmproto.Vote) error {
+func (pv MockPV) SignVote(chainID string, vote *tmproto.Vote, signExtension bool) error {
 	useChainID := chainID
 	if pv.breakVoteSigning {
 		useChainID = "incorrect-chain-id"
@@ -117,6 +119,14 @@ func (pv MockPV) SignVote(chainID string, vote *tmproto.Vote) error {
 		return err
 	}
 	vote.Signature = sig
+
+	if signExtension && IsProtoVoteExtendable(vote) {
+		extSig, err := pv.PrivKey.Sign(VoteExtensionSignBytes(useChainID, vote))
+		if err != nil {
+			return err
+		}
+		vote.ExtensionSignature = extSig
+	}
 	return nil
 }
 
@@ -169,7 +179,7 @@ type ErroringMockPV struct {
 var ErroringMockPVErr = errors.New("erroringMockPV always returns an error")
 
 // Implements PrivValidator.
-func (pv *ErroringMockPV) SignVote(chainID string, vote *tmproto.Vote) error {
+func (pv *ErroringMockPV) SignVote(chainID string, vote *tmproto.Vote, signExtension bool) error {
 	return ErroringMockPVErr
 }
 
@@ -112,11 +112,13 @@ func (oc2pb) ValidatorUpdates(vals *ValidatorSet) []abci.ValidatorUpdate {
 func (oc2pb) ConsensusParams(params *tmproto.ConsensusParams) *abci.ConsensusParams {
 	return &abci.ConsensusParams{
 		Block: &abci.BlockParams{
-			MaxBytes: params.Block.MaxBytes,
-			MaxGas:   params.Block.MaxGas,
+			MaxBytes:             params.Block.MaxBytes,
+			MaxGas:               params.Block.MaxGas,
+			ErasureParityPercent: params.Block.ErasureParityPercent,
 		},
 		Evidence:  &params.Evidence,
 		Validator: &params.Validator,
+		ABCI:      &params.ABCI,
 	}
 }
 
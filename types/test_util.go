@@ -37,11 +37,14 @@ func MakeCommit(blockID BlockID, height int64, round int32,
 
 func signAddVote(privVal PrivValidator, vote *Vote, voteSet *VoteSet) (signed bool, err error) {
 	v := vote.ToProto()
-	err = privVal.SignVote(voteSet.ChainID(), v)
+	err = privVal.SignVote(voteSet.ChainID(), v, voteSet.ExtensionsEnabled())
 	if err != nil {
 		return false, err
 	}
 	vote.Signature = v.Signature
+	if voteSet.ExtensionsEnabled() {
+		vote.ExtensionSignature = v.ExtensionSignature
+	}
 	return voteSet.AddVote(vote)
 }
 
@@ -70,7 +73,7 @@ func MakeVote(
 	}
 	v := vote.ToProto()
 
-	if err := privVal.SignVote(chainID, v); err != nil {
+	if err := privVal.SignVote(chainID, v, false); err != nil {
 		return nil, err
 	}
 
@@ -347,7 +347,7 @@ func TestProposerSelection3(t *testing.T) {
 		got := vset.SelectProposer([]byte{}, int64(i), 0).Address
 		expected := proposerOrder[i].Address
 		if !bytes.Equal(got, expected) {
-			t.Fatalf(fmt.Sprintf("vset.Proposer (%X) does not match expected proposer (%X) for %d", got, expected, i))
+			t.Fatalf("vset.Proposer (%X) does not match expected proposer (%X) for %d", got, expected, i)
 		}
 
 		// serialize, deserialize, check proposer
@@ -358,12 +358,10 @@ func TestProposerSelection3(t *testing.T) {
 		if i != 0 {
 			if !bytes.Equal(got, computed.Address) {
 				t.Fatalf(
-					fmt.Sprintf(
-						"vset.Proposer (%X) does not match computed proposer (%X) for %d",
-						got,
-						computed.Address,
-						i,
-					),
+					"vset.Proposer (%X) does not match computed proposer (%X) for %d",
+					got,
+					computed.Address,
+					i,
 				)
 			}
 		}
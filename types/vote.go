@@ -17,6 +17,9 @@ import (
 
 const (
 	nilVoteStr string = "nil-Vote"
+
+	// MaxVoteExtensionSize is the maximum size of a vote extension.
+	MaxVoteExtensionSize int = 65536 // 64kB
 )
 
 var (
@@ -27,6 +30,8 @@ var (
 	ErrVoteInvalidBlockHash          = errors.New("invalid block hash")
 	ErrVoteNonDeterministicSignature = errors.New("non-deterministic signature")
 	ErrVoteNil                       = errors.New("nil vote")
+	ErrVoteExtensionAbsent           = errors.New("vote extension absent")
+	ErrVoteInvalidExtensionSignature = errors.New("invalid vote extension signature")
 )
 
 type ErrVoteConflictingVotes struct {
@@ -59,6 +64,12 @@ type Vote struct {
 	ValidatorAddress Address               `json:"validator_address"`
 	ValidatorIndex   int32                 `json:"validator_index"`
 	Signature        []byte                `json:"signature"`
+	// Extension and ExtensionSignature are only set on the precommits for a
+	// block, once the vote extensions are enabled. The extension is signed
+	// apart from the vote, so that the signature of the vote can still be
+	// aggregated.
+	Extension          []byte `json:"extension"`
+	ExtensionSignature []byte `json:"extension_signature"`
 }
 
 func MaxVoteBytes(signSize int) int64 {
@@ -106,6 +117,20 @@ func (vote *Vote) CommitSig() CommitSig {
 	}
 }
 
+// ExtendedCommitSig converts the Vote to an ExtendedCommitSig, which carries
+// the vote extension along with the CommitSig.
+func (vote *Vote) ExtendedCommitSig() ExtendedCommitSig {
+	if vote == nil {
+		return NewExtendedCommitSigAbsent()
+	}
+
+	return ExtendedCommitSig{
+		CommitSig:          vote.CommitSig(),
+		Extension:          vote.Extension,
+		ExtensionSignature: vote.ExtensionSignature,
+	}
+}
+
 // VoteSignBytes returns the proto-encoding of the canonicalized Vote, for
 // signing. Panics is the marshaling fails.
 //
@@ -124,6 +149,20 @@ func VoteSignBytes(chainID string, vote *tmproto.Vote) []byte {
 	return bz
 }
 
+// VoteExtensionSignBytes returns the proto-encoding of the canonicalized vote
+// extension, for signing. Panics if the marshaling fails.
+//
+// See CanonicalizeVoteExtension
+func VoteExtensionSignBytes(chainID string, vote *tmproto.Vote) []byte {
+	pb := CanonicalizeVoteExtension(chainID, vote)
+	bz, err := protoio.MarshalDelimited(&pb)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
 func (vote *Vote) Copy() *Vote {
 	voteCopy := *vote
 	return &voteCopy
@@ -179,6 +218,62 @@ func (vote *Vote) Verify(chainID string, pubKey crypto.PubKey) error {
 	return nil
 }
 
+// VerifyExtension checks the signature of the vote extension. Only the
+// precommits for a block carry an extension, so any other vote passes.
+func (vote *Vote) VerifyExtension(chainID string, pubKey crypto.PubKey) error {
+	if !vote.isExtendable() {
+		return nil
+	}
+	if !bytes.Equal(pubKey.Address(), vote.ValidatorAddress) {
+		return ErrVoteInvalidValidatorAddress
+	}
+	v := vote.ToProto()
+	if !pubKey.VerifySignature(VoteExtensionSignBytes(chainID, v), vote.ExtensionSignature) {
+		return ErrVoteInvalidExtensionSignature
+	}
+	return nil
+}
+
+// VerifyVoteAndExtension checks the signatures of both the vote and its
+// extension.
+func (vote *Vote) VerifyVoteAndExtension(chainID string, pubKey crypto.PubKey) error {
+	if err := vote.Verify(chainID, pubKey); err != nil {
+		return err
+	}
+	return vote.VerifyExtension(chainID, pubKey)
+}
+
+// EnsureExtension checks that a precommit for a block carries an extension
+// signature. The extension itself may be empty.
+func (vote *Vote) EnsureExtension() error {
+	if vote.isExtendable() && len(vote.ExtensionSignature) == 0 {
+		return ErrVoteExtensionAbsent
+	}
+	return nil
+}
+
+// StripExtension removes the extension and its signature from the vote, and
+// returns true if there was anything to remove.
+func (vote *Vote) StripExtension() bool {
+	stripped := len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0
+	vote.Extension = nil
+	vote.ExtensionSignature = nil
+	return stripped
+}
+
+// IsProtoVoteExtendable returns true for the precommits for a block, which are
+// the only votes carrying an extension. Signers use it to know whether to sign
+// the vote extension along with the vote.
+func IsProtoVoteExtendable(vote *tmproto.Vote) bool {
+	return vote.Type == tmproto.PrecommitType && len(vote.BlockID.Hash) != 0
+}
+
+// isExtendable returns true for the precommits for a block, which are the only
+// votes carrying an extension.
+func (vote *Vote) isExtendable() bool {
+	return vote.Type == tmproto.PrecommitType && !vote.BlockID.IsZero()
+}
+
 // ValidateBasic performs basic validation.
 func (vote *Vote) ValidateBasic() error {
 	if !IsVoteTypeValid(vote.Type) {
@@ -222,6 +317,26 @@ func (vote *Vote) ValidateBasic() error {
 		return fmt.Errorf("signature is too big %d (max: %d)", len(vote.Signature), MaxSignatureSize)
 	}
 
+	if !vote.isExtendable() {
+		if len(vote.Extension) > 0 || len(vote.ExtensionSignature) > 0 {
+			return errors.New("unexpected vote extension; only precommits for a block may carry one")
+		}
+		return nil
+	}
+
+	if len(vote.Extension) > MaxVoteExtensionSize {
+		return fmt.Errorf("vote extension is too big %d (max: %d)", len(vote.Extension), MaxVoteExtensionSize)
+	}
+
+	if len(vote.ExtensionSignature) > MaxSignatureSize {
+		return fmt.Errorf("vote extension signature is too big %d (max: %d)",
+			len(vote.ExtensionSignature), MaxSignatureSize)
+	}
+
+	if len(vote.Extension) > 0 && len(vote.ExtensionSignature) == 0 {
+		return errors.New("vote extension signature is missing")
+	}
+
 	return nil
 }
 
@@ -233,14 +348,16 @@ func (vote *Vote) ToProto() *tmproto.Vote {
 	}
 
 	return &tmproto.Vote{
-		Type:             vote.Type,
-		Height:           vote.Height,
-		Round:            vote.Round,
-		BlockID:          vote.BlockID.ToProto(),
-		Timestamp:        vote.Timestamp,
-		ValidatorAddress: vote.ValidatorAddress,
-		ValidatorIndex:   vote.ValidatorIndex,
-		Signature:        vote.Signature,
+		Type:               vote.Type,
+		Height:             vote.Height,
+		Round:              vote.Round,
+		BlockID:            vote.BlockID.ToProto(),
+		Timestamp:          vote.Timestamp,
+		ValidatorAddress:   vote.ValidatorAddress,
+		ValidatorIndex:     vote.ValidatorIndex,
+		Signature:          vote.Signature,
+		Extension:          vote.Extension,
+		ExtensionSignature: vote.ExtensionSignature,
 	}
 }
 
@@ -265,6 +382,8 @@ func VoteFromProto(pv *tmproto.Vote) (*Vote, error) {
 	vote.ValidatorAddress = pv.ValidatorAddress
 	vote.ValidatorIndex = pv.ValidatorIndex
 	vote.Signature = pv.Signature
+	vote.Extension = pv.Extension
+	vote.ExtensionSignature = pv.ExtensionSignature
 
 	return vote, vote.ValidateBasic()
 }
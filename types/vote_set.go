@@ -66,6 +66,10 @@ type VoteSet struct {
 	signedMsgType tmproto.SignedMsgType
 	voterSet      *VoterSet
 
+	// extensionsEnabled requires the precommits for a block to carry a vote
+	// extension, whose signature is verified along with the vote.
+	extensionsEnabled bool
+
 	mtx           tmsync.Mutex
 	votesBitArray *bits.BitArray
 	votes         []*Vote                // Primary votes to share
@@ -96,6 +100,16 @@ func NewVoteSet(chainID string, height int64, round int32,
 	}
 }
 
+// NewExtendedVoteSet constructs a vote set with the vote extensions enabled.
+// The precommits for a block must carry an extension signature, which is
+// verified when adding them.
+func NewExtendedVoteSet(chainID string, height int64, round int32,
+	signedMsgType tmproto.SignedMsgType, voterSet *VoterSet) *VoteSet {
+	voteSet := NewVoteSet(chainID, height, round, signedMsgType, voterSet)
+	voteSet.extensionsEnabled = true
+	return voteSet
+}
+
 // used only test
 func (voteSet *VoteSet) GetSum() int64 {
 	if voteSet == nil {
@@ -110,6 +124,15 @@ func (voteSet *VoteSet) ChainID() string {
 	return voteSet.chainID
 }
 
+// ExtensionsEnabled returns true if the votes of this set carry vote
+// extensions.
+func (voteSet *VoteSet) ExtensionsEnabled() bool {
+	if voteSet == nil {
+		return false
+	}
+	return voteSet.extensionsEnabled
+}
+
 // Implements VoteSetReader.
 func (voteSet *VoteSet) GetHeight() int64 {
 	if voteSet == nil {
@@ -158,6 +181,9 @@ func (voteSet *VoteSet) AddVote(vote *Vote) (added bool, err error) {
 	voteSet.mtx.Lock()
 	defer voteSet.mtx.Unlock()
 
+	if voteSet.extensionsEnabled {
+		return voteSet.addVote(vote, vote.VerifyVoteAndExtension)
+	}
 	return voteSet.addVote(vote, vote.Verify)
 }
 
@@ -172,6 +198,9 @@ func (voteSet *VoteSet) AddAggregatedVote(vote *Vote) (added bool, err error) {
 		if !bytes.Equal(pubKey.Address(), vote.ValidatorAddress) {
 			return ErrVoteInvalidValidatorAddress
 		}
+		if voteSet.extensionsEnabled {
+			return vote.VerifyExtension(chainID, pubKey)
+		}
 		return nil
 	})
 }
@@ -202,6 +231,13 @@ func (voteSet *VoteSet) addVote(vote *Vote, execVoteVerify func(chainID string,
 			vote.Height, vote.Round, vote.Type, ErrVoteUnexpectedStep)
 	}
 
+	// Ensure that the extension is present if required.
+	if voteSet.extensionsEnabled {
+		if err := vote.EnsureExtension(); err != nil {
+			return false, err
+		}
+	}
+
 	// Ensure that signer is a validator.
 	lookupAddr, voter := voteSet.voterSet.GetByIndex(valIndex)
 	if voter == nil {
@@ -670,6 +706,49 @@ func (voteSet *VoteSet) MakeCommit() *Commit {
 	return newCommit
 }
 
+// MakeExtendedCommit constructs an ExtendedCommit from the VoteSet. Like
+// MakeCommit, it only includes precommits for the block, which has 2/3+
+// majority, and nil, and aggregates their signatures. The extensions and their
+// signatures are kept apart from the aggregate.
+//
+// Panics if the vote type is not PrecommitType or if there's no +2/3 votes for
+// a single block.
+func (voteSet *VoteSet) MakeExtendedCommit() *ExtendedCommit {
+	if voteSet.signedMsgType != tmproto.PrecommitType {
+		panic("Cannot MakeExtendedCommit() unless VoteSet.Type is PrecommitType")
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	// Make sure we have a 2/3 majority
+	if voteSet.maj23 == nil {
+		panic("Cannot MakeExtendedCommit() unless a blockhash has +2/3")
+	}
+
+	// For every validator, get the precommit with its extension
+	sigs := make([]ExtendedCommitSig, len(voteSet.votes))
+	for i, v := range voteSet.votes {
+		sig := v.ExtendedCommitSig()
+		if !sig.Absent() && sig.Signature == nil {
+			panic(fmt.Sprintf("This signature of commitSig is already aggregated: commitSig: <%v>", sig))
+		}
+		// if block ID exists but doesn't match, exclude sig
+		if sig.ForBlock() && !v.BlockID.Equals(*voteSet.maj23) {
+			sig = NewExtendedCommitSigAbsent()
+		}
+		sigs[i] = sig
+	}
+	extCommit := &ExtendedCommit{
+		Height:             voteSet.GetHeight(),
+		Round:              voteSet.GetRound(),
+		BlockID:            *voteSet.maj23,
+		ExtendedSignatures: sigs,
+	}
+	extCommit.AggregateSignatures()
+
+	return extCommit
+}
+
 //--------------------------------------------------------------------------------
 
 /*
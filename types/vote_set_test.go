@@ -69,7 +69,7 @@ func addVoteByAllVoterSet(t *testing.T, voteSet *VoteSet, privVals []PrivValidat
 			ValidatorIndex:   int32(i),
 		}
 		v := vote.ToProto()
-		err := privVals[i].SignVote(voteSet.chainID, v)
+		err := privVals[i].SignVote(voteSet.chainID, v, false)
 		require.NoError(t, err)
 		vote.Signature = v.Signature
 		added, err := voteSet.AddVote(vote)
@@ -653,3 +653,103 @@ func TestVoteSet_MakeCommit(t *testing.T) {
 		}
 	})
 }
+
+func TestVoteSet_MakeExtendedCommit(t *testing.T) {
+	height, round := int64(1), int32(0)
+	privKeys := [...]crypto.PrivKey{
+		bls.GenPrivKey(),
+		composite.GenPrivKey(),
+		ed25519.GenPrivKey(),
+		bls.GenPrivKey(),
+	}
+	_, _, voterSet, privValidators := randVoteSetForPrivKeys(height, round, tmproto.PrecommitType, privKeys[:], 1)
+	voteSet := NewExtendedVoteSet("test_chain_id", height, round, tmproto.PrecommitType, voterSet)
+	blockID := BlockID{crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}}
+
+	voteProto := &Vote{
+		Height:    height,
+		Round:     round,
+		Timestamp: tmtime.Now(),
+		Type:      tmproto.PrecommitType,
+		BlockID:   blockID,
+	}
+
+	// A precommit for a block without an extension signature is rejected.
+	{
+		pubKey, err := privValidators[0].GetPubKey()
+		require.NoError(t, err)
+		vote := withValidator(voteProto, pubKey.Address(), 0)
+		v := vote.ToProto()
+		require.NoError(t, privValidators[0].SignVote(voteSet.ChainID(), v, true))
+		vote.Signature = v.Signature
+		added, err := voteSet.AddVote(vote)
+		assert.False(t, added)
+		assert.ErrorIs(t, err, ErrVoteExtensionAbsent)
+	}
+
+	// A tampered extension is rejected.
+	{
+		pubKey, err := privValidators[0].GetPubKey()
+		require.NoError(t, err)
+		vote := withValidator(voteProto, pubKey.Address(), 0)
+		vote.Extension = []byte("extension")
+		v := vote.ToProto()
+		require.NoError(t, privValidators[0].SignVote(voteSet.ChainID(), v, true))
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+		vote.Extension = []byte("tampered")
+		added, err := voteSet.AddVote(vote)
+		assert.False(t, added)
+		assert.ErrorIs(t, err, ErrVoteInvalidExtensionSignature)
+	}
+
+	for i := range privKeys {
+		pubKey, err := privValidators[i].GetPubKey()
+		require.NoError(t, err)
+		vote := withValidator(voteProto, pubKey.Address(), int32(i))
+		vote.Extension = []byte(fmt.Sprintf("extension-%d", i))
+		_, err = signAddVote(privValidators[i], vote, voteSet)
+		require.NoError(t, err)
+	}
+
+	extCommit := voteSet.MakeExtendedCommit()
+	require.NoError(t, extCommit.ValidateBasic())
+	require.NoError(t, extCommit.EnsureExtensions())
+	assert.NotNil(t, extCommit.AggregatedSignature)
+	for i, sig := range extCommit.ExtendedSignatures {
+		assert.Equal(t, []byte(fmt.Sprintf("extension-%d", i)), sig.Extension)
+		assert.NotEmpty(t, sig.ExtensionSignature)
+	}
+
+	// The commit is the same as the one of the vote set.
+	assert.Equal(t, voteSet.MakeCommit().Hash(), extCommit.ToCommit().Hash())
+
+	// The aggregated signature and the extension signatures are verified.
+	require.NoError(t, voterSet.VerifyExtendedCommit(voteSet.ChainID(), blockID, height, extCommit))
+
+	// The vote set is restored with its extensions.
+	restored := ExtendedCommitToVoteSet(voteSet.ChainID(), extCommit, voterSet)
+	assert.True(t, restored.ExtensionsEnabled())
+	assert.True(t, restored.HasTwoThirdsMajority())
+	for i := range privKeys {
+		assert.Equal(t, extCommit.ExtendedSignatures[i].Extension, restored.GetByIndex(int32(i)).Extension)
+	}
+
+	t.Run("TamperedExtension", func(t *testing.T) {
+		pb := extCommit.ToProto()
+		pb.ExtendedSignatures[2].Extension = []byte("tampered")
+		tampered, err := ExtendedCommitFromProto(pb)
+		require.NoError(t, err)
+		err = voterSet.VerifyExtendedCommit(voteSet.ChainID(), blockID, height, tampered)
+		assert.ErrorIs(t, err, ErrVoteInvalidExtensionSignature)
+	})
+
+	t.Run("MissingExtension", func(t *testing.T) {
+		pb := extCommit.ToProto()
+		pb.ExtendedSignatures[1].ExtensionSignature = nil
+		missing, err := ExtendedCommitFromProto(pb)
+		require.NoError(t, err)
+		err = voterSet.VerifyExtendedCommit(voteSet.ChainID(), blockID, height, missing)
+		assert.ErrorIs(t, err, ErrVoteExtensionAbsent)
+	})
+}
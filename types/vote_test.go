@@ -168,7 +168,7 @@ func TestVoteVerifySignature(t *testing.T) {
 		signBytes := VoteSignBytes("test_chain_id", v)
 
 		// sign it
-		err = privVal.SignVote("test_chain_id", v)
+		err = privVal.SignVote("test_chain_id", v, false)
 		require.NoError(t, err)
 
 		// verify the same vote
@@ -255,7 +255,7 @@ func TestMaxVoteBytes(t *testing.T) {
 	forAllPrivKeyTypes(t, func(t *testing.T, name string, kt PrivKeyType) {
 		privVal := NewMockPV(kt)
 		pbVote := vote.ToProto()
-		err := privVal.SignVote("test_chain_id", pbVote)
+		err := privVal.SignVote("test_chain_id", pbVote, false)
 		require.NoError(t, err)
 
 		bz, err := pbVote.Marshal()
@@ -294,6 +294,26 @@ func TestVoteValidateBasic(t *testing.T) {
 		{"Invalid ValidatorIndex", func(v *Vote) { v.ValidatorIndex = -1 }, true},
 		{"Invalid Signature", func(v *Vote) { v.Signature = nil }, true},
 		{"Too big Signature", func(v *Vote) { v.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Extension", func(v *Vote) {
+			v.Extension = []byte("extension")
+			v.ExtensionSignature = make([]byte, MaxSignatureSize)
+		}, false},
+		{"Extension without Signature", func(v *Vote) { v.Extension = []byte("extension") }, true},
+		{"Too big Extension", func(v *Vote) {
+			v.Extension = make([]byte, MaxVoteExtensionSize+1)
+			v.ExtensionSignature = make([]byte, MaxSignatureSize)
+		}, true},
+		{"Too big Extension Signature", func(v *Vote) {
+			v.ExtensionSignature = make([]byte, MaxSignatureSize+1)
+		}, true},
+		{"Extension on nil Precommit", func(v *Vote) {
+			v.BlockID = BlockID{}
+			v.ExtensionSignature = make([]byte, MaxSignatureSize)
+		}, true},
+		{"Extension on Prevote", func(v *Vote) {
+			v.Type = tmproto.PrevoteType
+			v.Extension = []byte("extension")
+		}, true},
 	}
 	forAllPrivKeyTypes(t, func(t *testing.T, name string, kt PrivKeyType) {
 		privVal := NewMockPV(kt)
@@ -302,7 +322,7 @@ func TestVoteValidateBasic(t *testing.T) {
 			t.Run(tc.testName, func(t *testing.T) {
 				vote := examplePrecommit()
 				v := vote.ToProto()
-				err := privVal.SignVote("test_chain_id", v)
+				err := privVal.SignVote("test_chain_id", v, true)
 				vote.Signature = v.Signature
 				require.NoError(t, err)
 				tc.malleateVote(vote)
@@ -312,13 +332,61 @@ func TestVoteValidateBasic(t *testing.T) {
 	})
 }
 
+func TestVoteExtension(t *testing.T) {
+	forAllPrivKeyTypes(t, func(t *testing.T, name string, kt PrivKeyType) {
+		privVal := NewMockPV(kt)
+		pubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+
+		vote := examplePrecommit()
+		vote.ValidatorAddress = pubKey.Address()
+		vote.Extension = []byte("extension")
+		v := vote.ToProto()
+		require.NoError(t, privVal.SignVote("test_chain_id", v, true))
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+
+		require.NoError(t, vote.EnsureExtension())
+		require.NoError(t, vote.VerifyVoteAndExtension("test_chain_id", pubKey))
+
+		// The extension is signed apart from the vote.
+		tampered := vote.Copy()
+		tampered.Extension = []byte("tampered")
+		require.NoError(t, tampered.Verify("test_chain_id", pubKey))
+		require.ErrorIs(t, tampered.VerifyExtension("test_chain_id", pubKey), ErrVoteInvalidExtensionSignature)
+		require.ErrorIs(t, vote.VerifyExtension("other_chain_id", pubKey), ErrVoteInvalidExtensionSignature)
+
+		// The extension sign bytes are bound to the height and the round.
+		other := vote.Copy()
+		other.Round++
+		assert.NotEqual(t,
+			VoteExtensionSignBytes("test_chain_id", vote.ToProto()),
+			VoteExtensionSignBytes("test_chain_id", other.ToProto()))
+
+		assert.True(t, vote.StripExtension())
+		assert.Nil(t, vote.Extension)
+		assert.Nil(t, vote.ExtensionSignature)
+		assert.ErrorIs(t, vote.EnsureExtension(), ErrVoteExtensionAbsent)
+		assert.False(t, vote.StripExtension())
+
+		// Neither prevotes nor nil precommits carry an extension.
+		for _, vote := range []*Vote{examplePrevote(), {Type: tmproto.PrecommitType}} {
+			v := vote.ToProto()
+			require.NoError(t, privVal.SignVote("test_chain_id", v, true))
+			assert.Nil(t, v.ExtensionSignature)
+		}
+	})
+}
+
 func TestVoteProtobuf(t *testing.T) {
 	forAllPrivKeyTypes(t, func(t *testing.T, name string, kt PrivKeyType) {
 		privVal := NewMockPV(kt)
 		vote := examplePrecommit()
+		vote.Extension = []byte("extension")
 		v := vote.ToProto()
-		err := privVal.SignVote("test_chain_id", v)
+		err := privVal.SignVote("test_chain_id", v, true)
 		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
 		require.NoError(t, err)
 
 		testCases := []struct {
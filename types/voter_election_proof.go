@@ -0,0 +1,109 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/vrf"
+	tmbytes "github.com/line/ostracon/libs/bytes"
+)
+
+// VoterElectionProof holds everything needed to recompute the election of the
+// voters of a height, without trusting the node that provided it.
+//
+// The voters of height H are elected from the validators of H with the proof
+// hash of the VRF proof in the header of H-1. That proof is made by the
+// proposer of H-1 from the proof hash of H-2 (or the genesis), the height H-2
+// and the round of H-1.
+type VoterElectionProof struct {
+	// ProofHeader is the header of the block before the voters' height. It is
+	// the caller's job to check it against a trusted block ID.
+	ProofHeader *Header `json:"proof_header"`
+	// ProposerPubKey is the public key of the proposer of ProofHeader.
+	ProposerPubKey crypto.PubKey `json:"proposer_pub_key"`
+	// PreviousProofHash is the proof hash ProofHeader's proof was made from.
+	PreviousProofHash tmbytes.HexBytes `json:"previous_proof_hash"`
+	// Validators are the validators of the voters' height.
+	Validators *ValidatorSet `json:"validators"`
+	// VoterParams are the voter params of the voters' height. They aren't
+	// committed to by the header, see VerifyVoterElection.
+	VoterParams *VoterParams `json:"voter_params"`
+}
+
+// Height returns the height of the voters elected by the proof.
+func (p *VoterElectionProof) Height() int64 {
+	return p.ProofHeader.Height + 1
+}
+
+// ValidateBasic performs basic validation, and checks that the proposer
+// public key and the validators are those committed to by ProofHeader.
+func (p *VoterElectionProof) ValidateBasic() error {
+	if p.ProofHeader == nil {
+		return errors.New("missing proof header")
+	}
+	if err := p.ProofHeader.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid proof header: %w", err)
+	}
+	if p.ProposerPubKey == nil {
+		return errors.New("missing proposer public key")
+	}
+	if !bytes.Equal(p.ProposerPubKey.Address(), p.ProofHeader.ProposerAddress) {
+		return fmt.Errorf("proposer public key has address %X, expected %X",
+			p.ProposerPubKey.Address(), p.ProofHeader.ProposerAddress)
+	}
+	if p.Validators == nil {
+		return errors.New("missing validators")
+	}
+	if err := p.Validators.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid validators: %w", err)
+	}
+	if !bytes.Equal(p.Validators.Hash(), p.ProofHeader.NextValidatorsHash) {
+		return fmt.Errorf("validators hash %X does not match the next validators hash %X of the proof header",
+			p.Validators.Hash(), p.ProofHeader.NextValidatorsHash)
+	}
+	if p.VoterParams == nil {
+		return errors.New("missing voter params")
+	}
+	return p.VoterParams.Validate()
+}
+
+// ElectVoters verifies the VRF proof of ProofHeader and recomputes the
+// election of the voters with it.
+func (p *VoterElectionProof) ElectVoters() (*VoterSet, error) {
+	if err := p.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	message := MakeRoundHash(p.PreviousProofHash, p.ProofHeader.Height-1, p.ProofHeader.Round)
+	if _, err := p.ProposerPubKey.VRFVerify(crypto.Proof(p.ProofHeader.Proof), message); err != nil {
+		return nil, fmt.Errorf("invalid VRF proof of height %d: %w", p.ProofHeader.Height, err)
+	}
+	proofHash, err := vrf.ProofToHash(p.ProofHeader.Proof.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("invalid VRF proof of height %d: %w", p.ProofHeader.Height, err)
+	}
+
+	return SelectVoter(p.Validators, proofHash, p.VoterParams), nil
+}
+
+// VerifyVoterElection recomputes the election of the voters of the height
+// after proof's header, and checks the result against votersHash, which
+// should come from the trusted header of that height. The voter params aren't
+// committed to by any header, so they must come from a trusted source, e.g.
+// the genesis doc of the chain, and the proof must carry the same ones. It
+// returns the voters.
+func VerifyVoterElection(proof *VoterElectionProof, voterParams *VoterParams, votersHash []byte) (*VoterSet, error) {
+	if proof.VoterParams == nil || voterParams == nil || *proof.VoterParams != *voterParams {
+		return nil, fmt.Errorf("voter params %v do not match the trusted %v", proof.VoterParams, voterParams)
+	}
+	voters, err := proof.ElectVoters()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(voters.Hash(), votersHash) {
+		return nil, fmt.Errorf("elected voters hash %X does not match the expected %X", voters.Hash(), votersHash)
+	}
+	return voters, nil
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/crypto/ed25519"
+	tmbytes "github.com/line/ostracon/libs/bytes"
+	tmrand "github.com/line/ostracon/libs/rand"
+)
+
+func randValidatorSetWithPowers(n int, power func(i int) int64) *ValidatorSet {
+	vals := make([]*Validator, n)
+	for i := range vals {
+		vals[i], _ = RandValidator(false, power(i))
+	}
+	return NewValidatorSet(vals)
+}
+
+func makeVoterElectionProof(t *testing.T) *VoterElectionProof {
+	proposerKey := ed25519.GenPrivKey()
+	previousProofHash := tmrand.Bytes(32)
+	validators := randValidatorSetWithPowers(50, func(i int) int64 { return int64(i+1) * 100 })
+
+	header := makeHeaderRandom()
+	header.Round = 2
+	header.ProposerAddress = proposerKey.PubKey().Address()
+	header.NextValidatorsHash = validators.Hash()
+	proof, err := proposerKey.VRFProve(MakeRoundHash(previousProofHash, header.Height-1, header.Round))
+	require.NoError(t, err)
+	header.Proof = tmbytes.HexBytes(proof)
+
+	return &VoterElectionProof{
+		ProofHeader:       header,
+		ProposerPubKey:    proposerKey.PubKey(),
+		PreviousProofHash: previousProofHash,
+		Validators:        validators,
+		VoterParams:       &VoterParams{VoterElectionThreshold: 10, MaxTolerableByzantinePercentage: 20},
+	}
+}
+
+func TestVerifyVoterElection(t *testing.T) {
+	proof := makeVoterElectionProof(t)
+	voters, err := proof.ElectVoters()
+	require.NoError(t, err)
+	assert.Less(t, voters.Size(), proof.Validators.Size())
+
+	trusted := *proof.VoterParams
+	verified, err := VerifyVoterElection(proof, &trusted, voters.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, voters.Hash(), verified.Hash())
+
+	_, err = VerifyVoterElection(proof, &trusted, ToVoterAll(proof.Validators.Validators).Hash())
+	assert.Error(t, err)
+
+	// voter params other than the trusted ones are rejected, even if they
+	// elect the same voters
+	proof.VoterParams = &VoterParams{VoterElectionThreshold: 10, MaxTolerableByzantinePercentage: 21}
+	_, err = VerifyVoterElection(proof, &trusted, voters.Hash())
+	assert.Error(t, err)
+	_, err = VerifyVoterElection(proof, nil, voters.Hash())
+	assert.Error(t, err)
+}
+
+func TestVoterElectionProofInvalid(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(p *VoterElectionProof)
+	}{
+		{"missing proof header", func(p *VoterElectionProof) { p.ProofHeader = nil }},
+		{"other proposer", func(p *VoterElectionProof) { p.ProposerPubKey = ed25519.GenPrivKey().PubKey() }},
+		{"other validators", func(p *VoterElectionProof) {
+			p.Validators = randValidatorSetWithPowers(50, func(i int) int64 { return int64(i+1) * 100 })
+		}},
+		{"invalid voter params", func(p *VoterElectionProof) { p.VoterParams.MaxTolerableByzantinePercentage = 0 }},
+		{"other previous proof hash", func(p *VoterElectionProof) { p.PreviousProofHash = tmrand.Bytes(32) }},
+		{"other round", func(p *VoterElectionProof) { p.ProofHeader.Round++ }},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			proof := makeVoterElectionProof(t)
+			tc.malleate(proof)
+			_, err := proof.ElectVoters()
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"encoding/binary"
+	"math/rand"
+)
+
+// ValidatorElectionStats holds how a validator fared in a series of voter
+// elections.
+type ValidatorElectionStats struct {
+	Address     Address `json:"address"`
+	VotingPower int64   `json:"voting_power"`
+
+	// Candidacies is the number of elections the validator took part in, and
+	// Elected the number of those it was elected as a voter in.
+	Candidacies int `json:"candidacies"`
+	Elected     int `json:"elected"`
+
+	// weightShareSum is the sum of the validator's share of the total voting
+	// weight over all its candidacies.
+	weightShareSum float64
+}
+
+// ElectionFrequency returns the fraction of the validator's candidacies it was
+// elected as a voter in.
+func (s *ValidatorElectionStats) ElectionFrequency() float64 {
+	if s.Candidacies == 0 {
+		return 0
+	}
+	return float64(s.Elected) / float64(s.Candidacies)
+}
+
+// RewardShare returns the validator's expected share of the rewards, which
+// are distributed to the voters in proportion to their voting weight, per
+// election it took part in.
+func (s *ValidatorElectionStats) RewardShare() float64 {
+	if s.Candidacies == 0 {
+		return 0
+	}
+	return s.weightShareSum / float64(s.Candidacies)
+}
+
+// VoterElectionStats aggregates the outcome of a series of voter elections,
+// either replayed from the state or simulated with random proof hashes.
+type VoterElectionStats struct {
+	Elections int `json:"elections"`
+
+	// Validators holds the stats of every validator that took part in any of
+	// the elections, in the order they were first seen.
+	Validators []*ValidatorElectionStats `json:"validators"`
+
+	// The minimum, mean and maximum over the elections of the share of the
+	// total voting weight held by the voters with the most voting weight per
+	// voting power whose voting power is within the tolerable byzantine
+	// power. The VRF election only bounds the probability that the byzantine
+	// validators hold 1/3 of the voting weight, so this share isn't
+	// guaranteed to stay below 1/3.
+	MinByzantineWeight  float64 `json:"min_byzantine_weight"`
+	MeanByzantineWeight float64 `json:"mean_byzantine_weight"`
+	MaxByzantineWeight  float64 `json:"max_byzantine_weight"`
+
+	byAddress map[string]*ValidatorElectionStats
+}
+
+// NewVoterElectionStats returns empty VoterElectionStats.
+func NewVoterElectionStats() *VoterElectionStats {
+	return &VoterElectionStats{byAddress: make(map[string]*ValidatorElectionStats)}
+}
+
+// AddElection records the election of voters from validators with the given
+// voter params.
+func (s *VoterElectionStats) AddElection(validators *ValidatorSet, voters *VoterSet, voterParams *VoterParams) {
+	for _, val := range validators.Validators {
+		stats, ok := s.byAddress[string(val.Address)]
+		if !ok {
+			stats = &ValidatorElectionStats{Address: val.Address}
+			s.byAddress[string(val.Address)] = stats
+			s.Validators = append(s.Validators, stats)
+		}
+		stats.VotingPower = val.VotingPower
+		stats.Candidacies++
+	}
+
+	totalVotingWeight := voters.TotalVotingWeight()
+	for _, val := range voters.Voters {
+		stats, ok := s.byAddress[string(val.Address)]
+		if !ok {
+			continue
+		}
+		stats.Elected++
+		if totalVotingWeight > 0 {
+			stats.weightShareSum += float64(val.VotingWeight) / float64(totalVotingWeight)
+		}
+	}
+
+	byzantineWeight := float64(0)
+	if totalVotingWeight > 0 {
+		byzantineWeight = float64(topByzantineVotingWeight(validators, voters, voterParams)) /
+			float64(totalVotingWeight)
+	}
+	if s.Elections == 0 || byzantineWeight < s.MinByzantineWeight {
+		s.MinByzantineWeight = byzantineWeight
+	}
+	if s.Elections == 0 || byzantineWeight > s.MaxByzantineWeight {
+		s.MaxByzantineWeight = byzantineWeight
+	}
+	s.MeanByzantineWeight = (s.MeanByzantineWeight*float64(s.Elections) + byzantineWeight) /
+		float64(s.Elections+1)
+	s.Elections++
+}
+
+// topByzantineVotingWeight returns the voting weight of the voters an attacker
+// holding the tolerable byzantine voting power would best corrupt, as checked
+// by electVotersNonDup.
+func topByzantineVotingWeight(validators *ValidatorSet, voters *VoterSet, voterParams *VoterParams) int64 {
+	tolerableByzantinePower := getTolerableByzantinePower(validators.TotalVotingPower(),
+		int(voterParams.MaxTolerableByzantinePercentage))
+	candidates := make([]*voter, len(voters.Voters))
+	for i, val := range voters.Voters {
+		candidates[i] = &voter{val: val}
+	}
+	sortVoters(candidates)
+	return getTopByzantineVotingWeight(candidates, tolerableByzantinePower)
+}
+
+// SimulateVoterElections runs the given number of voter elections from
+// validators, each with a random proof hash drawn from seed.
+func SimulateVoterElections(
+	validators *ValidatorSet,
+	voterParams *VoterParams,
+	elections int,
+	seed int64,
+) *VoterElectionStats {
+	rnd := rand.New(rand.NewSource(seed)) //nolint:gosec // reproducible simulations
+	stats := NewVoterElectionStats()
+	proofHash := make([]byte, 8)
+	for i := 0; i < elections; i++ {
+		binary.LittleEndian.PutUint64(proofHash, rnd.Uint64())
+		stats.AddElection(validators, SelectVoter(validators, proofHash, voterParams), voterParams)
+	}
+	return stats
+}
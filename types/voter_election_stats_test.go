@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateVoterElections(t *testing.T) {
+	validatorSet := newValidatorSet(50, func(i int) int64 { return int64(i+1) * 100 })
+	voterParams := &VoterParams{VoterElectionThreshold: 10, MaxTolerableByzantinePercentage: 20}
+
+	stats := SimulateVoterElections(validatorSet, voterParams, 200, 1)
+	assert.Equal(t, 200, stats.Elections)
+	require.Len(t, stats.Validators, 50)
+
+	// the simulation is reproducible
+	assert.Equal(t, stats, SimulateVoterElections(validatorSet, voterParams, 200, 1))
+
+	rewardShares := float64(0)
+	weakest, strongest := stats.Validators[0], stats.Validators[0]
+	for _, val := range stats.Validators {
+		if val.VotingPower < weakest.VotingPower {
+			weakest = val
+		}
+		if val.VotingPower > strongest.VotingPower {
+			strongest = val
+		}
+		_, v := validatorSet.GetByAddress(val.Address)
+		assert.Equal(t, v.VotingPower, val.VotingPower)
+		assert.Equal(t, 200, val.Candidacies)
+		assert.True(t, val.Elected <= val.Candidacies)
+		assert.Equal(t, float64(val.Elected)/200, val.ElectionFrequency())
+		rewardShares += val.RewardShare()
+	}
+	assert.InDelta(t, 1, rewardShares, 1e-9)
+
+	// not all the validators are elected each time, but the voters with the
+	// tolerable byzantine power never hold a third of the voting weight
+	assert.Less(t, weakest.ElectionFrequency(), float64(1))
+	assert.True(t, stats.MinByzantineWeight <= stats.MeanByzantineWeight)
+	assert.True(t, stats.MeanByzantineWeight <= stats.MaxByzantineWeight)
+	assert.Less(t, stats.MaxByzantineWeight, float64(1)/3)
+
+	// validators with more voting power are elected more often
+	assert.Greater(t, strongest.ElectionFrequency(), weakest.ElectionFrequency())
+}
+
+func TestVoterElectionStatsAllElected(t *testing.T) {
+	validatorSet := newValidatorSet(10, func(i int) int64 { return 10 })
+
+	stats := SimulateVoterElections(validatorSet, DefaultVoterParams(), 10, 1)
+	for _, val := range stats.Validators {
+		assert.Equal(t, 10, val.Elected)
+		assert.InDelta(t, 0.1, val.RewardShare(), 1e-9)
+	}
+	// all the voters have the same weight, so the byzantine weight share is
+	// that of the tolerable byzantine power
+	assert.InDelta(t, 0.4, stats.MaxByzantineWeight, 1e-9)
+}
@@ -216,6 +216,36 @@ func (voters *VoterSet) VerifyCommit(chainID string, blockID BlockID, height int
 	return nil
 }
 
+// VerifyExtendedCommit verifies +2/3 of the set had signed the given extended
+// commit, like VerifyCommit does with the (possibly BLS aggregated) precommit
+// signatures, and then verifies the signature of each vote extension on its
+// own, since those are never aggregated.
+func (voters *VoterSet) VerifyExtendedCommit(chainID string, blockID BlockID, height int64,
+	extCommit *ExtendedCommit) error {
+	if voters == nil || extCommit == nil {
+		return fmt.Errorf("invalid nil voters or extended commit:[%v] or [%v]", voters, extCommit)
+	}
+
+	if err := voters.VerifyCommit(chainID, blockID, height, extCommit.ToCommit()); err != nil {
+		return err
+	}
+
+	for idx, sig := range extCommit.ExtendedSignatures {
+		if !sig.ForBlock() {
+			continue
+		}
+		if err := sig.EnsureExtension(); err != nil {
+			return fmt.Errorf("wrong extended commit sig (#%d): %w", idx, err)
+		}
+		vote := extCommit.GetExtendedVote(int32(idx))
+		if err := vote.VerifyExtension(chainID, voters.Voters[idx].PubKey); err != nil {
+			return fmt.Errorf("wrong vote extension signature (#%d): %X; %w", idx, sig.ExtensionSignature, err)
+		}
+	}
+
+	return nil
+}
+
 // LIGHT CLIENT VERIFICATION METHODS
 
 // VerifyCommitLight verifies +2/3 of the set had signed the given commit.